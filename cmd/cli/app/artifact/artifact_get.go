@@ -39,6 +39,8 @@ var artifact_getCmd = &cobra.Command{
 		tag := util.GetConfigValue(viper.GetViper(), "tag", "tag", cmd, "").(string)
 		artifactID := viper.GetString("id")
 		latest_versions := viper.GetInt32("latest-versions")
+		profile := viper.GetString("profile")
+		status := viper.GetString("status")
 
 		// tag and latest versions cannot be set at same time
 		if tag != "" && latest_versions != 1 {
@@ -59,6 +61,8 @@ var artifact_getCmd = &cobra.Command{
 			Id:             artifactID,
 			LatestVersions: latest_versions,
 			Tag:            tag,
+			Profile:        profile,
+			Status:         status,
 		})
 		util.ExitNicelyOnError(err, "Error getting repo by id")
 		out, err := util.GetJsonFromProto(art)
@@ -73,6 +77,9 @@ func init() {
 	artifact_getCmd.Flags().StringP("id", "i", "", "ID of the artifact to get info from")
 	artifact_getCmd.Flags().Int32P("latest-versions", "v", 1, "Latest artifact versions to retrieve")
 	artifact_getCmd.Flags().StringP("tag", "", "", "Specific artifact tag to retrieve")
+	artifact_getCmd.Flags().StringP("profile", "", "", "Only show the evaluation status of the given profile")
+	artifact_getCmd.Flags().StringP("status", "", "",
+		"Only show the versions in the given evaluation status (success, failure, error, skipped, pending)")
 	if err := artifact_getCmd.MarkFlagRequired("id"); err != nil {
 		fmt.Fprintf(os.Stderr, "Error marking flag as required: %s\n", err)
 	}
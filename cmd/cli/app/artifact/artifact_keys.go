@@ -0,0 +1,180 @@
+// Copyright 2023 Stacklok, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package artifact
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/stacklok/minder/internal/util"
+	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+var artifact_addKeyCmd = &cobra.Command{
+	Use:   "add-key",
+	Short: "Register a public key artifact signatures are verified against",
+	Long: `Artifact add-key registers a PEM encoded public key, e.g. one exported from a KMS,
+on a project. Artifacts signed with the matching private key are considered verified,
+and artifact get shows the identifier of the key that verified them.`,
+	PreRun: func(cmd *cobra.Command, args []string) {
+		if err := viper.BindPFlags(cmd.Flags()); err != nil {
+			fmt.Fprintf(os.Stderr, "error binding flags: %s", err)
+		}
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		publicKey, err := os.ReadFile(filepath.Clean(viper.GetString("key")))
+		util.ExitNicelyOnError(err, "Error reading public key")
+
+		conn, err := util.GrpcForCommand(cmd, viper.GetViper())
+		util.ExitNicelyOnError(err, "Error getting grpc connection")
+		defer conn.Close()
+
+		client := pb.NewArtifactServiceClient(conn)
+		ctx, cancel := util.GetAppContext()
+		defer cancel()
+
+		resp, err := client.CreateSigningKey(ctx, &pb.CreateSigningKeyRequest{
+			ProjectId:     viper.GetString("project-id"),
+			KeyIdentifier: viper.GetString("name"),
+			PublicKey:     string(publicKey),
+		})
+		util.ExitNicelyOnError(err, "Error registering public key")
+
+		cmd.Printf("Registered public key %s\n", resp.GetKey().GetKeyIdentifier())
+		return nil
+	},
+}
+
+var artifact_listKeysCmd = &cobra.Command{
+	Use:   "list-keys",
+	Short: "List the public keys artifact signatures are verified against",
+	Long:  `Artifact list-keys lists the public keys registered on a project`,
+	PreRun: func(cmd *cobra.Command, args []string) {
+		if err := viper.BindPFlags(cmd.Flags()); err != nil {
+			fmt.Fprintf(os.Stderr, "error binding flags: %s", err)
+		}
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		format := viper.GetString("output")
+
+		switch format {
+		case "json":
+		case "yaml":
+		case "table":
+		case "":
+		default:
+			return fmt.Errorf("invalid output format: %s", format)
+		}
+
+		conn, err := util.GrpcForCommand(cmd, viper.GetViper())
+		util.ExitNicelyOnError(err, "Error getting grpc connection")
+		defer conn.Close()
+
+		client := pb.NewArtifactServiceClient(conn)
+		ctx, cancel := util.GetAppContext()
+		defer cancel()
+
+		keys, err := client.ListSigningKeys(ctx, &pb.ListSigningKeysRequest{
+			ProjectId: viper.GetString("project-id"),
+		})
+		if err != nil {
+			return fmt.Errorf("error listing public keys: %s", err)
+		}
+
+		switch format {
+		case "", "table":
+			table := tablewriter.NewWriter(os.Stdout)
+
+			table.SetHeader([]string{"Identifier", "Creation date"})
+
+			for _, key := range keys.Keys {
+				table.Append([]string{
+					key.KeyIdentifier,
+					key.CreatedAt.AsTime().Format(time.RFC3339),
+				})
+			}
+
+			table.Render()
+		case "json":
+			out, err := util.GetJsonFromProto(keys)
+			util.ExitNicelyOnError(err, "Error getting json from proto")
+			fmt.Println(out)
+		case "yaml":
+			out, err := util.GetYamlFromProto(keys)
+			util.ExitNicelyOnError(err, "Error getting yaml from proto")
+			fmt.Println(out)
+		}
+
+		return nil
+	},
+}
+
+var artifact_deleteKeyCmd = &cobra.Command{
+	Use:   "delete-key",
+	Short: "Delete a public key artifact signatures are verified against",
+	Long:  `Artifact delete-key removes a public key from a project`,
+	PreRun: func(cmd *cobra.Command, args []string) {
+		if err := viper.BindPFlags(cmd.Flags()); err != nil {
+			fmt.Fprintf(os.Stderr, "error binding flags: %s", err)
+		}
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		name := viper.GetString("name")
+
+		conn, err := util.GrpcForCommand(cmd, viper.GetViper())
+		util.ExitNicelyOnError(err, "Error getting grpc connection")
+		defer conn.Close()
+
+		client := pb.NewArtifactServiceClient(conn)
+		ctx, cancel := util.GetAppContext()
+		defer cancel()
+
+		_, err = client.DeleteSigningKey(ctx, &pb.DeleteSigningKeyRequest{
+			ProjectId:     viper.GetString("project-id"),
+			KeyIdentifier: name,
+		})
+		util.ExitNicelyOnError(err, "Error deleting public key")
+
+		cmd.Printf("Deleted public key %s\n", name)
+		return nil
+	},
+}
+
+func init() {
+	ArtifactCmd.AddCommand(artifact_addKeyCmd)
+	artifact_addKeyCmd.Flags().StringP("key", "k", "", "Path to the PEM encoded public key")
+	artifact_addKeyCmd.Flags().StringP("name", "n", "", "Identifier of the key, defaults to its fingerprint")
+	artifact_addKeyCmd.Flags().StringP("project-id", "g", "", "ID of the project to register the key on")
+	if err := artifact_addKeyCmd.MarkFlagRequired("key"); err != nil {
+		fmt.Fprintf(os.Stderr, "Error marking flag as required: %s\n", err)
+	}
+
+	ArtifactCmd.AddCommand(artifact_listKeysCmd)
+	artifact_listKeysCmd.Flags().StringP("output", "f", "", "Output format (json or yaml)")
+	artifact_listKeysCmd.Flags().StringP("project-id", "g", "", "ID of the project to list the keys of")
+
+	ArtifactCmd.AddCommand(artifact_deleteKeyCmd)
+	artifact_deleteKeyCmd.Flags().StringP("name", "n", "", "Identifier of the key to delete")
+	artifact_deleteKeyCmd.Flags().StringP("project-id", "g", "", "ID of the project of the key")
+	if err := artifact_deleteKeyCmd.MarkFlagRequired("name"); err != nil {
+		fmt.Fprintf(os.Stderr, "Error marking flag as required: %s\n", err)
+	}
+}
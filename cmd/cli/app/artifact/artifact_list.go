@@ -18,6 +18,7 @@ package artifact
 import (
 	"fmt"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/olekukonko/tablewriter"
@@ -46,6 +47,8 @@ var artifact_listCmd = &cobra.Command{
 			return fmt.Errorf("only %s is supported at this time", auth.Github)
 		}
 		projectID := viper.GetString("project-id")
+		profile := viper.GetString("profile")
+		status := viper.GetString("status")
 
 		switch format {
 		case "json":
@@ -69,6 +72,8 @@ var artifact_listCmd = &cobra.Command{
 			&pb.ListArtifactsRequest{
 				Provider:  provider,
 				ProjectId: projectID,
+				Profile:   profile,
+				Status:    status,
 			},
 		)
 
@@ -80,7 +85,7 @@ var artifact_listCmd = &cobra.Command{
 		case "", "table":
 			table := tablewriter.NewWriter(os.Stdout)
 
-			table.SetHeader([]string{"ID", "Type", "Owner", "Name", "Repository", "Visibility", "Creation date"})
+			table.SetHeader([]string{"ID", "Type", "Owner", "Name", "Repository", "Visibility", "Creation date", "Latest status"})
 
 			for _, artifact_item := range artifacts.Results {
 				table.Append([]string{
@@ -91,6 +96,7 @@ var artifact_listCmd = &cobra.Command{
 					artifact_item.Repository,
 					artifact_item.Visibility,
 					artifact_item.CreatedAt.AsTime().Format(time.RFC3339),
+					latestVersionStatus(artifact_item),
 				})
 			}
 
@@ -109,11 +115,28 @@ var artifact_listCmd = &cobra.Command{
 	},
 }
 
+// latestVersionStatus returns the status of the profiles evaluated against the most
+// recent evaluated version of the artifact
+func latestVersionStatus(artifact *pb.Artifact) string {
+	if len(artifact.GetVersions()) == 0 {
+		return ""
+	}
+
+	statuses := []string{}
+	for _, profileStatus := range artifact.GetVersions()[0].GetProfileStatus() {
+		statuses = append(statuses, fmt.Sprintf("%s: %s", profileStatus.GetProfileName(), profileStatus.GetProfileStatus()))
+	}
+	return strings.Join(statuses, "\n")
+}
+
 func init() {
 	ArtifactCmd.AddCommand(artifact_listCmd)
 	artifact_listCmd.Flags().StringP("output", "f", "", "Output format (json or yaml)")
 	artifact_listCmd.Flags().StringP("provider", "p", "", "Name for the provider to enroll")
 	artifact_listCmd.Flags().StringP("project-id", "g", "", "ID of the project for repo registration")
+	artifact_listCmd.Flags().StringP("profile", "", "", "Only show the evaluation status of the given profile")
+	artifact_listCmd.Flags().StringP("status", "", "",
+		"Only list artifacts with versions in the given evaluation status (success, failure, error, skipped, pending)")
 
 	if err := artifact_listCmd.MarkFlagRequired("provider"); err != nil {
 		fmt.Fprintf(os.Stderr, "Error marking flag as required: %s\n", err)
@@ -0,0 +1,170 @@
+// Copyright 2023 Stacklok, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package artifact
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/stacklok/minder/internal/util"
+	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+var artifact_retentionCmd = &cobra.Command{
+	Use:   "retention",
+	Short: "Get or set the retention policy of artifact versions",
+	Long: `Artifact retention shows the retention policy of the artifact versions of a project,
+or sets it if --keep-last or --max-age-days is given. A version is removed if it is older
+than max-age-days and not one of the keep-last most recent versions of its artifact.
+Zero means no limit.`,
+	PreRun: func(cmd *cobra.Command, args []string) {
+		if err := viper.BindPFlags(cmd.Flags()); err != nil {
+			fmt.Fprintf(os.Stderr, "error binding flags: %s", err)
+		}
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		conn, err := util.GrpcForCommand(cmd, viper.GetViper())
+		util.ExitNicelyOnError(err, "Error getting grpc connection")
+		defer conn.Close()
+
+		client := pb.NewArtifactServiceClient(conn)
+		ctx, cancel := util.GetAppContext()
+		defer cancel()
+
+		projectID := viper.GetString("project-id")
+		var policy *pb.ArtifactRetentionPolicy
+		if cmd.Flags().Changed("keep-last") || cmd.Flags().Changed("max-age-days") {
+			resp, err := client.SetArtifactRetentionPolicy(ctx, &pb.SetArtifactRetentionPolicyRequest{
+				ProjectId: projectID,
+				Policy: &pb.ArtifactRetentionPolicy{
+					KeepLast:   viper.GetInt32("keep-last"),
+					MaxAgeDays: viper.GetInt32("max-age-days"),
+				},
+			})
+			util.ExitNicelyOnError(err, "Error setting retention policy")
+			policy = resp.GetPolicy()
+		} else {
+			resp, err := client.GetArtifactRetentionPolicy(ctx, &pb.GetArtifactRetentionPolicyRequest{
+				ProjectId: projectID,
+			})
+			util.ExitNicelyOnError(err, "Error getting retention policy")
+			policy = resp.GetPolicy()
+		}
+
+		cmd.Printf("Keep last: %s\n", retentionLimit(policy.GetKeepLast()))
+		cmd.Printf("Max age in days: %s\n", retentionLimit(policy.GetMaxAgeDays()))
+		return nil
+	},
+}
+
+var artifact_pruneCmd = &cobra.Command{
+	Use:   "prune",
+	Short: "Remove the artifact versions the retention policy doesn't keep",
+	Long: `Artifact prune removes the stored artifact versions the retention policy of a
+project doesn't keep, and lists them. With --dry-run the versions are only listed.`,
+	PreRun: func(cmd *cobra.Command, args []string) {
+		if err := viper.BindPFlags(cmd.Flags()); err != nil {
+			fmt.Fprintf(os.Stderr, "error binding flags: %s", err)
+		}
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		format := viper.GetString("output")
+
+		switch format {
+		case "json":
+		case "yaml":
+		case "table":
+		case "":
+		default:
+			return fmt.Errorf("invalid output format: %s", format)
+		}
+
+		conn, err := util.GrpcForCommand(cmd, viper.GetViper())
+		util.ExitNicelyOnError(err, "Error getting grpc connection")
+		defer conn.Close()
+
+		client := pb.NewArtifactServiceClient(conn)
+		ctx, cancel := util.GetAppContext()
+		defer cancel()
+
+		req := &pb.PruneArtifactVersionsRequest{
+			ProjectId: viper.GetString("project-id"),
+			DryRun:    viper.GetBool("dry-run"),
+		}
+		if artifactID := viper.GetString("artifact-id"); artifactID != "" {
+			req.ArtifactId = &artifactID
+		}
+
+		pruned, err := client.PruneArtifactVersions(ctx, req)
+		if err != nil {
+			return fmt.Errorf("error pruning artifact versions: %s", err)
+		}
+
+		switch format {
+		case "", "table":
+			table := tablewriter.NewWriter(os.Stdout)
+
+			table.SetHeader([]string{"Artifact", "Version", "Sha", "Creation date"})
+
+			for _, pv := range pruned.Versions {
+				table.Append([]string{
+					pv.ArtifactName,
+					strconv.FormatInt(pv.GetVersion().GetVersionId(), 10),
+					pv.GetVersion().GetSha(),
+					pv.GetVersion().GetCreatedAt().AsTime().Format(time.RFC3339),
+				})
+			}
+
+			table.Render()
+		case "json":
+			out, err := util.GetJsonFromProto(pruned)
+			util.ExitNicelyOnError(err, "Error getting json from proto")
+			fmt.Println(out)
+		case "yaml":
+			out, err := util.GetYamlFromProto(pruned)
+			util.ExitNicelyOnError(err, "Error getting yaml from proto")
+			fmt.Println(out)
+		}
+
+		return nil
+	},
+}
+
+// retentionLimit returns the printable value of a limit of a retention policy
+func retentionLimit(limit int32) string {
+	if limit <= 0 {
+		return "no limit"
+	}
+	return strconv.Itoa(int(limit))
+}
+
+func init() {
+	ArtifactCmd.AddCommand(artifact_retentionCmd)
+	artifact_retentionCmd.Flags().Int32("keep-last", 0, "Number of most recent versions of each artifact to keep")
+	artifact_retentionCmd.Flags().Int32("max-age-days", 0, "Age in days after which versions are removed")
+	artifact_retentionCmd.Flags().StringP("project-id", "g", "", "ID of the project of the retention policy")
+
+	ArtifactCmd.AddCommand(artifact_pruneCmd)
+	artifact_pruneCmd.Flags().StringP("artifact-id", "i", "", "ID of the artifact to prune, defaults to all artifacts")
+	artifact_pruneCmd.Flags().Bool("dry-run", false, "List the versions that would be removed without removing them")
+	artifact_pruneCmd.Flags().StringP("output", "f", "", "Output format (json or yaml)")
+	artifact_pruneCmd.Flags().StringP("project-id", "g", "", "ID of the project to prune the artifact versions of")
+}
@@ -0,0 +1,214 @@
+// Copyright 2023 Stacklok, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package profile
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/stacklok/minder/cmd/cli/app"
+	"github.com/stacklok/minder/internal/util"
+	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+var profile_remediationCmd = &cobra.Command{
+	Use:   "remediation",
+	Short: "Manage the remediations of profiles that require approval",
+	Long: `The minder profile remediation subcommands list the requests proposed by the
+remediations of the profiles set to remediate with "approval_required", and approve
+or reject them. An approved request is performed right away.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return cmd.Usage()
+	},
+}
+
+var profile_remediationListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the remediations proposed for approval",
+	Long: `The minder profile remediation list subcommand lists the requests proposed by the
+remediations that require approval, optionally filtered by status (pending, applying,
+applied, rejected or failed).`,
+	PreRun: func(cmd *cobra.Command, args []string) {
+		if err := viper.BindPFlags(cmd.Flags()); err != nil {
+			fmt.Fprintf(os.Stderr, "Error binding flags: %s\n", err)
+		}
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		format := viper.GetString("output")
+
+		switch format {
+		case app.JSON:
+		case app.YAML:
+		case app.Table:
+		default:
+			return fmt.Errorf("invalid format: %s", format)
+		}
+
+		conn, err := util.GrpcForCommand(cmd, viper.GetViper())
+		if err != nil {
+			return fmt.Errorf("error getting grpc connection: %w", err)
+		}
+		defer conn.Close()
+
+		client := pb.NewProfileServiceClient(conn)
+		ctx, cancel := util.GetAppContext()
+		defer cancel()
+
+		resp, err := client.ListRemediationApprovals(ctx, &pb.ListRemediationApprovalsRequest{
+			Context: &pb.Context{Provider: viper.GetString("provider")},
+			Status:  viper.GetString("status"),
+		})
+		if err != nil {
+			return fmt.Errorf("error listing remediation approvals: %w", err)
+		}
+
+		switch format {
+		case app.JSON:
+			out, err := util.GetJsonFromProto(resp)
+			util.ExitNicelyOnError(err, "Error getting json from proto")
+			fmt.Println(out)
+		case app.YAML:
+			out, err := util.GetYamlFromProto(resp)
+			util.ExitNicelyOnError(err, "Error getting yaml from proto")
+			fmt.Println(out)
+		case app.Table:
+			table := tablewriter.NewWriter(os.Stdout)
+			table.SetHeader([]string{"Id", "Profile", "Rule", "Repository", "Request", "Status", "Created"})
+			table.SetAutoWrapText(false)
+
+			for _, approval := range resp.Approvals {
+				table.Append([]string{
+					approval.Id,
+					approval.ProfileName,
+					approval.RuleTypeName,
+					fmt.Sprintf("%s/%s", approval.RepoOwner, approval.RepoName),
+					fmt.Sprintf("%s %s\n%s", approval.Method, approval.Endpoint, approval.Body),
+					approval.Status,
+					approval.GetCreatedAt().AsTime().Format(time.RFC3339),
+				})
+			}
+			table.Render()
+		}
+
+		return nil
+	},
+}
+
+var profile_remediationApproveCmd = &cobra.Command{
+	Use:   "approve",
+	Short: "Approve and perform a proposed remediation",
+	Long: `The minder profile remediation approve subcommand performs the request proposed
+by a remediation that requires approval.`,
+	PreRun: func(cmd *cobra.Command, args []string) {
+		if err := viper.BindPFlags(cmd.Flags()); err != nil {
+			fmt.Fprintf(os.Stderr, "Error binding flags: %s\n", err)
+		}
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		conn, err := util.GrpcForCommand(cmd, viper.GetViper())
+		if err != nil {
+			return fmt.Errorf("error getting grpc connection: %w", err)
+		}
+		defer conn.Close()
+
+		client := pb.NewProfileServiceClient(conn)
+		ctx, cancel := util.GetAppContext()
+		defer cancel()
+
+		resp, err := client.ApproveRemediation(ctx, &pb.ApproveRemediationRequest{
+			Context: &pb.Context{Provider: viper.GetString("provider")},
+			Id:      viper.GetString("id"),
+		})
+		if err != nil {
+			return fmt.Errorf("error approving remediation: %w", err)
+		}
+
+		approval := resp.GetApproval()
+		if approval.GetStatus() == "failed" {
+			return fmt.Errorf("remediation %s was approved but failed: %s", approval.GetId(), approval.GetDetails())
+		}
+		cmd.Printf("Remediation %s was approved and applied\n", approval.GetId())
+		return nil
+	},
+}
+
+var profile_remediationRejectCmd = &cobra.Command{
+	Use:   "reject",
+	Short: "Reject a proposed remediation",
+	Long: `The minder profile remediation reject subcommand rejects the request proposed by a
+remediation that requires approval. It's proposed again only if the remediation proposes
+a different request.`,
+	PreRun: func(cmd *cobra.Command, args []string) {
+		if err := viper.BindPFlags(cmd.Flags()); err != nil {
+			fmt.Fprintf(os.Stderr, "Error binding flags: %s\n", err)
+		}
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		conn, err := util.GrpcForCommand(cmd, viper.GetViper())
+		if err != nil {
+			return fmt.Errorf("error getting grpc connection: %w", err)
+		}
+		defer conn.Close()
+
+		client := pb.NewProfileServiceClient(conn)
+		ctx, cancel := util.GetAppContext()
+		defer cancel()
+
+		resp, err := client.RejectRemediation(ctx, &pb.RejectRemediationRequest{
+			Context: &pb.Context{Provider: viper.GetString("provider")},
+			Id:      viper.GetString("id"),
+		})
+		if err != nil {
+			return fmt.Errorf("error rejecting remediation: %w", err)
+		}
+
+		cmd.Printf("Remediation %s was rejected\n", resp.GetApproval().GetId())
+		return nil
+	},
+}
+
+func init() {
+	ProfileCmd.AddCommand(profile_remediationCmd)
+
+	profile_remediationCmd.AddCommand(profile_remediationListCmd)
+	profile_remediationListCmd.Flags().StringP("provider", "p", "", "Provider to list remediations for")
+	profile_remediationListCmd.Flags().StringP("status", "s", "",
+		"Status of the remediations to list (pending, applying, applied, rejected or failed)")
+	profile_remediationListCmd.Flags().StringP("output", "o", app.Table, "Output format (json, yaml or table)")
+
+	for _, cmd := range []*cobra.Command{profile_remediationApproveCmd, profile_remediationRejectCmd} {
+		profile_remediationCmd.AddCommand(cmd)
+		cmd.Flags().StringP("provider", "p", "", "Provider of the profile of the remediation")
+		cmd.Flags().StringP("id", "i", "", "ID of the remediation")
+		if err := cmd.MarkFlagRequired("id"); err != nil {
+			fmt.Fprintf(os.Stderr, "Error marking flag as required: %s\n", err)
+			os.Exit(1)
+		}
+	}
+
+	for _, cmd := range []*cobra.Command{
+		profile_remediationListCmd, profile_remediationApproveCmd, profile_remediationRejectCmd,
+	} {
+		if err := cmd.MarkFlagRequired("provider"); err != nil {
+			fmt.Fprintf(os.Stderr, "Error marking flag as required: %s\n", err)
+			os.Exit(1)
+		}
+	}
+}
@@ -27,7 +27,8 @@ import (
 // InitializeTable initializes the table for rendering profiles
 func InitializeTable(cmd *cobra.Command) *tablewriter.Table {
 	table := tablewriter.NewWriter(cmd.OutOrStdout())
-	table.SetHeader([]string{"Id", "Name", "Provider", "Entity", "Rule", "Rule Params", "Rule Definition"})
+	table.SetHeader([]string{"Id", "Name", "Provider", "Entity", "Rule", "Remediate", "Alert",
+		"Rule Params", "Rule Definition"})
 	table.SetRowLine(true)
 	table.SetRowSeparator("-")
 	table.SetAutoMergeCellsByColumnIndex([]int{0, 1, 2, 3, 4})
@@ -84,12 +85,24 @@ func renderRuleTable(
 		p.Context.Provider,
 		entType.String(),
 		rule.Type,
+		// the settings in effect for the rule, which may override the ones of the profile
+		settingOrDefault(p.GetRuleRemediate(rule), "off"),
+		settingOrDefault(p.GetRuleAlert(rule), "on"),
 		params,
 		def,
 	}
 	table.Append(row)
 }
 
+// settingOrDefault returns the given remediate or alert setting, or the default the
+// server applies when it's not set
+func settingOrDefault(setting, def string) string {
+	if setting == "" {
+		return def
+	}
+	return setting
+}
+
 func marshalStructOrEmpty(v *structpb.Struct) string {
 	if v == nil {
 		return ""
@@ -16,6 +16,7 @@
 package profile_status
 
 import (
+	"fmt"
 	"strings"
 	"time"
 
@@ -88,7 +89,7 @@ func renderRuleEvaluationStatusTable(
 		reval.RuleName,
 		reval.Entity,
 		getEvalStatusText(reval.Status),
-		getRemediationStatusText(reval.RemediationStatus),
+		getRemediationText(reval),
 		mapToYAMLOrEmpty(reval.EntityInfo),
 		guidanceOrEncouragement(reval.Status, reval.Guidance),
 	}
@@ -124,9 +125,21 @@ func getEvalStatusText(status string) string {
 	}
 }
 
+// Gets a friendly remediation status text along with the attempts made, if more than one
+func getRemediationText(reval *pb.RuleEvaluationStatus) string {
+	text := getRemediationStatusText(reval.RemediationStatus)
+	if reval.RemediationAttempts > 1 {
+		text = fmt.Sprintf("%s (%d attempts)", text, reval.RemediationAttempts)
+	}
+	if reval.RemediationNextAttempt != nil {
+		text = fmt.Sprintf("%s\nnext attempt: %s", text, reval.RemediationNextAttempt.AsTime().Format(time.RFC3339))
+	}
+	return text
+}
+
 // Gets a friendly status text with an emoji
 func getRemediationStatusText(status string) string {
-	// remediation statuses can be 'success', 'failure', 'error', 'skipped', 'not supported'
+	// remediation statuses can be 'success', 'failure', 'error', 'skipped', 'pending', 'not supported'
 	switch strings.ToLower(status) {
 	case successStatus:
 		return "✅ Success"
@@ -136,6 +149,8 @@ func getRemediationStatusText(status string) string {
 		return "❌ Error"
 	case skippedStatus:
 		return "" // visually empty as we didn't have to remediate
+	case pendingStatus:
+		return "⏳ Pending"
 	case notAvailableStatus:
 		return "🚫 Not Available"
 	default:
@@ -160,7 +175,7 @@ func getEvalStatusColor(status string) tablewriter.Colors {
 }
 
 func getRemediateStatusColor(status string) tablewriter.Colors {
-	// remediation statuses can be 'success', 'failure', 'error', 'skipped', 'not supported'
+	// remediation statuses can be 'success', 'failure', 'error', 'skipped', 'pending', 'not supported'
 	switch strings.ToLower(status) {
 	case successStatus:
 		return tablewriter.Colors{tablewriter.FgGreenColor}
@@ -168,7 +183,7 @@ func getRemediateStatusColor(status string) tablewriter.Colors {
 		return tablewriter.Colors{tablewriter.FgRedColor}
 	case errorStatus:
 		return tablewriter.Colors{tablewriter.FgRedColor}
-	case notAvailableStatus:
+	case pendingStatus, notAvailableStatus:
 		return tablewriter.Colors{tablewriter.FgYellowColor}
 	default:
 		return tablewriter.Colors{}
@@ -0,0 +1,122 @@
+// Copyright 2023 Stacklok, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/stacklok/minder/internal/util"
+	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+var createOCIProviderCmd = &cobra.Command{
+	Use:   "create-oci",
+	Short: "Create an OCI registry provider within the minder control plane",
+	Long: `The minder provider create-oci command creates a provider for a generic
+OCI registry, e.g. Docker Hub, Quay or a self-hosted registry. Images in the
+registry are linked to the registered repositories they were built from through
+the org.opencontainers.image.source annotation or label, or through the mappings
+given with --image-repository.`,
+	PreRun: func(cmd *cobra.Command, args []string) {
+		if err := viper.BindPFlags(cmd.Flags()); err != nil {
+			fmt.Fprintf(os.Stderr, "Error binding flags: %s\n", err)
+		}
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		name := viper.GetString("provider")
+		project := viper.GetString("project")
+		registry := viper.GetString("registry")
+		username := viper.GetString("username")
+		namespace := viper.GetString("namespace")
+		repositories := viper.GetStringSlice("repository")
+		imageRepositories, err := parseImageRepositories(viper.GetStringSlice("image-repository"))
+		util.ExitNicelyOnError(err, "Invalid image repository mapping")
+		token := util.GetConfigValue(viper.GetViper(), "token", "token", cmd, "").(string)
+
+		conn, err := util.GrpcForCommand(cmd, viper.GetViper())
+		util.ExitNicelyOnError(err, "Error getting grpc connection")
+		defer conn.Close()
+
+		client := pb.NewProviderServiceClient(conn)
+		ctx, cancel := util.GetAppContext()
+		defer cancel()
+
+		req := &pb.CreateProviderRequest{
+			ProjectId: project,
+			Provider: &pb.Provider{
+				Name:       name,
+				Implements: []string{"oci"},
+				Def: &pb.Provider_Definition{
+					Oci: &pb.OCIProviderConfig{
+						RegistryUrl:       registry,
+						Username:          username,
+						Namespace:         namespace,
+						Repositories:      repositories,
+						ImageRepositories: imageRepositories,
+					},
+				},
+			},
+		}
+		if token != "" {
+			req.AccessToken = &token
+		}
+
+		resp, err := client.CreateProvider(ctx, req)
+		util.ExitNicelyOnError(err, "Error creating provider")
+
+		out, err := util.GetJsonFromProto(resp.GetProvider())
+		util.ExitNicelyOnError(err, "Error getting json from proto")
+		fmt.Println(out)
+		return nil
+	},
+}
+
+func init() {
+	ProviderCmd.AddCommand(createOCIProviderCmd)
+	createOCIProviderCmd.Flags().StringP("provider", "p", "", "Name for the provider to create")
+	createOCIProviderCmd.Flags().StringP("project", "r", "", "ID of the project for creating the provider")
+	createOCIProviderCmd.Flags().String("registry", "", "URL of the registry, e.g. https://index.docker.io")
+	createOCIProviderCmd.Flags().StringP("username", "u", "", "Username to authenticate to the registry with")
+	createOCIProviderCmd.Flags().StringP("token", "t", "", "Password or token to authenticate to the registry with")
+	createOCIProviderCmd.Flags().String("namespace", "", "Only consider the repositories of the registry under this namespace")
+	createOCIProviderCmd.Flags().StringSlice("repository", []string{},
+		"Repositories of the registry to consider, for registries that do not allow listing their catalog")
+	createOCIProviderCmd.Flags().StringSlice("image-repository", []string{},
+		"Repository an image is built from as image=owner/name, for images without an org.opencontainers.image.source label")
+	for _, flag := range []string{"provider", "registry"} {
+		if err := createOCIProviderCmd.MarkFlagRequired(flag); err != nil {
+			fmt.Fprintf(os.Stderr, "Error marking flag as required: %s\n", err)
+		}
+	}
+}
+
+// parseImageRepositories parses the mappings of images to the repositories they are built
+// from, given as image=owner/name
+func parseImageRepositories(mappings []string) ([]*pb.OCIImageRepository, error) {
+	result := make([]*pb.OCIImageRepository, 0, len(mappings))
+	for _, mapping := range mappings {
+		image, repository, ok := strings.Cut(mapping, "=")
+		if !ok || image == "" || repository == "" {
+			return nil, fmt.Errorf("%s is not in the image=owner/name format", mapping)
+		}
+		result = append(result, &pb.OCIImageRepository{Image: image, Repository: repository})
+	}
+	return result, nil
+}
@@ -0,0 +1,123 @@
+// Copyright 2023 Stacklok, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/stacklok/minder/internal/util"
+	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+var sigstoreProviderCmd = &cobra.Command{
+	Use:   "sigstore",
+	Short: "Configure the sigstore instance artifact signatures are verified against",
+	Long: `The minder provider sigstore command configures the sigstore instance that the
+signatures of the artifacts of a provider are verified against, e.g. a private
+Fulcio and Rekor deployment. The trust roots are either distributed through a TUF
+repository or passed explicitly as PEM files. Without any flag the public good
+instance is restored.`,
+	PreRun: func(cmd *cobra.Command, args []string) {
+		if err := viper.BindPFlags(cmd.Flags()); err != nil {
+			fmt.Fprintf(os.Stderr, "Error binding flags: %s\n", err)
+		}
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		provider := viper.GetString("provider")
+		project := viper.GetString("project")
+
+		cfg := &pb.SigstoreTrustConfig{
+			TufMirror: viper.GetString("tuf-mirror"),
+			RekorUrl:  viper.GetString("rekor-url"),
+			Offline:   viper.GetBool("offline"),
+		}
+
+		var err error
+		cfg.TufRoot, err = readOptionalFile(viper.GetString("tuf-root"))
+		util.ExitNicelyOnError(err, "Error reading TUF root")
+		cfg.FulcioRoots, err = readOptionalFile(viper.GetString("fulcio-roots"))
+		util.ExitNicelyOnError(err, "Error reading Fulcio roots")
+		cfg.FulcioIntermediates, err = readOptionalFile(viper.GetString("fulcio-intermediates"))
+		util.ExitNicelyOnError(err, "Error reading Fulcio intermediates")
+		cfg.RekorPublicKeys, err = readFiles(viper.GetStringSlice("rekor-key"))
+		util.ExitNicelyOnError(err, "Error reading Rekor public keys")
+		cfg.CtLogPublicKeys, err = readFiles(viper.GetStringSlice("ct-log-key"))
+		util.ExitNicelyOnError(err, "Error reading CT log public keys")
+
+		conn, err := util.GrpcForCommand(cmd, viper.GetViper())
+		util.ExitNicelyOnError(err, "Error getting grpc connection")
+		defer conn.Close()
+
+		client := pb.NewProviderServiceClient(conn)
+		ctx, cancel := util.GetAppContext()
+		defer cancel()
+
+		_, err = client.SetSigstoreTrustConfig(ctx, &pb.SetSigstoreTrustConfigRequest{
+			ProjectId: project,
+			Provider:  provider,
+			Config:    cfg,
+		})
+		util.ExitNicelyOnError(err, "Error setting sigstore configuration")
+
+		cmd.Printf("Sigstore configuration of provider %s updated\n", provider)
+		return nil
+	},
+}
+
+func readOptionalFile(path string) (string, error) {
+	if path == "" {
+		return "", nil
+	}
+
+	content, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return "", err
+	}
+	return string(content), nil
+}
+
+func readFiles(paths []string) ([]string, error) {
+	contents := make([]string, 0, len(paths))
+	for _, path := range paths {
+		content, err := readOptionalFile(path)
+		if err != nil {
+			return nil, err
+		}
+		contents = append(contents, content)
+	}
+	return contents, nil
+}
+
+func init() {
+	ProviderCmd.AddCommand(sigstoreProviderCmd)
+	sigstoreProviderCmd.Flags().StringP("provider", "p", "", "Name of the provider to configure")
+	sigstoreProviderCmd.Flags().StringP("project", "r", "", "ID of the project of the provider")
+	sigstoreProviderCmd.Flags().String("tuf-mirror", "", "URL of the TUF repository distributing the trust roots")
+	sigstoreProviderCmd.Flags().String("tuf-root", "", "Path to the initial root.json of the TUF repository")
+	sigstoreProviderCmd.Flags().String("fulcio-roots", "", "Path to the PEM encoded Fulcio root certificates")
+	sigstoreProviderCmd.Flags().String("fulcio-intermediates", "", "Path to the PEM encoded Fulcio intermediate certificates")
+	sigstoreProviderCmd.Flags().StringSlice("rekor-key", []string{}, "Path to a PEM encoded Rekor public key")
+	sigstoreProviderCmd.Flags().StringSlice("ct-log-key", []string{}, "Path to a PEM encoded certificate transparency log public key")
+	sigstoreProviderCmd.Flags().String("rekor-url", "", "URL of Rekor to look up signatures without a Rekor bundle")
+	sigstoreProviderCmd.Flags().Bool("offline", false, "Only verify signatures against their Rekor bundle")
+	if err := sigstoreProviderCmd.MarkFlagRequired("provider"); err != nil {
+		fmt.Fprintf(os.Stderr, "Error marking flag as required: %s\n", err)
+	}
+}
@@ -0,0 +1,145 @@
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package repo
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/charmbracelet/bubbles/table"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	github "github.com/stacklok/minder/internal/providers/github"
+	"github.com/stacklok/minder/internal/util"
+	"github.com/stacklok/minder/internal/util/cli"
+	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+var repoRemediationPrsCmd = &cobra.Command{
+	Use:   "remediation-prs",
+	Short: "List the open pull requests opened by remediations in a repository",
+	Long: `Repo remediation-prs lists the open pull requests that the pull request
+remediations of the profiles opened in a repository. Minder updates these pull
+requests when the proposed changes change, and closes them once the rule passes.`,
+	PreRun: func(cmd *cobra.Command, args []string) {
+		if err := viper.BindPFlags(cmd.Flags()); err != nil {
+			fmt.Fprintf(os.Stderr, "error binding flags: %s", err)
+		}
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		provider := util.GetConfigValue(viper.GetViper(), "provider", "provider", cmd, "").(string)
+		repoid := viper.GetString("repo-id")
+		name := util.GetConfigValue(viper.GetViper(), "name", "name", cmd, "").(string)
+		format := viper.GetString("output")
+
+		// if name is set, repo-id cannot be set
+		if name != "" && repoid != "" {
+			return fmt.Errorf("cannot set both name and repo-id")
+		}
+
+		// either name or repoid needs to be set
+		if name == "" && repoid == "" {
+			return fmt.Errorf("either name or repo-id needs to be set")
+		}
+
+		// if name is set, provider needs to be set
+		if name != "" && provider == "" {
+			return fmt.Errorf("provider needs to be set if name is set")
+		}
+
+		switch format {
+		case formatJSON, formatYAML, formatTable, formatDefault:
+		default:
+			return fmt.Errorf("invalid output format: %s", format)
+		}
+
+		conn, err := util.GrpcForCommand(cmd, viper.GetViper())
+		util.ExitNicelyOnError(err, "Error getting grpc connection")
+		defer conn.Close()
+
+		client := pb.NewRepositoryServiceClient(conn)
+		ctx, cancel := util.GetAppContext()
+		defer cancel()
+
+		if repoid == "" {
+			if provider != github.Github {
+				return fmt.Errorf("only %s is supported at this time", github.Github)
+			}
+
+			resp, err := client.GetRepositoryByName(ctx, &pb.GetRepositoryByNameRequest{Provider: provider, Name: name})
+			util.ExitNicelyOnError(err, "Error getting repo by name")
+			repoid = resp.GetRepository().GetId()
+		}
+
+		resp, err := client.ListRemediationPullRequests(ctx, &pb.ListRemediationPullRequestsRequest{
+			RepositoryId: repoid,
+		})
+		util.ExitNicelyOnError(err, "Error listing remediation pull requests")
+
+		switch format {
+		case formatDefault, formatTable:
+			columns := []table.Column{
+				{Title: "Repository", Width: 30},
+				{Title: "Pull Request", Width: 12},
+				{Title: "Branch", Width: 40},
+				{Title: "Profile", Width: 20},
+				{Title: "Rule", Width: 30},
+				{Title: "Last Updated", Width: 20},
+			}
+
+			rows := make([]table.Row, 0, len(resp.Results))
+			for _, pr := range resp.Results {
+				rows = append(rows, table.Row{
+					fmt.Sprintf("%s/%s", pr.GetOwner(), pr.GetName()),
+					fmt.Sprintf("#%d", pr.GetNumber()),
+					pr.GetBranch(),
+					pr.GetProfileName(),
+					pr.GetRuleTypeName(),
+					pr.GetUpdatedAt().AsTime().Format(time.RFC3339),
+				})
+			}
+
+			t := table.New(
+				table.WithColumns(columns),
+				table.WithRows(rows),
+				table.WithFocused(false),
+				table.WithHeight(len(rows)),
+				table.WithStyles(cli.TableHiddenSelectStyles),
+			)
+
+			cli.PrintCmd(cmd, cli.TableRender(t))
+		case formatJSON:
+			out, err := util.GetJsonFromProto(resp)
+			util.ExitNicelyOnError(err, "Error getting json from proto")
+			fmt.Println(out)
+		case formatYAML:
+			out, err := util.GetYamlFromProto(resp)
+			util.ExitNicelyOnError(err, "Error getting yaml from proto")
+			fmt.Println(out)
+		}
+
+		return nil
+	},
+}
+
+func init() {
+	RepoCmd.AddCommand(repoRemediationPrsCmd)
+	repoRemediationPrsCmd.Flags().StringP("output", "f", "", "Output format (json, yaml or table)")
+	repoRemediationPrsCmd.Flags().StringP("provider", "p", "", "Name of the enrolled provider")
+	repoRemediationPrsCmd.Flags().StringP("name", "n", "", "Name of the repository (owner/name format)")
+	repoRemediationPrsCmd.Flags().StringP("repo-id", "r", "", "ID of the repo to list the remediation pull requests of")
+}
@@ -0,0 +1,109 @@
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package repo
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	github "github.com/stacklok/minder/internal/providers/github"
+	"github.com/stacklok/minder/internal/util"
+	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+var repoSBOMCmd = &cobra.Command{
+	Use:   "sbom",
+	Short: "Export the SBOM of a repository",
+	Long: `Repo sbom exports a software bill of materials of a registered repository.
+The SBOM is generated from the dependency files found in the repository and is
+printed in CycloneDX or SPDX JSON format.`,
+	PreRun: func(cmd *cobra.Command, args []string) {
+		if err := viper.BindPFlags(cmd.Flags()); err != nil {
+			fmt.Fprintf(os.Stderr, "error binding flags: %s", err)
+		}
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		provider := util.GetConfigValue(viper.GetViper(), "provider", "provider", cmd, "").(string)
+		repoid := viper.GetString("repo-id")
+		name := util.GetConfigValue(viper.GetViper(), "name", "name", cmd, "").(string)
+		format := viper.GetString("format")
+		branch := viper.GetString("branch")
+		outFile := viper.GetString("output-file")
+
+		// if name is set, repo-id cannot be set
+		if name != "" && repoid != "" {
+			return fmt.Errorf("cannot set both name and repo-id")
+		}
+
+		// either name or repoid needs to be set
+		if name == "" && repoid == "" {
+			return fmt.Errorf("either name or repo-id needs to be set")
+		}
+
+		// if name is set, provider needs to be set
+		if name != "" && provider == "" {
+			return fmt.Errorf("provider needs to be set if name is set")
+		}
+
+		conn, err := util.GrpcForCommand(cmd, viper.GetViper())
+		util.ExitNicelyOnError(err, "Error getting grpc connection")
+		defer conn.Close()
+
+		client := pb.NewRepositoryServiceClient(conn)
+		ctx, cancel := util.GetAppContext()
+		defer cancel()
+
+		// the SBOM is looked up by id, so resolve the name first
+		if repoid == "" {
+			if provider != github.Github {
+				return fmt.Errorf("only %s is supported at this time", github.Github)
+			}
+
+			resp, err := client.GetRepositoryByName(ctx, &pb.GetRepositoryByNameRequest{Provider: provider, Name: name})
+			util.ExitNicelyOnError(err, "Error getting repo by name")
+			repoid = resp.GetRepository().GetId()
+		}
+
+		resp, err := client.GetRepositorySBOM(ctx, &pb.GetRepositorySBOMRequest{
+			RepositoryId: repoid,
+			Format:       format,
+			Branch:       branch,
+		})
+		util.ExitNicelyOnError(err, "Error getting repo SBOM")
+
+		if outFile != "" {
+			err := os.WriteFile(outFile, []byte(resp.Sbom), 0600)
+			util.ExitNicelyOnError(err, "Error writing SBOM to file")
+			cmd.Printf("Wrote %s SBOM to %s\n", resp.Format, outFile)
+			return nil
+		}
+
+		fmt.Println(resp.Sbom)
+		return nil
+	},
+}
+
+func init() {
+	RepoCmd.AddCommand(repoSBOMCmd)
+	repoSBOMCmd.Flags().StringP("provider", "p", "", "Name of the enrolled provider")
+	repoSBOMCmd.Flags().StringP("name", "n", "", "Name of the repository (owner/name format)")
+	repoSBOMCmd.Flags().StringP("repo-id", "r", "", "ID of the repo to export the SBOM of")
+	repoSBOMCmd.Flags().StringP("format", "f", "cyclonedx", "SBOM format (cyclonedx or spdx)")
+	repoSBOMCmd.Flags().StringP("branch", "b", "", "Branch of the repository to scan (defaults to the default branch)")
+	repoSBOMCmd.Flags().StringP("output-file", "o", "", "Write the SBOM to a file instead of stdout")
+}
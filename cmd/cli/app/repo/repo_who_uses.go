@@ -0,0 +1,87 @@
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package repo
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/stacklok/minder/internal/util"
+	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+var repoWhoUsesCmd = &cobra.Command{
+	Use:   "who-uses",
+	Short: "List the repositories that use a package",
+	Long: `Repo who-uses lists the repositories of a project that depend on a package,
+optionally restricted to a range of versions. For example:
+
+  minder repo who-uses --ecosystem npm --package lodash --version-constraint "< 4.17.21"`,
+	PreRun: func(cmd *cobra.Command, args []string) {
+		if err := viper.BindPFlags(cmd.Flags()); err != nil {
+			fmt.Fprintf(os.Stderr, "error binding flags: %s", err)
+		}
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		projectID := viper.GetString("project-id")
+		ecosystem := viper.GetString("ecosystem")
+		pkg := viper.GetString("package")
+		constraint := viper.GetString("version-constraint")
+		format := viper.GetString("output")
+
+		switch format {
+		case formatJSON, formatYAML, formatTable, formatDefault:
+		default:
+			return fmt.Errorf("invalid output format: %s", format)
+		}
+
+		conn, err := util.GrpcForCommand(cmd, viper.GetViper())
+		util.ExitNicelyOnError(err, "Error getting grpc connection")
+		defer conn.Close()
+
+		client := pb.NewRepositoryServiceClient(conn)
+		ctx, cancel := util.GetAppContext()
+		defer cancel()
+
+		resp, err := client.ListDependencyUsage(ctx, &pb.ListDependencyUsageRequest{
+			ProjectId:         projectID,
+			Ecosystem:         ecosystem,
+			Package:           pkg,
+			VersionConstraint: constraint,
+		})
+		util.ExitNicelyOnError(err, "Error listing dependency usage")
+
+		printDependencyUsage(cmd, format, resp, resp.Results)
+		return nil
+	},
+}
+
+func init() {
+	RepoCmd.AddCommand(repoWhoUsesCmd)
+	repoWhoUsesCmd.Flags().StringP("output", "f", "", "Output format (json, yaml or table)")
+	repoWhoUsesCmd.Flags().StringP("project-id", "g", "", "ID of the project to search")
+	repoWhoUsesCmd.Flags().StringP("ecosystem", "e", "", "Ecosystem of the package (npm, go or pypi)")
+	repoWhoUsesCmd.Flags().StringP("package", "k", "", "Name of the package")
+	repoWhoUsesCmd.Flags().StringP("version-constraint", "v", "", "Version range to match, in the range syntax of the ecosystem, e.g. \"^1.2.0\" for npm")
+	if err := repoWhoUsesCmd.MarkFlagRequired("ecosystem"); err != nil {
+		fmt.Fprintf(os.Stderr, "Error marking flag as required: %s\n", err)
+	}
+	if err := repoWhoUsesCmd.MarkFlagRequired("package"); err != nil {
+		fmt.Fprintf(os.Stderr, "Error marking flag as required: %s\n", err)
+	}
+}
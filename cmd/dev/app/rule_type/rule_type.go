@@ -223,7 +223,7 @@ func readEntityFromFile(fpath string, entType minderv1.Entity) (protoreflect.Pro
 	case minderv1.Entity_ENTITY_PULL_REQUESTS:
 		out = &minderv1.PullRequest{}
 	case minderv1.Entity_ENTITY_BUILD_ENVIRONMENTS:
-		return nil, fmt.Errorf("build environments not yet supported")
+		out = &minderv1.BuildEnvironment{}
 	case minderv1.Entity_ENTITY_UNSPECIFIED:
 		return nil, fmt.Errorf("entity type unspecified")
 	default:
@@ -22,6 +22,7 @@ import (
 	"net/url"
 	"os"
 	"os/signal"
+	"time"
 
 	"github.com/rs/zerolog"
 	"github.com/spf13/cobra"
@@ -147,6 +148,11 @@ var serveCmd = &cobra.Command{
 
 		errg.Go(s.HandleEvents(ctx))
 
+		errg.Go(func() error {
+			interval := time.Duration(cfg.Reconciler.ArtifactsInterval) * time.Second
+			return rec.RunArtifactReconciliation(ctx, interval)
+		})
+
 		// Wait for event handlers to start running
 		<-evt.Running()
 
@@ -0,0 +1,40 @@
+-- Copyright 2023 Stacklok, Inc
+--
+-- Licensed under the Apache License, Version 2.0 (the "License");
+-- you may not use this file except in compliance with the License.
+-- You may obtain a copy of the License at
+--
+--      http://www.apache.org/licenses/LICENSE-2.0
+--
+-- Unless required by applicable law or agreed to in writing, software
+-- distributed under the License is distributed on an "AS IS" BASIS,
+-- WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+-- See the License for the specific language governing permissions and
+-- limitations under the License.
+
+
+-- repository_dependencies is the inventory of the dependencies used by each
+-- repository. Dependencies found on the default branch have no pull request,
+-- dependencies introduced by a pull request reference it.
+CREATE TABLE IF NOT EXISTS repository_dependencies (
+    id UUID NOT NULL DEFAULT gen_random_uuid() PRIMARY KEY,
+    repository_id UUID NOT NULL REFERENCES repositories(id) ON DELETE CASCADE,
+    pull_request_id UUID REFERENCES pull_requests(id) ON DELETE CASCADE,
+    ecosystem TEXT NOT NULL,
+    name TEXT NOT NULL,
+    version TEXT NOT NULL,
+    file TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS repository_dependencies_idx ON repository_dependencies(
+    repository_id,
+    COALESCE(pull_request_id, '00000000-0000-0000-0000-000000000000'::UUID),
+    ecosystem,
+    name,
+    version,
+    file);
+
+-- used to look up which repositories use a package
+CREATE INDEX IF NOT EXISTS repository_dependencies_package_idx ON repository_dependencies(ecosystem, name);
@@ -0,0 +1,21 @@
+-- Copyright 2023 Stacklok, Inc
+--
+-- Licensed under the Apache License, Version 2.0 (the "License");
+-- you may not use this file except in compliance with the License.
+-- You may obtain a copy of the License at
+--
+--      http://www.apache.org/licenses/LICENSE-2.0
+--
+-- Unless required by applicable law or agreed to in writing, software
+-- distributed under the License is distributed on an "AS IS" BASIS,
+-- WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+-- See the License for the specific language governing permissions and
+-- limitations under the License.
+
+ALTER TABLE signing_keys DROP CONSTRAINT IF EXISTS unique_project_key_identifier;
+
+-- verification-only keys can't be represented anymore
+DELETE FROM signing_keys WHERE private_key IS NULL OR passphrase IS NULL;
+ALTER TABLE signing_keys ALTER COLUMN private_key SET NOT NULL;
+ALTER TABLE signing_keys ALTER COLUMN passphrase SET NOT NULL;
+ALTER TABLE signing_keys ADD CONSTRAINT unique_key_identifier UNIQUE (key_identifier);
@@ -0,0 +1,24 @@
+-- Copyright 2023 Stacklok, Inc
+--
+-- Licensed under the Apache License, Version 2.0 (the "License");
+-- you may not use this file except in compliance with the License.
+-- You may obtain a copy of the License at
+--
+--      http://www.apache.org/licenses/LICENSE-2.0
+--
+-- Unless required by applicable law or agreed to in writing, software
+-- distributed under the License is distributed on an "AS IS" BASIS,
+-- WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+-- See the License for the specific language governing permissions and
+-- limitations under the License.
+
+-- signing_keys may hold only the public key of a key pair, e.g. one exported
+-- from a KMS, that the signatures of the artifacts of the project are
+-- verified against.
+ALTER TABLE signing_keys ALTER COLUMN private_key DROP NOT NULL;
+ALTER TABLE signing_keys ALTER COLUMN passphrase DROP NOT NULL;
+
+-- key identifiers are chosen by the users of a project, they only need to be
+-- unique within the project
+ALTER TABLE signing_keys DROP CONSTRAINT IF EXISTS unique_key_identifier;
+ALTER TABLE signing_keys ADD CONSTRAINT unique_project_key_identifier UNIQUE (project_id, key_identifier);
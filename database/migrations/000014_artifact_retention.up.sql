@@ -0,0 +1,27 @@
+-- Copyright 2023 Stacklok, Inc
+--
+-- Licensed under the Apache License, Version 2.0 (the "License");
+-- you may not use this file except in compliance with the License.
+-- You may obtain a copy of the License at
+--
+--      http://www.apache.org/licenses/LICENSE-2.0
+--
+-- Unless required by applicable law or agreed to in writing, software
+-- distributed under the License is distributed on an "AS IS" BASIS,
+-- WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+-- See the License for the specific language governing permissions and
+-- limitations under the License.
+
+-- artifact_retention_policies holds how long the artifact versions of a
+-- project are kept. Projects without a policy keep the versions of the last
+-- 30 days.
+CREATE TABLE artifact_retention_policies (
+    project_id UUID PRIMARY KEY REFERENCES projects(id) ON DELETE CASCADE,
+    -- the number of most recent versions of each artifact that are kept
+    -- regardless of their age, no limit if NULL
+    keep_last INTEGER CHECK (keep_last > 0),
+    -- versions older than this are removed, no limit if NULL
+    max_age_days INTEGER CHECK (max_age_days > 0),
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
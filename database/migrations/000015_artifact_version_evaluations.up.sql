@@ -0,0 +1,27 @@
+-- Copyright 2023 Stacklok, Inc
+--
+-- Licensed under the Apache License, Version 2.0 (the "License");
+-- you may not use this file except in compliance with the License.
+-- You may obtain a copy of the License at
+--
+--      http://www.apache.org/licenses/LICENSE-2.0
+--
+-- Unless required by applicable law or agreed to in writing, software
+-- distributed under the License is distributed on an "AS IS" BASIS,
+-- WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+-- See the License for the specific language governing permissions and
+-- limitations under the License.
+
+-- This table stores how each version of an artifact fared against the rules
+-- it was evaluated with. Rule evaluations are kept per artifact, the versions
+-- an evaluation ingested are recorded here.
+CREATE TABLE artifact_version_evaluations (
+    artifact_version_id UUID NOT NULL REFERENCES artifact_versions(id) ON DELETE CASCADE,
+    rule_eval_id UUID NOT NULL REFERENCES rule_evaluations(id) ON DELETE CASCADE,
+    status eval_status_types NOT NULL,
+    details TEXT NOT NULL,
+    last_updated TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (artifact_version_id, rule_eval_id)
+);
+
+CREATE INDEX artifact_version_evaluations_rule_eval_id_idx ON artifact_version_evaluations(rule_eval_id);
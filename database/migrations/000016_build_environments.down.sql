@@ -0,0 +1,41 @@
+-- Copyright 2023 Stacklok, Inc
+--
+-- Licensed under the Apache License, Version 2.0 (the "License");
+-- you may not use this file except in compliance with the License.
+-- You may obtain a copy of the License at
+--
+--      http://www.apache.org/licenses/LICENSE-2.0
+--
+-- Unless required by applicable law or agreed to in writing, software
+-- distributed under the License is distributed on an "AS IS" BASIS,
+-- WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+-- See the License for the specific language governing permissions and
+-- limitations under the License.
+
+DELETE FROM rule_evaluations WHERE build_environment_id IS NOT NULL;
+DELETE FROM entity_execution_lock WHERE build_environment_id IS NOT NULL;
+DELETE FROM flush_cache WHERE build_environment_id IS NOT NULL;
+
+DROP INDEX IF EXISTS flush_cache_idx;
+ALTER TABLE flush_cache DROP COLUMN IF EXISTS build_environment_id;
+CREATE UNIQUE INDEX IF NOT EXISTS flush_cache_idx ON flush_cache(
+    entity,
+    repository_id,
+    COALESCE(artifact_id, '00000000-0000-0000-0000-000000000000'::UUID),
+    COALESCE(pull_request_id, '00000000-0000-0000-0000-000000000000'::UUID));
+
+DROP INDEX IF EXISTS entity_execution_lock_idx;
+ALTER TABLE entity_execution_lock DROP COLUMN IF EXISTS build_environment_id;
+CREATE UNIQUE INDEX IF NOT EXISTS entity_execution_lock_idx ON entity_execution_lock(
+    entity,
+    repository_id,
+    COALESCE(artifact_id, '00000000-0000-0000-0000-000000000000'::UUID),
+    COALESCE(pull_request_id, '00000000-0000-0000-0000-000000000000'::UUID));
+
+DROP INDEX IF EXISTS rule_evaluations_results_idx;
+ALTER TABLE rule_evaluations DROP COLUMN IF EXISTS build_environment_id;
+CREATE UNIQUE INDEX rule_evaluations_results_idx
+    ON rule_evaluations(profile_id, repository_id, COALESCE(artifact_id, '00000000-0000-0000-0000-000000000000'::UUID), entity, rule_type_id, COALESCE(pull_request_id, '00000000-0000-0000-0000-000000000000'::UUID));
+
+DROP INDEX IF EXISTS build_environment_in_repo_unique;
+DROP TABLE IF EXISTS build_environments;
@@ -0,0 +1,65 @@
+-- Copyright 2023 Stacklok, Inc
+--
+-- Licensed under the Apache License, Version 2.0 (the "License");
+-- you may not use this file except in compliance with the License.
+-- You may obtain a copy of the License at
+--
+--      http://www.apache.org/licenses/LICENSE-2.0
+--
+-- Unless required by applicable law or agreed to in writing, software
+-- distributed under the License is distributed on an "AS IS" BASIS,
+-- WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+-- See the License for the specific language governing permissions and
+-- limitations under the License.
+
+-- build_environments are the deployment environments of a repository that its
+-- workflows build and deploy in. The properties are the entity as last read from
+-- the provider.
+CREATE TABLE IF NOT EXISTS build_environments (
+    id UUID NOT NULL DEFAULT gen_random_uuid() PRIMARY KEY,
+    repository_id UUID NOT NULL REFERENCES repositories(id) ON DELETE CASCADE,
+    name TEXT NOT NULL,
+    properties JSONB NOT NULL DEFAULT '{}',
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS build_environment_in_repo_unique ON build_environments(repository_id, name);
+
+ALTER TABLE rule_evaluations
+    ADD COLUMN build_environment_id UUID REFERENCES build_environments(id) ON DELETE CASCADE;
+
+DROP INDEX IF EXISTS rule_evaluations_results_idx;
+
+CREATE UNIQUE INDEX rule_evaluations_results_idx ON rule_evaluations(
+    profile_id,
+    repository_id,
+    COALESCE(artifact_id, '00000000-0000-0000-0000-000000000000'::UUID),
+    entity,
+    rule_type_id,
+    COALESCE(pull_request_id, '00000000-0000-0000-0000-000000000000'::UUID),
+    COALESCE(build_environment_id, '00000000-0000-0000-0000-000000000000'::UUID));
+
+ALTER TABLE entity_execution_lock
+    ADD COLUMN build_environment_id UUID REFERENCES build_environments(id) ON DELETE CASCADE;
+
+DROP INDEX IF EXISTS entity_execution_lock_idx;
+
+CREATE UNIQUE INDEX IF NOT EXISTS entity_execution_lock_idx ON entity_execution_lock(
+    entity,
+    repository_id,
+    COALESCE(artifact_id, '00000000-0000-0000-0000-000000000000'::UUID),
+    COALESCE(pull_request_id, '00000000-0000-0000-0000-000000000000'::UUID),
+    COALESCE(build_environment_id, '00000000-0000-0000-0000-000000000000'::UUID));
+
+ALTER TABLE flush_cache
+    ADD COLUMN build_environment_id UUID REFERENCES build_environments(id) ON DELETE CASCADE;
+
+DROP INDEX IF EXISTS flush_cache_idx;
+
+CREATE UNIQUE INDEX IF NOT EXISTS flush_cache_idx ON flush_cache(
+    entity,
+    repository_id,
+    COALESCE(artifact_id, '00000000-0000-0000-0000-000000000000'::UUID),
+    COALESCE(pull_request_id, '00000000-0000-0000-0000-000000000000'::UUID),
+    COALESCE(build_environment_id, '00000000-0000-0000-0000-000000000000'::UUID));
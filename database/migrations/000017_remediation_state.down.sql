@@ -0,0 +1,19 @@
+-- Copyright 2023 Stacklok, Inc
+--
+-- Licensed under the Apache License, Version 2.0 (the "License");
+-- you may not use this file except in compliance with the License.
+-- You may obtain a copy of the License at
+--
+--      http://www.apache.org/licenses/LICENSE-2.0
+--
+-- Unless required by applicable law or agreed to in writing, software
+-- distributed under the License is distributed on an "AS IS" BASIS,
+-- WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+-- See the License for the specific language governing permissions and
+-- limitations under the License.
+
+ALTER TABLE rule_details_remediate DROP COLUMN IF EXISTS metadata;
+
+-- Postgres can't remove a value for an enum type, so the pending status is kept
+-- and the remediations in that status are marked as skipped instead.
+UPDATE rule_details_remediate SET status = 'skipped' WHERE status = 'pending';
@@ -0,0 +1,20 @@
+-- Copyright 2023 Stacklok, Inc
+--
+-- Licensed under the Apache License, Version 2.0 (the "License");
+-- you may not use this file except in compliance with the License.
+-- You may obtain a copy of the License at
+--
+--      http://www.apache.org/licenses/LICENSE-2.0
+--
+-- Unless required by applicable law or agreed to in writing, software
+-- distributed under the License is distributed on an "AS IS" BASIS,
+-- WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+-- See the License for the specific language governing permissions and
+-- limitations under the License.
+
+DROP TABLE IF EXISTS remediation_approvals;
+DROP TYPE IF EXISTS remediation_approval_status;
+
+-- Postgres can't remove a value for an enum type, so the approval_required action
+-- is kept and the profiles using it fall back to the default remediation instead.
+UPDATE profiles SET remediate = NULL WHERE remediate = 'approval_required';
@@ -0,0 +1,37 @@
+-- Copyright 2023 Stacklok, Inc
+--
+-- Licensed under the Apache License, Version 2.0 (the "License");
+-- you may not use this file except in compliance with the License.
+-- You may obtain a copy of the License at
+--
+--      http://www.apache.org/licenses/LICENSE-2.0
+--
+-- Unless required by applicable law or agreed to in writing, software
+-- distributed under the License is distributed on an "AS IS" BASIS,
+-- WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+-- See the License for the specific language governing permissions and
+-- limitations under the License.
+
+-- approval_required records the remediations of a profile as proposals, which are
+-- only applied once approved by a project owner
+ALTER TYPE action_type ADD VALUE 'approval_required';
+
+CREATE TYPE remediation_approval_status AS ENUM ('pending', 'applied', 'rejected', 'failed');
+
+-- remediation_approvals holds the requests proposed by the remediation of a rule
+-- evaluation, along with the decision on them
+CREATE TABLE remediation_approvals (
+    id UUID NOT NULL DEFAULT gen_random_uuid() PRIMARY KEY,
+    rule_eval_id UUID NOT NULL REFERENCES rule_evaluations(id) ON DELETE CASCADE,
+    method TEXT NOT NULL,
+    endpoint TEXT NOT NULL,
+    body TEXT NOT NULL,
+    status remediation_approval_status NOT NULL DEFAULT 'pending',
+    details TEXT NOT NULL DEFAULT '',
+    decided_by TEXT,
+    decided_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX remediation_approvals_rule_eval_idx ON remediation_approvals(rule_eval_id);
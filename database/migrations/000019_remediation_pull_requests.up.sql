@@ -0,0 +1,29 @@
+-- Copyright 2023 Stacklok, Inc
+--
+-- Licensed under the Apache License, Version 2.0 (the "License");
+-- you may not use this file except in compliance with the License.
+-- You may obtain a copy of the License at
+--
+--      http://www.apache.org/licenses/LICENSE-2.0
+--
+-- Unless required by applicable law or agreed to in writing, software
+-- distributed under the License is distributed on an "AS IS" BASIS,
+-- WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+-- See the License for the specific language governing permissions and
+-- limitations under the License.
+
+-- remediation_pull_requests tracks the pull requests opened by the pull request
+-- remediation of a rule evaluation for as long as they're open
+CREATE TABLE remediation_pull_requests (
+    id UUID NOT NULL DEFAULT gen_random_uuid() PRIMARY KEY,
+    rule_eval_id UUID NOT NULL REFERENCES rule_evaluations(id) ON DELETE CASCADE,
+    repository_id UUID NOT NULL REFERENCES repositories(id) ON DELETE CASCADE,
+    pr_number BIGINT NOT NULL,
+    branch TEXT NOT NULL,
+    content_sha TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX remediation_pull_requests_rule_eval_idx ON remediation_pull_requests(rule_eval_id);
+CREATE INDEX remediation_pull_requests_repository_idx ON remediation_pull_requests(repository_id);
@@ -438,6 +438,34 @@ func (mr *MockStoreMockRecorder) DeleteArtifactVersion(arg0, arg1 interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteArtifactVersion", reflect.TypeOf((*MockStore)(nil).DeleteArtifactVersion), arg0, arg1)
 }
 
+// DeleteBuildEnvironment mocks base method.
+func (m *MockStore) DeleteBuildEnvironment(arg0 context.Context, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBuildEnvironment", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteBuildEnvironment indicates an expected call of DeleteBuildEnvironment.
+func (mr *MockStoreMockRecorder) DeleteBuildEnvironment(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBuildEnvironment", reflect.TypeOf((*MockStore)(nil).DeleteBuildEnvironment), arg0, arg1)
+}
+
+// DeleteBuildEnvironmentByName mocks base method.
+func (m *MockStore) DeleteBuildEnvironmentByName(arg0 context.Context, arg1 db.DeleteBuildEnvironmentByNameParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBuildEnvironmentByName", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteBuildEnvironmentByName indicates an expected call of DeleteBuildEnvironmentByName.
+func (mr *MockStoreMockRecorder) DeleteBuildEnvironmentByName(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBuildEnvironmentByName", reflect.TypeOf((*MockStore)(nil).DeleteBuildEnvironmentByName), arg0, arg1)
+}
+
 // DeleteExpiredSessionStates mocks base method.
 func (m *MockStore) DeleteExpiredSessionStates(arg0 context.Context) error {
 	m.ctrl.T.Helper()
@@ -537,6 +565,21 @@ func (mr *MockStoreMockRecorder) DeleteProvider(arg0, arg1 interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProvider", reflect.TypeOf((*MockStore)(nil).DeleteProvider), arg0, arg1)
 }
 
+// DeletePrunableArtifactVersions mocks base method.
+func (m *MockStore) DeletePrunableArtifactVersions(arg0 context.Context, arg1 db.DeletePrunableArtifactVersionsParams) ([]db.ArtifactVersion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePrunableArtifactVersions", arg0, arg1)
+	ret0, _ := ret[0].([]db.ArtifactVersion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeletePrunableArtifactVersions indicates an expected call of DeletePrunableArtifactVersions.
+func (mr *MockStoreMockRecorder) DeletePrunableArtifactVersions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePrunableArtifactVersions", reflect.TypeOf((*MockStore)(nil).DeletePrunableArtifactVersions), arg0, arg1)
+}
+
 // DeletePullRequest mocks base method.
 func (m *MockStore) DeletePullRequest(arg0 context.Context, arg1 db.DeletePullRequestParams) error {
 	m.ctrl.T.Helper()
@@ -551,6 +594,34 @@ func (mr *MockStoreMockRecorder) DeletePullRequest(arg0, arg1 interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePullRequest", reflect.TypeOf((*MockStore)(nil).DeletePullRequest), arg0, arg1)
 }
 
+// DeleteRemediationApproval mocks base method.
+func (m *MockStore) DeleteRemediationApproval(arg0 context.Context, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRemediationApproval", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRemediationApproval indicates an expected call of DeleteRemediationApproval.
+func (mr *MockStoreMockRecorder) DeleteRemediationApproval(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRemediationApproval", reflect.TypeOf((*MockStore)(nil).DeleteRemediationApproval), arg0, arg1)
+}
+
+// DeleteRemediationPullRequest mocks base method.
+func (m *MockStore) DeleteRemediationPullRequest(arg0 context.Context, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRemediationPullRequest", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRemediationPullRequest indicates an expected call of DeleteRemediationPullRequest.
+func (mr *MockStoreMockRecorder) DeleteRemediationPullRequest(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRemediationPullRequest", reflect.TypeOf((*MockStore)(nil).DeleteRemediationPullRequest), arg0, arg1)
+}
+
 // DeleteRepository mocks base method.
 func (m *MockStore) DeleteRepository(arg0 context.Context, arg1 uuid.UUID) error {
 	m.ctrl.T.Helper()
@@ -565,6 +636,20 @@ func (mr *MockStoreMockRecorder) DeleteRepository(arg0, arg1 interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRepository", reflect.TypeOf((*MockStore)(nil).DeleteRepository), arg0, arg1)
 }
 
+// DeleteRepositoryDependencies mocks base method.
+func (m *MockStore) DeleteRepositoryDependencies(arg0 context.Context, arg1 db.DeleteRepositoryDependenciesParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRepositoryDependencies", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRepositoryDependencies indicates an expected call of DeleteRepositoryDependencies.
+func (mr *MockStoreMockRecorder) DeleteRepositoryDependencies(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRepositoryDependencies", reflect.TypeOf((*MockStore)(nil).DeleteRepositoryDependencies), arg0, arg1)
+}
+
 // DeleteRole mocks base method.
 func (m *MockStore) DeleteRole(arg0 context.Context, arg1 int32) error {
 	m.ctrl.T.Helper()
@@ -663,6 +748,20 @@ func (mr *MockStoreMockRecorder) DeleteSigningKey(arg0, arg1 interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSigningKey", reflect.TypeOf((*MockStore)(nil).DeleteSigningKey), arg0, arg1)
 }
 
+// DeleteStaleArtifactVersionEvaluations mocks base method.
+func (m *MockStore) DeleteStaleArtifactVersionEvaluations(arg0 context.Context, arg1 db.DeleteStaleArtifactVersionEvaluationsParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteStaleArtifactVersionEvaluations", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteStaleArtifactVersionEvaluations indicates an expected call of DeleteStaleArtifactVersionEvaluations.
+func (mr *MockStoreMockRecorder) DeleteStaleArtifactVersionEvaluations(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteStaleArtifactVersionEvaluations", reflect.TypeOf((*MockStore)(nil).DeleteStaleArtifactVersionEvaluations), arg0, arg1)
+}
+
 // DeleteUser mocks base method.
 func (m *MockStore) DeleteUser(arg0 context.Context, arg1 int32) error {
 	m.ctrl.T.Helper()
@@ -767,6 +866,21 @@ func (mr *MockStoreMockRecorder) GetArtifactByID(arg0, arg1 interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetArtifactByID", reflect.TypeOf((*MockStore)(nil).GetArtifactByID), arg0, arg1)
 }
 
+// GetArtifactRetentionPolicy mocks base method.
+func (m *MockStore) GetArtifactRetentionPolicy(arg0 context.Context, arg1 uuid.UUID) (db.ArtifactRetentionPolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetArtifactRetentionPolicy", arg0, arg1)
+	ret0, _ := ret[0].(db.ArtifactRetentionPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetArtifactRetentionPolicy indicates an expected call of GetArtifactRetentionPolicy.
+func (mr *MockStoreMockRecorder) GetArtifactRetentionPolicy(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetArtifactRetentionPolicy", reflect.TypeOf((*MockStore)(nil).GetArtifactRetentionPolicy), arg0, arg1)
+}
+
 // GetArtifactVersionByID mocks base method.
 func (m *MockStore) GetArtifactVersionByID(arg0 context.Context, arg1 uuid.UUID) (db.ArtifactVersion, error) {
 	m.ctrl.T.Helper()
@@ -797,6 +911,21 @@ func (mr *MockStoreMockRecorder) GetArtifactVersionBySha(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetArtifactVersionBySha", reflect.TypeOf((*MockStore)(nil).GetArtifactVersionBySha), arg0, arg1)
 }
 
+// GetBuildEnvironmentByID mocks base method.
+func (m *MockStore) GetBuildEnvironmentByID(arg0 context.Context, arg1 uuid.UUID) (db.BuildEnvironment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBuildEnvironmentByID", arg0, arg1)
+	ret0, _ := ret[0].(db.BuildEnvironment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBuildEnvironmentByID indicates an expected call of GetBuildEnvironmentByID.
+func (mr *MockStoreMockRecorder) GetBuildEnvironmentByID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBuildEnvironmentByID", reflect.TypeOf((*MockStore)(nil).GetBuildEnvironmentByID), arg0, arg1)
+}
+
 // GetChildrenProjects mocks base method.
 func (m *MockStore) GetChildrenProjects(arg0 context.Context, arg1 uuid.UUID) ([]db.GetChildrenProjectsRow, error) {
 	m.ctrl.T.Helper()
@@ -1156,6 +1285,21 @@ func (mr *MockStoreMockRecorder) GetQuerierWithTransaction(arg0 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQuerierWithTransaction", reflect.TypeOf((*MockStore)(nil).GetQuerierWithTransaction), arg0)
 }
 
+// GetRemediationApprovalByID mocks base method.
+func (m *MockStore) GetRemediationApprovalByID(arg0 context.Context, arg1 db.GetRemediationApprovalByIDParams) (db.GetRemediationApprovalByIDRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRemediationApprovalByID", arg0, arg1)
+	ret0, _ := ret[0].(db.GetRemediationApprovalByIDRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRemediationApprovalByID indicates an expected call of GetRemediationApprovalByID.
+func (mr *MockStoreMockRecorder) GetRemediationApprovalByID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRemediationApprovalByID", reflect.TypeOf((*MockStore)(nil).GetRemediationApprovalByID), arg0, arg1)
+}
+
 // GetRepositoryByID mocks base method.
 func (m *MockStore) GetRepositoryByID(arg0 context.Context, arg1 uuid.UUID) (db.Repository, error) {
 	m.ctrl.T.Helper()
@@ -1351,6 +1495,21 @@ func (mr *MockStoreMockRecorder) GetSigningKeyByIdentifier(arg0, arg1 interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSigningKeyByIdentifier", reflect.TypeOf((*MockStore)(nil).GetSigningKeyByIdentifier), arg0, arg1)
 }
 
+// GetSigningKeyByProjectAndIdentifier mocks base method.
+func (m *MockStore) GetSigningKeyByProjectAndIdentifier(arg0 context.Context, arg1 db.GetSigningKeyByProjectAndIdentifierParams) (db.SigningKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSigningKeyByProjectAndIdentifier", arg0, arg1)
+	ret0, _ := ret[0].(db.SigningKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSigningKeyByProjectAndIdentifier indicates an expected call of GetSigningKeyByProjectAndIdentifier.
+func (mr *MockStoreMockRecorder) GetSigningKeyByProjectAndIdentifier(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSigningKeyByProjectAndIdentifier", reflect.TypeOf((*MockStore)(nil).GetSigningKeyByProjectAndIdentifier), arg0, arg1)
+}
+
 // GetSigningKeyByProjectID mocks base method.
 func (m *MockStore) GetSigningKeyByProjectID(arg0 context.Context, arg1 uuid.UUID) (db.SigningKey, error) {
 	m.ctrl.T.Helper()
@@ -1441,6 +1600,21 @@ func (mr *MockStoreMockRecorder) GlobalListProviders(arg0 interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GlobalListProviders", reflect.TypeOf((*MockStore)(nil).GlobalListProviders), arg0)
 }
 
+// ListAllRegisteredRepositories mocks base method.
+func (m *MockStore) ListAllRegisteredRepositories(arg0 context.Context) ([]db.Repository, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAllRegisteredRepositories", arg0)
+	ret0, _ := ret[0].([]db.Repository)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAllRegisteredRepositories indicates an expected call of ListAllRegisteredRepositories.
+func (mr *MockStoreMockRecorder) ListAllRegisteredRepositories(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAllRegisteredRepositories", reflect.TypeOf((*MockStore)(nil).ListAllRegisteredRepositories), arg0)
+}
+
 // ListAllRepositories mocks base method.
 func (m *MockStore) ListAllRepositories(arg0 context.Context, arg1 string) ([]db.Repository, error) {
 	m.ctrl.T.Helper()
@@ -1456,6 +1630,21 @@ func (mr *MockStoreMockRecorder) ListAllRepositories(arg0, arg1 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAllRepositories", reflect.TypeOf((*MockStore)(nil).ListAllRepositories), arg0, arg1)
 }
 
+// ListArtifactVersionEvaluations mocks base method.
+func (m *MockStore) ListArtifactVersionEvaluations(arg0 context.Context, arg1 db.ListArtifactVersionEvaluationsParams) ([]db.ListArtifactVersionEvaluationsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListArtifactVersionEvaluations", arg0, arg1)
+	ret0, _ := ret[0].([]db.ListArtifactVersionEvaluationsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListArtifactVersionEvaluations indicates an expected call of ListArtifactVersionEvaluations.
+func (mr *MockStoreMockRecorder) ListArtifactVersionEvaluations(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListArtifactVersionEvaluations", reflect.TypeOf((*MockStore)(nil).ListArtifactVersionEvaluations), arg0, arg1)
+}
+
 // ListArtifactVersionsByArtifactID mocks base method.
 func (m *MockStore) ListArtifactVersionsByArtifactID(arg0 context.Context, arg1 db.ListArtifactVersionsByArtifactIDParams) ([]db.ArtifactVersion, error) {
 	m.ctrl.T.Helper()
@@ -1486,6 +1675,36 @@ func (mr *MockStoreMockRecorder) ListArtifactVersionsByArtifactIDAndTag(arg0, ar
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListArtifactVersionsByArtifactIDAndTag", reflect.TypeOf((*MockStore)(nil).ListArtifactVersionsByArtifactIDAndTag), arg0, arg1)
 }
 
+// ListArtifactsByProjectID mocks base method.
+func (m *MockStore) ListArtifactsByProjectID(arg0 context.Context, arg1 uuid.UUID) ([]db.Artifact, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListArtifactsByProjectID", arg0, arg1)
+	ret0, _ := ret[0].([]db.Artifact)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListArtifactsByProjectID indicates an expected call of ListArtifactsByProjectID.
+func (mr *MockStoreMockRecorder) ListArtifactsByProjectID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListArtifactsByProjectID", reflect.TypeOf((*MockStore)(nil).ListArtifactsByProjectID), arg0, arg1)
+}
+
+// ListArtifactsByProvider mocks base method.
+func (m *MockStore) ListArtifactsByProvider(arg0 context.Context, arg1 db.ListArtifactsByProviderParams) ([]db.Artifact, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListArtifactsByProvider", arg0, arg1)
+	ret0, _ := ret[0].([]db.Artifact)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListArtifactsByProvider indicates an expected call of ListArtifactsByProvider.
+func (mr *MockStoreMockRecorder) ListArtifactsByProvider(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListArtifactsByProvider", reflect.TypeOf((*MockStore)(nil).ListArtifactsByProvider), arg0, arg1)
+}
+
 // ListArtifactsByRepoID mocks base method.
 func (m *MockStore) ListArtifactsByRepoID(arg0 context.Context, arg1 uuid.UUID) ([]db.Artifact, error) {
 	m.ctrl.T.Helper()
@@ -1501,6 +1720,36 @@ func (mr *MockStoreMockRecorder) ListArtifactsByRepoID(arg0, arg1 interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListArtifactsByRepoID", reflect.TypeOf((*MockStore)(nil).ListArtifactsByRepoID), arg0, arg1)
 }
 
+// ListBuildEnvironmentsByRepositoryID mocks base method.
+func (m *MockStore) ListBuildEnvironmentsByRepositoryID(arg0 context.Context, arg1 uuid.UUID) ([]db.BuildEnvironment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBuildEnvironmentsByRepositoryID", arg0, arg1)
+	ret0, _ := ret[0].([]db.BuildEnvironment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBuildEnvironmentsByRepositoryID indicates an expected call of ListBuildEnvironmentsByRepositoryID.
+func (mr *MockStoreMockRecorder) ListBuildEnvironmentsByRepositoryID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBuildEnvironmentsByRepositoryID", reflect.TypeOf((*MockStore)(nil).ListBuildEnvironmentsByRepositoryID), arg0, arg1)
+}
+
+// ListDependencyUsageByPackage mocks base method.
+func (m *MockStore) ListDependencyUsageByPackage(arg0 context.Context, arg1 db.ListDependencyUsageByPackageParams) ([]db.ListDependencyUsageByPackageRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDependencyUsageByPackage", arg0, arg1)
+	ret0, _ := ret[0].([]db.ListDependencyUsageByPackageRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDependencyUsageByPackage indicates an expected call of ListDependencyUsageByPackage.
+func (mr *MockStoreMockRecorder) ListDependencyUsageByPackage(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDependencyUsageByPackage", reflect.TypeOf((*MockStore)(nil).ListDependencyUsageByPackage), arg0, arg1)
+}
+
 // ListFlushCache mocks base method.
 func (m *MockStore) ListFlushCache(arg0 context.Context) ([]db.FlushCache, error) {
 	m.ctrl.T.Helper()
@@ -1576,6 +1825,36 @@ func (mr *MockStoreMockRecorder) ListProvidersByProjectID(arg0, arg1 interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProvidersByProjectID", reflect.TypeOf((*MockStore)(nil).ListProvidersByProjectID), arg0, arg1)
 }
 
+// ListPrunableArtifactVersions mocks base method.
+func (m *MockStore) ListPrunableArtifactVersions(arg0 context.Context, arg1 db.ListPrunableArtifactVersionsParams) ([]db.ArtifactVersion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPrunableArtifactVersions", arg0, arg1)
+	ret0, _ := ret[0].([]db.ArtifactVersion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPrunableArtifactVersions indicates an expected call of ListPrunableArtifactVersions.
+func (mr *MockStoreMockRecorder) ListPrunableArtifactVersions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPrunableArtifactVersions", reflect.TypeOf((*MockStore)(nil).ListPrunableArtifactVersions), arg0, arg1)
+}
+
+// ListRegisteredRepositoriesByProjectID mocks base method.
+func (m *MockStore) ListRegisteredRepositoriesByProjectID(arg0 context.Context, arg1 uuid.UUID) ([]db.Repository, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRegisteredRepositoriesByProjectID", arg0, arg1)
+	ret0, _ := ret[0].([]db.Repository)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRegisteredRepositoriesByProjectID indicates an expected call of ListRegisteredRepositoriesByProjectID.
+func (mr *MockStoreMockRecorder) ListRegisteredRepositoriesByProjectID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRegisteredRepositoriesByProjectID", reflect.TypeOf((*MockStore)(nil).ListRegisteredRepositoriesByProjectID), arg0, arg1)
+}
+
 // ListRegisteredRepositoriesByProjectIDAndProvider mocks base method.
 func (m *MockStore) ListRegisteredRepositoriesByProjectIDAndProvider(arg0 context.Context, arg1 db.ListRegisteredRepositoriesByProjectIDAndProviderParams) ([]db.Repository, error) {
 	m.ctrl.T.Helper()
@@ -1591,6 +1870,36 @@ func (mr *MockStoreMockRecorder) ListRegisteredRepositoriesByProjectIDAndProvide
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRegisteredRepositoriesByProjectIDAndProvider", reflect.TypeOf((*MockStore)(nil).ListRegisteredRepositoriesByProjectIDAndProvider), arg0, arg1)
 }
 
+// ListRemediationApprovalsByProject mocks base method.
+func (m *MockStore) ListRemediationApprovalsByProject(arg0 context.Context, arg1 db.ListRemediationApprovalsByProjectParams) ([]db.ListRemediationApprovalsByProjectRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRemediationApprovalsByProject", arg0, arg1)
+	ret0, _ := ret[0].([]db.ListRemediationApprovalsByProjectRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRemediationApprovalsByProject indicates an expected call of ListRemediationApprovalsByProject.
+func (mr *MockStoreMockRecorder) ListRemediationApprovalsByProject(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRemediationApprovalsByProject", reflect.TypeOf((*MockStore)(nil).ListRemediationApprovalsByProject), arg0, arg1)
+}
+
+// ListRemediationPullRequestsByRepository mocks base method.
+func (m *MockStore) ListRemediationPullRequestsByRepository(arg0 context.Context, arg1 uuid.UUID) ([]db.ListRemediationPullRequestsByRepositoryRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRemediationPullRequestsByRepository", arg0, arg1)
+	ret0, _ := ret[0].([]db.ListRemediationPullRequestsByRepositoryRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRemediationPullRequestsByRepository indicates an expected call of ListRemediationPullRequestsByRepository.
+func (mr *MockStoreMockRecorder) ListRemediationPullRequestsByRepository(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRemediationPullRequestsByRepository", reflect.TypeOf((*MockStore)(nil).ListRemediationPullRequestsByRepository), arg0, arg1)
+}
+
 // ListRepositoriesByOwner mocks base method.
 func (m *MockStore) ListRepositoriesByOwner(arg0 context.Context, arg1 db.ListRepositoriesByOwnerParams) ([]db.Repository, error) {
 	m.ctrl.T.Helper()
@@ -1621,6 +1930,21 @@ func (mr *MockStoreMockRecorder) ListRepositoriesByProjectID(arg0, arg1 interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRepositoriesByProjectID", reflect.TypeOf((*MockStore)(nil).ListRepositoriesByProjectID), arg0, arg1)
 }
 
+// ListRepositoryDependencies mocks base method.
+func (m *MockStore) ListRepositoryDependencies(arg0 context.Context, arg1 uuid.UUID) ([]db.ListRepositoryDependenciesRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRepositoryDependencies", arg0, arg1)
+	ret0, _ := ret[0].([]db.ListRepositoryDependenciesRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRepositoryDependencies indicates an expected call of ListRepositoryDependencies.
+func (mr *MockStoreMockRecorder) ListRepositoryDependencies(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRepositoryDependencies", reflect.TypeOf((*MockStore)(nil).ListRepositoryDependencies), arg0, arg1)
+}
+
 // ListRoles mocks base method.
 func (m *MockStore) ListRoles(arg0 context.Context, arg1 db.ListRolesParams) ([]db.Role, error) {
 	m.ctrl.T.Helper()
@@ -1681,6 +2005,21 @@ func (mr *MockStoreMockRecorder) ListRuleTypesByProviderAndProject(arg0, arg1 in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRuleTypesByProviderAndProject", reflect.TypeOf((*MockStore)(nil).ListRuleTypesByProviderAndProject), arg0, arg1)
 }
 
+// ListSigningKeysByProjectID mocks base method.
+func (m *MockStore) ListSigningKeysByProjectID(arg0 context.Context, arg1 uuid.UUID) ([]db.SigningKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSigningKeysByProjectID", arg0, arg1)
+	ret0, _ := ret[0].([]db.SigningKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSigningKeysByProjectID indicates an expected call of ListSigningKeysByProjectID.
+func (mr *MockStoreMockRecorder) ListSigningKeysByProjectID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSigningKeysByProjectID", reflect.TypeOf((*MockStore)(nil).ListSigningKeysByProjectID), arg0, arg1)
+}
+
 // ListUsers mocks base method.
 func (m *MockStore) ListUsers(arg0 context.Context, arg1 db.ListUsersParams) ([]db.User, error) {
 	m.ctrl.T.Helper()
@@ -1843,6 +2182,36 @@ func (mr *MockStoreMockRecorder) UpdateProfile(arg0, arg1 interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProfile", reflect.TypeOf((*MockStore)(nil).UpdateProfile), arg0, arg1)
 }
 
+// UpdateProviderDefinition mocks base method.
+func (m *MockStore) UpdateProviderDefinition(arg0 context.Context, arg1 db.UpdateProviderDefinitionParams) (db.Provider, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProviderDefinition", arg0, arg1)
+	ret0, _ := ret[0].(db.Provider)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateProviderDefinition indicates an expected call of UpdateProviderDefinition.
+func (mr *MockStoreMockRecorder) UpdateProviderDefinition(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProviderDefinition", reflect.TypeOf((*MockStore)(nil).UpdateProviderDefinition), arg0, arg1)
+}
+
+// UpdateRemediationApprovalStatus mocks base method.
+func (m *MockStore) UpdateRemediationApprovalStatus(arg0 context.Context, arg1 db.UpdateRemediationApprovalStatusParams) (db.RemediationApproval, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRemediationApprovalStatus", arg0, arg1)
+	ret0, _ := ret[0].(db.RemediationApproval)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateRemediationApprovalStatus indicates an expected call of UpdateRemediationApprovalStatus.
+func (mr *MockStoreMockRecorder) UpdateRemediationApprovalStatus(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRemediationApprovalStatus", reflect.TypeOf((*MockStore)(nil).UpdateRemediationApprovalStatus), arg0, arg1)
+}
+
 // UpdateRepository mocks base method.
 func (m *MockStore) UpdateRepository(arg0 context.Context, arg1 db.UpdateRepositoryParams) (db.Repository, error) {
 	m.ctrl.T.Helper()
@@ -1873,6 +2242,20 @@ func (mr *MockStoreMockRecorder) UpdateRepositoryByID(arg0, arg1 interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRepositoryByID", reflect.TypeOf((*MockStore)(nil).UpdateRepositoryByID), arg0, arg1)
 }
 
+// UpdateRepositoryDefaultBranch mocks base method.
+func (m *MockStore) UpdateRepositoryDefaultBranch(arg0 context.Context, arg1 db.UpdateRepositoryDefaultBranchParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRepositoryDefaultBranch", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateRepositoryDefaultBranch indicates an expected call of UpdateRepositoryDefaultBranch.
+func (mr *MockStoreMockRecorder) UpdateRepositoryDefaultBranch(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRepositoryDefaultBranch", reflect.TypeOf((*MockStore)(nil).UpdateRepositoryDefaultBranch), arg0, arg1)
+}
+
 // UpdateRole mocks base method.
 func (m *MockStore) UpdateRole(arg0 context.Context, arg1 db.UpdateRoleParams) (db.Role, error) {
 	m.ctrl.T.Helper()
@@ -1917,6 +2300,21 @@ func (mr *MockStoreMockRecorder) UpsertArtifact(arg0, arg1 interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertArtifact", reflect.TypeOf((*MockStore)(nil).UpsertArtifact), arg0, arg1)
 }
 
+// UpsertArtifactRetentionPolicy mocks base method.
+func (m *MockStore) UpsertArtifactRetentionPolicy(arg0 context.Context, arg1 db.UpsertArtifactRetentionPolicyParams) (db.ArtifactRetentionPolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertArtifactRetentionPolicy", arg0, arg1)
+	ret0, _ := ret[0].(db.ArtifactRetentionPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertArtifactRetentionPolicy indicates an expected call of UpsertArtifactRetentionPolicy.
+func (mr *MockStoreMockRecorder) UpsertArtifactRetentionPolicy(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertArtifactRetentionPolicy", reflect.TypeOf((*MockStore)(nil).UpsertArtifactRetentionPolicy), arg0, arg1)
+}
+
 // UpsertArtifactVersion mocks base method.
 func (m *MockStore) UpsertArtifactVersion(arg0 context.Context, arg1 db.UpsertArtifactVersionParams) (db.ArtifactVersion, error) {
 	m.ctrl.T.Helper()
@@ -1932,6 +2330,35 @@ func (mr *MockStoreMockRecorder) UpsertArtifactVersion(arg0, arg1 interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertArtifactVersion", reflect.TypeOf((*MockStore)(nil).UpsertArtifactVersion), arg0, arg1)
 }
 
+// UpsertArtifactVersionEvaluations mocks base method.
+func (m *MockStore) UpsertArtifactVersionEvaluations(arg0 context.Context, arg1 db.UpsertArtifactVersionEvaluationsParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertArtifactVersionEvaluations", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertArtifactVersionEvaluations indicates an expected call of UpsertArtifactVersionEvaluations.
+func (mr *MockStoreMockRecorder) UpsertArtifactVersionEvaluations(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertArtifactVersionEvaluations", reflect.TypeOf((*MockStore)(nil).UpsertArtifactVersionEvaluations), arg0, arg1)
+}
+
+// UpsertBuildEnvironment mocks base method.
+func (m *MockStore) UpsertBuildEnvironment(arg0 context.Context, arg1 db.UpsertBuildEnvironmentParams) (db.BuildEnvironment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertBuildEnvironment", arg0, arg1)
+	ret0, _ := ret[0].(db.BuildEnvironment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertBuildEnvironment indicates an expected call of UpsertBuildEnvironment.
+func (mr *MockStoreMockRecorder) UpsertBuildEnvironment(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertBuildEnvironment", reflect.TypeOf((*MockStore)(nil).UpsertBuildEnvironment), arg0, arg1)
+}
+
 // UpsertProfileForEntity mocks base method.
 func (m *MockStore) UpsertProfileForEntity(arg0 context.Context, arg1 db.UpsertProfileForEntityParams) (db.EntityProfile, error) {
 	m.ctrl.T.Helper()
@@ -1962,6 +2389,48 @@ func (mr *MockStoreMockRecorder) UpsertPullRequest(arg0, arg1 interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertPullRequest", reflect.TypeOf((*MockStore)(nil).UpsertPullRequest), arg0, arg1)
 }
 
+// UpsertRemediationApproval mocks base method.
+func (m *MockStore) UpsertRemediationApproval(arg0 context.Context, arg1 db.UpsertRemediationApprovalParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertRemediationApproval", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertRemediationApproval indicates an expected call of UpsertRemediationApproval.
+func (mr *MockStoreMockRecorder) UpsertRemediationApproval(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertRemediationApproval", reflect.TypeOf((*MockStore)(nil).UpsertRemediationApproval), arg0, arg1)
+}
+
+// UpsertRemediationPullRequest mocks base method.
+func (m *MockStore) UpsertRemediationPullRequest(arg0 context.Context, arg1 db.UpsertRemediationPullRequestParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertRemediationPullRequest", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertRemediationPullRequest indicates an expected call of UpsertRemediationPullRequest.
+func (mr *MockStoreMockRecorder) UpsertRemediationPullRequest(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertRemediationPullRequest", reflect.TypeOf((*MockStore)(nil).UpsertRemediationPullRequest), arg0, arg1)
+}
+
+// UpsertRepositoryDependency mocks base method.
+func (m *MockStore) UpsertRepositoryDependency(arg0 context.Context, arg1 db.UpsertRepositoryDependencyParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertRepositoryDependency", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertRepositoryDependency indicates an expected call of UpsertRepositoryDependency.
+func (mr *MockStoreMockRecorder) UpsertRepositoryDependency(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertRepositoryDependency", reflect.TypeOf((*MockStore)(nil).UpsertRepositoryDependency), arg0, arg1)
+}
+
 // UpsertRuleDetailsAlert mocks base method.
 func (m *MockStore) UpsertRuleDetailsAlert(arg0 context.Context, arg1 db.UpsertRuleDetailsAlertParams) (uuid.UUID, error) {
 	m.ctrl.T.Helper()
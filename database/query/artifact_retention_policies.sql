@@ -0,0 +1,12 @@
+-- name: GetArtifactRetentionPolicy :one
+SELECT * FROM artifact_retention_policies WHERE project_id = $1;
+
+-- name: UpsertArtifactRetentionPolicy :one
+INSERT INTO artifact_retention_policies (project_id, keep_last, max_age_days)
+VALUES ($1, $2, $3)
+ON CONFLICT (project_id)
+DO UPDATE SET
+    keep_last = $2,
+    max_age_days = $3,
+    updated_at = NOW()
+RETURNING *;
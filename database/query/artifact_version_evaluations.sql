@@ -0,0 +1,50 @@
+-- name: UpsertArtifactVersionEvaluations :exec
+-- records the status of a rule evaluation for the versions of the artifact
+-- with the given digests
+INSERT INTO artifact_version_evaluations (
+    artifact_version_id,
+    rule_eval_id,
+    status,
+    details,
+    last_updated
+)
+SELECT av.id, sqlc.arg(rule_eval_id), sqlc.arg(status), sqlc.arg(details), NOW()
+FROM artifact_versions av
+WHERE av.artifact_id = sqlc.arg(artifact_id) AND av.sha = ANY(sqlc.arg(shas)::text[])
+ON CONFLICT (artifact_version_id, rule_eval_id)
+    DO UPDATE SET
+        status = EXCLUDED.status,
+        details = EXCLUDED.details,
+        last_updated = NOW();
+
+-- name: DeleteStaleArtifactVersionEvaluations :exec
+-- removes the statuses of a rule evaluation for the versions of the artifact
+-- that are not among the given digests
+DELETE FROM artifact_version_evaluations ave
+USING artifact_versions av
+WHERE ave.artifact_version_id = av.id
+AND ave.rule_eval_id = sqlc.arg(rule_eval_id)
+AND NOT (av.sha = ANY(sqlc.arg(shas)::text[]));
+
+-- name: ListArtifactVersionEvaluations :many
+-- lists the rule evaluation statuses of the versions of an artifact, optionally
+-- restricted to a profile
+SELECT
+    av.id AS artifact_version_id,
+    av.version,
+    av.sha,
+    av.tags,
+    av.created_at,
+    p.name AS profile_name,
+    rt.name AS rule_type_name,
+    ave.status,
+    ave.details,
+    ave.last_updated
+FROM artifact_version_evaluations ave
+INNER JOIN artifact_versions av ON av.id = ave.artifact_version_id
+INNER JOIN rule_evaluations re ON re.id = ave.rule_eval_id
+INNER JOIN profiles p ON p.id = re.profile_id
+INNER JOIN rule_type rt ON rt.id = re.rule_type_id
+WHERE av.artifact_id = sqlc.arg(artifact_id)
+AND (p.name = sqlc.narg(profile_name) OR sqlc.narg(profile_name) IS NULL)
+ORDER BY av.created_at DESC, p.name, rt.name;
@@ -5,9 +5,11 @@ INSERT INTO artifact_versions (
     tags,
     sha,
     signature_verification,
-    github_workflow, created_at) VALUES ($1, $2, $3, $4,
+    github_workflow,
+    provenance, created_at) VALUES ($1, $2, $3, $4,
     sqlc.arg(signature_verification)::jsonb,
     sqlc.arg(github_workflow)::jsonb,
+    sqlc.arg(provenance)::jsonb,
     $5) RETURNING *;
 
 -- name: UpsertArtifactVersion :one
@@ -18,10 +20,12 @@ INSERT INTO artifact_versions (
     sha,
     signature_verification,
     github_workflow,
+    provenance,
     created_at
 ) VALUES ($1, $2, $3, $4,
     sqlc.arg(signature_verification)::jsonb,
     sqlc.arg(github_workflow)::jsonb,
+    sqlc.arg(provenance)::jsonb,
     $5)
 ON CONFLICT (artifact_id, sha)
 DO UPDATE SET
@@ -29,6 +33,7 @@ DO UPDATE SET
     tags = $3,
     signature_verification = sqlc.arg(signature_verification)::jsonb,
     github_workflow = sqlc.arg(github_workflow)::jsonb,
+    provenance = sqlc.arg(provenance)::jsonb,
     created_at = $5
 WHERE artifact_versions.artifact_id = $1 AND artifact_versions.sha = $4
 RETURNING *;
@@ -59,4 +64,31 @@ WHERE id = $1;
 
 -- name: DeleteOldArtifactVersions :exec
 DELETE FROM artifact_versions
-WHERE artifact_id = $1 AND created_at <= $2;
\ No newline at end of file
+WHERE artifact_id = $1 AND created_at <= $2;
+
+-- name: ListPrunableArtifactVersions :many
+-- versions older than created_before that are not among the keep_last most
+-- recent ones, a NULL argument means no limit
+SELECT * FROM artifact_versions
+WHERE artifact_versions.artifact_id = sqlc.arg(artifact_id)
+AND (sqlc.narg(created_before)::timestamp IS NOT NULL OR sqlc.narg(keep_last)::int IS NOT NULL)
+AND (sqlc.narg(created_before)::timestamp IS NULL OR artifact_versions.created_at <= sqlc.narg(created_before)::timestamp)
+AND (sqlc.narg(keep_last)::int IS NULL OR artifact_versions.id NOT IN (
+    SELECT kept.id FROM artifact_versions AS kept
+    WHERE kept.artifact_id = sqlc.arg(artifact_id)
+    ORDER BY kept.created_at DESC
+    LIMIT sqlc.narg(keep_last)::int))
+ORDER BY artifact_versions.created_at DESC;
+
+-- name: DeletePrunableArtifactVersions :many
+-- deletes the versions ListPrunableArtifactVersions lists
+DELETE FROM artifact_versions
+WHERE artifact_versions.artifact_id = sqlc.arg(artifact_id)
+AND (sqlc.narg(created_before)::timestamp IS NOT NULL OR sqlc.narg(keep_last)::int IS NOT NULL)
+AND (sqlc.narg(created_before)::timestamp IS NULL OR artifact_versions.created_at <= sqlc.narg(created_before)::timestamp)
+AND (sqlc.narg(keep_last)::int IS NULL OR artifact_versions.id NOT IN (
+    SELECT kept.id FROM artifact_versions AS kept
+    WHERE kept.artifact_id = sqlc.arg(artifact_id)
+    ORDER BY kept.created_at DESC
+    LIMIT sqlc.narg(keep_last)::int))
+RETURNING *;
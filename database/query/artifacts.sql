@@ -10,18 +10,20 @@ INSERT INTO artifacts (
     repository_id,
     artifact_name,
     artifact_type,
-    artifact_visibility
-) VALUES ($1, $2, $3, $4)
+    artifact_visibility,
+    provider
+) VALUES ($1, $2, $3, $4, $5)
 ON CONFLICT (repository_id, LOWER(artifact_name))
 DO UPDATE SET
     artifact_type = $3,
-    artifact_visibility = $4
+    artifact_visibility = $4,
+    provider = $5
 WHERE artifacts.repository_id = $1 AND artifacts.artifact_name = $2
 RETURNING *;
 
 -- name: GetArtifactByID :one
 SELECT artifacts.id, artifacts.repository_id, artifacts.artifact_name, artifacts.artifact_type,
-artifacts.artifact_visibility, artifacts.created_at,
+artifacts.artifact_visibility, artifacts.created_at, artifacts.provider AS artifact_provider,
 repositories.provider, repositories.project_id, repositories.repo_owner, repositories.repo_name
 FROM artifacts INNER JOIN repositories ON repositories.id = artifacts.repository_id
 WHERE artifacts.id = $1;
@@ -31,6 +33,18 @@ SELECT * FROM artifacts
 WHERE repository_id = $1
 ORDER BY id;
 
+-- name: ListArtifactsByProjectID :many
+SELECT artifacts.* FROM artifacts
+INNER JOIN repositories ON repositories.id = artifacts.repository_id
+WHERE repositories.project_id = $1
+ORDER BY artifacts.id;
+
+-- name: ListArtifactsByProvider :many
+SELECT artifacts.* FROM artifacts
+INNER JOIN repositories ON repositories.id = artifacts.repository_id
+WHERE repositories.project_id = $1 AND artifacts.provider = $2
+ORDER BY artifacts.id;
+
 -- name: DeleteArtifact :exec
 DELETE FROM artifacts
 WHERE id = $1;
\ No newline at end of file
@@ -0,0 +1,29 @@
+-- name: UpsertBuildEnvironment :one
+INSERT INTO build_environments (
+    repository_id,
+    name,
+    properties
+) VALUES ($1, $2, sqlc.arg(properties)::jsonb)
+ON CONFLICT (repository_id, name)
+DO UPDATE SET
+    properties = sqlc.arg(properties)::jsonb,
+    updated_at = NOW()
+WHERE build_environments.repository_id = $1 AND build_environments.name = $2
+RETURNING *;
+
+-- name: GetBuildEnvironmentByID :one
+SELECT * FROM build_environments
+WHERE id = $1;
+
+-- name: ListBuildEnvironmentsByRepositoryID :many
+SELECT * FROM build_environments
+WHERE repository_id = $1
+ORDER BY name;
+
+-- name: DeleteBuildEnvironment :exec
+DELETE FROM build_environments
+WHERE id = $1;
+
+-- name: DeleteBuildEnvironmentByName :exec
+DELETE FROM build_environments
+WHERE repository_id = $1 AND name = $2;
@@ -11,15 +11,17 @@ INSERT INTO entity_execution_lock(
     last_lock_time,
     repository_id,
     artifact_id,
-    pull_request_id
+    pull_request_id,
+    build_environment_id
 ) VALUES(
     sqlc.arg(entity)::entities,
     gen_random_uuid(),
     NOW(),
     sqlc.arg(repository_id)::UUID,
     sqlc.narg(artifact_id)::UUID,
-    sqlc.narg(pull_request_id)::UUID
-) ON CONFLICT(entity, repository_id, COALESCE(artifact_id, '00000000-0000-0000-0000-000000000000'::UUID), COALESCE(pull_request_id, '00000000-0000-0000-0000-000000000000'::UUID))
+    sqlc.narg(pull_request_id)::UUID,
+    sqlc.narg(build_environment_id)::UUID
+) ON CONFLICT(entity, repository_id, COALESCE(artifact_id, '00000000-0000-0000-0000-000000000000'::UUID), COALESCE(pull_request_id, '00000000-0000-0000-0000-000000000000'::UUID), COALESCE(build_environment_id, '00000000-0000-0000-0000-000000000000'::UUID))
 DO UPDATE SET
     locked_by = gen_random_uuid(),
     last_lock_time = NOW()
@@ -35,6 +37,7 @@ DELETE FROM entity_execution_lock
 WHERE entity = sqlc.arg(entity)::entities AND repository_id = sqlc.arg(repository_id)::UUID AND
     COALESCE(artifact_id, '00000000-0000-0000-0000-000000000000'::UUID) = COALESCE(sqlc.narg(artifact_id)::UUID, '00000000-0000-0000-0000-000000000000'::UUID) AND
     COALESCE(pull_request_id, '00000000-0000-0000-0000-000000000000'::UUID) = COALESCE(sqlc.narg(pull_request_id)::UUID, '00000000-0000-0000-0000-000000000000'::UUID) AND
+    COALESCE(build_environment_id, '00000000-0000-0000-0000-000000000000'::UUID) = COALESCE(sqlc.narg(build_environment_id)::UUID, '00000000-0000-0000-0000-000000000000'::UUID) AND
     locked_by = sqlc.arg(locked_by)::UUID;
 
 -- name: UpdateLease :exec
@@ -42,6 +45,7 @@ UPDATE entity_execution_lock SET last_lock_time = NOW()
 WHERE entity = $1 AND repository_id = $2 AND
 COALESCE(artifact_id, '00000000-0000-0000-0000-000000000000'::UUID) = COALESCE(sqlc.narg(artifact_id)::UUID, '00000000-0000-0000-0000-000000000000'::UUID) AND
 COALESCE(pull_request_id, '00000000-0000-0000-0000-000000000000'::UUID) = COALESCE(sqlc.narg(pull_request_id)::UUID, '00000000-0000-0000-0000-000000000000'::UUID) AND
+COALESCE(build_environment_id, '00000000-0000-0000-0000-000000000000'::UUID) = COALESCE(sqlc.narg(build_environment_id)::UUID, '00000000-0000-0000-0000-000000000000'::UUID) AND
 locked_by = sqlc.arg(locked_by)::UUID;
 
 -- name: EnqueueFlush :one
@@ -49,13 +53,15 @@ INSERT INTO flush_cache(
     entity,
     repository_id,
     artifact_id,
-    pull_request_id
+    pull_request_id,
+    build_environment_id
 ) VALUES(
     sqlc.arg(entity)::entities,
     sqlc.arg(repository_id)::UUID,
     sqlc.narg(artifact_id)::UUID,
-    sqlc.narg(pull_request_id)::UUID
-) ON CONFLICT(entity, repository_id, COALESCE(artifact_id, '00000000-0000-0000-0000-000000000000'::UUID), COALESCE(pull_request_id, '00000000-0000-0000-0000-000000000000'::UUID))
+    sqlc.narg(pull_request_id)::UUID,
+    sqlc.narg(build_environment_id)::UUID
+) ON CONFLICT(entity, repository_id, COALESCE(artifact_id, '00000000-0000-0000-0000-000000000000'::UUID), COALESCE(pull_request_id, '00000000-0000-0000-0000-000000000000'::UUID), COALESCE(build_environment_id, '00000000-0000-0000-0000-000000000000'::UUID))
 DO NOTHING
 RETURNING *;
 
@@ -63,7 +69,8 @@ RETURNING *;
 DELETE FROM flush_cache
 WHERE entity = $1 AND repository_id = $2 AND
     COALESCE(artifact_id, '00000000-0000-0000-0000-000000000000'::UUID) = COALESCE(sqlc.narg(artifact_id)::UUID, '00000000-0000-0000-0000-000000000000'::UUID) AND
-    COALESCE(pull_request_id, '00000000-0000-0000-0000-000000000000'::UUID) = COALESCE(sqlc.narg(pull_request_id)::UUID, '00000000-0000-0000-0000-000000000000'::UUID)
+    COALESCE(pull_request_id, '00000000-0000-0000-0000-000000000000'::UUID) = COALESCE(sqlc.narg(pull_request_id)::UUID, '00000000-0000-0000-0000-000000000000'::UUID) AND
+    COALESCE(build_environment_id, '00000000-0000-0000-0000-000000000000'::UUID) = COALESCE(sqlc.narg(build_environment_id)::UUID, '00000000-0000-0000-0000-000000000000'::UUID)
 RETURNING *;
 
 -- name: ListFlushCache :many
@@ -1,9 +1,9 @@
 
 -- name: UpsertRuleEvaluations :one
 INSERT INTO rule_evaluations (
-    profile_id, repository_id, artifact_id, pull_request_id, rule_type_id, entity
-) VALUES ($1, $2, $3, $4, $5, $6)
-ON CONFLICT (profile_id, repository_id, COALESCE(artifact_id, '00000000-0000-0000-0000-000000000000'::UUID), COALESCE(pull_request_id, '00000000-0000-0000-0000-000000000000'::UUID), entity, rule_type_id)
+    profile_id, repository_id, artifact_id, pull_request_id, rule_type_id, entity, build_environment_id
+) VALUES ($1, $2, $3, $4, $5, $6, sqlc.narg(build_environment_id))
+ON CONFLICT (profile_id, repository_id, COALESCE(artifact_id, '00000000-0000-0000-0000-000000000000'::UUID), COALESCE(pull_request_id, '00000000-0000-0000-0000-000000000000'::UUID), entity, rule_type_id, COALESCE(build_environment_id, '00000000-0000-0000-0000-000000000000'::UUID))
   DO UPDATE SET profile_id = $1
 RETURNING id;
 
@@ -28,13 +28,15 @@ INSERT INTO rule_details_remediate (
     rule_eval_id,
     status,
     details,
+    metadata,
     last_updated
 )
-VALUES ($1, $2, $3, NOW())
+VALUES ($1, $2, $3, sqlc.arg(metadata)::jsonb, NOW())
 ON CONFLICT(rule_eval_id)
     DO UPDATE SET
                   status = $2,
                   details = $3,
+                  metadata = sqlc.arg(metadata)::jsonb,
                   last_updated = NOW()
     WHERE rule_details_remediate.rule_eval_id = $1
 RETURNING id;
@@ -96,6 +98,7 @@ WITH
            rule_eval_id,
            status AS rem_status,
            details AS rem_details,
+           metadata AS rem_metadata,
            last_updated AS rem_last_updated
        FROM rule_details_remediate
    ),
@@ -115,12 +118,14 @@ SELECT
     ed.eval_details,
     rd.rem_status,
     rd.rem_details,
+    rd.rem_metadata,
     rd.rem_last_updated,
     ad.alert_status,
     ad.alert_details,
     ad.alert_metadata,
     ad.alert_last_updated,
     res.repository_id,
+    res.build_environment_id,
     res.entity,
     repo.repo_name,
     repo.repo_owner,
@@ -140,6 +145,7 @@ WHERE res.profile_id = $1 AND
             WHEN sqlc.narg(entity_type)::entities  = 'artifact' AND res.artifact_id = sqlc.narg(entity_id)::UUID THEN true
             WHEN sqlc.narg(entity_type)::entities  = 'artifact' AND res.artifact_id = sqlc.narg(entity_id)::UUID THEN true
             WHEN sqlc.narg(entity_type)::entities  = 'pull_request' AND res.pull_request_id = sqlc.narg(entity_id)::UUID THEN true
+            WHEN sqlc.narg(entity_type)::entities  = 'build_environment' AND res.build_environment_id = sqlc.narg(entity_id)::UUID THEN true
             WHEN sqlc.narg(entity_id)::UUID IS NULL THEN true
             ELSE false
             END
@@ -18,4 +18,8 @@ SELECT * FROM providers WHERE project_id = $1;
 SELECT * FROM providers;
 
 -- name: DeleteProvider :exec
-DELETE FROM providers WHERE id = $1 AND project_id = $2;
\ No newline at end of file
+DELETE FROM providers WHERE id = $1 AND project_id = $2;
+
+-- name: UpdateProviderDefinition :one
+UPDATE providers SET definition = sqlc.arg(definition)::jsonb, updated_at = NOW()
+WHERE id = $1 AND project_id = $2 RETURNING *;
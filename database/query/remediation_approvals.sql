@@ -0,0 +1,64 @@
+-- UpsertRemediationApproval records the request proposed by the remediation of a rule
+-- evaluation. A proposal that didn't change keeps its decision, unless applying it failed or
+-- it was applied already and is proposed again because the rule still fails.
+
+-- name: UpsertRemediationApproval :exec
+INSERT INTO remediation_approvals (
+    rule_eval_id,
+    method,
+    endpoint,
+    body
+) VALUES ($1, $2, $3, $4)
+ON CONFLICT (rule_eval_id)
+DO UPDATE SET
+    method = $2,
+    endpoint = $3,
+    body = $4,
+    status = 'pending',
+    details = '',
+    decided_by = NULL,
+    decided_at = NULL,
+    updated_at = NOW()
+WHERE remediation_approvals.status IN ('failed', 'applied') OR
+    (remediation_approvals.status <> 'applying' AND
+    (remediation_approvals.method, remediation_approvals.endpoint, remediation_approvals.body) IS DISTINCT FROM ($2, $3, $4));
+
+-- DeleteRemediationApproval removes the approval of a rule evaluation whose remediation doesn't
+-- propose a request anymore, so that the next proposal is decided on again. An approval that is
+-- being applied is kept until its request is performed.
+
+-- name: DeleteRemediationApproval :exec
+DELETE FROM remediation_approvals
+WHERE rule_eval_id = $1 AND status <> 'applying';
+
+-- name: GetRemediationApprovalByID :one
+SELECT ra.*, p.provider, p.name AS profile_name FROM remediation_approvals ra
+INNER JOIN rule_evaluations re ON re.id = ra.rule_eval_id
+INNER JOIN profiles p ON p.id = re.profile_id
+WHERE ra.id = $1 AND p.project_id = $2;
+
+-- name: ListRemediationApprovalsByProject :many
+SELECT ra.*,
+    p.name AS profile_name,
+    rt.name AS rule_type_name,
+    re.entity,
+    repo.repo_owner,
+    repo.repo_name
+FROM remediation_approvals ra
+INNER JOIN rule_evaluations re ON re.id = ra.rule_eval_id
+INNER JOIN profiles p ON p.id = re.profile_id
+INNER JOIN rule_type rt ON rt.id = re.rule_type_id
+INNER JOIN repositories repo ON repo.id = re.repository_id
+WHERE p.project_id = $1 AND
+    (sqlc.narg(status)::remediation_approval_status IS NULL OR ra.status = sqlc.narg(status)::remediation_approval_status)
+ORDER BY ra.created_at;
+
+-- UpdateRemediationApprovalStatus moves a remediation approval from the given status to a
+-- new one. No rows are returned if the approval isn't in the given status (anymore), e.g.
+-- because it was decided on concurrently.
+
+-- name: UpdateRemediationApprovalStatus :one
+UPDATE remediation_approvals
+SET status = $2, details = $3, decided_by = $4, decided_at = NOW(), updated_at = NOW()
+WHERE id = $1 AND status = sqlc.arg(from_status)::remediation_approval_status
+RETURNING *;
@@ -0,0 +1,36 @@
+-- UpsertRemediationPullRequest records the pull request opened by the remediation of a
+-- rule evaluation. A different pull request replaces the one recorded before.
+
+-- name: UpsertRemediationPullRequest :exec
+INSERT INTO remediation_pull_requests (
+    rule_eval_id,
+    repository_id,
+    pr_number,
+    branch,
+    content_sha
+) VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (rule_eval_id)
+DO UPDATE SET
+    repository_id = $2,
+    pr_number = $3,
+    branch = $4,
+    content_sha = $5,
+    created_at = CASE WHEN remediation_pull_requests.pr_number = $3
+        THEN remediation_pull_requests.created_at ELSE NOW() END,
+    updated_at = NOW()
+WHERE (remediation_pull_requests.pr_number, remediation_pull_requests.branch, remediation_pull_requests.content_sha)
+    IS DISTINCT FROM ($3, $4, $5);
+
+-- name: DeleteRemediationPullRequest :exec
+DELETE FROM remediation_pull_requests WHERE rule_eval_id = $1;
+
+-- name: ListRemediationPullRequestsByRepository :many
+SELECT rpr.*,
+    p.name AS profile_name,
+    rt.name AS rule_type_name
+FROM remediation_pull_requests rpr
+INNER JOIN rule_evaluations re ON re.id = rpr.rule_eval_id
+INNER JOIN profiles p ON p.id = re.profile_id
+INNER JOIN rule_type rt ON rt.id = re.rule_type_id
+WHERE rpr.repository_id = $1
+ORDER BY rpr.pr_number;
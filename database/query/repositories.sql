@@ -10,7 +10,8 @@ INSERT INTO repositories (
     webhook_id,
     webhook_url,
     deploy_url,
-    clone_url) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11) RETURNING *;
+    clone_url,
+    default_branch) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12) RETURNING *;
 
 -- name: GetRepositoryByID :one
 SELECT * FROM repositories WHERE id = $1;
@@ -34,6 +35,11 @@ SELECT * FROM repositories
 WHERE provider = $1 AND project_id = $2 AND webhook_id IS NOT NULL
 ORDER BY repo_name;
 
+-- name: ListRegisteredRepositoriesByProjectID :many
+SELECT * FROM repositories
+WHERE project_id = $1 AND webhook_id IS NOT NULL
+ORDER BY repo_name;
+
 -- name: ListRepositoriesByOwner :many
 SELECT * FROM repositories
 WHERE provider = $1 AND repo_owner = $2
@@ -45,6 +51,11 @@ OFFSET $4;
 SELECT * FROM repositories WHERE provider = $1
 ORDER BY repo_name;
 
+-- name: ListAllRegisteredRepositories :many
+SELECT * FROM repositories
+WHERE webhook_id IS NOT NULL
+ORDER BY id;
+
 
 -- name: UpdateRepository :one
 UPDATE repositories 
@@ -79,6 +90,12 @@ updated_at = NOW()
 WHERE repo_id = $1 RETURNING *;
 
 
+-- name: UpdateRepositoryDefaultBranch :exec
+UPDATE repositories
+SET default_branch = $2,
+updated_at = NOW()
+WHERE id = $1;
+
 -- name: DeleteRepository :exec
 DELETE FROM repositories
 WHERE id = $1;
@@ -0,0 +1,40 @@
+-- name: UpsertRepositoryDependency :exec
+INSERT INTO repository_dependencies (
+    repository_id,
+    pull_request_id,
+    ecosystem,
+    name,
+    version,
+    file
+) VALUES (
+    sqlc.arg(repository_id)::UUID,
+    sqlc.narg(pull_request_id)::UUID,
+    sqlc.arg(ecosystem),
+    sqlc.arg(name),
+    sqlc.arg(version),
+    sqlc.arg(file)
+) ON CONFLICT(repository_id, COALESCE(pull_request_id, '00000000-0000-0000-0000-000000000000'::UUID), ecosystem, name, version, file)
+DO UPDATE SET
+    updated_at = NOW();
+
+-- DeleteRepositoryDependencies removes the dependency set of a repository's
+-- default branch, or of one of its pull requests if pull_request_id is set.
+-- It is used to replace the whole set after a new scan.
+
+-- name: DeleteRepositoryDependencies :exec
+DELETE FROM repository_dependencies
+WHERE repository_id = sqlc.arg(repository_id)::UUID AND
+    COALESCE(pull_request_id, '00000000-0000-0000-0000-000000000000'::UUID) = COALESCE(sqlc.narg(pull_request_id)::UUID, '00000000-0000-0000-0000-000000000000'::UUID);
+
+-- name: ListRepositoryDependencies :many
+SELECT rd.*, pr.pr_number FROM repository_dependencies rd
+LEFT JOIN pull_requests pr ON pr.id = rd.pull_request_id
+WHERE rd.repository_id = $1
+ORDER BY rd.ecosystem, rd.name, rd.version, rd.file;
+
+-- name: ListDependencyUsageByPackage :many
+SELECT rd.*, r.repo_owner, r.repo_name, r.provider, pr.pr_number FROM repository_dependencies rd
+JOIN repositories r ON r.id = rd.repository_id
+LEFT JOIN pull_requests pr ON pr.id = rd.pull_request_id
+WHERE r.project_id = sqlc.arg(project_id)::UUID AND rd.ecosystem = sqlc.arg(ecosystem) AND rd.name = sqlc.arg(name)
+ORDER BY r.repo_owner, r.repo_name, rd.version, rd.file;
@@ -4,8 +4,14 @@ INSERT INTO signing_keys (project_id, private_key, public_key, passphrase, key_i
 -- name: GetSigningKeyByProjectID :one
 SELECT * FROM signing_keys WHERE project_id = $1;
 
+-- name: ListSigningKeysByProjectID :many
+SELECT * FROM signing_keys WHERE project_id = $1 ORDER BY key_identifier;
+
+-- name: GetSigningKeyByProjectAndIdentifier :one
+SELECT * FROM signing_keys WHERE project_id = $1 AND key_identifier = $2;
+
 -- name: DeleteSigningKey :exec
 DELETE FROM signing_keys WHERE project_id = $1 AND key_identifier = $2;
 
 -- name: GetSigningKeyByIdentifier :one
-SELECT * FROM signing_keys WHERE key_identifier = $1;
\ No newline at end of file
+SELECT * FROM signing_keys WHERE key_identifier = $1;
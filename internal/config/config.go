@@ -41,6 +41,7 @@ type Config struct {
 	Auth          AuthConfig         `mapstructure:"auth"`
 	WebhookConfig WebhookConfig      `mapstructure:"webhook-config"`
 	Events        EventConfig        `mapstructure:"events"`
+	Reconciler    ReconcilerConfig   `mapstructure:"reconciler"`
 }
 
 // DefaultConfigForTest returns a configuration with all the struct defaults set,
@@ -0,0 +1,25 @@
+//
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+// ReconcilerConfig is the configuration for the periodic reconciliation of entities
+type ReconcilerConfig struct {
+	// ArtifactsInterval is the interval, in seconds, at which the artifacts of every
+	// registered repository are reconciled. Zero, the default, disables the periodic
+	// reconciliation. Every server enabling it reconciles all the repositories, so it
+	// should only be enabled on one replica.
+	ArtifactsInterval int64 `mapstructure:"artifacts_interval" default:"0"`
+}
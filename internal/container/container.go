@@ -19,6 +19,7 @@ import (
 	"context"
 	"crypto/x509"
 	"encoding/asn1"
+	"encoding/base64"
 	"encoding/json"
 	"encoding/pem"
 	"errors"
@@ -34,7 +35,6 @@ import (
 	"github.com/google/go-containerregistry/pkg/v1/remote"
 	"github.com/google/go-containerregistry/pkg/v1/remote/transport"
 	"github.com/rs/zerolog"
-	"github.com/sigstore/cosign/v2/cmd/cosign/cli/fulcio"
 	cosign "github.com/sigstore/cosign/v2/pkg/cosign"
 	"github.com/sigstore/cosign/v2/pkg/cosign/bundle"
 	oci "github.com/sigstore/cosign/v2/pkg/oci"
@@ -63,6 +63,12 @@ func (g githubAuthenticator) Authorization() (*authn.AuthConfig, error) {
 	}, nil
 }
 
+// githubRemoteOptions returns the options to access images in the GitHub
+// container registry with a GitHub token
+func githubRemoteOptions(owner, token string) []remote.Option {
+	return []remote.Option{remote.WithAuth(githubAuthenticator{owner, token})}
+}
+
 func artifactImageRef(registry, owner, artifactName, versionName string) string {
 	if registry == "" {
 		registry = REGISTRY
@@ -81,18 +87,37 @@ var (
 	ErrProtoParse = errors.New("error getting bytes from proto")
 )
 
-// GetArtifactSignatureAndWorkflowInfo returns the signature and workflow information as raw JSON for a given artifact
+// GetArtifactSignatureAndWorkflowInfo returns the signature and workflow information as raw JSON for a given artifact.
+// The signature is verified against the trust root, or the public good instance if it is nil.
 func GetArtifactSignatureAndWorkflowInfo(
 	ctx context.Context,
 	cli provifv1.Provider,
+	trust *TrustRoot,
 	ownerLogin, artifactName, versionName string,
 ) (sigInfo json.RawMessage, workflowInfo json.RawMessage, err error) {
 	imageRef := artifactImageRef("", ownerLogin, artifactName, versionName)
-	signatureVerification, githubWorkflow, validateErr := ValidateSignature(ctx,
-		cli.GetToken(), ownerLogin, imageRef)
+	ref, parseErr := name.ParseReference(imageRef)
+	if parseErr != nil {
+		err = fmt.Errorf("%w: error parsing image-ref %s: %s", ErrSigValidation,
+			imageRef, parseErr.Error())
+		return
+	}
+
+	return GetImageSignatureAndWorkflowInfo(ctx, ref, trust, githubRemoteOptions(ownerLogin, cli.GetToken())...)
+}
+
+// GetImageSignatureAndWorkflowInfo returns the signature and workflow information as raw JSON
+// for an image in any OCI registry, the remote options carry the credentials of the registry
+func GetImageSignatureAndWorkflowInfo(
+	ctx context.Context,
+	ref name.Reference,
+	trust *TrustRoot,
+	remoteOpts ...remote.Option,
+) (sigInfo json.RawMessage, workflowInfo json.RawMessage, err error) {
+	signatureVerification, githubWorkflow, validateErr := validateSignature(ctx, ref, remoteOpts, trust)
 	if validateErr != nil {
 		err = fmt.Errorf("%w: errorvalidating image-ref %s: %s", ErrSigValidation,
-			imageRef, validateErr.Error())
+			ref.String(), validateErr.Error())
 		return
 	}
 
@@ -118,7 +143,9 @@ func GetArtifactSignatureAndWorkflowInfo(
 
 func extractAndValidateSignature(
 	ctx context.Context,
-	package_url, package_owner, accessToken string,
+	ref name.Reference,
+	remoteOpts []remote.Option,
+	trust *TrustRoot,
 	manifest containerregistry.Manifest,
 	signatureVerification *pb.SignatureVerification,
 	githubWorkflow *pb.GithubWorkflow,
@@ -130,7 +157,7 @@ func extractAndValidateSignature(
 		signatureVerification.CertIssuer = &issuer
 
 		// we have issuer and identity, we can verify the image
-		verified, bundleVerified, imageKeys, err := VerifyFromIdentity(ctx, package_url, package_owner, accessToken, identity, issuer)
+		verified, bundleVerified, imageKeys, err := verifyFromIdentity(ctx, ref, remoteOpts, trust, identity, issuer)
 		if err == nil {
 			// we can add information for the image
 			signatureVerification.IsVerified = verified
@@ -190,6 +217,14 @@ func extractAndValidateSignature(
 	} else {
 		log.Printf("error extracting identity from certificate: %v", err)
 	}
+
+	// signatures made with a key carry no certificate, try the keys of the project
+	if !signatureVerification.IsVerified {
+		if keyID := trust.verifyFromKeys(ctx, ref, remoteOpts); keyID != "" {
+			signatureVerification.IsVerified = true
+			signatureVerification.KeyIdentifier = &keyID
+		}
+	}
 }
 
 // readValueAs gets the typed value from the given accessor. Returns an error when the accessor
@@ -222,6 +257,84 @@ func ValidateSignature(ctx context.Context, accessToken string, package_owner st
 		return nil, nil, fmt.Errorf("error parsing image path: %w", err)
 	}
 
+	return validateSignature(ctx, baseRef, githubRemoteOptions(package_owner, accessToken), nil)
+}
+
+// validateSignature returns the signature verification of an image. For multi-platform
+// images the index signature is verified, and the images of each platform are verified
+// and reported separately, since they may be signed instead of the index.
+func validateSignature(
+	ctx context.Context,
+	baseRef name.Reference,
+	remoteOpts []remote.Option,
+	trust *TrustRoot,
+) (*pb.SignatureVerification, *pb.GithubWorkflow, error) {
+	signature_verification, github_workflow := validateManifestSignature(ctx, baseRef, remoteOpts, trust)
+
+	platforms, err := getIndexPlatforms(baseRef, remoteOpts)
+	if err != nil {
+		// just log error, the signature of the index is still reported
+		log.Printf("error getting platforms of %s: %v", baseRef.String(), err)
+		return signature_verification, github_workflow, nil
+	}
+
+	for _, platform := range platforms {
+		platformRef := baseRef.Context().Digest(platform.Digest.String())
+		platformVerification, platformWorkflow := validateManifestSignature(ctx, platformRef, remoteOpts, trust)
+		signature_verification.Platforms = append(signature_verification.Platforms, &pb.PlatformSignatureVerification{
+			Platform:              platformString(platform.Platform),
+			Digest:                platform.Digest.String(),
+			SignatureVerification: platformVerification,
+		})
+
+		// images signed per platform carry the workflow that built them
+		if github_workflow.GetName() == "" && platformVerification.IsVerified {
+			github_workflow = platformWorkflow
+		}
+	}
+
+	return signature_verification, github_workflow, nil
+}
+
+// getIndexPlatforms returns the descriptors of the images of the platforms of a
+// multi-platform image, or none if the reference is not an index
+func getIndexPlatforms(ref name.Reference, remoteOpts []remote.Option) ([]containerregistry.Descriptor, error) {
+	desc, err := remote.Get(ref, remoteOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error getting descriptor: %w", err)
+	}
+	if !desc.MediaType.IsIndex() {
+		return nil, nil
+	}
+
+	idx, err := desc.ImageIndex()
+	if err != nil {
+		return nil, fmt.Errorf("error getting index: %w", err)
+	}
+	manifest, err := idx.IndexManifest()
+	if err != nil {
+		return nil, fmt.Errorf("error getting index manifest: %w", err)
+	}
+
+	var platforms []containerregistry.Descriptor
+	for _, desc := range manifest.Manifests {
+		// attestation manifests of buildkit are listed with an unknown platform
+		if desc.Platform == nil || desc.Platform.OS == "unknown" || !desc.MediaType.IsImage() {
+			continue
+		}
+		platforms = append(platforms, desc)
+	}
+
+	return platforms, nil
+}
+
+// validateManifestSignature returns the signature verification of a single manifest
+func validateManifestSignature(
+	ctx context.Context,
+	baseRef name.Reference,
+	remoteOpts []remote.Option,
+	trust *TrustRoot,
+) (*pb.SignatureVerification, *pb.GithubWorkflow) {
 	// need to retrieve package by name
 	signature_verification := &pb.SignatureVerification{
 		IsVerified:       false,
@@ -231,24 +344,24 @@ func ValidateSignature(ctx context.Context, accessToken string, package_owner st
 	github_workflow := &pb.GithubWorkflow{}
 
 	// get information about signature
-	signature, err := GetSignatureTag(baseRef, package_owner, accessToken)
+	signature, err := getSignatureTag(baseRef, remoteOpts)
 
 	// if there is a signature, we can move forward and retrieve details
 	if err == nil && signature != nil {
 		// we need to extract manifest from the signature
-		manifest, err := GetImageManifest(signature, package_owner, accessToken)
+		manifest, err := getImageManifest(signature, remoteOpts)
 		if errors.Is(err, errManifestNotFound) {
 			zerolog.Ctx(ctx).Info().
-				Str("packageUrl", package_url).
+				Str("packageUrl", baseRef.String()).
 				Msg("no manifest found")
 		} else if err != nil {
 			log.Printf("error getting manifest: %v", err)
 		} else if manifest.Layers != nil {
 			extractAndValidateSignature(
 				ctx,
-				package_url,
-				package_owner,
-				accessToken,
+				baseRef,
+				remoteOpts,
+				trust,
 				manifest,
 				signature_verification,
 				github_workflow)
@@ -256,14 +369,16 @@ func ValidateSignature(ctx context.Context, accessToken string, package_owner st
 	} else {
 		log.Printf("error getting signature tag: %v", err)
 	}
-	return signature_verification, github_workflow, nil
-
+	return signature_verification, github_workflow
 }
 
 // GetSignatureTag returns the signature tag for a given image if exists
 func GetSignatureTag(imageRef name.Reference, username string, token string) (name.Reference, error) {
-	auth := githubAuthenticator{username, token}
-	dstRef, err := ociremote.SignatureTag(imageRef, ociremote.WithRemoteOptions(remote.WithAuth(auth)))
+	return getSignatureTag(imageRef, githubRemoteOptions(username, token))
+}
+
+func getSignatureTag(imageRef name.Reference, remoteOpts []remote.Option) (name.Reference, error) {
+	dstRef, err := ociremote.SignatureTag(imageRef, ociremote.WithRemoteOptions(remoteOpts...))
 	if err != nil {
 		return nil, fmt.Errorf("error getting signature tag: %w", err)
 	}
@@ -272,8 +387,11 @@ func GetSignatureTag(imageRef name.Reference, username string, token string) (na
 
 // GetImageManifest returns the manifest for the given image
 func GetImageManifest(imageRef name.Reference, username string, token string) (containerregistry.Manifest, error) {
-	auth := githubAuthenticator{username, token}
-	img, err := remote.Image(imageRef, remote.WithAuth(auth))
+	return getImageManifest(imageRef, githubRemoteOptions(username, token))
+}
+
+func getImageManifest(imageRef name.Reference, remoteOpts []remote.Option) (containerregistry.Manifest, error) {
+	img, err := remote.Image(imageRef, remoteOpts...)
 	if err != nil {
 		var transportErr *transport.Error
 		if errors.As(err, &transportErr) {
@@ -292,6 +410,165 @@ func GetImageManifest(imageRef name.Reference, username string, token string) (c
 	return *manifest, nil
 }
 
+// ErrAttestationNotFound is returned when an image has no attestation of the requested type
+var ErrAttestationNotFound = errors.New("no matching attestation found")
+
+// ErrAttestationNotVerified is returned when none of the attestations of the requested
+// type attached to an image can be verified against the trust root
+var ErrAttestationNotVerified = errors.New("attestation could not be verified")
+
+// dsseEnvelope is the envelope cosign stores attestations in
+type dsseEnvelope struct {
+	PayloadType string `json:"payloadType"`
+	Payload     string `json:"payload"`
+}
+
+// inTotoStatement is the subset of an in-toto statement we care about
+type inTotoStatement struct {
+	PredicateType string          `json:"predicateType"`
+	Predicate     json.RawMessage `json:"predicate"`
+}
+
+var errNotInToto = errors.New("attestation is not an in-toto statement")
+
+// statementFromAttestation decodes the in-toto statement in the DSSE envelope of an attestation
+func statementFromAttestation(att oci.Signature) (*inTotoStatement, error) {
+	envPayload, err := att.Payload()
+	if err != nil {
+		return nil, fmt.Errorf("error reading attestation payload: %w", err)
+	}
+
+	var env dsseEnvelope
+	if err := json.Unmarshal(envPayload, &env); err != nil {
+		return nil, fmt.Errorf("%w: not a DSSE envelope: %s", errNotInToto, err)
+	}
+
+	rawStatement, err := base64.StdEncoding.DecodeString(env.Payload)
+	if err != nil {
+		return nil, fmt.Errorf("%w: undecodable payload: %s", errNotInToto, err)
+	}
+
+	var statement inTotoStatement
+	if err := json.Unmarshal(rawStatement, &statement); err != nil {
+		return nil, fmt.Errorf("%w: %s", errNotInToto, err)
+	}
+
+	return &statement, nil
+}
+
+// GetArtifactAttestationPredicate returns the predicate of the first verified attestation
+// of one of predicateTypes attached to the given artifact version
+func GetArtifactAttestationPredicate(
+	ctx context.Context,
+	cli provifv1.Provider,
+	trust *TrustRoot,
+	ownerLogin, artifactName, versionName string,
+	predicateTypes []string,
+) (string, json.RawMessage, error) {
+	imageRef := artifactImageRef("", ownerLogin, artifactName, versionName)
+	ref, err := name.ParseReference(imageRef)
+	if err != nil {
+		return "", nil, fmt.Errorf("error parsing reference url: %w", err)
+	}
+
+	return GetAttestationPredicate(ctx, ref, trust, predicateTypes, githubRemoteOptions(ownerLogin, cli.GetToken())...)
+}
+
+// GetAttestationPredicate returns the predicate of the first attestation attached
+// to the image whose predicate type is one of predicateTypes, along with the
+// predicate type. Only attestations verified against the trust root, either keyless
+// or with one of its keys, are considered. ErrAttestationNotVerified is returned
+// if the image only carries unverified attestations of the requested types.
+func GetAttestationPredicate(
+	ctx context.Context,
+	ref name.Reference,
+	trust *TrustRoot,
+	predicateTypes []string,
+	remoteOpts ...remote.Option,
+) (string, json.RawMessage, error) {
+	if trust == nil {
+		var err error
+		trust, err = PublicGoodTrustRoot(ctx)
+		if err != nil {
+			return "", nil, err
+		}
+	}
+
+	registryClientOpts := []ociremote.Option{ociremote.WithRemoteOptions(remoteOpts...)}
+
+	co := trust.checkOpts([]cosign.Identity{{IssuerRegExp: ".+", SubjectRegExp: ".+"}}, registryClientOpts)
+	co.ClaimVerifier = cosign.IntotoSubjectClaimVerifier
+	if atts, _, err := cosign.VerifyImageAttestations(ctx, ref, co); err == nil {
+		if pt, predicate := predicateFromAttestations(ctx, atts, predicateTypes); pt != "" {
+			return pt, predicate, nil
+		}
+	} else {
+		zerolog.Ctx(ctx).Debug().Err(err).Str("image", ref.String()).Msg("no verified keyless attestation")
+	}
+
+	for _, key := range trust.keys {
+		co := &cosign.CheckOpts{
+			RegistryClientOpts: registryClientOpts,
+			SigVerifier:        key.verifier,
+			IgnoreTlog:         true,
+			ClaimVerifier:      cosign.IntotoSubjectClaimVerifier,
+		}
+		atts, _, err := cosign.VerifyImageAttestations(ctx, ref, co)
+		if err != nil {
+			continue
+		}
+		if pt, predicate := predicateFromAttestations(ctx, atts, predicateTypes); pt != "" {
+			return pt, predicate, nil
+		}
+	}
+
+	// tell a missing attestation apart from one that doesn't verify
+	se, err := ociremote.SignedEntity(ref, registryClientOpts...)
+	if err != nil {
+		return "", nil, fmt.Errorf("error getting signed entity: %w", err)
+	}
+
+	atts, err := se.Attestations()
+	if err != nil {
+		return "", nil, fmt.Errorf("error getting attestations: %w", err)
+	}
+
+	unverified, err := atts.Get()
+	if err != nil {
+		return "", nil, fmt.Errorf("error reading attestations: %w", err)
+	}
+
+	if pt, _ := predicateFromAttestations(ctx, unverified, predicateTypes); pt != "" {
+		return "", nil, ErrAttestationNotVerified
+	}
+
+	return "", nil, ErrAttestationNotFound
+}
+
+// predicateFromAttestations returns the predicate type and predicate of the first
+// attestation whose predicate type is one of predicateTypes
+func predicateFromAttestations(
+	ctx context.Context,
+	atts []oci.Signature,
+	predicateTypes []string,
+) (string, json.RawMessage) {
+	for _, att := range atts {
+		statement, err := statementFromAttestation(att)
+		if err != nil {
+			zerolog.Ctx(ctx).Debug().Err(err).Msg("skipping attestation")
+			continue
+		}
+
+		for _, pt := range predicateTypes {
+			if statement.PredicateType == pt {
+				return statement.PredicateType, statement.Predicate
+			}
+		}
+	}
+
+	return "", nil
+}
+
 // ExtractIdentityFromCertificate returns the identity and issuer from the certificate
 func ExtractIdentityFromCertificate(manifest containerregistry.Manifest) (string, string, error) {
 	identity := ""
@@ -401,41 +678,37 @@ func GetKeysFromVerified(verified []oci.Signature) ([]payload.SimpleContainerIma
 	return outputKeys, nil
 }
 
-// VerifyFromIdentity verifies the image from the identity and extracts the keys
+// VerifyFromIdentity verifies the image from the identity and extracts the keys. The
+// signature is verified against the trust root, or the public good instance if it is nil.
 func VerifyFromIdentity(ctx context.Context, imageRef string, owner string, token string,
-	identity string, issuer string) (bool, bool, map[string]interface{}, error) {
-	imageKeys := make(map[string]interface{})
-
+	trust *TrustRoot, identity string, issuer string) (bool, bool, map[string]interface{}, error) {
 	options := []name.Option{}
 	ref, err := name.ParseReference(imageRef, options...)
 	if err != nil {
 		return false, false, nil, fmt.Errorf("error parsing reference url: %w", err)
 	}
-	identityObj := []cosign.Identity{{Issuer: issuer, Subject: identity}}
 
-	// get fulcio roots
-	rootCerts, err := fulcio.GetRoots()
-	if err != nil {
-		return false, false, nil, fmt.Errorf("error getting fulcio roots: %w", err)
-	}
+	return verifyFromIdentity(ctx, ref, githubRemoteOptions(owner, token), trust, identity, issuer)
+}
 
-	pubkeys, err := cosign.GetRekorPubs(ctx)
-	if err != nil {
-		return false, false, nil, fmt.Errorf("error getting rekor public keys: %w", err)
+func verifyFromIdentity(ctx context.Context, ref name.Reference, remoteOpts []remote.Option,
+	trust *TrustRoot, identity string, issuer string) (bool, bool, map[string]interface{}, error) {
+	imageKeys := make(map[string]interface{})
+
+	identityObj := []cosign.Identity{{Issuer: issuer, Subject: identity}}
+
+	if trust == nil {
+		var err error
+		trust, err = PublicGoodTrustRoot(ctx)
+		if err != nil {
+			return false, false, nil, err
+		}
 	}
 
 	// need to authenticate in case artifact is private
-	auth := githubAuthenticator{owner, token}
-	registryClientOpts := []ociremote.Option{ociremote.WithRemoteOptions(remote.WithAuth(auth))}
+	registryClientOpts := []ociremote.Option{ociremote.WithRemoteOptions(remoteOpts...)}
 
-	co := &cosign.CheckOpts{
-		RegistryClientOpts: registryClientOpts,
-		Identities:         identityObj,
-		RootCerts:          rootCerts,
-		RekorPubKeys:       pubkeys,
-		IgnoreSCT:          true,
-		ClaimVerifier:      cosign.SimpleClaimVerifier,
-	}
+	co := trust.checkOpts(identityObj, registryClientOpts)
 	verified, bundleVerified, err := cosign.VerifyImageSignatures(ctx, ref, co)
 	if err != nil {
 		return false, false, nil, fmt.Errorf("error verifying image: %w", err)
@@ -465,6 +738,55 @@ func VerifyFromIdentity(ctx context.Context, imageRef string, owner string, toke
 	return is_verified, bundleVerified, imageKeys, err
 }
 
+// ImageSourceAnnotation is the annotation or label that links an image to the
+// repository it was built from
+const ImageSourceAnnotation = "org.opencontainers.image.source"
+
+// ImageInfo describes an image in an OCI registry
+type ImageInfo struct {
+	// Digest is the digest of the manifest or index the reference points to
+	Digest string
+	// Created is the creation time declared in the image config
+	Created time.Time
+	// Source is the repository the image was built from, as declared by the
+	// org.opencontainers.image.source annotation of the manifest or label of the config
+	Source string
+}
+
+// InspectImage returns the digest, creation time and source repository of an image.
+// For multi-platform images the creation time and labels are read from the image
+// of the default platform.
+func InspectImage(ref name.Reference, remoteOpts ...remote.Option) (*ImageInfo, error) {
+	desc, err := remote.Get(ref, remoteOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error getting descriptor of %s: %w", ref.String(), err)
+	}
+
+	img, err := desc.Image()
+	if err != nil {
+		return nil, fmt.Errorf("error getting image %s: %w", ref.String(), err)
+	}
+
+	info := &ImageInfo{Digest: desc.Digest.String()}
+
+	manifest, err := img.Manifest()
+	if err != nil {
+		return nil, fmt.Errorf("error getting manifest of %s: %w", ref.String(), err)
+	}
+	info.Source = manifest.Annotations[ImageSourceAnnotation]
+
+	cfg, err := img.ConfigFile()
+	if err != nil {
+		return nil, fmt.Errorf("error getting config of %s: %w", ref.String(), err)
+	}
+	info.Created = cfg.Created.Time
+	if info.Source == "" {
+		info.Source = cfg.Config.Labels[ImageSourceAnnotation]
+	}
+
+	return info, nil
+}
+
 // TagsContainSignature if tag contains the .sig suffix it's a signature, as cosign
 // stores signatures in that format
 func TagsContainSignature(tags []string) bool {
@@ -489,3 +811,19 @@ func FindSignatureTag(tags []string) string {
 	}
 	return ""
 }
+
+// TagsContainAttestation if tag contains the .att suffix it's an attestation, as cosign
+// stores attestations in that format
+func TagsContainAttestation(tags []string) bool {
+	return FindAttestationTag(tags) != ""
+}
+
+// FindAttestationTag returns the attestation tag for a given image if exists
+func FindAttestationTag(tags []string) string {
+	for _, tag := range tags {
+		if strings.HasSuffix(tag, ".att") {
+			return tag
+		}
+	}
+	return ""
+}
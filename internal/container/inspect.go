@@ -0,0 +1,321 @@
+// Copyright 2023 Stacklok, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package container
+
+import (
+	"archive/tar"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"sort"
+
+	"github.com/go-git/go-billy/v5"
+	"github.com/go-git/go-billy/v5/memfs"
+	"github.com/google/go-containerregistry/pkg/name"
+	containerregistry "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+)
+
+const (
+	// ImageBaseNameAnnotation is the annotation or label naming the base image of an image
+	ImageBaseNameAnnotation = "org.opencontainers.image.base.name"
+	// ImageBaseDigestAnnotation is the annotation or label with the digest of the base image of an image
+	ImageBaseDigestAnnotation = "org.opencontainers.image.base.digest"
+
+	// maxImageFilesystemSize is the maximum size of the files of an image extracted in memory
+	maxImageFilesystemSize = 512 << 20
+)
+
+// ErrImageFilesystemTooLarge is returned when the files of an image don't fit in memory
+var ErrImageFilesystemTooLarge = errors.New("image filesystem is too large")
+
+// ImageContent is the config and manifest of a container image rules evaluate
+type ImageContent struct {
+	// User is the user the image runs as, empty if it runs as root
+	User string `json:"user"`
+	// ExposedPorts are the ports the image exposes, e.g. 8080/tcp
+	ExposedPorts []string `json:"exposed_ports"`
+	// Labels are the labels of the image config
+	Labels map[string]string `json:"labels"`
+	// Annotations are the annotations of the image manifest
+	Annotations map[string]string `json:"annotations"`
+	// Entrypoint is the entrypoint of the image
+	Entrypoint []string `json:"entrypoint"`
+	// Cmd is the default command of the image
+	Cmd []string `json:"cmd"`
+	// Env are the environment variables of the image
+	Env []string `json:"env"`
+	// WorkingDir is the working directory of the image
+	WorkingDir string `json:"working_dir"`
+	// BaseImageName is the name of the image the image was built from, if declared
+	BaseImageName string `json:"base_image_name"`
+	// BaseImageDigest is the digest of the image the image was built from, if declared
+	BaseImageDigest string `json:"base_image_digest"`
+	// Platforms are the OS/architecture pairs the image is available for
+	Platforms []string `json:"platforms"`
+	// Layers are the digests of the layers of the image
+	Layers []string `json:"layers"`
+}
+
+// GetArtifactImageContent returns the config and manifest of a version of an artifact and,
+// if requested, its flattened filesystem. The GitHub token is only sent to the GitHub
+// container registry.
+func GetArtifactImageContent(
+	owner, artifactName, digest, token string,
+	withFilesystem bool,
+) (*ImageContent, billy.Filesystem, error) {
+	ref, err := ArtifactReference(owner, artifactName, digest)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error parsing artifact reference: %w", err)
+	}
+
+	return GetImageContent(ref, withFilesystem, artifactRemoteOptions(ref, owner, token)...)
+}
+
+// GetImageContent returns the config and manifest of an image and, if requested, its
+// flattened filesystem. For multi-platform images the config and filesystem are read
+// from the image of the default platform.
+func GetImageContent(
+	ref name.Reference,
+	withFilesystem bool,
+	remoteOpts ...remote.Option,
+) (*ImageContent, billy.Filesystem, error) {
+	desc, err := remote.Get(ref, remoteOpts...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error getting descriptor of %s: %w", ref.String(), err)
+	}
+
+	var platforms []string
+	if desc.MediaType.IsIndex() {
+		idx, err := desc.ImageIndex()
+		if err != nil {
+			return nil, nil, fmt.Errorf("error getting index %s: %w", ref.String(), err)
+		}
+		platforms, err = indexPlatforms(idx)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error getting platforms of %s: %w", ref.String(), err)
+		}
+	}
+
+	img, err := desc.Image()
+	if err != nil {
+		return nil, nil, fmt.Errorf("error getting image %s: %w", ref.String(), err)
+	}
+
+	content, err := imageContent(img)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error inspecting image %s: %w", ref.String(), err)
+	}
+	if platforms != nil {
+		content.Platforms = platforms
+	}
+
+	if !withFilesystem {
+		return content, nil, nil
+	}
+
+	fs, err := ImageFilesystem(img, maxImageFilesystemSize)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error extracting filesystem of %s: %w", ref.String(), err)
+	}
+
+	return content, fs, nil
+}
+
+// imageContent returns the config and manifest of an image
+func imageContent(img containerregistry.Image) (*ImageContent, error) {
+	manifest, err := img.Manifest()
+	if err != nil {
+		return nil, fmt.Errorf("error getting manifest: %w", err)
+	}
+
+	cfg, err := img.ConfigFile()
+	if err != nil {
+		return nil, fmt.Errorf("error getting config: %w", err)
+	}
+
+	content := &ImageContent{
+		User:        cfg.Config.User,
+		Labels:      cfg.Config.Labels,
+		Annotations: manifest.Annotations,
+		Entrypoint:  cfg.Config.Entrypoint,
+		Cmd:         cfg.Config.Cmd,
+		Env:         cfg.Config.Env,
+		WorkingDir:  cfg.Config.WorkingDir,
+		Platforms:   []string{platformString(cfg.Platform())},
+	}
+
+	for port := range cfg.Config.ExposedPorts {
+		content.ExposedPorts = append(content.ExposedPorts, port)
+	}
+	sort.Strings(content.ExposedPorts)
+
+	content.BaseImageName = annotationOrLabel(manifest.Annotations, cfg.Config.Labels, ImageBaseNameAnnotation)
+	content.BaseImageDigest = annotationOrLabel(manifest.Annotations, cfg.Config.Labels, ImageBaseDigestAnnotation)
+
+	for _, layer := range manifest.Layers {
+		content.Layers = append(content.Layers, layer.Digest.String())
+	}
+
+	return content, nil
+}
+
+// indexPlatforms returns the platforms of the images of an index
+func indexPlatforms(idx containerregistry.ImageIndex) ([]string, error) {
+	manifest, err := idx.IndexManifest()
+	if err != nil {
+		return nil, err
+	}
+
+	platforms := []string{}
+	for _, desc := range manifest.Manifests {
+		// attestation manifests of buildkit are listed with an unknown platform
+		if desc.Platform == nil || desc.Platform.OS == "unknown" {
+			continue
+		}
+		platforms = append(platforms, platformString(desc.Platform))
+	}
+
+	return platforms, nil
+}
+
+// platformString returns the OS/architecture[/variant] form of a platform
+func platformString(p *containerregistry.Platform) string {
+	if p == nil {
+		return ""
+	}
+
+	platform := p.OS + "/" + p.Architecture
+	if p.Variant != "" {
+		platform += "/" + p.Variant
+	}
+	return platform
+}
+
+func annotationOrLabel(annotations, labels map[string]string, key string) string {
+	if value := annotations[key]; value != "" {
+		return value
+	}
+	return labels[key]
+}
+
+// ImageFilesystem extracts the flattened filesystem of an image in memory. Files that
+// would make the filesystem larger than maxSize bytes fail the extraction.
+func ImageFilesystem(img containerregistry.Image, maxSize int64) (billy.Filesystem, error) {
+	rc := mutate.Extract(img)
+	defer rc.Close()
+
+	fs := memfs.New()
+	tr := tar.NewReader(rc)
+	var size int64
+	// the layers are extracted from the top, the target of a hard link may come after it
+	links := map[string]string{}
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return nil, fmt.Errorf("error reading layers: %w", err)
+		}
+
+		filename, ok := imageFilePath(hdr.Name)
+		if !ok {
+			continue
+		}
+
+		switch hdr.Typeflag {
+		case tar.TypeDir:
+			err = fs.MkdirAll(filename, hdr.FileInfo().Mode().Perm())
+		case tar.TypeSymlink:
+			err = fs.Symlink(hdr.Linkname, filename)
+		case tar.TypeLink:
+			links[filename] = path.Clean("/" + hdr.Linkname)
+		case tar.TypeReg:
+			size += hdr.Size
+			if size > maxSize {
+				return nil, ErrImageFilesystemTooLarge
+			}
+			err = writeImageFile(fs, filename, hdr, tr)
+		default:
+			// devices and fifos have no content to evaluate
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("error extracting %s: %w", hdr.Name, err)
+		}
+	}
+
+	// memfs doesn't support hard links, their targets are copied
+	for filename, target := range links {
+		info, err := fs.Stat(target)
+		if err != nil {
+			// the target was removed by an upper layer
+			continue
+		}
+		size += info.Size()
+		if size > maxSize {
+			return nil, ErrImageFilesystemTooLarge
+		}
+		if err := copyImageFile(fs, target, filename); err != nil {
+			return nil, fmt.Errorf("error extracting %s: %w", filename, err)
+		}
+	}
+
+	return fs, nil
+}
+
+// imageFilePath returns the path of a file of an image layer relative to the root of
+// the image, the root itself is not extracted
+func imageFilePath(name string) (string, bool) {
+	filename := path.Clean("/" + name)
+	return filename, filename != "/"
+}
+
+func writeImageFile(fs billy.Filesystem, filename string, hdr *tar.Header, r io.Reader) error {
+	f, err := fs.OpenFile(filename, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, hdr.FileInfo().Mode().Perm())
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	_, err = io.CopyN(f, r, hdr.Size)
+	return err
+}
+
+// copyImageFile copies the target of a hard link to the link
+func copyImageFile(fs billy.Filesystem, target, filename string) error {
+	src, err := fs.Open(target)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+
+	info, err := fs.Stat(target)
+	if err != nil {
+		return err
+	}
+
+	dst, err := fs.OpenFile(filename, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, info.Mode().Perm())
+	if err != nil {
+		return err
+	}
+	defer dst.Close()
+
+	_, err = io.Copy(dst, src)
+	return err
+}
@@ -0,0 +1,177 @@
+// Copyright 2023 Stacklok, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package container
+
+import (
+	"archive/tar"
+	"bytes"
+	"fmt"
+	"io"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-git/go-billy/v5/util"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/registry"
+	containerregistry "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/tarball"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testFile struct {
+	name     string
+	typeflag byte
+	content  string
+	linkname string
+}
+
+func testLayer(t *testing.T, files ...testFile) containerregistry.Layer {
+	t.Helper()
+
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	for _, f := range files {
+		hdr := &tar.Header{Name: f.name, Typeflag: f.typeflag, Mode: 0o755, Linkname: f.linkname}
+		if f.typeflag == tar.TypeReg {
+			hdr.Size = int64(len(f.content))
+		}
+		require.NoError(t, tw.WriteHeader(hdr))
+		_, err := tw.Write([]byte(f.content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+
+	layer, err := tarball.LayerFromOpener(func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(buf.Bytes())), nil
+	})
+	require.NoError(t, err)
+	return layer
+}
+
+// testImage returns an image with a non-root user whose binary is replaced by its
+// second layer
+func testImage(t *testing.T, arch string) containerregistry.Image {
+	t.Helper()
+
+	img, err := mutate.AppendLayers(empty.Image,
+		testLayer(t,
+			testFile{name: "bin/", typeflag: tar.TypeDir},
+			testFile{name: "bin/app", typeflag: tar.TypeReg, content: "v1"},
+			testFile{name: "etc/passwd", typeflag: tar.TypeReg, content: "root:x:0:0::/root:/bin/sh"},
+		),
+		testLayer(t,
+			testFile{name: "bin/app", typeflag: tar.TypeReg, content: "v2"},
+			testFile{name: "usr/bin/app", typeflag: tar.TypeLink, linkname: "bin/app"},
+			testFile{name: "usr/local/bin/app", typeflag: tar.TypeSymlink, linkname: "/bin/app"},
+		),
+	)
+	require.NoError(t, err)
+
+	cfg, err := img.ConfigFile()
+	require.NoError(t, err)
+	cfg = cfg.DeepCopy()
+	cfg.OS = "linux"
+	cfg.Architecture = arch
+	cfg.Config.User = "65532"
+	cfg.Config.ExposedPorts = map[string]struct{}{"8080/tcp": {}, "443/tcp": {}}
+	cfg.Config.Entrypoint = []string{"/bin/app"}
+	cfg.Config.Labels = map[string]string{
+		ImageSourceAnnotation:     "https://github.com/stacklok/minder",
+		ImageBaseDigestAnnotation: "sha256:base",
+	}
+	img, err = mutate.ConfigFile(img, cfg)
+	require.NoError(t, err)
+
+	return mutate.Annotations(img, map[string]string{
+		ImageBaseNameAnnotation: "cgr.dev/chainguard/static:latest",
+	}).(containerregistry.Image)
+}
+
+func TestGetImageContent(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(registry.New())
+	defer srv.Close()
+	host := strings.TrimPrefix(srv.URL, "http://")
+
+	ref, err := name.ParseReference(fmt.Sprintf("%s/stacklok/minder:latest", host))
+	require.NoError(t, err)
+	require.NoError(t, remote.Write(ref, testImage(t, "amd64")))
+
+	content, fs, err := GetImageContent(ref, false)
+	require.NoError(t, err)
+	assert.Nil(t, fs, "the filesystem is only extracted on request")
+	assert.Equal(t, "65532", content.User)
+	assert.Equal(t, []string{"443/tcp", "8080/tcp"}, content.ExposedPorts)
+	assert.Equal(t, []string{"/bin/app"}, content.Entrypoint)
+	assert.Equal(t, "https://github.com/stacklok/minder", content.Labels[ImageSourceAnnotation])
+	assert.Equal(t, "cgr.dev/chainguard/static:latest", content.BaseImageName)
+	assert.Equal(t, "sha256:base", content.BaseImageDigest, "the label is used without annotation")
+	assert.Equal(t, []string{"linux/amd64"}, content.Platforms)
+	assert.Len(t, content.Layers, 2)
+
+	_, fs, err = GetImageContent(ref, true)
+	require.NoError(t, err)
+	for _, filename := range []string{"/bin/app", "/usr/bin/app", "/usr/local/bin/app"} {
+		data, err := util.ReadFile(fs, filename)
+		require.NoError(t, err, filename)
+		assert.Equal(t, "v2", string(data), "%s is the binary of the upper layer", filename)
+	}
+	data, err := util.ReadFile(fs, "/etc/passwd")
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "root")
+}
+
+func TestGetImageContentOfIndex(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(registry.New())
+	defer srv.Close()
+	host := strings.TrimPrefix(srv.URL, "http://")
+
+	idx := mutate.AppendManifests(empty.Index,
+		mutate.IndexAddendum{
+			Add:        testImage(t, "amd64"),
+			Descriptor: containerregistry.Descriptor{Platform: &containerregistry.Platform{OS: "linux", Architecture: "amd64"}},
+		},
+		mutate.IndexAddendum{
+			Add: testImage(t, "arm64"),
+			Descriptor: containerregistry.Descriptor{
+				Platform: &containerregistry.Platform{OS: "linux", Architecture: "arm64", Variant: "v8"},
+			},
+		},
+	)
+
+	ref, err := name.ParseReference(fmt.Sprintf("%s/stacklok/minder:latest", host))
+	require.NoError(t, err)
+	require.NoError(t, remote.WriteIndex(ref, idx))
+
+	content, _, err := GetImageContent(ref, false)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"linux/amd64", "linux/arm64/v8"}, content.Platforms)
+	assert.Equal(t, "65532", content.User, "the config of the default platform is read")
+}
+
+func TestImageFilesystemTooLarge(t *testing.T) {
+	t.Parallel()
+
+	_, err := ImageFilesystem(testImage(t, "amd64"), 4)
+	assert.ErrorIs(t, err, ErrImageFilesystemTooLarge)
+}
@@ -0,0 +1,172 @@
+// Copyright 2023 Stacklok, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package container
+
+import (
+	"context"
+	"crypto"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/sigstore/cosign/v2/pkg/cosign"
+	ociremote "github.com/sigstore/cosign/v2/pkg/oci/remote"
+	"github.com/sigstore/sigstore/pkg/cryptoutils"
+	"github.com/sigstore/sigstore/pkg/signature"
+
+	"github.com/stacklok/minder/internal/db"
+)
+
+// VerificationKey is a public key, e.g. exported from a KMS, that signatures
+// made with the matching private key are verified against
+type VerificationKey struct {
+	// Identifier names the key in the verification results
+	Identifier string
+	verifier   signature.Verifier
+}
+
+// NewVerificationKey parses a PEM encoded public key. If the identifier is empty
+// the fingerprint of the key is used instead.
+func NewVerificationKey(identifier string, pemKey []byte) (*VerificationKey, error) {
+	pub, err := cryptoutils.UnmarshalPEMToPublicKey(pemKey)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing public key: %w", err)
+	}
+
+	verifier, err := signature.LoadVerifier(pub, crypto.SHA256)
+	if err != nil {
+		return nil, fmt.Errorf("error loading public key: %w", err)
+	}
+
+	if identifier == "" {
+		identifier, err = KeyFingerprint(pub)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &VerificationKey{Identifier: identifier, verifier: verifier}, nil
+}
+
+// KeyFingerprint returns the SHA-256 fingerprint of the DER encoding of a public key
+func KeyFingerprint(pub crypto.PublicKey) (string, error) {
+	der, err := cryptoutils.MarshalPublicKeyToDER(pub)
+	if err != nil {
+		return "", fmt.Errorf("error encoding public key: %w", err)
+	}
+
+	sum := sha256.Sum256(der)
+	return "sha256:" + hex.EncodeToString(sum[:]), nil
+}
+
+// WithSigningKeys returns a copy of the trust root that also accepts signatures
+// made with the private keys of the given signing keys of a project. Keys that
+// can't be parsed are skipped.
+func (t *TrustRoot) WithSigningKeys(keys []db.SigningKey) *TrustRoot {
+	root := &TrustRoot{}
+	if t != nil {
+		*root = *t
+	}
+
+	root.keys = make([]*VerificationKey, 0, len(keys))
+	for _, k := range keys {
+		key, err := NewVerificationKey(k.KeyIdentifier, []byte(k.PublicKey))
+		if err != nil {
+			log.Printf("error loading signing key %s: %v", k.KeyIdentifier, err)
+			continue
+		}
+		root.keys = append(root.keys, key)
+	}
+
+	return root
+}
+
+// verifyFromKeys returns the identifier of the first key of the trust root that
+// verifies a signature of the image, or an empty string if none does
+func (t *TrustRoot) verifyFromKeys(ctx context.Context, ref name.Reference, remoteOpts []remote.Option) string {
+	if t == nil {
+		return ""
+	}
+
+	return verifyFromKeys(ctx, ref, remoteOpts, t.keys)
+}
+
+func verifyFromKeys(
+	ctx context.Context,
+	ref name.Reference,
+	remoteOpts []remote.Option,
+	keys []*VerificationKey,
+) string {
+	registryClientOpts := []ociremote.Option{ociremote.WithRemoteOptions(remoteOpts...)}
+
+	for _, key := range keys {
+		co := &cosign.CheckOpts{
+			RegistryClientOpts: registryClientOpts,
+			SigVerifier:        key.verifier,
+			// the key is the trust anchor, signatures made with keys are commonly
+			// not uploaded to a transparency log
+			IgnoreTlog:    true,
+			ClaimVerifier: cosign.SimpleClaimVerifier,
+		}
+
+		verified, _, err := cosign.VerifyImageSignatures(ctx, ref, co)
+		if err == nil && len(verified) > 0 {
+			return key.Identifier
+		}
+	}
+
+	return ""
+}
+
+// ArtifactReference returns the reference to a version of an artifact. Artifacts
+// of OCI registry providers are named after their repository in the registry,
+// other artifacts are GitHub packages.
+func ArtifactReference(owner, artifactName, digest string) (name.Reference, error) {
+	imageRef := artifactImageRef("", owner, artifactName, digest)
+	if host, _, ok := strings.Cut(artifactName, "/"); ok && strings.ContainsAny(host, ".:") {
+		imageRef = fmt.Sprintf("%s@%s", artifactName, digest)
+	}
+
+	return name.ParseReference(imageRef)
+}
+
+// VerifyArtifactWithKeys verifies the signatures of a version of an artifact against
+// public keys and returns the identifier of the key that verified it, or an empty
+// string. The GitHub token is only sent to the GitHub container registry.
+func VerifyArtifactWithKeys(
+	ctx context.Context,
+	owner, artifactName, digest, token string,
+	keys []*VerificationKey,
+) (string, error) {
+	ref, err := ArtifactReference(owner, artifactName, digest)
+	if err != nil {
+		return "", fmt.Errorf("error parsing artifact reference: %w", err)
+	}
+
+	return verifyFromKeys(ctx, ref, artifactRemoteOptions(ref, owner, token), keys), nil
+}
+
+// artifactRemoteOptions returns the options to access a version of an artifact, the GitHub
+// token is only sent to the GitHub container registry
+func artifactRemoteOptions(ref name.Reference, owner, token string) []remote.Option {
+	if ref.Context().RegistryStr() != REGISTRY {
+		return nil
+	}
+	return githubRemoteOptions(owner, token)
+}
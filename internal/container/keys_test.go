@@ -0,0 +1,238 @@
+// Copyright 2023 Stacklok, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package container
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/registry"
+	containerregistry "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	ggcrmutate "github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/sigstore/cosign/v2/pkg/oci/mutate"
+	ociremote "github.com/sigstore/cosign/v2/pkg/oci/remote"
+	"github.com/sigstore/cosign/v2/pkg/oci/static"
+	"github.com/sigstore/sigstore/pkg/cryptoutils"
+	"github.com/sigstore/sigstore/pkg/signature"
+	"github.com/sigstore/sigstore/pkg/signature/payload"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/stacklok/minder/internal/db"
+)
+
+// pushSignedImage pushes a random image to the registry and signs it with the key
+// the same way `cosign sign --key` does
+func pushSignedImage(t *testing.T, host string, key *ecdsa.PrivateKey) name.Digest {
+	t.Helper()
+
+	img, err := random.Image(1024, 1)
+	require.NoError(t, err)
+
+	ref, err := name.ParseReference(fmt.Sprintf("%s/stacklok/minder:latest", host))
+	require.NoError(t, err)
+	require.NoError(t, remote.Write(ref, img))
+
+	digest, err := img.Digest()
+	require.NoError(t, err)
+	digestRef := ref.Context().Digest(digest.String())
+
+	if key != nil {
+		signImage(t, digestRef, key)
+	}
+
+	return digestRef
+}
+
+// signImage signs an image in the registry with the key the same way `cosign sign --key` does
+func signImage(t *testing.T, digestRef name.Digest, key *ecdsa.PrivateKey) {
+	t.Helper()
+
+	rawPayload, err := (&payload.Cosign{Image: digestRef}).MarshalJSON()
+	require.NoError(t, err)
+
+	signer, err := signature.LoadECDSASignerVerifier(key, crypto.SHA256)
+	require.NoError(t, err)
+	sig, err := signer.SignMessage(bytes.NewReader(rawPayload))
+	require.NoError(t, err)
+
+	ociSig, err := static.NewSignature(rawPayload, base64.StdEncoding.EncodeToString(sig))
+	require.NoError(t, err)
+
+	entity, err := ociremote.SignedEntity(digestRef)
+	require.NoError(t, err)
+	signed, err := mutate.AttachSignatureToEntity(entity, ociSig)
+	require.NoError(t, err)
+	require.NoError(t, ociremote.WriteSignatures(digestRef.Repository, signed))
+}
+
+func signingKey(t *testing.T, identifier string, key *ecdsa.PrivateKey) db.SigningKey {
+	t.Helper()
+
+	pemKey, err := cryptoutils.MarshalPublicKeyToPEM(key.Public())
+	require.NoError(t, err)
+
+	return db.SigningKey{KeyIdentifier: identifier, PublicKey: string(pemKey)}
+}
+
+func TestValidateSignatureWithSigningKeys(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(registry.New())
+	defer srv.Close()
+	host := strings.TrimPrefix(srv.URL, "http://")
+
+	releaseKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	ref := pushSignedImage(t, host, releaseKey)
+
+	trust := (*TrustRoot)(nil).WithSigningKeys([]db.SigningKey{
+		signingKey(t, "other", otherKey),
+		{KeyIdentifier: "broken", PublicKey: "not a key"},
+		signingKey(t, "release", releaseKey),
+	})
+	require.Len(t, trust.keys, 2, "keys that can't be parsed are skipped")
+
+	sigVerification, _, err := validateSignature(context.Background(), ref, nil, trust)
+	require.NoError(t, err)
+	assert.True(t, sigVerification.IsSigned)
+	assert.True(t, sigVerification.IsVerified)
+	assert.Equal(t, "release", sigVerification.GetKeyIdentifier())
+
+	// the image is signed, but not with any key of the project
+	trust = (*TrustRoot)(nil).WithSigningKeys([]db.SigningKey{signingKey(t, "other", otherKey)})
+	sigVerification, _, err = validateSignature(context.Background(), ref, nil, trust)
+	require.NoError(t, err)
+	assert.True(t, sigVerification.IsSigned)
+	assert.False(t, sigVerification.IsVerified)
+	assert.Nil(t, sigVerification.KeyIdentifier)
+}
+
+func TestVerifyArtifactWithKeys(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(registry.New())
+	defer srv.Close()
+	host := strings.TrimPrefix(srv.URL, "http://")
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	pemKey, err := cryptoutils.MarshalPublicKeyToPEM(key.Public())
+	require.NoError(t, err)
+
+	verificationKey, err := NewVerificationKey("", pemKey)
+	require.NoError(t, err)
+	fingerprint, err := KeyFingerprint(key.Public())
+	require.NoError(t, err)
+	assert.Equal(t, fingerprint, verificationKey.Identifier)
+	assert.True(t, strings.HasPrefix(fingerprint, "sha256:"))
+
+	signed := pushSignedImage(t, host, key)
+	keyID, err := VerifyArtifactWithKeys(context.Background(), "stacklok", host+"/stacklok/minder",
+		signed.DigestStr(), "", []*VerificationKey{verificationKey})
+	require.NoError(t, err)
+	assert.Equal(t, fingerprint, keyID)
+
+	unsigned := pushSignedImage(t, host, nil)
+	keyID, err = VerifyArtifactWithKeys(context.Background(), "stacklok", host+"/stacklok/minder",
+		unsigned.DigestStr(), "", []*VerificationKey{verificationKey})
+	require.NoError(t, err)
+	assert.Empty(t, keyID)
+
+	_, err = NewVerificationKey("", []byte("not a key"))
+	assert.Error(t, err)
+}
+
+func TestArtifactReference(t *testing.T) {
+	t.Parallel()
+
+	digest := "sha256:" + strings.Repeat("a", 64)
+
+	ref, err := ArtifactReference("Stacklok", "minder", digest)
+	require.NoError(t, err)
+	assert.Equal(t, "ghcr.io/stacklok/minder@"+digest, ref.String())
+
+	ref, err = ArtifactReference("stacklok", "quay.io/stacklok/minder", digest)
+	require.NoError(t, err)
+	assert.Equal(t, "quay.io/stacklok/minder@"+digest, ref.String())
+}
+
+func TestValidateSignatureOfIndex(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(registry.New())
+	defer srv.Close()
+	host := strings.TrimPrefix(srv.URL, "http://")
+
+	amd64, arm64 := testImage(t, "amd64"), testImage(t, "arm64")
+	idx := ggcrmutate.AppendManifests(empty.Index,
+		ggcrmutate.IndexAddendum{
+			Add:        amd64,
+			Descriptor: containerregistry.Descriptor{Platform: &containerregistry.Platform{OS: "linux", Architecture: "amd64"}},
+		},
+		ggcrmutate.IndexAddendum{
+			Add:        arm64,
+			Descriptor: containerregistry.Descriptor{Platform: &containerregistry.Platform{OS: "linux", Architecture: "arm64"}},
+		},
+	)
+
+	ref, err := name.ParseReference(fmt.Sprintf("%s/stacklok/minder:latest", host))
+	require.NoError(t, err)
+	require.NoError(t, remote.WriteIndex(ref, idx))
+
+	// only the image of amd64 is signed
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	amd64Digest, err := amd64.Digest()
+	require.NoError(t, err)
+	signImage(t, ref.Context().Digest(amd64Digest.String()), key)
+
+	trust := (*TrustRoot)(nil).WithSigningKeys([]db.SigningKey{signingKey(t, "release", key)})
+	sigVerification, _, err := validateSignature(context.Background(), ref, nil, trust)
+	require.NoError(t, err)
+	assert.False(t, sigVerification.IsSigned, "the index is not signed")
+	require.Len(t, sigVerification.Platforms, 2)
+
+	assert.Equal(t, "linux/amd64", sigVerification.Platforms[0].Platform)
+	assert.Equal(t, amd64Digest.String(), sigVerification.Platforms[0].Digest)
+	assert.True(t, sigVerification.Platforms[0].SignatureVerification.IsVerified)
+	assert.Equal(t, "release", sigVerification.Platforms[0].SignatureVerification.GetKeyIdentifier())
+
+	assert.Equal(t, "linux/arm64", sigVerification.Platforms[1].Platform)
+	assert.False(t, sigVerification.Platforms[1].SignatureVerification.IsSigned)
+
+	// single platform images have no platforms to report
+	amd64Ref := ref.Context().Digest(amd64Digest.String())
+	sigVerification, _, err = validateSignature(context.Background(), amd64Ref, nil, trust)
+	require.NoError(t, err)
+	assert.True(t, sigVerification.IsVerified)
+	assert.Empty(t, sigVerification.Platforms)
+}
@@ -0,0 +1,461 @@
+// Copyright 2023 Stacklok, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package container
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/secure-systems-lab/go-securesystemslib/dsse"
+	"github.com/sigstore/cosign/v2/pkg/cosign"
+	cbundle "github.com/sigstore/cosign/v2/pkg/cosign/bundle"
+	sigs "github.com/sigstore/cosign/v2/pkg/signature"
+	sigdsse "github.com/sigstore/sigstore/pkg/signature/dsse"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/stacklok/minder/internal/util"
+	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+// NpmRegistryURL is the URL of the public npm registry, which hosts the
+// provenance attestations of npm packages published with --provenance
+const NpmRegistryURL = "https://registry.npmjs.org"
+
+// NpmPackageName returns the scoped name of an npm package hosted in GitHub Packages,
+// which are always scoped to their owner
+func NpmPackageName(ownerLogin, packageName string) string {
+	if strings.HasPrefix(packageName, "@") {
+		return packageName
+	}
+	return fmt.Sprintf("@%s/%s", strings.ToLower(ownerLogin), packageName)
+}
+
+// GetNpmPackageSignatureAndProvenance returns the signature verification, the workflow and the
+// build provenance of a version of an npm package as raw JSON. The provenance is read from the
+// sigstore bundles the npm registry serves for the version and verified against the trust root,
+// or the public good instance if it is nil. Versions without provenance are not signed.
+func GetNpmPackageSignatureAndProvenance(
+	ctx context.Context,
+	trust *TrustRoot,
+	registryURL, packageName, version string,
+) (sigInfo json.RawMessage, workflowInfo json.RawMessage, provenanceInfo json.RawMessage, err error) {
+	sigVerification, githubWorkflow, provenance, err := getNpmProvenance(
+		ctx, http.DefaultClient, trust, registryURL, packageName, version)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("%w: error verifying npm package %s@%s: %s", ErrSigValidation,
+			packageName, version, err.Error())
+	}
+
+	sigInfo, err = util.GetBytesFromProto(sigVerification)
+	if err != nil {
+		return nil, nil, nil, ErrProtoParse
+	}
+	workflowInfo, err = util.GetBytesFromProto(githubWorkflow)
+	if err != nil {
+		return nil, nil, nil, ErrProtoParse
+	}
+	provenanceInfo, err = util.GetBytesFromProto(provenance)
+	if err != nil {
+		return nil, nil, nil, ErrProtoParse
+	}
+
+	return sigInfo, workflowInfo, provenanceInfo, nil
+}
+
+// npmAttestations is the response of the attestations endpoint of the npm registry
+type npmAttestations struct {
+	Attestations []struct {
+		PredicateType string          `json:"predicateType"`
+		Bundle        *sigstoreBundle `json:"bundle"`
+	} `json:"attestations"`
+}
+
+// sigstoreBundle is the subset of a sigstore bundle of a DSSE envelope we verify
+type sigstoreBundle struct {
+	MediaType            string `json:"mediaType"`
+	VerificationMaterial struct {
+		X509CertificateChain *struct {
+			Certificates []struct {
+				RawBytes []byte `json:"rawBytes"`
+			} `json:"certificates"`
+		} `json:"x509CertificateChain,omitempty"`
+		// bundles of version 0.3 and later carry only the leaf certificate
+		Certificate *struct {
+			RawBytes []byte `json:"rawBytes"`
+		} `json:"certificate,omitempty"`
+		TlogEntries []tlogEntry `json:"tlogEntries"`
+	} `json:"verificationMaterial"`
+	DSSEEnvelope *dsse.Envelope `json:"dsseEnvelope"`
+}
+
+// tlogEntry is a transparency log entry of a sigstore bundle
+type tlogEntry struct {
+	LogIndex int64 `json:"logIndex,string"`
+	LogID    struct {
+		KeyID []byte `json:"keyId"`
+	} `json:"logId"`
+	IntegratedTime   int64 `json:"integratedTime,string"`
+	InclusionPromise *struct {
+		SignedEntryTimestamp []byte `json:"signedEntryTimestamp"`
+	} `json:"inclusionPromise,omitempty"`
+	CanonicalizedBody []byte `json:"canonicalizedBody"`
+}
+
+// rekorHash is a hash of a rekor entry
+type rekorHash struct {
+	Algorithm string `json:"algorithm"`
+	Value     string `json:"value"`
+}
+
+// rekorEntryBody is the subset of the canonicalized body of a dsse or intoto rekor entry
+// that binds the entry to the envelope
+type rekorEntryBody struct {
+	Kind string `json:"kind"`
+	Spec struct {
+		// dsse entries
+		PayloadHash *rekorHash `json:"payloadHash"`
+		Signatures  []struct {
+			Signature string `json:"signature"`
+		} `json:"signatures"`
+		// intoto entries
+		Content struct {
+			PayloadHash *rekorHash `json:"payloadHash"`
+			Envelope    struct {
+				Signatures []struct {
+					Sig string `json:"sig"`
+				} `json:"signatures"`
+			} `json:"envelope"`
+		} `json:"content"`
+	} `json:"spec"`
+}
+
+func getNpmProvenance(
+	ctx context.Context,
+	client *http.Client,
+	trust *TrustRoot,
+	registryURL, packageName, version string,
+) (*pb.SignatureVerification, *pb.GithubWorkflow, *pb.Provenance, error) {
+	sigVerification := &pb.SignatureVerification{}
+	githubWorkflow := &pb.GithubWorkflow{}
+
+	var atts npmAttestations
+	found, err := getNpmRegistryJSON(ctx, client,
+		fmt.Sprintf("%s/-/npm/v1/attestations/%s@%s", registryURL, escapeNpmName(packageName), version), &atts)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("error getting attestations: %w", err)
+	} else if !found {
+		// the version was published without provenance
+		return sigVerification, githubWorkflow, &pb.Provenance{}, nil
+	}
+
+	integrity, err := getNpmIntegrity(ctx, client, registryURL, packageName, version)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	if trust == nil {
+		trust, err = PublicGoodTrustRoot(ctx)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+	}
+
+	for _, att := range atts.Attestations {
+		if att.PredicateType != SLSAProvenanceV02 && att.PredicateType != SLSAProvenanceV1 {
+			continue
+		}
+		if att.Bundle == nil || att.Bundle.DSSEEnvelope == nil {
+			continue
+		}
+
+		statement, err := npmStatement(att.Bundle.DSSEEnvelope, packageName, version, integrity)
+		if err != nil {
+			zerolog.Ctx(ctx).Debug().Err(err).Msg("skipping npm attestation")
+			continue
+		}
+
+		provenance, err := ProvenanceFromPredicate(statement.PredicateType, statement.Predicate)
+		if err != nil {
+			zerolog.Ctx(ctx).Debug().Err(err).Msg("skipping npm attestation")
+			continue
+		}
+
+		sigVerification.IsSigned = true
+		cert, entry, err := trust.verifyBundle(att.Bundle)
+		if err != nil {
+			// the provenance is still reported, but not as verified
+			zerolog.Ctx(ctx).Debug().Err(err).Str("package", packageName).Msg("npm provenance not verified")
+			return sigVerification, githubWorkflow, provenance, nil
+		}
+
+		sigVerification.IsVerified = true
+		sigVerification.IsBundleVerified = true
+		sigVerification.CertIdentity = proto.String(sigs.CertSubject(cert))
+		ce := cosign.CertExtensions{Cert: cert}
+		if issuer := ce.GetIssuer(); issuer != "" {
+			sigVerification.CertIssuer = proto.String(issuer)
+		}
+		sigVerification.RekorLogId = proto.String(hex.EncodeToString(entry.LogID.KeyID))
+		sigVerification.RekorLogIndex = proto.Int32(int32(entry.LogIndex))
+		sigVerification.SignatureTime = timestamppb.New(time.Unix(entry.IntegratedTime, 0))
+
+		githubWorkflow.Name = ce.GetCertExtensionGithubWorkflowName()
+		githubWorkflow.Repository = ce.GetCertExtensionGithubWorkflowRepository()
+		githubWorkflow.CommitSha = ce.GetExtensionGithubWorkflowSha()
+		githubWorkflow.Trigger = ce.GetCertExtensionGithubWorkflowTrigger()
+
+		provenance.IsVerified = true
+		provenance.CertIdentity = sigVerification.CertIdentity
+		provenance.CertIssuer = sigVerification.CertIssuer
+		return sigVerification, githubWorkflow, provenance, nil
+	}
+
+	return sigVerification, githubWorkflow, &pb.Provenance{}, nil
+}
+
+// getNpmIntegrity returns the hex encoded SHA-512 digest of the tarball of a version
+func getNpmIntegrity(ctx context.Context, client *http.Client, registryURL, packageName, version string) (string, error) {
+	var manifest struct {
+		Dist struct {
+			Integrity string `json:"integrity"`
+		} `json:"dist"`
+	}
+	found, err := getNpmRegistryJSON(ctx, client,
+		fmt.Sprintf("%s/%s/%s", registryURL, escapeNpmName(packageName), version), &manifest)
+	if err != nil {
+		return "", fmt.Errorf("error getting package manifest: %w", err)
+	} else if !found {
+		return "", fmt.Errorf("version %s of %s not found in the registry", version, packageName)
+	}
+
+	digest, ok := strings.CutPrefix(manifest.Dist.Integrity, "sha512-")
+	if !ok {
+		return "", fmt.Errorf("unsupported integrity %q", manifest.Dist.Integrity)
+	}
+	raw, err := base64.StdEncoding.DecodeString(digest)
+	if err != nil {
+		return "", fmt.Errorf("error decoding integrity: %w", err)
+	}
+
+	return hex.EncodeToString(raw), nil
+}
+
+// getNpmRegistryJSON decodes the response of the npm registry into out, returning
+// false if the registry doesn't know the resource
+func getNpmRegistryJSON(ctx context.Context, client *http.Client, u string, out any) (bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return false, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return false, nil
+	} else if resp.StatusCode != http.StatusOK {
+		return false, fmt.Errorf("unexpected status %s from %s", resp.Status, u)
+	}
+
+	if err := json.NewDecoder(io.LimitReader(resp.Body, 10<<20)).Decode(out); err != nil {
+		return false, fmt.Errorf("error decoding response of %s: %w", u, err)
+	}
+
+	return true, nil
+}
+
+// escapeNpmName escapes the slash of a scoped package name, as the registry expects
+func escapeNpmName(packageName string) string {
+	return strings.Replace(packageName, "/", "%2f", 1)
+}
+
+// npmStatement returns the in-toto statement of the envelope, making sure it is about the version
+func npmStatement(envelope *dsse.Envelope, packageName, version, integrity string) (*inTotoStatement, error) {
+	if envelope.PayloadType != "application/vnd.in-toto+json" {
+		return nil, errNotInToto
+	}
+
+	payload, err := base64.StdEncoding.DecodeString(envelope.Payload)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding payload: %w", err)
+	}
+
+	var statement struct {
+		inTotoStatement
+		Subject []struct {
+			Name   string            `json:"name"`
+			Digest map[string]string `json:"digest"`
+		} `json:"subject"`
+	}
+	if err := json.Unmarshal(payload, &statement); err != nil {
+		return nil, fmt.Errorf("error parsing statement: %w", err)
+	}
+
+	// the subject is the package URL of the version, where the @ of the scope is escaped
+	purl := fmt.Sprintf("pkg:npm/%s@%s", strings.Replace(packageName, "@", "%40", 1), version)
+	for _, subject := range statement.Subject {
+		if subject.Name == purl && strings.EqualFold(subject.Digest["sha512"], integrity) {
+			return &statement.inTotoStatement, nil
+		}
+	}
+
+	return nil, fmt.Errorf("no subject of the statement matches %s", purl)
+}
+
+// verifyBundle verifies the certificate, the signature and the transparency log entry of a sigstore
+// bundle of a DSSE envelope, returning the signing certificate and the entry
+func (t *TrustRoot) verifyBundle(bundle *sigstoreBundle) (*x509.Certificate, *tlogEntry, error) {
+	var rawCerts [][]byte
+	if chain := bundle.VerificationMaterial.X509CertificateChain; chain != nil {
+		for _, c := range chain.Certificates {
+			rawCerts = append(rawCerts, c.RawBytes)
+		}
+	} else if c := bundle.VerificationMaterial.Certificate; c != nil {
+		rawCerts = append(rawCerts, c.RawBytes)
+	}
+	if len(rawCerts) == 0 {
+		return nil, nil, errors.New("bundle has no certificate")
+	}
+
+	cert, err := x509.ParseCertificate(rawCerts[0])
+	if err != nil {
+		return nil, nil, fmt.Errorf("error parsing certificate: %w", err)
+	}
+
+	// the certificate of any identity, the identity is recorded so that rules can check it
+	co := t.checkOpts([]cosign.Identity{{IssuerRegExp: ".+", SubjectRegExp: ".+"}}, nil)
+	verifier, err := cosign.ValidateAndUnpackCert(cert, co)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error validating certificate: %w", err)
+	}
+
+	envelope, err := json.Marshal(bundle.DSSEEnvelope)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := sigdsse.WrapVerifier(verifier).VerifySignature(bytes.NewReader(envelope), nil); err != nil {
+		return nil, nil, fmt.Errorf("error verifying envelope: %w", err)
+	}
+
+	// the certificate is short lived, it must have been valid when the entry was logged
+	for i := range bundle.VerificationMaterial.TlogEntries {
+		entry := &bundle.VerificationMaterial.TlogEntries[i]
+		if err := t.verifyTlogEntry(entry, bundle.DSSEEnvelope); err != nil {
+			continue
+		}
+		if err := cosign.CheckExpiry(cert, time.Unix(entry.IntegratedTime, 0)); err != nil {
+			return nil, nil, err
+		}
+		return cert, entry, nil
+	}
+
+	return nil, nil, errors.New("no verified transparency log entry")
+}
+
+// verifyTlogEntry verifies the inclusion promise of a transparency log entry and that
+// the entry is about the envelope
+func (t *TrustRoot) verifyTlogEntry(entry *tlogEntry, envelope *dsse.Envelope) error {
+	if entry.InclusionPromise == nil {
+		return errors.New("entry has no inclusion promise")
+	}
+	if t.rekorPubKeys == nil {
+		return errors.New("no trusted rekor public keys")
+	}
+
+	logID := hex.EncodeToString(entry.LogID.KeyID)
+	pubKey, ok := t.rekorPubKeys.Keys[logID]
+	if !ok {
+		return fmt.Errorf("rekor log %s is not trusted", logID)
+	}
+	ecdsaKey, ok := pubKey.PubKey.(*ecdsa.PublicKey)
+	if !ok {
+		return fmt.Errorf("rekor public key of log %s is not an ECDSA key", logID)
+	}
+
+	err := cosign.VerifySET(cbundle.RekorPayload{
+		Body:           base64.StdEncoding.EncodeToString(entry.CanonicalizedBody),
+		IntegratedTime: entry.IntegratedTime,
+		LogIndex:       entry.LogIndex,
+		LogID:          logID,
+	}, entry.InclusionPromise.SignedEntryTimestamp, ecdsaKey)
+	if err != nil {
+		return err
+	}
+
+	var body rekorEntryBody
+	if err := json.Unmarshal(entry.CanonicalizedBody, &body); err != nil {
+		return fmt.Errorf("error parsing entry: %w", err)
+	}
+
+	payload, err := base64.StdEncoding.DecodeString(envelope.Payload)
+	if err != nil {
+		return err
+	}
+	payloadDigest := sha256.Sum256(payload)
+
+	var payloadHash *rekorHash
+	var logged []string
+	switch body.Kind {
+	case "dsse":
+		payloadHash = body.Spec.PayloadHash
+		for _, s := range body.Spec.Signatures {
+			logged = append(logged, s.Signature)
+		}
+	case "intoto":
+		payloadHash = body.Spec.Content.PayloadHash
+		for _, s := range body.Spec.Content.Envelope.Signatures {
+			// the signatures of intoto entries are encoded twice
+			sig, err := base64.StdEncoding.DecodeString(s.Sig)
+			if err != nil {
+				return err
+			}
+			logged = append(logged, string(sig))
+		}
+	default:
+		return fmt.Errorf("unsupported entry kind %q", body.Kind)
+	}
+
+	if payloadHash == nil || payloadHash.Algorithm != "sha256" ||
+		payloadHash.Value != hex.EncodeToString(payloadDigest[:]) {
+		return errors.New("entry is not about the envelope payload")
+	}
+	for _, sig := range envelope.Signatures {
+		for _, l := range logged {
+			if sig.Sig == l {
+				return nil
+			}
+		}
+	}
+
+	return errors.New("entry is not about the envelope signature")
+}
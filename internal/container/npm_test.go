@@ -0,0 +1,259 @@
+// Copyright 2023 Stacklok, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package container
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/secure-systems-lab/go-securesystemslib/dsse"
+	"github.com/sigstore/cosign/v2/pkg/cosign"
+	"github.com/sigstore/sigstore/pkg/cryptoutils"
+	"github.com/sigstore/sigstore/pkg/signature"
+	sigdsse "github.com/sigstore/sigstore/pkg/signature/dsse"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+const npmTestIdentity = "https://github.com/stacklok/minder/.github/workflows/release.yml@refs/heads/main"
+
+// npmFixture is a fake npm registry serving a sigstore bundle of a SLSA v1 provenance
+// signed with a certificate of a private fulcio, logged in a private rekor
+type npmFixture struct {
+	trust   *TrustRoot
+	tarball []byte
+	bundle  *sigstoreBundle
+}
+
+func newNpmFixture(t *testing.T) *npmFixture {
+	t.Helper()
+
+	rootPEM, rootCert, rootKey := generateCertificatePEM(t, "private fulcio root", nil, nil)
+	rekorKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	rekorPEM, err := cryptoutils.MarshalPublicKeyToPEM(rekorKey.Public())
+	require.NoError(t, err)
+
+	trust, err := NewTrustRoot(context.Background(), &pb.SigstoreTrustConfig{
+		FulcioRoots:     string(rootPEM),
+		RekorPublicKeys: []string{string(rekorPEM)},
+		Offline:         true,
+	})
+	require.NoError(t, err)
+
+	// the leaf certificate fulcio issues to the release workflow
+	signerKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	identity, err := url.Parse(npmTestIdentity)
+	require.NoError(t, err)
+	leaf := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: "sigstore"},
+		NotBefore:    time.Now().Add(-time.Minute),
+		NotAfter:     time.Now().Add(10 * time.Minute),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning},
+		URIs:         []*url.URL{identity},
+		ExtraExtensions: []pkix.Extension{{
+			Id:    asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 57264, 1, 1},
+			Value: []byte("https://token.actions.githubusercontent.com"),
+		}},
+	}
+	leafDER, err := x509.CreateCertificate(rand.Reader, leaf, rootCert, &signerKey.PublicKey, rootKey)
+	require.NoError(t, err)
+
+	tarball := []byte("package tarball")
+	digest := sha512.Sum512(tarball)
+	statement, err := json.Marshal(map[string]any{
+		"_type":         "https://in-toto.io/Statement/v1",
+		"predicateType": SLSAProvenanceV1,
+		"subject": []map[string]any{{
+			"name":   "pkg:npm/%40stacklok/minder@1.0.0",
+			"digest": map[string]string{"sha512": hex.EncodeToString(digest[:])},
+		}},
+		"predicate": json.RawMessage(slsaV1Predicate),
+	})
+	require.NoError(t, err)
+
+	signer, err := signature.LoadECDSASignerVerifier(signerKey, crypto.SHA256)
+	require.NoError(t, err)
+	rawEnvelope, err := sigdsse.WrapSigner(signer, "application/vnd.in-toto+json").SignMessage(bytes.NewReader(statement))
+	require.NoError(t, err)
+	envelope := &dsse.Envelope{}
+	require.NoError(t, json.Unmarshal(rawEnvelope, envelope))
+
+	// the dsse entry rekor logs for the envelope
+	payloadDigest := sha256.Sum256(statement)
+	body, err := json.Marshal(map[string]any{
+		"apiVersion": "0.0.1",
+		"kind":       "dsse",
+		"spec": map[string]any{
+			"payloadHash": map[string]string{"algorithm": "sha256", "value": hex.EncodeToString(payloadDigest[:])},
+			"signatures":  []map[string]string{{"signature": envelope.Signatures[0].Sig}},
+		},
+	})
+	require.NoError(t, err)
+
+	logID, err := cosign.GetTransparencyLogID(rekorKey.Public())
+	require.NoError(t, err)
+	rawLogID, err := hex.DecodeString(logID)
+	require.NoError(t, err)
+	integratedTime := time.Now().Unix()
+
+	// the keys of a marshalled map are sorted, which makes it canonical
+	setPayload, err := json.Marshal(map[string]any{
+		"body":           base64.StdEncoding.EncodeToString(body),
+		"integratedTime": integratedTime,
+		"logIndex":       42,
+		"logID":          logID,
+	})
+	require.NoError(t, err)
+	setDigest := sha256.Sum256(setPayload)
+	set, err := ecdsa.SignASN1(rand.Reader, rekorKey, setDigest[:])
+	require.NoError(t, err)
+
+	bundle := &sigstoreBundle{MediaType: "application/vnd.dev.sigstore.bundle+json;version=0.2"}
+	bundle.DSSEEnvelope = envelope
+	bundle.VerificationMaterial.X509CertificateChain = &struct {
+		Certificates []struct {
+			RawBytes []byte `json:"rawBytes"`
+		} `json:"certificates"`
+	}{Certificates: []struct {
+		RawBytes []byte `json:"rawBytes"`
+	}{{RawBytes: leafDER}}}
+	entry := tlogEntry{LogIndex: 42, IntegratedTime: integratedTime, CanonicalizedBody: body}
+	entry.LogID.KeyID = rawLogID
+	entry.InclusionPromise = &struct {
+		SignedEntryTimestamp []byte `json:"signedEntryTimestamp"`
+	}{SignedEntryTimestamp: set}
+	bundle.VerificationMaterial.TlogEntries = []tlogEntry{entry}
+
+	return &npmFixture{trust: trust, tarball: tarball, bundle: bundle}
+}
+
+func (f *npmFixture) serve(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	digest := sha512.Sum512(f.tarball)
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var resp any
+		switch r.URL.Path {
+		case "/@stacklok/minder/1.0.0":
+			resp = map[string]any{"dist": map[string]string{
+				"integrity": "sha512-" + base64.StdEncoding.EncodeToString(digest[:]),
+			}}
+		case "/-/npm/v1/attestations/@stacklok/minder@1.0.0":
+			resp = map[string]any{"attestations": []map[string]any{
+				{"predicateType": "https://github.com/npm/attestation/tree/main/specs/publish/v0.1"},
+				{"predicateType": SLSAProvenanceV1, "bundle": f.bundle},
+			}}
+		default:
+			http.NotFound(w, r)
+			return
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(resp))
+	}))
+}
+
+func TestGetNpmProvenance(t *testing.T) {
+	t.Parallel()
+
+	fixture := newNpmFixture(t)
+	srv := fixture.serve(t)
+	defer srv.Close()
+
+	sig, workflow, provenance, err := getNpmProvenance(context.Background(), srv.Client(), fixture.trust,
+		srv.URL, NpmPackageName("Stacklok", "minder"), "1.0.0")
+	require.NoError(t, err)
+	assert.True(t, sig.IsSigned)
+	assert.True(t, sig.IsVerified)
+	assert.True(t, sig.IsBundleVerified)
+	assert.Equal(t, npmTestIdentity, sig.GetCertIdentity())
+	assert.Equal(t, "https://token.actions.githubusercontent.com", sig.GetCertIssuer())
+	assert.Equal(t, int32(42), sig.GetRekorLogIndex())
+	assert.NotNil(t, workflow)
+	assert.True(t, provenance.IsVerified)
+	assert.Equal(t, "https://github.com/stacklok/minder", provenance.SourceRepository)
+	assert.Equal(t, npmTestIdentity, provenance.GetCertIdentity())
+
+	// a trust root that doesn't know the fulcio of the certificate
+	untrusted := newNpmFixture(t).trust
+	sig, _, provenance, err = getNpmProvenance(context.Background(), srv.Client(), untrusted,
+		srv.URL, "@stacklok/minder", "1.0.0")
+	require.NoError(t, err)
+	assert.True(t, sig.IsSigned)
+	assert.False(t, sig.IsVerified)
+	assert.False(t, provenance.IsVerified)
+	assert.Equal(t, "refs/heads/main", provenance.SourceRef)
+
+	// versions published without provenance are not signed
+	sig, _, provenance, err = getNpmProvenance(context.Background(), srv.Client(), fixture.trust,
+		srv.URL, "@stacklok/minder", "2.0.0")
+	require.NoError(t, err)
+	assert.False(t, sig.IsSigned)
+	assert.Empty(t, provenance.PredicateType)
+}
+
+func TestGetNpmProvenanceOfOtherTarball(t *testing.T) {
+	t.Parallel()
+
+	fixture := newNpmFixture(t)
+	// the registry serves a tarball the provenance is not about
+	fixture.tarball = []byte("tampered tarball")
+	srv := fixture.serve(t)
+	defer srv.Close()
+
+	sig, _, provenance, err := getNpmProvenance(context.Background(), srv.Client(), fixture.trust,
+		srv.URL, "@stacklok/minder", "1.0.0")
+	require.NoError(t, err)
+	assert.False(t, sig.IsSigned)
+	assert.Empty(t, provenance.PredicateType)
+}
+
+func TestVerifyTlogEntryOfOtherEnvelope(t *testing.T) {
+	t.Parallel()
+
+	fixture := newNpmFixture(t)
+	entry := fixture.bundle.VerificationMaterial.TlogEntries[0]
+	require.NoError(t, fixture.trust.verifyTlogEntry(&entry, fixture.bundle.DSSEEnvelope))
+
+	other := *fixture.bundle.DSSEEnvelope
+	other.Payload = base64.StdEncoding.EncodeToString([]byte(`{"predicateType":"other"}`))
+	assert.Error(t, fixture.trust.verifyTlogEntry(&entry, &other))
+
+	entry.IntegratedTime++
+	assert.Error(t, fixture.trust.verifyTlogEntry(&entry, fixture.bundle.DSSEEnvelope),
+		"the inclusion promise covers the integrated time")
+}
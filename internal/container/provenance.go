@@ -0,0 +1,308 @@
+// Copyright 2023 Stacklok, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package container
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/rs/zerolog"
+	"github.com/sigstore/cosign/v2/pkg/cosign"
+	"github.com/sigstore/cosign/v2/pkg/oci"
+	ociremote "github.com/sigstore/cosign/v2/pkg/oci/remote"
+	sigs "github.com/sigstore/cosign/v2/pkg/signature"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/stacklok/minder/internal/util"
+	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+	provifv1 "github.com/stacklok/minder/pkg/providers/v1"
+)
+
+const (
+	// SLSAProvenanceV02 is the predicate type of SLSA v0.2 provenance
+	SLSAProvenanceV02 = "https://slsa.dev/provenance/v0.2"
+	// SLSAProvenanceV1 is the predicate type of SLSA v1 provenance
+	SLSAProvenanceV1 = "https://slsa.dev/provenance/v1"
+)
+
+// GetArtifactProvenance returns the build provenance of a version of a GitHub package as raw JSON.
+// The attestations are verified against the trust root, or the public good instance if it is nil.
+func GetArtifactProvenance(
+	ctx context.Context,
+	cli provifv1.Provider,
+	trust *TrustRoot,
+	ownerLogin, artifactName, versionName string,
+) (json.RawMessage, error) {
+	imageRef := artifactImageRef("", ownerLogin, artifactName, versionName)
+	ref, err := name.ParseReference(imageRef)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing image-ref %s: %w", imageRef, err)
+	}
+
+	return GetImageProvenance(ctx, ref, trust, githubRemoteOptions(ownerLogin, cli.GetToken())...)
+}
+
+// GetImageProvenance returns the build provenance of an image in any OCI registry as raw JSON.
+// If none of the SLSA provenance attestations of the image can be verified, the first one is
+// returned marked as not verified. An image without provenance has an empty provenance.
+func GetImageProvenance(
+	ctx context.Context,
+	ref name.Reference,
+	trust *TrustRoot,
+	remoteOpts ...remote.Option,
+) (json.RawMessage, error) {
+	provenance, err := getProvenance(ctx, ref, remoteOpts, trust)
+	if err != nil {
+		return nil, err
+	}
+
+	raw, err := util.GetBytesFromProto(provenance)
+	if err != nil {
+		return nil, ErrProtoParse
+	}
+
+	return raw, nil
+}
+
+func getProvenance(
+	ctx context.Context,
+	ref name.Reference,
+	remoteOpts []remote.Option,
+	trust *TrustRoot,
+) (*pb.Provenance, error) {
+	if trust == nil {
+		var err error
+		trust, err = PublicGoodTrustRoot(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	registryClientOpts := []ociremote.Option{ociremote.WithRemoteOptions(remoteOpts...)}
+
+	// keyless attestations of any identity, the identity is recorded so that rules can
+	// check who signed the provenance
+	co := trust.checkOpts([]cosign.Identity{{IssuerRegExp: ".+", SubjectRegExp: ".+"}}, registryClientOpts)
+	co.ClaimVerifier = cosign.IntotoSubjectClaimVerifier
+	if atts, _, err := cosign.VerifyImageAttestations(ctx, ref, co); err == nil {
+		if provenance := provenanceFromAttestations(ctx, atts); provenance != nil {
+			provenance.IsVerified = true
+			setSignerIdentity(atts, provenance)
+			return provenance, nil
+		}
+	} else {
+		zerolog.Ctx(ctx).Debug().Err(err).Str("image", ref.String()).Msg("no verified keyless attestation")
+	}
+
+	for _, key := range trust.keys {
+		co := &cosign.CheckOpts{
+			RegistryClientOpts: registryClientOpts,
+			SigVerifier:        key.verifier,
+			IgnoreTlog:         true,
+			ClaimVerifier:      cosign.IntotoSubjectClaimVerifier,
+		}
+		atts, _, err := cosign.VerifyImageAttestations(ctx, ref, co)
+		if err != nil {
+			continue
+		}
+		if provenance := provenanceFromAttestations(ctx, atts); provenance != nil {
+			provenance.IsVerified = true
+			provenance.KeyIdentifier = proto.String(key.Identifier)
+			return provenance, nil
+		}
+	}
+
+	// fall back to the unverified provenance, rules can still require it to be verified
+	se, err := ociremote.SignedEntity(ref, registryClientOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error getting signed entity: %w", err)
+	}
+
+	attestations, err := se.Attestations()
+	if err != nil {
+		return nil, fmt.Errorf("error getting attestations: %w", err)
+	}
+
+	atts, err := attestations.Get()
+	if err != nil {
+		return nil, fmt.Errorf("error reading attestations: %w", err)
+	}
+
+	if provenance := provenanceFromAttestations(ctx, atts); provenance != nil {
+		return provenance, nil
+	}
+
+	return &pb.Provenance{}, nil
+}
+
+// provenanceFromAttestations returns the provenance of the first SLSA provenance attestation
+func provenanceFromAttestations(ctx context.Context, atts []oci.Signature) *pb.Provenance {
+	for _, att := range atts {
+		statement, err := statementFromAttestation(att)
+		if err != nil {
+			zerolog.Ctx(ctx).Debug().Err(err).Msg("skipping attestation")
+			continue
+		}
+
+		provenance, err := ProvenanceFromPredicate(statement.PredicateType, statement.Predicate)
+		if errors.Is(err, ErrNotProvenance) {
+			continue
+		} else if err != nil {
+			log.Printf("error parsing provenance: %v", err)
+			continue
+		}
+
+		return provenance
+	}
+
+	return nil
+}
+
+// setSignerIdentity records the identity of the certificate the provenance attestation was signed with
+func setSignerIdentity(atts []oci.Signature, provenance *pb.Provenance) {
+	for _, att := range atts {
+		cert, err := att.Cert()
+		if err != nil || cert == nil {
+			continue
+		}
+
+		provenance.CertIdentity = proto.String(sigs.CertSubject(cert))
+		ce := cosign.CertExtensions{Cert: cert}
+		if issuer := ce.GetIssuer(); issuer != "" {
+			provenance.CertIssuer = proto.String(issuer)
+		}
+		return
+	}
+}
+
+// ErrNotProvenance is returned when parsing a predicate that is not a SLSA provenance
+var ErrNotProvenance = errors.New("predicate is not a SLSA provenance")
+
+// slsaProvenanceV02 is the subset of a SLSA v0.2 predicate we care about
+type slsaProvenanceV02 struct {
+	Builder struct {
+		ID string `json:"id"`
+	} `json:"builder"`
+	BuildType  string `json:"buildType"`
+	Invocation struct {
+		ConfigSource struct {
+			URI        string            `json:"uri"`
+			Digest     map[string]string `json:"digest"`
+			EntryPoint string            `json:"entryPoint"`
+		} `json:"configSource"`
+	} `json:"invocation"`
+}
+
+// slsaProvenanceV1 is the subset of a SLSA v1 predicate we care about
+type slsaProvenanceV1 struct {
+	BuildDefinition struct {
+		BuildType          string `json:"buildType"`
+		ExternalParameters struct {
+			// set by the GitHub Actions build types
+			Workflow struct {
+				Ref        string `json:"ref"`
+				Repository string `json:"repository"`
+				Path       string `json:"path"`
+			} `json:"workflow"`
+		} `json:"externalParameters"`
+		ResolvedDependencies []struct {
+			URI    string            `json:"uri"`
+			Digest map[string]string `json:"digest"`
+		} `json:"resolvedDependencies"`
+	} `json:"buildDefinition"`
+	RunDetails struct {
+		Builder struct {
+			ID string `json:"id"`
+		} `json:"builder"`
+	} `json:"runDetails"`
+}
+
+// ProvenanceFromPredicate extracts the builder, build type and source of the predicate of a
+// SLSA provenance. The provenance is not verified.
+func ProvenanceFromPredicate(predicateType string, predicate json.RawMessage) (*pb.Provenance, error) {
+	switch predicateType {
+	case SLSAProvenanceV02:
+		var p slsaProvenanceV02
+		if err := json.Unmarshal(predicate, &p); err != nil {
+			return nil, fmt.Errorf("error parsing SLSA v0.2 provenance: %w", err)
+		}
+
+		repo, ref := splitSourceURI(p.Invocation.ConfigSource.URI)
+		return &pb.Provenance{
+			PredicateType:    predicateType,
+			BuilderId:        p.Builder.ID,
+			BuildType:        p.BuildType,
+			SourceRepository: repo,
+			SourceRef:        ref,
+			SourceDigest:     commitDigest(p.Invocation.ConfigSource.Digest),
+			Workflow:         p.Invocation.ConfigSource.EntryPoint,
+		}, nil
+	case SLSAProvenanceV1:
+		var p slsaProvenanceV1
+		if err := json.Unmarshal(predicate, &p); err != nil {
+			return nil, fmt.Errorf("error parsing SLSA v1 provenance: %w", err)
+		}
+
+		provenance := &pb.Provenance{
+			PredicateType:    predicateType,
+			BuilderId:        p.RunDetails.Builder.ID,
+			BuildType:        p.BuildDefinition.BuildType,
+			SourceRepository: p.BuildDefinition.ExternalParameters.Workflow.Repository,
+			SourceRef:        p.BuildDefinition.ExternalParameters.Workflow.Ref,
+			Workflow:         p.BuildDefinition.ExternalParameters.Workflow.Path,
+		}
+
+		// the source is the first resolved dependency
+		if deps := p.BuildDefinition.ResolvedDependencies; len(deps) > 0 {
+			repo, ref := splitSourceURI(deps[0].URI)
+			if provenance.SourceRepository == "" {
+				provenance.SourceRepository = repo
+			}
+			if provenance.SourceRef == "" {
+				provenance.SourceRef = ref
+			}
+			provenance.SourceDigest = commitDigest(deps[0].Digest)
+		}
+
+		return provenance, nil
+	default:
+		return nil, ErrNotProvenance
+	}
+}
+
+// splitSourceURI splits a source URI like git+https://github.com/org/repo@refs/heads/main
+// into the repository and the ref
+func splitSourceURI(uri string) (string, string) {
+	uri = strings.TrimPrefix(uri, "git+")
+	if i := strings.LastIndex(uri, "@"); i > strings.Index(uri, "://")+2 {
+		return uri[:i], uri[i+1:]
+	}
+	return uri, ""
+}
+
+func commitDigest(digest map[string]string) string {
+	for _, alg := range []string{"gitCommit", "sha1", "sha256"} {
+		if d, ok := digest[alg]; ok {
+			return d
+		}
+	}
+	return ""
+}
@@ -0,0 +1,184 @@
+// Copyright 2023 Stacklok, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package container
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/json"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/registry"
+	"github.com/sigstore/cosign/v2/pkg/oci/mutate"
+	ociremote "github.com/sigstore/cosign/v2/pkg/oci/remote"
+	"github.com/sigstore/cosign/v2/pkg/oci/static"
+	"github.com/sigstore/cosign/v2/pkg/types"
+	"github.com/sigstore/sigstore/pkg/signature"
+	"github.com/sigstore/sigstore/pkg/signature/dsse"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/stacklok/minder/internal/db"
+)
+
+const slsaV02Predicate = `{
+  "builder": {"id": "https://github.com/slsa-framework/slsa-github-generator/.github/workflows/generator_container_slsa3.yml@refs/tags/v1.9.0"},
+  "buildType": "https://github.com/slsa-framework/slsa-github-generator/container@v1",
+  "invocation": {
+    "configSource": {
+      "uri": "git+https://github.com/stacklok/minder@refs/heads/main",
+      "digest": {"sha1": "f1d2d2f924e986ac86fdf7b36c94bcdf32beec15"},
+      "entryPoint": ".github/workflows/release.yml"
+    }
+  }
+}`
+
+const slsaV1Predicate = `{
+  "buildDefinition": {
+    "buildType": "https://actions.github.io/buildtypes/workflow/v1",
+    "externalParameters": {
+      "workflow": {
+        "ref": "refs/heads/main",
+        "repository": "https://github.com/stacklok/minder",
+        "path": ".github/workflows/release.yml"
+      }
+    },
+    "resolvedDependencies": [
+      {"uri": "git+https://github.com/stacklok/minder@refs/heads/main", "digest": {"gitCommit": "f1d2d2f924e986ac86fdf7b36c94bcdf32beec15"}}
+    ]
+  },
+  "runDetails": {"builder": {"id": "https://github.com/actions/runner/github-hosted"}}
+}`
+
+func TestProvenanceFromPredicate(t *testing.T) {
+	t.Parallel()
+
+	v02, err := ProvenanceFromPredicate(SLSAProvenanceV02, json.RawMessage(slsaV02Predicate))
+	require.NoError(t, err)
+	assert.Equal(t, "https://github.com/slsa-framework/slsa-github-generator/.github/workflows/generator_container_slsa3.yml@refs/tags/v1.9.0",
+		v02.BuilderId)
+	assert.Equal(t, "https://github.com/slsa-framework/slsa-github-generator/container@v1", v02.BuildType)
+	assert.Equal(t, "https://github.com/stacklok/minder", v02.SourceRepository)
+	assert.Equal(t, "refs/heads/main", v02.SourceRef)
+	assert.Equal(t, "f1d2d2f924e986ac86fdf7b36c94bcdf32beec15", v02.SourceDigest)
+	assert.Equal(t, ".github/workflows/release.yml", v02.Workflow)
+	assert.False(t, v02.IsVerified)
+
+	v1, err := ProvenanceFromPredicate(SLSAProvenanceV1, json.RawMessage(slsaV1Predicate))
+	require.NoError(t, err)
+	assert.Equal(t, "https://github.com/actions/runner/github-hosted", v1.BuilderId)
+	assert.Equal(t, "https://actions.github.io/buildtypes/workflow/v1", v1.BuildType)
+	assert.Equal(t, "https://github.com/stacklok/minder", v1.SourceRepository)
+	assert.Equal(t, "refs/heads/main", v1.SourceRef)
+	assert.Equal(t, "f1d2d2f924e986ac86fdf7b36c94bcdf32beec15", v1.SourceDigest)
+	assert.Equal(t, ".github/workflows/release.yml", v1.Workflow)
+
+	_, err = ProvenanceFromPredicate("https://spdx.dev/Document", json.RawMessage(`{}`))
+	assert.ErrorIs(t, err, ErrNotProvenance)
+
+	_, err = ProvenanceFromPredicate(SLSAProvenanceV02, json.RawMessage(`[]`))
+	assert.Error(t, err)
+}
+
+// attachProvenance attaches a SLSA v0.2 provenance attestation signed with the key
+// to the image, the same way `cosign attest --key` does
+func attachProvenance(t *testing.T, ref name.Digest, key *ecdsa.PrivateKey) {
+	t.Helper()
+
+	statement, err := json.Marshal(map[string]any{
+		"_type":         "https://in-toto.io/Statement/v0.1",
+		"predicateType": SLSAProvenanceV02,
+		"subject": []map[string]any{{
+			"name":   ref.Repository.String(),
+			"digest": map[string]string{"sha256": strings.TrimPrefix(ref.DigestStr(), "sha256:")},
+		}},
+		"predicate": json.RawMessage(slsaV02Predicate),
+	})
+	require.NoError(t, err)
+
+	signer, err := signature.LoadECDSASignerVerifier(key, crypto.SHA256)
+	require.NoError(t, err)
+	envelope, err := dsse.WrapSigner(signer, types.IntotoPayloadType).SignMessage(bytes.NewReader(statement))
+	require.NoError(t, err)
+
+	att, err := static.NewAttestation(envelope)
+	require.NoError(t, err)
+
+	entity, err := ociremote.SignedEntity(ref)
+	require.NoError(t, err)
+	attested, err := mutate.AttachAttestationToEntity(entity, att)
+	require.NoError(t, err)
+	require.NoError(t, ociremote.WriteAttestations(ref.Repository, attested))
+}
+
+func TestGetProvenanceWithSigningKeys(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(registry.New())
+	defer srv.Close()
+	host := strings.TrimPrefix(srv.URL, "http://")
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	ref := pushSignedImage(t, host, nil)
+	attachProvenance(t, ref, key)
+
+	// offline trust root without transparency log access, only the keys can verify
+	offline := &TrustRoot{offline: true}
+
+	provenance, err := getProvenance(context.Background(), ref, nil,
+		offline.WithSigningKeys([]db.SigningKey{signingKey(t, "release", key)}))
+	require.NoError(t, err)
+	assert.True(t, provenance.IsVerified)
+	assert.Equal(t, "release", provenance.GetKeyIdentifier())
+	assert.Equal(t, SLSAProvenanceV02, provenance.PredicateType)
+	assert.Equal(t, "refs/heads/main", provenance.SourceRef)
+
+	// the provenance is still reported, but not as verified
+	provenance, err = getProvenance(context.Background(), ref, nil,
+		offline.WithSigningKeys([]db.SigningKey{signingKey(t, "other", otherKey)}))
+	require.NoError(t, err)
+	assert.False(t, provenance.IsVerified)
+	assert.Nil(t, provenance.KeyIdentifier)
+	assert.Equal(t, "https://github.com/stacklok/minder", provenance.SourceRepository)
+
+	// images without attestations have an empty provenance
+	unattested := pushSignedImage(t, host, nil)
+	provenance, err = getProvenance(context.Background(), unattested, nil, offline)
+	require.NoError(t, err)
+	assert.Empty(t, provenance.PredicateType)
+}
+
+func TestSplitSourceURI(t *testing.T) {
+	t.Parallel()
+
+	repo, ref := splitSourceURI("git+https://github.com/stacklok/minder@refs/tags/v1.0.0")
+	assert.Equal(t, "https://github.com/stacklok/minder", repo)
+	assert.Equal(t, "refs/tags/v1.0.0", ref)
+
+	repo, ref = splitSourceURI("https://github.com/stacklok/minder")
+	assert.Equal(t, "https://github.com/stacklok/minder", repo)
+	assert.Empty(t, ref)
+}
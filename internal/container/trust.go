@@ -0,0 +1,350 @@
+// Copyright 2023 Stacklok, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package container
+
+import (
+	"bytes"
+	"context"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/sigstore/cosign/v2/cmd/cosign/cli/fulcio"
+	"github.com/sigstore/cosign/v2/pkg/cosign"
+	ociremote "github.com/sigstore/cosign/v2/pkg/oci/remote"
+	rekorclient "github.com/sigstore/rekor/pkg/client"
+	rekorgen "github.com/sigstore/rekor/pkg/generated/client"
+	"github.com/sigstore/sigstore/pkg/cryptoutils"
+	sigtuf "github.com/sigstore/sigstore/pkg/tuf"
+	tufclient "github.com/theupdateframework/go-tuf/client"
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+// trustRootTTL is how long trust roots fetched from a TUF repository are cached
+const trustRootTTL = time.Hour
+
+// maxCachedTrustRoots bounds the number of trust roots kept in the cache
+const maxCachedTrustRoots = 100
+
+// TrustRoot is the sigstore trust material that signatures are verified against
+type TrustRoot struct {
+	fulcioRoots         *x509.CertPool
+	fulcioIntermediates *x509.CertPool
+	rekorPubKeys        *cosign.TrustedTransparencyLogPubKeys
+	ctLogPubKeys        *cosign.TrustedTransparencyLogPubKeys
+	rekorClient         *rekorgen.Rekor
+	offline             bool
+	// keys are the public keys of the project, see WithSigningKeys
+	keys []*VerificationKey
+}
+
+// PublicGoodTrustRoot returns the trust root of the public good sigstore instance.
+// Certificates are not required to embed an SCT.
+func PublicGoodTrustRoot(ctx context.Context) (*TrustRoot, error) {
+	rootCerts, err := fulcio.GetRoots()
+	if err != nil {
+		return nil, fmt.Errorf("error getting fulcio roots: %w", err)
+	}
+
+	pubkeys, err := cosign.GetRekorPubs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error getting rekor public keys: %w", err)
+	}
+
+	return &TrustRoot{
+		fulcioRoots:  rootCerts,
+		rekorPubKeys: pubkeys,
+	}, nil
+}
+
+type cachedTrustRoot struct {
+	root      *TrustRoot
+	fetchedAt time.Time
+}
+
+var (
+	trustRootCacheMu sync.Mutex
+	trustRootCache   = make(map[string]cachedTrustRoot)
+)
+
+// NewTrustRoot returns the trust root described by a sigstore trust configuration.
+// A nil or empty configuration stands for the public good instance.
+func NewTrustRoot(ctx context.Context, cfg *pb.SigstoreTrustConfig) (*TrustRoot, error) {
+	if cfg == nil || proto.Equal(cfg, &pb.SigstoreTrustConfig{}) {
+		return PublicGoodTrustRoot(ctx)
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid sigstore trust configuration: %w", err)
+	}
+
+	rawKey, err := proto.MarshalOptions{Deterministic: true}.Marshal(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("error marshalling sigstore trust configuration: %w", err)
+	}
+	key := string(rawKey)
+
+	trustRootCacheMu.Lock()
+	cached, ok := trustRootCache[key]
+	trustRootCacheMu.Unlock()
+	if ok && time.Since(cached.fetchedAt) < trustRootTTL {
+		return cached.root, nil
+	}
+
+	root, err := buildTrustRoot(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	cacheTrustRoot(key, root)
+
+	return root, nil
+}
+
+// cacheTrustRoot caches a trust root. Expired entries are dropped once the cache is
+// full, and the cache starts over if all of them are still fresh.
+func cacheTrustRoot(key string, root *TrustRoot) {
+	trustRootCacheMu.Lock()
+	defer trustRootCacheMu.Unlock()
+
+	now := time.Now()
+	if len(trustRootCache) >= maxCachedTrustRoots {
+		for k, entry := range trustRootCache {
+			if now.Sub(entry.fetchedAt) >= trustRootTTL {
+				delete(trustRootCache, k)
+			}
+		}
+		if len(trustRootCache) >= maxCachedTrustRoots {
+			trustRootCache = make(map[string]cachedTrustRoot)
+		}
+	}
+
+	trustRootCache[key] = cachedTrustRoot{root: root, fetchedAt: now}
+}
+
+// buildTrustRoot builds the trust root of a configuration. The Fulcio roots and the Rekor
+// public keys must be configured explicitly or distributed through TUF, a private instance
+// never falls back to the trust roots of the public good one.
+func buildTrustRoot(cfg *pb.SigstoreTrustConfig) (*TrustRoot, error) {
+	tufTargets := make(map[sigtuf.UsageKind][][]byte)
+	if cfg.GetTufMirror() != "" {
+		var err error
+		tufTargets, err = fetchTUFTargets(cfg.GetTufMirror(), []byte(cfg.GetTufRoot()))
+		if err != nil {
+			return nil, fmt.Errorf("error fetching trust roots from %s: %w", cfg.GetTufMirror(), err)
+		}
+	}
+
+	root := &TrustRoot{offline: cfg.GetOffline()}
+
+	if err := root.loadFulcio(cfg, tufTargets[sigtuf.Fulcio]); err != nil {
+		return nil, err
+	}
+
+	rekorKeys := pemList(cfg.GetRekorPublicKeys())
+	if len(rekorKeys) == 0 {
+		rekorKeys = tufTargets[sigtuf.Rekor]
+	}
+	if len(rekorKeys) == 0 {
+		return nil, fmt.Errorf("no rekor public keys are configured or distributed through the TUF repository")
+	}
+	pubkeys, err := transparencyLogPubKeys(rekorKeys)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing rekor public keys: %w", err)
+	}
+	root.rekorPubKeys = pubkeys
+
+	ctKeys := pemList(cfg.GetCtLogPublicKeys())
+	if len(ctKeys) == 0 {
+		ctKeys = tufTargets[sigtuf.CTFE]
+	}
+	if len(ctKeys) > 0 {
+		pubkeys, err := transparencyLogPubKeys(ctKeys)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing CT log public keys: %w", err)
+		}
+		root.ctLogPubKeys = pubkeys
+	}
+
+	if cfg.GetRekorUrl() != "" {
+		cli, err := rekorclient.GetRekorClient(cfg.GetRekorUrl())
+		if err != nil {
+			return nil, fmt.Errorf("error creating rekor client: %w", err)
+		}
+		root.rekorClient = cli
+	}
+
+	return root, nil
+}
+
+// loadFulcio loads the explicitly configured Fulcio certificates, falling back to the ones
+// distributed through TUF
+func (t *TrustRoot) loadFulcio(cfg *pb.SigstoreTrustConfig, tufCerts [][]byte) error {
+	if cfg.GetFulcioRoots() != "" {
+		roots, err := certPool([]byte(cfg.GetFulcioRoots()))
+		if err != nil {
+			return fmt.Errorf("error parsing fulcio roots: %w", err)
+		}
+		t.fulcioRoots = roots
+
+		if cfg.GetFulcioIntermediates() != "" {
+			intermediates, err := certPool([]byte(cfg.GetFulcioIntermediates()))
+			if err != nil {
+				return fmt.Errorf("error parsing fulcio intermediates: %w", err)
+			}
+			t.fulcioIntermediates = intermediates
+		}
+		return nil
+	}
+
+	if len(tufCerts) > 0 {
+		// the TUF repository distributes roots and intermediates as the same usage,
+		// tell them apart the same way cosign does
+		t.fulcioRoots = x509.NewCertPool()
+		for _, raw := range tufCerts {
+			certs, err := cryptoutils.UnmarshalCertificatesFromPEM(raw)
+			if err != nil {
+				return fmt.Errorf("error parsing fulcio certificates: %w", err)
+			}
+			for _, cert := range certs {
+				if bytes.Equal(cert.RawSubject, cert.RawIssuer) {
+					t.fulcioRoots.AddCert(cert)
+					continue
+				}
+				if t.fulcioIntermediates == nil {
+					t.fulcioIntermediates = x509.NewCertPool()
+				}
+				t.fulcioIntermediates.AddCert(cert)
+			}
+		}
+		return nil
+	}
+
+	return fmt.Errorf("no fulcio roots are configured or distributed through the TUF repository")
+}
+
+// checkOpts returns the cosign options to verify signatures against the trust root
+func (t *TrustRoot) checkOpts(identities []cosign.Identity, registryClientOpts []ociremote.Option) *cosign.CheckOpts {
+	return &cosign.CheckOpts{
+		RegistryClientOpts: registryClientOpts,
+		Identities:         identities,
+		RootCerts:          t.fulcioRoots,
+		IntermediateCerts:  t.fulcioIntermediates,
+		RekorPubKeys:       t.rekorPubKeys,
+		RekorClient:        t.rekorClient,
+		CTLogPubKeys:       t.ctLogPubKeys,
+		// only require an SCT if we know the keys of the CT log
+		IgnoreSCT:     t.ctLogPubKeys == nil,
+		Offline:       t.offline,
+		ClaimVerifier: cosign.SimpleClaimVerifier,
+	}
+}
+
+func pemList(pems []string) [][]byte {
+	out := make([][]byte, 0, len(pems))
+	for _, p := range pems {
+		if p != "" {
+			out = append(out, []byte(p))
+		}
+	}
+	return out
+}
+
+func certPool(pemBytes []byte) (*x509.CertPool, error) {
+	certs, err := cryptoutils.UnmarshalCertificatesFromPEM(pemBytes)
+	if err != nil {
+		return nil, err
+	}
+	if len(certs) == 0 {
+		return nil, fmt.Errorf("no certificates found")
+	}
+
+	pool := x509.NewCertPool()
+	for _, cert := range certs {
+		pool.AddCert(cert)
+	}
+	return pool, nil
+}
+
+func transparencyLogPubKeys(pems [][]byte) (*cosign.TrustedTransparencyLogPubKeys, error) {
+	pubkeys := cosign.NewTrustedTransparencyLogPubKeys()
+	for _, p := range pems {
+		if err := pubkeys.AddTransparencyLogPubKey(p, sigtuf.Active); err != nil {
+			return nil, err
+		}
+	}
+	return &pubkeys, nil
+}
+
+// tufTargetMetadata is the custom metadata sigstore attaches to its TUF targets
+type tufTargetMetadata struct {
+	Sigstore struct {
+		Usage sigtuf.UsageKind `json:"usage"`
+	} `json:"sigstore"`
+}
+
+type tufBuffer struct {
+	bytes.Buffer
+}
+
+func (*tufBuffer) Delete() error {
+	return nil
+}
+
+// fetchTUFTargets fetches the targets of a sigstore TUF repository, grouped by usage.
+// Unlike the cosign helpers this does not touch the process-wide TUF client, each
+// provider can use its own repository.
+func fetchTUFTargets(mirror string, rootJSON []byte) (map[sigtuf.UsageKind][][]byte, error) {
+	remote, err := tufclient.HTTPRemoteStore(mirror, nil, &http.Client{Timeout: 30 * time.Second})
+	if err != nil {
+		return nil, fmt.Errorf("error creating TUF remote store: %w", err)
+	}
+
+	cli := tufclient.NewClient(tufclient.MemoryLocalStore(), remote)
+	if err := cli.Init(rootJSON); err != nil {
+		return nil, fmt.Errorf("error initializing TUF client: %w", err)
+	}
+
+	targets, err := cli.Update()
+	if err != nil {
+		return nil, fmt.Errorf("error updating TUF metadata: %w", err)
+	}
+
+	out := make(map[sigtuf.UsageKind][][]byte)
+	for name, meta := range targets {
+		if meta.Custom == nil {
+			continue
+		}
+
+		var custom tufTargetMetadata
+		if err := json.Unmarshal(*meta.Custom, &custom); err != nil {
+			// targets that are not sigstore trust roots
+			continue
+		}
+
+		var buf tufBuffer
+		if err := cli.Download(name, &buf); err != nil {
+			return nil, fmt.Errorf("error downloading TUF target %s: %w", name, err)
+		}
+		out[custom.Sigstore.Usage] = append(out[custom.Sigstore.Usage], buf.Bytes())
+	}
+
+	return out, nil
+}
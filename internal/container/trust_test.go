@@ -0,0 +1,237 @@
+// Copyright 2023 Stacklok, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package container
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sigstore/sigstore/pkg/cryptoutils"
+	sigtuf "github.com/sigstore/sigstore/pkg/tuf"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/theupdateframework/go-tuf"
+
+	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+func generateCertificatePEM(t *testing.T, commonName string, parent *x509.Certificate, parentKey *ecdsa.PrivateKey) (
+	[]byte, *x509.Certificate, *ecdsa.PrivateKey) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: commonName},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+
+	if parent == nil {
+		parent, parentKey = tmpl, key
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, parent, &key.PublicKey, parentKey)
+	require.NoError(t, err)
+
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	pemBytes, err := cryptoutils.MarshalCertificateToPEM(cert)
+	require.NoError(t, err)
+
+	return pemBytes, cert, key
+}
+
+func generatePublicKeyPEM(t *testing.T) []byte {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	pemBytes, err := cryptoutils.MarshalPublicKeyToPEM(key.Public())
+	require.NoError(t, err)
+
+	return pemBytes
+}
+
+func TestNewTrustRootExplicit(t *testing.T) {
+	t.Parallel()
+
+	rootPEM, rootCert, rootKey := generateCertificatePEM(t, "private fulcio root", nil, nil)
+	intermediatePEM, _, _ := generateCertificatePEM(t, "private fulcio intermediate", rootCert, rootKey)
+	rekorPEM := generatePublicKeyPEM(t)
+	ctPEM := generatePublicKeyPEM(t)
+
+	root, err := NewTrustRoot(context.Background(), &pb.SigstoreTrustConfig{
+		FulcioRoots:         string(rootPEM),
+		FulcioIntermediates: string(intermediatePEM),
+		RekorPublicKeys:     []string{string(rekorPEM)},
+		CtLogPublicKeys:     []string{string(ctPEM)},
+		Offline:             true,
+	})
+	require.NoError(t, err)
+
+	co := root.checkOpts(nil, nil)
+	assert.True(t, co.Offline)
+	assert.False(t, co.IgnoreSCT, "SCTs are required when the CT log keys are known")
+	require.NotNil(t, co.RootCerts)
+	require.NotNil(t, co.IntermediateCerts)
+	require.NotNil(t, co.RekorPubKeys)
+	assert.Len(t, co.RekorPubKeys.Keys, 1)
+	require.NotNil(t, co.CTLogPubKeys)
+	assert.Len(t, co.CTLogPubKeys.Keys, 1)
+	assert.Nil(t, co.RekorClient)
+
+	_, err = rootCert.Verify(x509.VerifyOptions{Roots: co.RootCerts})
+	assert.NoError(t, err)
+
+	// the same configuration is served from the cache
+	again, err := NewTrustRoot(context.Background(), &pb.SigstoreTrustConfig{
+		FulcioRoots:         string(rootPEM),
+		FulcioIntermediates: string(intermediatePEM),
+		RekorPublicKeys:     []string{string(rekorPEM)},
+		CtLogPublicKeys:     []string{string(ctPEM)},
+		Offline:             true,
+	})
+	require.NoError(t, err)
+	assert.Same(t, root, again)
+}
+
+func TestNewTrustRootInvalid(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewTrustRoot(context.Background(), &pb.SigstoreTrustConfig{
+		Offline:   true,
+		TufMirror: "https://tuf.example.com",
+		TufRoot:   "{}",
+	})
+	assert.Error(t, err)
+
+	_, err = NewTrustRoot(context.Background(), &pb.SigstoreTrustConfig{
+		FulcioRoots:     "not a certificate",
+		RekorPublicKeys: []string{"not a key"},
+		Offline:         true,
+	})
+	assert.Error(t, err)
+
+	// a private instance doesn't fall back to the trust roots of the public good one
+	rootPEM, _, _ := generateCertificatePEM(t, "private fulcio root", nil, nil)
+	_, err = NewTrustRoot(context.Background(), &pb.SigstoreTrustConfig{
+		RekorUrl: "https://rekor.example.com",
+	})
+	assert.Error(t, err)
+
+	_, err = NewTrustRoot(context.Background(), &pb.SigstoreTrustConfig{
+		FulcioRoots: string(rootPEM),
+		Offline:     true,
+	})
+	assert.Error(t, err)
+}
+
+func TestCacheTrustRootIsBounded(t *testing.T) {
+	t.Parallel()
+
+	for i := 0; i <= maxCachedTrustRoots; i++ {
+		cacheTrustRoot(fmt.Sprintf("bounded-%d", i), &TrustRoot{})
+	}
+
+	trustRootCacheMu.Lock()
+	defer trustRootCacheMu.Unlock()
+	assert.LessOrEqual(t, len(trustRootCache), maxCachedTrustRoots)
+}
+
+func TestNewTrustRootFromTUF(t *testing.T) {
+	t.Parallel()
+
+	rootPEM, rootCert, rootKey := generateCertificatePEM(t, "private fulcio root", nil, nil)
+	intermediatePEM, _, _ := generateCertificatePEM(t, "private fulcio intermediate", rootCert, rootKey)
+	rekorPEM := generatePublicKeyPEM(t)
+
+	meta := make(map[string]json.RawMessage)
+	files := map[string][]byte{
+		"fulcio.crt.pem":              rootPEM,
+		"fulcio_intermediate.crt.pem": intermediatePEM,
+		"rekor.pub":                   rekorPEM,
+	}
+
+	repo, err := tuf.NewRepo(tuf.MemoryStore(meta, files))
+	require.NoError(t, err)
+	require.NoError(t, repo.Init(false))
+	for _, role := range []string{"root", "targets", "snapshot", "timestamp"} {
+		_, err := repo.GenKey(role)
+		require.NoError(t, err)
+	}
+
+	for name, usage := range map[string]sigtuf.UsageKind{
+		"fulcio.crt.pem":              sigtuf.Fulcio,
+		"fulcio_intermediate.crt.pem": sigtuf.Fulcio,
+		"rekor.pub":                   sigtuf.Rekor,
+	} {
+		custom := json.RawMessage(`{"sigstore": {"usage": "` + usage.String() + `", "status": "Active"}}`)
+		require.NoError(t, repo.AddTarget(name, custom))
+	}
+	require.NoError(t, repo.Snapshot())
+	require.NoError(t, repo.Timestamp())
+	require.NoError(t, repo.Commit())
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		name := strings.TrimPrefix(r.URL.Path, "/")
+		if target, ok := strings.CutPrefix(name, "targets/"); ok {
+			if content, ok := files[target]; ok {
+				_, _ = w.Write(content)
+				return
+			}
+		} else if content, ok := meta[name]; ok {
+			_, _ = w.Write(content)
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer ts.Close()
+
+	root, err := NewTrustRoot(context.Background(), &pb.SigstoreTrustConfig{
+		TufMirror: ts.URL,
+		TufRoot:   string(meta["root.json"]),
+	})
+	require.NoError(t, err)
+
+	co := root.checkOpts(nil, nil)
+	assert.False(t, co.Offline)
+	assert.True(t, co.IgnoreSCT, "no CT log keys are distributed by the repository")
+	require.NotNil(t, co.IntermediateCerts, "the intermediate is told apart from the root")
+	require.NotNil(t, co.RekorPubKeys)
+	assert.Len(t, co.RekorPubKeys.Keys, 1)
+
+	_, err = rootCert.Verify(x509.VerifyOptions{Roots: co.RootCerts})
+	assert.NoError(t, err)
+}
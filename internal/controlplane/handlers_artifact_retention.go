@@ -0,0 +1,170 @@
+// Copyright 2023 Stacklok, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controlplane
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/stacklok/minder/internal/db"
+	"github.com/stacklok/minder/internal/reconcilers"
+	"github.com/stacklok/minder/internal/util"
+	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+// GetArtifactRetentionPolicy returns the retention policy of the artifact versions of a project
+func (s *Server) GetArtifactRetentionPolicy(
+	ctx context.Context,
+	in *pb.GetArtifactRetentionPolicyRequest,
+) (*pb.GetArtifactRetentionPolicyResponse, error) {
+	projectID, err := getProjectFromRequestOrDefault(ctx, in)
+	if err != nil {
+		return nil, util.UserVisibleError(codes.InvalidArgument, err.Error())
+	}
+
+	// check if user is authorized
+	if err := AuthorizedOnProject(ctx, projectID); err != nil {
+		return nil, err
+	}
+
+	retention, err := reconcilers.GetArtifactRetention(ctx, s.store, projectID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get artifact retention policy: %s", err)
+	}
+
+	return &pb.GetArtifactRetentionPolicyResponse{Policy: retention.ToPb()}, nil
+}
+
+// SetArtifactRetentionPolicy sets the retention policy of the artifact versions of a project
+func (s *Server) SetArtifactRetentionPolicy(
+	ctx context.Context,
+	in *pb.SetArtifactRetentionPolicyRequest,
+) (*pb.SetArtifactRetentionPolicyResponse, error) {
+	projectID, err := getProjectFromRequestOrDefault(ctx, in)
+	if err != nil {
+		return nil, util.UserVisibleError(codes.InvalidArgument, err.Error())
+	}
+
+	// check if user is authorized
+	if err := AuthorizedOnProject(ctx, projectID); err != nil {
+		return nil, err
+	}
+
+	retention, err := reconcilers.ArtifactRetentionFromPb(in.GetPolicy())
+	if err != nil {
+		return nil, util.UserVisibleError(codes.InvalidArgument, "invalid retention policy: %s", err)
+	}
+
+	policy, err := s.store.UpsertArtifactRetentionPolicy(ctx, db.UpsertArtifactRetentionPolicyParams{
+		ProjectID:  projectID,
+		KeepLast:   sql.NullInt32{Valid: retention.KeepLast > 0, Int32: retention.KeepLast},
+		MaxAgeDays: sql.NullInt32{Valid: retention.MaxAgeDays > 0, Int32: retention.MaxAgeDays},
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to set artifact retention policy: %s", err)
+	}
+
+	return &pb.SetArtifactRetentionPolicyResponse{Policy: &pb.ArtifactRetentionPolicy{
+		KeepLast:   policy.KeepLast.Int32,
+		MaxAgeDays: policy.MaxAgeDays.Int32,
+	}}, nil
+}
+
+// PruneArtifactVersions removes the stored artifact versions the retention policy of
+// the project doesn't keep, or lists them on a dry run
+func (s *Server) PruneArtifactVersions(
+	ctx context.Context,
+	in *pb.PruneArtifactVersionsRequest,
+) (*pb.PruneArtifactVersionsResponse, error) {
+	projectID, err := getProjectFromRequestOrDefault(ctx, in)
+	if err != nil {
+		return nil, util.UserVisibleError(codes.InvalidArgument, err.Error())
+	}
+
+	// check if user is authorized
+	if err := AuthorizedOnProject(ctx, projectID); err != nil {
+		return nil, err
+	}
+
+	artifacts, err := s.getArtifactsToPrune(ctx, projectID, in.ArtifactId)
+	if err != nil {
+		return nil, err
+	}
+
+	retention, err := reconcilers.GetArtifactRetention(ctx, s.store, projectID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get artifact retention policy: %s", err)
+	}
+
+	pruned := []*pb.PrunedArtifactVersion{}
+	for _, artifact := range artifacts {
+		versions, err := reconcilers.PruneArtifactVersions(ctx, s.store, artifact.ID, retention, in.GetDryRun())
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to prune artifact versions: %s", err)
+		}
+
+		for _, version := range versions {
+			pbVersion, err := artifactVersionToPb(version)
+			if err != nil {
+				return nil, err
+			}
+			pruned = append(pruned, &pb.PrunedArtifactVersion{
+				ArtifactId:   artifact.ID.String(),
+				ArtifactName: artifact.ArtifactName,
+				Version:      pbVersion,
+			})
+		}
+	}
+
+	return &pb.PruneArtifactVersionsResponse{Versions: pruned}, nil
+}
+
+// getArtifactsToPrune returns the given artifact of the project, or all of the artifacts
+// of the project if none is given
+func (s *Server) getArtifactsToPrune(ctx context.Context, projectID uuid.UUID, artifactID *string) ([]db.Artifact, error) {
+	if artifactID == nil {
+		artifacts, err := s.store.ListArtifactsByProjectID(ctx, projectID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to list artifacts: %s", err)
+		}
+		return artifacts, nil
+	}
+
+	parsedArtifactID, err := uuid.Parse(*artifactID)
+	if err != nil {
+		return nil, util.UserVisibleError(codes.InvalidArgument, "invalid artifact ID")
+	}
+
+	artifact, err := s.store.GetArtifactByID(ctx, parsedArtifactID)
+	if errors.Is(err, sql.ErrNoRows) || (err == nil && artifact.ProjectID != projectID) {
+		return nil, status.Errorf(codes.NotFound, "artifact not found")
+	} else if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get artifact: %s", err)
+	}
+
+	return []db.Artifact{{
+		ID:                 artifact.ID,
+		RepositoryID:       artifact.RepositoryID,
+		ArtifactName:       artifact.ArtifactName,
+		ArtifactType:       artifact.ArtifactType,
+		ArtifactVisibility: artifact.ArtifactVisibility,
+		CreatedAt:          artifact.CreatedAt,
+	}}, nil
+}
@@ -0,0 +1,223 @@
+// Copyright 2023 Stacklok, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controlplane
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	mockdb "github.com/stacklok/minder/database/mock"
+	"github.com/stacklok/minder/internal/auth"
+	"github.com/stacklok/minder/internal/db"
+	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+func artifactRetentionTestContext(projectID uuid.UUID) context.Context {
+	orgID := uuid.New()
+	return auth.WithPermissionsContext(context.Background(), auth.UserPermissions{
+		UserId:         1,
+		OrganizationId: orgID,
+		ProjectIds:     []uuid.UUID{projectID},
+		IsStaff:        true, // TODO: remove this
+		Roles: []auth.RoleInfo{
+			{RoleID: 1, IsAdmin: true, ProjectID: &projectID, OrganizationID: orgID}},
+	})
+}
+
+func TestGetArtifactRetentionPolicyDefault(t *testing.T) {
+	t.Parallel()
+
+	projectID := uuid.New()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().
+		GetArtifactRetentionPolicy(gomock.Any(), projectID).
+		Return(db.ArtifactRetentionPolicy{}, sql.ErrNoRows)
+
+	server := newDefaultServer(t, store)
+	res, err := server.GetArtifactRetentionPolicy(artifactRetentionTestContext(projectID),
+		&pb.GetArtifactRetentionPolicyRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, int32(0), res.GetPolicy().GetKeepLast())
+	assert.Equal(t, int32(30), res.GetPolicy().GetMaxAgeDays())
+}
+
+func TestSetArtifactRetentionPolicy(t *testing.T) {
+	t.Parallel()
+
+	projectID := uuid.New()
+
+	testCases := []struct {
+		name         string
+		policy       *pb.ArtifactRetentionPolicy
+		buildStubs   func(store *mockdb.MockStore)
+		expectedCode codes.Code
+	}{
+		{
+			name:   "keeps last versions without age limit",
+			policy: &pb.ArtifactRetentionPolicy{KeepLast: 5},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					UpsertArtifactRetentionPolicy(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, arg db.UpsertArtifactRetentionPolicyParams) (db.ArtifactRetentionPolicy, error) {
+						assert.Equal(t, projectID, arg.ProjectID)
+						assert.Equal(t, sql.NullInt32{Valid: true, Int32: 5}, arg.KeepLast)
+						assert.False(t, arg.MaxAgeDays.Valid, "zero means no limit")
+						return db.ArtifactRetentionPolicy{ProjectID: arg.ProjectID, KeepLast: arg.KeepLast}, nil
+					})
+			},
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "negative limit",
+			policy:       &pb.ArtifactRetentionPolicy{MaxAgeDays: -1},
+			buildStubs:   func(_ *mockdb.MockStore) {},
+			expectedCode: codes.InvalidArgument,
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newDefaultServer(t, store)
+			res, err := server.SetArtifactRetentionPolicy(artifactRetentionTestContext(projectID),
+				&pb.SetArtifactRetentionPolicyRequest{Policy: tc.policy})
+			if tc.expectedCode != codes.OK {
+				require.Error(t, err)
+				assert.Equal(t, tc.expectedCode, status.Code(err))
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.policy.GetKeepLast(), res.GetPolicy().GetKeepLast())
+			assert.Equal(t, tc.policy.GetMaxAgeDays(), res.GetPolicy().GetMaxAgeDays())
+		})
+	}
+}
+
+func TestPruneArtifactVersions(t *testing.T) {
+	t.Parallel()
+
+	projectID := uuid.New()
+	artifactID := uuid.New()
+	otherArtifactID := uuid.New()
+
+	testCases := []struct {
+		name          string
+		req           *pb.PruneArtifactVersionsRequest
+		buildStubs    func(store *mockdb.MockStore)
+		expectedCount int
+		expectedCode  codes.Code
+	}{
+		{
+			name: "dry run lists the versions of all artifacts",
+			req:  &pb.PruneArtifactVersionsRequest{DryRun: true},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListArtifactsByProjectID(gomock.Any(), projectID).
+					Return([]db.Artifact{{ID: artifactID, ArtifactName: "minder"}}, nil)
+				store.EXPECT().
+					GetArtifactRetentionPolicy(gomock.Any(), projectID).
+					Return(db.ArtifactRetentionPolicy{KeepLast: sql.NullInt32{Valid: true, Int32: 2}}, nil)
+				store.EXPECT().
+					ListPrunableArtifactVersions(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, arg db.ListPrunableArtifactVersionsParams) ([]db.ArtifactVersion, error) {
+						assert.Equal(t, artifactID, arg.ArtifactID)
+						assert.Equal(t, sql.NullInt32{Valid: true, Int32: 2}, arg.KeepLast)
+						assert.False(t, arg.CreatedBefore.Valid, "the policy has no age limit")
+						return []db.ArtifactVersion{{ArtifactID: artifactID, Version: 1, Sha: "sha256:1"}}, nil
+					})
+			},
+			expectedCount: 1,
+			expectedCode:  codes.OK,
+		},
+		{
+			name: "prunes an artifact",
+			req:  &pb.PruneArtifactVersionsRequest{ArtifactId: proto.String(artifactID.String())},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetArtifactByID(gomock.Any(), artifactID).
+					Return(db.GetArtifactByIDRow{ID: artifactID, ProjectID: projectID}, nil)
+				store.EXPECT().
+					GetArtifactRetentionPolicy(gomock.Any(), projectID).
+					Return(db.ArtifactRetentionPolicy{}, sql.ErrNoRows)
+				store.EXPECT().
+					DeletePrunableArtifactVersions(gomock.Any(), gomock.Any()).
+					Return([]db.ArtifactVersion{{ArtifactID: artifactID}, {ArtifactID: artifactID}}, nil)
+			},
+			expectedCount: 2,
+			expectedCode:  codes.OK,
+		},
+		{
+			name: "artifact of another project",
+			req:  &pb.PruneArtifactVersionsRequest{ArtifactId: proto.String(otherArtifactID.String())},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetArtifactByID(gomock.Any(), otherArtifactID).
+					Return(db.GetArtifactByIDRow{ID: otherArtifactID, ProjectID: uuid.New()}, nil)
+			},
+			expectedCode: codes.NotFound,
+		},
+		{
+			name:         "invalid artifact ID",
+			req:          &pb.PruneArtifactVersionsRequest{ArtifactId: proto.String("not an id")},
+			buildStubs:   func(_ *mockdb.MockStore) {},
+			expectedCode: codes.InvalidArgument,
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newDefaultServer(t, store)
+			res, err := server.PruneArtifactVersions(artifactRetentionTestContext(projectID), tc.req)
+			if tc.expectedCode != codes.OK {
+				require.Error(t, err)
+				assert.Equal(t, tc.expectedCode, status.Code(err))
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Len(t, res.GetVersions(), tc.expectedCount)
+		})
+	}
+}
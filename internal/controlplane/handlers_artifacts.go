@@ -39,6 +39,10 @@ func (s *Server) ListArtifacts(ctx context.Context, in *pb.ListArtifactsRequest)
 		return nil, util.UserVisibleError(codes.InvalidArgument, err.Error())
 	}
 
+	if err := validateEvalStatusFilter(in.Status); err != nil {
+		return nil, err
+	}
+
 	// check if user is authorized
 	if err := AuthorizedOnProject(ctx, projectID); err != nil {
 		return nil, err
@@ -70,6 +74,16 @@ func (s *Server) ListArtifacts(ctx context.Context, in *pb.ListArtifactsRequest)
 		}
 
 		for _, artifact := range artifacts {
+			evaluated, err := s.listEvaluatedArtifactVersions(ctx, artifact.ID, in.Profile)
+			if err != nil {
+				return nil, err
+			}
+
+			versions := filterArtifactVersionsByStatus(evaluated, in.Status)
+			if in.Status != "" && len(versions) == 0 {
+				continue
+			}
+
 			results = append(results, &pb.Artifact{
 				ArtifactPk: artifact.ID.String(),
 				Owner:      repository.RepoOwner,
@@ -77,6 +91,7 @@ func (s *Server) ListArtifacts(ctx context.Context, in *pb.ListArtifactsRequest)
 				Type:       artifact.ArtifactType,
 				Visibility: artifact.ArtifactVisibility,
 				Repository: repository.RepoName,
+				Versions:   versions,
 				CreatedAt:  timestamppb.New(artifact.CreatedAt),
 			})
 		}
@@ -96,6 +111,10 @@ func (s *Server) GetArtifactById(ctx context.Context, in *pb.GetArtifactByIdRequ
 		return nil, status.Errorf(codes.InvalidArgument, "latest versions must be between 1 and 10")
 	}
 
+	if err := validateEvalStatusFilter(in.Status); err != nil {
+		return nil, err
+	}
+
 	parsedArtifactID, err := uuid.Parse(in.Id)
 	if err != nil {
 		return nil, util.UserVisibleError(codes.InvalidArgument, "invalid artifact ID")
@@ -137,37 +156,26 @@ func (s *Server) GetArtifactById(ctx context.Context, in *pb.GetArtifactByIdRequ
 		return nil, status.Errorf(codes.Unknown, "failed to get artifact versions: %s", err)
 	}
 
+	evaluated, err := s.listEvaluatedArtifactVersions(ctx, parsedArtifactID, in.Profile)
+	if err != nil {
+		return nil, err
+	}
+	// versions are matched by digest, images of OCI registries have no numeric version IDs
+	profileStatus := make(map[string][]*pb.ArtifactVersionProfileStatus, len(evaluated))
+	for _, version := range evaluated {
+		profileStatus[version.Sha] = version.ProfileStatus
+	}
+
 	final_versions := []*pb.ArtifactVersion{}
 	for _, version := range versions {
-		tags := []string{}
-		if version.Tags.Valid {
-			tags = strings.Split(version.Tags.String, ",")
-		}
-
-		sigVerification := &pb.SignatureVerification{}
-		if version.SignatureVerification.Valid {
-			if err := protojson.Unmarshal(version.SignatureVerification.RawMessage, sigVerification); err != nil {
-				return nil, err
-			}
-		}
-
-		ghWorkflow := &pb.GithubWorkflow{}
-		if version.GithubWorkflow.Valid {
-			if err := protojson.Unmarshal(version.GithubWorkflow.RawMessage, ghWorkflow); err != nil {
-				return nil, err
-			}
+		pbVersion, err := artifactVersionToPb(version)
+		if err != nil {
+			return nil, err
 		}
-
-		final_versions = append(final_versions, &pb.ArtifactVersion{
-			VersionId:             version.Version,
-			Tags:                  tags,
-			Sha:                   version.Sha,
-			SignatureVerification: sigVerification,
-			GithubWorkflow:        ghWorkflow,
-			CreatedAt:             timestamppb.New(version.CreatedAt),
-		})
-
+		pbVersion.ProfileStatus = profileStatus[version.Sha]
+		final_versions = append(final_versions, pbVersion)
 	}
+	final_versions = filterArtifactVersionsByStatus(final_versions, in.Status)
 
 	return &pb.GetArtifactByIdResponse{Artifact: &pb.Artifact{
 		ArtifactPk: artifact.ID.String(),
@@ -181,3 +189,158 @@ func (s *Server) GetArtifactById(ctx context.Context, in *pb.GetArtifactByIdRequ
 		Versions: final_versions,
 	}, nil
 }
+
+// artifactVersionToPb returns the protobuf message of a stored artifact version
+func artifactVersionToPb(version db.ArtifactVersion) (*pb.ArtifactVersion, error) {
+	tags := []string{}
+	if version.Tags.Valid {
+		tags = strings.Split(version.Tags.String, ",")
+	}
+
+	sigVerification := &pb.SignatureVerification{}
+	if version.SignatureVerification.Valid {
+		if err := protojson.Unmarshal(version.SignatureVerification.RawMessage, sigVerification); err != nil {
+			return nil, err
+		}
+	}
+
+	ghWorkflow := &pb.GithubWorkflow{}
+	if version.GithubWorkflow.Valid {
+		if err := protojson.Unmarshal(version.GithubWorkflow.RawMessage, ghWorkflow); err != nil {
+			return nil, err
+		}
+	}
+
+	provenance := &pb.Provenance{}
+	if version.Provenance.Valid {
+		if err := protojson.Unmarshal(version.Provenance.RawMessage, provenance); err != nil {
+			return nil, err
+		}
+	}
+
+	return &pb.ArtifactVersion{
+		VersionId:             version.Version,
+		Tags:                  tags,
+		Sha:                   version.Sha,
+		SignatureVerification: sigVerification,
+		GithubWorkflow:        ghWorkflow,
+		Provenance:            provenance,
+		CreatedAt:             timestamppb.New(version.CreatedAt),
+	}, nil
+}
+
+// validateEvalStatusFilter makes sure a status used to filter artifact versions is
+// an evaluation status
+func validateEvalStatusFilter(evalStatus string) error {
+	switch db.EvalStatusTypes(evalStatus) {
+	case "", db.EvalStatusTypesSuccess, db.EvalStatusTypesFailure, db.EvalStatusTypesError,
+		db.EvalStatusTypesSkipped, db.EvalStatusTypesPending:
+		return nil
+	default:
+		return util.UserVisibleError(codes.InvalidArgument, "invalid evaluation status: %s", evalStatus)
+	}
+}
+
+// listEvaluatedArtifactVersions returns the versions of an artifact that were evaluated,
+// along with the status of the profiles evaluated against them, newest first
+func (s *Server) listEvaluatedArtifactVersions(
+	ctx context.Context,
+	artifactID uuid.UUID,
+	profile string,
+) ([]*pb.ArtifactVersion, error) {
+	rows, err := s.store.ListArtifactVersionEvaluations(ctx, db.ListArtifactVersionEvaluationsParams{
+		ArtifactID:  artifactID,
+		ProfileName: sql.NullString{String: profile, Valid: profile != ""},
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Unknown, "failed to get artifact version evaluations: %s", err)
+	}
+
+	versions := []*pb.ArtifactVersion{}
+	byVersion := make(map[uuid.UUID]*pb.ArtifactVersion)
+	byProfile := make(map[uuid.UUID]map[string]*pb.ArtifactVersionProfileStatus)
+	for _, row := range rows {
+		version, ok := byVersion[row.ArtifactVersionID]
+		if !ok {
+			tags := []string{}
+			if row.Tags.Valid {
+				tags = strings.Split(row.Tags.String, ",")
+			}
+			version = &pb.ArtifactVersion{
+				VersionId: row.Version,
+				Tags:      tags,
+				Sha:       row.Sha,
+				CreatedAt: timestamppb.New(row.CreatedAt),
+			}
+			byVersion[row.ArtifactVersionID] = version
+			byProfile[row.ArtifactVersionID] = make(map[string]*pb.ArtifactVersionProfileStatus)
+			versions = append(versions, version)
+		}
+
+		profileStatus, ok := byProfile[row.ArtifactVersionID][row.ProfileName]
+		if !ok {
+			profileStatus = &pb.ArtifactVersionProfileStatus{ProfileName: row.ProfileName}
+			byProfile[row.ArtifactVersionID][row.ProfileName] = profileStatus
+			version.ProfileStatus = append(version.ProfileStatus, profileStatus)
+		}
+
+		profileStatus.Rules = append(profileStatus.Rules, &pb.ArtifactVersionRuleStatus{
+			RuleTypeName: row.RuleTypeName,
+			Status:       string(row.Status),
+			Details:      row.Details,
+			LastUpdated:  timestamppb.New(row.LastUpdated),
+		})
+		if profileStatus.LastUpdated == nil || profileStatus.LastUpdated.AsTime().Before(row.LastUpdated) {
+			profileStatus.LastUpdated = timestamppb.New(row.LastUpdated)
+		}
+	}
+
+	for _, version := range versions {
+		for _, profileStatus := range version.ProfileStatus {
+			profileStatus.ProfileStatus = string(aggregateRuleStatus(profileStatus.Rules))
+		}
+	}
+
+	return versions, nil
+}
+
+// aggregateRuleStatus returns the status of a profile from the status of its rules,
+// the same way the status of a profile is computed for its entities
+func aggregateRuleStatus(rules []*pb.ArtifactVersionRuleStatus) db.EvalStatusTypes {
+	seen := make(map[db.EvalStatusTypes]bool)
+	for _, rule := range rules {
+		seen[db.EvalStatusTypes(rule.Status)] = true
+	}
+
+	switch {
+	case seen[db.EvalStatusTypesError]:
+		return db.EvalStatusTypesError
+	case seen[db.EvalStatusTypesFailure]:
+		return db.EvalStatusTypesFailure
+	case seen[db.EvalStatusTypesPending]:
+		return db.EvalStatusTypesPending
+	case seen[db.EvalStatusTypesSuccess]:
+		return db.EvalStatusTypesSuccess
+	default:
+		return db.EvalStatusTypesSkipped
+	}
+}
+
+// filterArtifactVersionsByStatus returns the versions with at least one profile in
+// the given status, or all of them if no status is given
+func filterArtifactVersionsByStatus(versions []*pb.ArtifactVersion, evalStatus string) []*pb.ArtifactVersion {
+	if evalStatus == "" {
+		return versions
+	}
+
+	filtered := []*pb.ArtifactVersion{}
+	for _, version := range versions {
+		for _, profileStatus := range version.ProfileStatus {
+			if profileStatus.ProfileStatus == evalStatus {
+				filtered = append(filtered, version)
+				break
+			}
+		}
+	}
+	return filtered
+}
@@ -0,0 +1,136 @@
+// Copyright 2023 Stacklok, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controlplane
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	mockdb "github.com/stacklok/minder/database/mock"
+	"github.com/stacklok/minder/internal/db"
+	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+func TestGetArtifactByIdVersionStatus(t *testing.T) {
+	t.Parallel()
+
+	projectID := uuid.New()
+	artifactID := uuid.New()
+	now := time.Now()
+
+	// images of OCI registries have no numeric version IDs, versions are matched by digest
+	versions := []db.ArtifactVersion{
+		{ID: uuid.New(), ArtifactID: artifactID, Version: 0, Sha: "sha256:2",
+			Tags: sql.NullString{Valid: true, String: "latest"}, CreatedAt: now},
+		{ID: uuid.New(), ArtifactID: artifactID, Version: 0, Sha: "sha256:1",
+			Tags: sql.NullString{Valid: true, String: "v1"}, CreatedAt: now.Add(-time.Hour)},
+	}
+	evaluations := []db.ListArtifactVersionEvaluationsRow{
+		{ArtifactVersionID: versions[0].ID, Version: 0, Sha: "sha256:2", CreatedAt: now,
+			ProfileName: "signed", RuleTypeName: "artifact_signature", Status: db.EvalStatusTypesSuccess, LastUpdated: now},
+		{ArtifactVersionID: versions[0].ID, Version: 0, Sha: "sha256:2", CreatedAt: now,
+			ProfileName: "signed", RuleTypeName: "artifact_provenance", Status: db.EvalStatusTypesSkipped, LastUpdated: now},
+		{ArtifactVersionID: versions[1].ID, Version: 0, Sha: "sha256:1", CreatedAt: now.Add(-time.Hour),
+			ProfileName: "signed", RuleTypeName: "artifact_signature", Status: db.EvalStatusTypesFailure,
+			Details: "artifact not signed", LastUpdated: now},
+		{ArtifactVersionID: versions[1].ID, Version: 0, Sha: "sha256:1", CreatedAt: now.Add(-time.Hour),
+			ProfileName: "signed", RuleTypeName: "artifact_provenance", Status: db.EvalStatusTypesError, LastUpdated: now},
+	}
+
+	testCases := []struct {
+		name           string
+		status         string
+		expectedCode   codes.Code
+		expectedStatus map[string]string
+	}{
+		{
+			name:           "all versions",
+			expectedCode:   codes.OK,
+			expectedStatus: map[string]string{"sha256:2": "success", "sha256:1": "error"},
+		},
+		{
+			name:           "versions in error",
+			status:         "error",
+			expectedCode:   codes.OK,
+			expectedStatus: map[string]string{"sha256:1": "error"},
+		},
+		{
+			name:           "no version in failure",
+			status:         "failure",
+			expectedCode:   codes.OK,
+			expectedStatus: map[string]string{},
+		},
+		{
+			name:         "invalid status",
+			status:       "broken",
+			expectedCode: codes.InvalidArgument,
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			if tc.expectedCode == codes.OK {
+				store.EXPECT().
+					GetArtifactByID(gomock.Any(), artifactID).
+					Return(db.GetArtifactByIDRow{ID: artifactID, ProjectID: projectID, ArtifactName: "image"}, nil)
+				store.EXPECT().
+					ListArtifactVersionsByArtifactID(gomock.Any(), gomock.Any()).
+					Return(versions, nil)
+				store.EXPECT().
+					ListArtifactVersionEvaluations(gomock.Any(), db.ListArtifactVersionEvaluationsParams{
+						ArtifactID: artifactID,
+					}).
+					Return(evaluations, nil)
+			}
+
+			server := newDefaultServer(t, store)
+			res, err := server.GetArtifactById(artifactRetentionTestContext(projectID), &pb.GetArtifactByIdRequest{
+				Id:             artifactID.String(),
+				LatestVersions: 10,
+				Status:         tc.status,
+			})
+			if tc.expectedCode != codes.OK {
+				require.Error(t, err)
+				assert.Equal(t, tc.expectedCode, status.Code(err))
+				return
+			}
+			require.NoError(t, err)
+
+			got := make(map[string]string)
+			for _, version := range res.GetVersions() {
+				require.Len(t, version.GetProfileStatus(), 1)
+				assert.Equal(t, "signed", version.GetProfileStatus()[0].GetProfileName())
+				assert.Len(t, version.GetProfileStatus()[0].GetRules(), 2)
+				got[version.GetSha()] = version.GetProfileStatus()[0].GetProfileStatus()
+			}
+			assert.Equal(t, tc.expectedStatus, got)
+		})
+	}
+}
@@ -0,0 +1,158 @@
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controlplane
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/stacklok/minder/internal/db"
+	"github.com/stacklok/minder/internal/engine/ingester/diff"
+	"github.com/stacklok/minder/internal/util"
+	"github.com/stacklok/minder/internal/util/versionconstraint"
+	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+// ListRepositoryDependencies returns the dependency inventory of a repository
+// as recorded by the latest rule evaluations.
+func (s *Server) ListRepositoryDependencies(ctx context.Context,
+	in *pb.ListRepositoryDependenciesRequest) (*pb.ListRepositoryDependenciesResponse, error) {
+	parsedRepositoryID, err := uuid.Parse(in.RepositoryId)
+	if err != nil {
+		return nil, util.UserVisibleError(codes.InvalidArgument, "invalid repository ID")
+	}
+
+	repo, err := s.store.GetRepositoryByID(ctx, parsedRepositoryID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "repository not found")
+	} else if err != nil {
+		return nil, status.Errorf(codes.Internal, "cannot read repository: %v", err)
+	}
+
+	// check if user is authorized
+	if err := AuthorizedOnProject(ctx, repo.ProjectID); err != nil {
+		return nil, err
+	}
+
+	deps, err := s.store.ListRepositoryDependencies(ctx, repo.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "cannot list dependencies: %v", err)
+	}
+
+	results := make([]*pb.DependencyUsage, 0, len(deps))
+	for _, d := range deps {
+		results = append(results, &pb.DependencyUsage{
+			RepositoryId: repo.ID.String(),
+			Owner:        repo.RepoOwner,
+			Name:         repo.RepoName,
+			Provider:     repo.Provider,
+			Dependency: &pb.Dependency{
+				Ecosystem: diff.DependencyEcosystem(d.Ecosystem).AsProto(),
+				Name:      d.Name,
+				Version:   d.Version,
+			},
+			File:              d.File,
+			PullRequestNumber: nullInt64Ptr(d.PrNumber),
+			UpdatedAt:         timestamppb.New(d.UpdatedAt),
+		})
+	}
+
+	return &pb.ListRepositoryDependenciesResponse{Results: results}, nil
+}
+
+// ListDependencyUsage returns the repositories of a project that use a package,
+// optionally restricted to the versions matching a version constraint.
+func (s *Server) ListDependencyUsage(ctx context.Context,
+	in *pb.ListDependencyUsageRequest) (*pb.ListDependencyUsageResponse, error) {
+	projectID, err := getProjectFromRequestOrDefault(ctx, in)
+	if err != nil {
+		return nil, util.UserVisibleError(codes.InvalidArgument, err.Error())
+	}
+
+	// check if user is authorized
+	if err := AuthorizedOnProject(ctx, projectID); err != nil {
+		return nil, err
+	}
+
+	eco := diff.DependencyEcosystem(strings.ToLower(in.Ecosystem))
+	if eco.AsProto() == pb.DepEcosystem_DEP_ECOSYSTEM_UNSPECIFIED {
+		return nil, util.UserVisibleError(codes.InvalidArgument, "invalid ecosystem %q, must be one of npm, go or pypi", in.Ecosystem)
+	}
+
+	if in.Package == "" {
+		return nil, util.UserVisibleError(codes.InvalidArgument, "package name is required")
+	}
+
+	var constraint *versionconstraint.Constraint
+	if in.VersionConstraint != "" {
+		constraint, err = versionconstraint.Parse(eco.AsProto(), in.VersionConstraint)
+		if err != nil {
+			return nil, util.UserVisibleError(codes.InvalidArgument, "invalid version constraint: %v", err)
+		}
+	}
+
+	// the inventory stores normalized python package names
+	name := in.Package
+	if eco == diff.DepEcosystemPyPI {
+		name = strings.ToLower(name)
+	}
+
+	deps, err := s.store.ListDependencyUsageByPackage(ctx, db.ListDependencyUsageByPackageParams{
+		ProjectID: projectID,
+		Ecosystem: string(eco),
+		Name:      name,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "cannot list dependency usage: %v", err)
+	}
+
+	results := make([]*pb.DependencyUsage, 0, len(deps))
+	for _, d := range deps {
+		if constraint != nil && !constraint.Check(d.Version) {
+			continue
+		}
+
+		results = append(results, &pb.DependencyUsage{
+			RepositoryId: d.RepositoryID.String(),
+			Owner:        d.RepoOwner,
+			Name:         d.RepoName,
+			Provider:     d.Provider,
+			Dependency: &pb.Dependency{
+				Ecosystem: eco.AsProto(),
+				Name:      d.Name,
+				Version:   d.Version,
+			},
+			File:              d.File,
+			PullRequestNumber: nullInt64Ptr(d.PrNumber),
+			UpdatedAt:         timestamppb.New(d.UpdatedAt),
+		})
+	}
+
+	return &pb.ListDependencyUsageResponse{Results: results}, nil
+}
+
+func nullInt64Ptr(n sql.NullInt64) *int64 {
+	if !n.Valid {
+		return nil
+	}
+	return &n.Int64
+}
@@ -0,0 +1,130 @@
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controlplane
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	mockdb "github.com/stacklok/minder/database/mock"
+	"github.com/stacklok/minder/internal/auth"
+	"github.com/stacklok/minder/internal/db"
+	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+func TestListDependencyUsage(t *testing.T) {
+	t.Parallel()
+
+	projectID := uuid.New()
+	repoID := uuid.New()
+
+	rows := []db.ListDependencyUsageByPackageRow{
+		{RepositoryID: repoID, Ecosystem: "npm", Name: "lodash", Version: "4.17.20", File: "package-lock.json",
+			RepoOwner: "stacklok", RepoName: "frontend", Provider: "github"},
+		{RepositoryID: repoID, Ecosystem: "npm", Name: "lodash", Version: "4.17.21", File: "package-lock.json",
+			RepoOwner: "stacklok", RepoName: "frontend", Provider: "github",
+			PrNumber: sql.NullInt64{Int64: 42, Valid: true}},
+	}
+
+	tests := []struct {
+		name          string
+		req           *pb.ListDependencyUsageRequest
+		expectQuery   bool
+		expectCode    codes.Code
+		expectVersion []string
+	}{
+		{
+			name:          "all versions",
+			req:           &pb.ListDependencyUsageRequest{Ecosystem: "npm", Package: "lodash"},
+			expectQuery:   true,
+			expectVersion: []string{"4.17.20", "4.17.21"},
+		},
+		{
+			name:          "version range",
+			req:           &pb.ListDependencyUsageRequest{Ecosystem: "NPM", Package: "lodash", VersionConstraint: "< 4.17.21"},
+			expectQuery:   true,
+			expectVersion: []string{"4.17.20"},
+		},
+		{
+			name:          "npm range syntax",
+			req:           &pb.ListDependencyUsageRequest{Ecosystem: "npm", Package: "lodash", VersionConstraint: "^4.17.21"},
+			expectQuery:   true,
+			expectVersion: []string{"4.17.21"},
+		},
+		{
+			name:       "unknown ecosystem",
+			req:        &pb.ListDependencyUsageRequest{Ecosystem: "cargo", Package: "serde"},
+			expectCode: codes.InvalidArgument,
+		},
+		{
+			name:       "invalid constraint",
+			req:        &pb.ListDependencyUsageRequest{Ecosystem: "npm", Package: "lodash", VersionConstraint: "not a range"},
+			expectCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := mockdb.NewMockStore(ctrl)
+			if tt.expectQuery {
+				mockStore.EXPECT().
+					ListDependencyUsageByPackage(gomock.Any(), db.ListDependencyUsageByPackageParams{
+						ProjectID: projectID,
+						Ecosystem: "npm",
+						Name:      "lodash",
+					}).
+					Return(rows, nil)
+			}
+
+			ctx := auth.WithPermissionsContext(context.Background(), auth.UserPermissions{
+				UserId:     1,
+				ProjectIds: []uuid.UUID{projectID},
+				IsStaff:    true,
+			})
+
+			server := &Server{store: mockStore}
+			tt.req.ProjectId = projectID.String()
+			resp, err := server.ListDependencyUsage(ctx, tt.req)
+			if tt.expectCode != codes.OK {
+				require.Error(t, err)
+				assert.Equal(t, tt.expectCode, status.Code(err))
+				return
+			}
+			require.NoError(t, err)
+
+			versions := make([]string, 0, len(resp.Results))
+			for _, r := range resp.Results {
+				assert.Equal(t, "stacklok", r.Owner)
+				assert.Equal(t, pb.DepEcosystem_DEP_ECOSYSTEM_NPM, r.Dependency.Ecosystem)
+				versions = append(versions, r.Dependency.Version)
+			}
+			assert.Equal(t, tt.expectVersion, versions)
+		})
+	}
+}
@@ -34,7 +34,6 @@ import (
 	"sort"
 	"strconv"
 	"strings"
-	"time"
 
 	"github.com/ThreeDotsLabs/watermill/message"
 	"github.com/google/go-github/v53/github"
@@ -51,6 +50,7 @@ import (
 	"github.com/stacklok/minder/internal/events"
 	"github.com/stacklok/minder/internal/providers"
 	githubprovider "github.com/stacklok/minder/internal/providers/github"
+	"github.com/stacklok/minder/internal/reconcilers"
 	"github.com/stacklok/minder/internal/util"
 	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
 	provifv1 "github.com/stacklok/minder/pkg/providers/v1"
@@ -124,6 +124,8 @@ func entityFromWebhookEventTypeKey(m *message.Message) pb.Entity {
 		return pb.Entity_ENTITY_ARTIFACTS
 	case key == "pull_request":
 		return pb.Entity_ENTITY_PULL_REQUESTS
+	case key == "deployment":
+		return pb.Entity_ENTITY_BUILD_ENVIRONMENTS
 	case slices.Contains(repoEvents, key):
 		return pb.Entity_ENTITY_REPOSITORIES
 	}
@@ -352,6 +354,7 @@ func (s *Server) registerWebhookForRepository(
 	regResult.Repository.HookUuid = urlUUID
 	regResult.Repository.IsPrivate = repoGet.GetPrivate()
 	regResult.Repository.IsFork = repoGet.GetFork()
+	regResult.Repository.DefaultBranch = repoGet.GetDefaultBranch()
 
 	return regResult, nil
 }
@@ -411,6 +414,8 @@ func (s *Server) parseGithubEventForProcessing(
 			ctx, payload, msg, dbRepo, s.store, provBuilder)
 	} else if ent == pb.Entity_ENTITY_REPOSITORIES {
 		return parseRepoEvent(msg, dbRepo, provBuilder.GetName())
+	} else if ent == pb.Entity_ENTITY_BUILD_ENVIRONMENTS {
+		return parseDeploymentEvent(ctx, payload, msg, action, dbRepo, s.store, provBuilder)
 	}
 
 	return newErrNotHandled("event %s with action %s not handled",
@@ -424,14 +429,15 @@ func parseRepoEvent(
 ) error {
 	// protobufs are our API, so we always execute on these instead of the DB directly.
 	repo := &pb.Repository{
-		Owner:     dbrepo.RepoOwner,
-		Name:      dbrepo.RepoName,
-		RepoId:    dbrepo.RepoID,
-		HookUrl:   dbrepo.WebhookUrl,
-		DeployUrl: dbrepo.DeployUrl,
-		CloneUrl:  dbrepo.CloneUrl,
-		CreatedAt: timestamppb.New(dbrepo.CreatedAt),
-		UpdatedAt: timestamppb.New(dbrepo.UpdatedAt),
+		Owner:         dbrepo.RepoOwner,
+		Name:          dbrepo.RepoName,
+		RepoId:        dbrepo.RepoID,
+		HookUrl:       dbrepo.WebhookUrl,
+		DeployUrl:     dbrepo.DeployUrl,
+		CloneUrl:      dbrepo.CloneUrl,
+		DefaultBranch: dbrepo.DefaultBranch,
+		CreatedAt:     timestamppb.New(dbrepo.CreatedAt),
+		UpdatedAt:     timestamppb.New(dbrepo.UpdatedAt),
 	}
 
 	eiw := engine.NewEntityInfoWrapper().
@@ -468,7 +474,18 @@ func (s *Server) parseArtifactPublishedEvent(
 		return err
 	}
 
-	tempArtifact, err := gatherArtifact(ctx, cli, s.store, whPayload)
+	trust, err := prov.GetTrustRoot(ctx)
+	if err != nil {
+		return fmt.Errorf("error getting sigstore trust root: %w", err)
+	}
+
+	keys, err := s.store.ListSigningKeysByProjectID(ctx, dbrepo.ProjectID)
+	if err != nil {
+		return fmt.Errorf("error listing signing keys: %w", err)
+	}
+	trust = trust.WithSigningKeys(keys)
+
+	tempArtifact, err := gatherArtifact(ctx, cli, trust, s.store, whPayload)
 	if err != nil {
 		return fmt.Errorf("error gathering versioned artifact: %w", err)
 	}
@@ -542,6 +559,75 @@ func parsePullRequestModEvent(
 	return eiw.ToMessage(msg)
 }
 
+// parseDeploymentEvent evaluates the build environment of a deployment, as read from
+// the provider at the time of the event. Environments that were deleted are removed.
+func parseDeploymentEvent(
+	ctx context.Context,
+	whPayload map[string]any,
+	msg *message.Message,
+	action string,
+	dbrepo db.Repository,
+	store db.Store,
+	prov *providers.ProviderBuilder,
+) error {
+	// NOTE: this webhook is very specific to github
+	if !prov.Implements(db.ProviderTypeGithub) {
+		log.Printf("provider %s is not supported for github webhook", prov.GetName())
+		return nil
+	}
+
+	envName, err := util.JQReadFrom[string](ctx, ".deployment.environment", whPayload)
+	if err != nil {
+		return fmt.Errorf("error getting environment from payload: %w", err)
+	}
+
+	if action == "deleted" {
+		return deleteBuildEnvironment(ctx, store, dbrepo, envName)
+	}
+
+	cli, err := prov.GetGitHub(ctx)
+	if err != nil {
+		log.Printf("error creating github provider: %v", err)
+		return err
+	}
+
+	env, err := cli.GetEnvironment(ctx, dbrepo.RepoOwner, dbrepo.RepoName, envName)
+	if errors.Is(err, githubprovider.ErrNotFound) {
+		// deployments don't need an environment to be configured for them, but
+		// if we stored one under that name it was deleted since
+		return deleteBuildEnvironment(ctx, store, dbrepo, envName)
+	} else if err != nil {
+		return fmt.Errorf("error getting environment %s of deployment: %w", envName, err)
+	}
+
+	dbEnv, pbEnv, err := reconcilers.UpsertBuildEnvironment(ctx, store, cli, dbrepo, env)
+	if err != nil {
+		return fmt.Errorf("error upserting build environment: %w", err)
+	}
+
+	eiw := engine.NewEntityInfoWrapper().
+		WithBuildEnvironment(pbEnv).
+		WithBuildEnvironmentID(dbEnv.ID).
+		WithProvider(prov.GetName()).
+		WithProjectID(dbrepo.ProjectID).
+		WithRepositoryID(dbrepo.ID)
+
+	return eiw.ToMessage(msg)
+}
+
+// deleteBuildEnvironment removes a build environment that no longer exists in the provider
+func deleteBuildEnvironment(ctx context.Context, store db.Store, dbrepo db.Repository, envName string) error {
+	err := store.DeleteBuildEnvironmentByName(ctx, db.DeleteBuildEnvironmentByNameParams{
+		RepositoryID: dbrepo.ID,
+		Name:         envName,
+	})
+	if err != nil {
+		return fmt.Errorf("error deleting build environment %s: %w", envName, err)
+	}
+
+	return newErrNotHandled("build environment %s no longer exists", envName)
+}
+
 func extractArtifactFromPayload(ctx context.Context, payload map[string]any) (*pb.Artifact, error) {
 	artifactName, err := util.JQReadFrom[string](ctx, ".package.name", payload)
 	if err != nil {
@@ -627,8 +713,8 @@ func transformTag(tag string) string {
 		tag = prefixReplacement + tag[len(prefixToMatch):]
 	}
 
-	// If the tag has a trailing ".sig", strip it off
-	return strings.TrimSuffix(tag, ".sig")
+	// If the tag has a trailing ".sig" or ".att", strip it off
+	return strings.TrimSuffix(strings.TrimSuffix(tag, ".sig"), ".att")
 }
 
 // handles the case when we get a notification about an image,
@@ -658,6 +744,7 @@ func lookUpVersionBySignature(
 func gatherArtifactVersionInfo(
 	ctx context.Context,
 	cli provifv1.GitHub,
+	trust *container.TrustRoot,
 	payload map[string]any,
 	artifactOwnerLogin, artifactName string,
 ) (*pb.ArtifactVersion, error) {
@@ -668,7 +755,7 @@ func gatherArtifactVersionInfo(
 
 	// not all information is in the payload, we need to get it from the container registry
 	// and/or GH API
-	err = updateArtifactVersionFromRegistry(ctx, cli, payload, artifactOwnerLogin, artifactName, version)
+	err = updateArtifactVersionFromRegistry(ctx, cli, trust, payload, artifactOwnerLogin, artifactName, version)
 	if err != nil {
 		return nil, fmt.Errorf("error getting upstream information for artifact version: %w", err)
 	}
@@ -679,6 +766,7 @@ func gatherArtifactVersionInfo(
 func gatherArtifact(
 	ctx context.Context,
 	cli provifv1.GitHub,
+	trust *container.TrustRoot,
 	store db.Store,
 	payload map[string]any,
 ) (*pb.Artifact, error) {
@@ -688,7 +776,7 @@ func gatherArtifact(
 	}
 
 	var tagIsSigErr *tagIsASignatureError
-	version, err := gatherArtifactVersionInfo(ctx, cli, payload, artifact.Owner, artifact.Name)
+	version, err := gatherArtifactVersionInfo(ctx, cli, trust, payload, artifact.Owner, artifact.Name)
 	if errors.As(err, &tagIsSigErr) {
 		storedVersion, lookupErr := lookUpVersionBySignature(ctx, store, tagIsSigErr.signatureTag)
 		if lookupErr != nil {
@@ -701,7 +789,7 @@ func gatherArtifact(
 		// let's continue with the stored version
 		// now get information for signature and workflow
 		err = storeSignatureAndWorkflowInVersion(
-			ctx, cli, artifact.Owner, artifact.Name, transformTag(tagIsSigErr.signatureTag), storedVersion)
+			ctx, cli, trust, artifact.Owner, artifact.Name, transformTag(tagIsSigErr.signatureTag), storedVersion)
 		if err != nil {
 			return nil, fmt.Errorf("error storing signature and workflow in version: %w", err)
 		}
@@ -714,15 +802,18 @@ func gatherArtifact(
 	return artifact, nil
 }
 
+// storeSignatureAndWorkflowInVersion fills in the signature verification, the workflow
+// and the build provenance of a version from the container registry
 func storeSignatureAndWorkflowInVersion(
 	ctx context.Context,
 	client provifv1.GitHub,
+	trust *container.TrustRoot,
 	artifactOwnerLogin, artifactName, packageVersionName string,
 	version *pb.ArtifactVersion,
 ) error {
 	// now get information for signature and workflow
 	sigInfo, workflowInfo, err := container.GetArtifactSignatureAndWorkflowInfo(
-		ctx, client, artifactOwnerLogin, artifactName, packageVersionName)
+		ctx, client, trust, artifactOwnerLogin, artifactName, packageVersionName)
 	if err != nil {
 		return fmt.Errorf("error getting signature and workflow info: %w", err)
 	}
@@ -737,14 +828,27 @@ func storeSignatureAndWorkflowInVersion(
 		return err
 	}
 
+	// the build provenance records the builder and source beyond the workflow of the signature
+	provenance := &pb.Provenance{}
+	provenanceInfo, err := container.GetArtifactProvenance(
+		ctx, client, trust, artifactOwnerLogin, artifactName, packageVersionName)
+	if err != nil {
+		// just log error, the version is stored without provenance
+		log.Printf("error getting provenance: %v", err)
+	} else if err := protojson.Unmarshal(provenanceInfo, provenance); err != nil {
+		return err
+	}
+
 	version.SignatureVerification = sigVerification
 	version.GithubWorkflow = ghWorkflow
+	version.Provenance = provenance
 	return nil
 }
 
 func updateArtifactVersionFromRegistry(
 	ctx context.Context,
 	client provifv1.GitHub,
+	trust *container.TrustRoot,
 	payload map[string]any,
 	artifactOwnerLogin, artifactName string,
 	version *pb.ArtifactVersion,
@@ -767,11 +871,15 @@ func updateArtifactVersionFromRegistry(
 		// handle the case where a signature arrives later than the image
 		return newTagIsASignatureError("version is a signature", container.FindSignatureTag(tags))
 	}
+	if container.TagsContainAttestation(tags) {
+		// attestations, e.g. the provenance, may also arrive later than the image
+		return newTagIsASignatureError("version is an attestation", container.FindAttestationTag(tags))
+	}
 	sort.Strings(tags)
 
 	// now get information for signature and workflow
 	err = storeSignatureAndWorkflowInVersion(
-		ctx, client, artifactOwnerLogin, artifactName, packageVersionName, version)
+		ctx, client, trust, artifactOwnerLogin, artifactName, packageVersionName, version)
 	if err != nil {
 		return fmt.Errorf("error storing signature and workflow in version: %w", err)
 	}
@@ -801,6 +909,11 @@ func upsertVersionedArtifact(
 		return nil, nil, fmt.Errorf("error marshalling workflow info: %w", err)
 	}
 
+	provenanceInfo, err := protojson.Marshal(newArtifactVersion.Provenance)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error marshalling provenance: %w", err)
+	}
+
 	tx, err := store.BeginTransaction()
 	if err != nil {
 		return nil, nil, fmt.Errorf("error starting transaction: %w", err)
@@ -814,19 +927,12 @@ func upsertVersionedArtifact(
 		ArtifactName:       artifact.GetName(),
 		ArtifactType:       artifact.GetType(),
 		ArtifactVisibility: artifact.Visibility,
+		Provider:           dbrepo.Provider,
 	})
 	if err != nil {
 		return nil, nil, fmt.Errorf("error upserting artifact: %w", err)
 	}
 
-	thirtyDaysAgo := time.Now().AddDate(0, 0, -30)
-	err = qtx.DeleteOldArtifactVersions(ctx,
-		db.DeleteOldArtifactVersionsParams{ArtifactID: dbArtifact.ID, CreatedAt: thirtyDaysAgo})
-	if err != nil {
-		// just log error, we will not remove older for now
-		log.Printf("error removing older artifact versions: %v", err)
-	}
-
 	// To avoid conflicts, we search for all existing entries that have the incoming tag in their Tags field.
 	// If found, the existing artifact is updated by removing the incoming tag from its tags column.
 	// Loop through all incoming tags
@@ -861,6 +967,7 @@ func upsertVersionedArtifact(
 				CreatedAt:             existing.CreatedAt,
 				SignatureVerification: existing.SignatureVerification.RawMessage,
 				GithubWorkflow:        existing.GithubWorkflow.RawMessage,
+				Provenance:            existing.Provenance.RawMessage,
 			})
 			if err != nil {
 				return nil, nil, fmt.Errorf("error upserting artifact %s with version %d: %w", existing.ArtifactID, existing.Version, err)
@@ -880,11 +987,21 @@ func upsertVersionedArtifact(
 		CreatedAt:             newArtifactVersion.CreatedAt.AsTime(),
 		SignatureVerification: sigInfo,
 		GithubWorkflow:        workflowInfo,
+		Provenance:            provenanceInfo,
 	})
 	if err != nil {
 		return nil, nil, fmt.Errorf("error upserting artifact version: %w", err)
 	}
 
+	// remove the versions the retention policy of the project doesn't keep
+	retention, err := reconcilers.GetArtifactRetention(ctx, qtx, dbrepo.ProjectID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if _, err := reconcilers.PruneArtifactVersions(ctx, qtx, dbArtifact.ID, retention, false); err != nil {
+		return nil, nil, fmt.Errorf("error removing older artifact versions: %w", err)
+	}
+
 	err = tx.Commit()
 	if err != nil {
 		return nil, nil, fmt.Errorf("error committing transaction: %w", err)
@@ -1024,6 +1141,17 @@ func getRepoInformationFromPayload(
 		}
 	}
 
+	// keep the default branch up to date, it changes when the repository is edited
+	if branch, ok := repoInfo["default_branch"].(string); ok && branch != "" && branch != dbrepo.DefaultBranch {
+		if err := store.UpdateRepositoryDefaultBranch(ctx, db.UpdateRepositoryDefaultBranchParams{
+			ID:            dbrepo.ID,
+			DefaultBranch: branch,
+		}); err != nil {
+			return db.Repository{}, fmt.Errorf("error updating default branch: %w", err)
+		}
+		dbrepo.DefaultBranch = branch
+	}
+
 	log.Printf("handling event for repository %d", id)
 
 	return dbrepo, nil
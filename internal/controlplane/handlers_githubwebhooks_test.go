@@ -31,6 +31,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ThreeDotsLabs/watermill/message"
 	"github.com/golang/mock/gomock"
 	"github.com/google/go-github/v53/github"
 	"github.com/google/uuid"
@@ -44,6 +45,7 @@ import (
 	"github.com/stacklok/minder/internal/crypto"
 	"github.com/stacklok/minder/internal/db"
 	"github.com/stacklok/minder/internal/engine"
+	"github.com/stacklok/minder/internal/providers"
 	"github.com/stacklok/minder/internal/util/rand"
 	"github.com/stacklok/minder/internal/util/testqueue"
 )
@@ -393,3 +395,43 @@ func TestAll(t *testing.T) {
 	RunUnitTestSuite(t)
 	// Call other test runner functions for additional test suites
 }
+
+func TestParseDeploymentEventDeleted(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	dbrepo := db.Repository{
+		ID:        uuid.New(),
+		ProjectID: uuid.New(),
+		RepoOwner: "stacklok",
+		RepoName:  "minder",
+	}
+	mockStore := mockdb.NewMockStore(ctrl)
+	mockStore.EXPECT().
+		DeleteBuildEnvironmentByName(gomock.Any(), db.DeleteBuildEnvironmentByNameParams{
+			RepositoryID: dbrepo.ID,
+			Name:         "production",
+		}).
+		Return(nil)
+
+	prov := providers.NewProviderBuilder(
+		&db.Provider{
+			Name:       "github",
+			Implements: []db.ProviderType{db.ProviderTypeGithub},
+		},
+		db.ProviderAccessToken{},
+		"token",
+	)
+	payload := map[string]any{
+		"action": "deleted",
+		"deployment": map[string]any{
+			"environment": "production",
+		},
+	}
+
+	err := parseDeploymentEvent(context.Background(), payload, message.NewMessage("id", nil),
+		"deleted", dbrepo, mockStore, prov)
+	require.ErrorIs(t, err, errNotHandled, "a deleted environment must not be evaluated")
+}
@@ -31,6 +31,8 @@ import (
 	"github.com/stacklok/minder/internal/auth"
 	"github.com/stacklok/minder/internal/db"
 	"github.com/stacklok/minder/internal/engine"
+	"github.com/stacklok/minder/internal/engine/actions/remediate"
+	"github.com/stacklok/minder/internal/engine/actions/remediate/pull_request"
 	"github.com/stacklok/minder/internal/entities"
 	"github.com/stacklok/minder/internal/reconcilers"
 	"github.com/stacklok/minder/internal/util"
@@ -116,6 +118,59 @@ func validateActionType(r string) db.NullActionType {
 	return db.NullActionType{Valid: false}
 }
 
+// validateRemediateType returns the appropriate remediate type, which unlike the
+// alert type can require approval, or the NULL DB type if the input is invalid.
+func validateRemediateType(r string) db.NullActionType {
+	if r == "approval_required" {
+		return db.NullActionType{ActionType: db.ActionTypeApprovalRequired, Valid: true}
+	}
+
+	return validateActionType(r)
+}
+
+// validateRuleActionOverrides validates the remediate and alert settings that the rules
+// of a profile override. Unlike the settings of the profile, which are ignored when
+// invalid, an invalid rule setting is rejected.
+func validateRuleActionOverrides(in *minderv1.Profile) error {
+	for _, rules := range [][]*minderv1.Profile_Rule{
+		in.GetRepository(), in.GetBuildEnvironment(), in.GetArtifact(), in.GetPullRequest(),
+	} {
+		for _, rule := range rules {
+			if rule.Remediate != nil && !validateRemediateType(rule.GetRemediate()).Valid {
+				return util.UserVisibleError(codes.InvalidArgument,
+					"invalid remediate setting %q for rule %s", rule.GetRemediate(), rule.GetType())
+			}
+			if rule.Alert != nil && !validateActionType(rule.GetAlert()).Valid {
+				return util.UserVisibleError(codes.InvalidArgument,
+					"invalid alert setting %q for rule %s", rule.GetAlert(), rule.GetType())
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateRemediationApproval rejects requiring approval for a rule whose remediation opens a
+// pull request, which is reviewed before being merged instead. The rule can override the
+// remediate setting of a profile that requires approval.
+func validateRemediationApproval(prof *minderv1.Profile, rule *minderv1.Profile_Rule, ruleType *minderv1.RuleType) error {
+	if ruleType.GetDef().GetRemediate().GetType() != pull_request.RemediateType {
+		return nil
+	}
+
+	setting := prof.GetRemediate()
+	if rule.Remediate != nil {
+		setting = rule.GetRemediate()
+	}
+	if validateRemediateType(setting).ActionType == db.ActionTypeApprovalRequired {
+		return &engine.RuleValidationError{
+			Err:      "pull request remediations can't require approval, set the remediate setting of the rule",
+			RuleType: rule.GetType(),
+		}
+	}
+	return nil
+}
+
 // CreateProfile creates a profile for a group
 func (s *Server) CreateProfile(ctx context.Context,
 	cpr *minderv1.CreateProfileRequest) (*minderv1.CreateProfileResponse, error) {
@@ -140,6 +195,10 @@ func (s *Server) CreateProfile(ctx context.Context,
 		return nil, status.Errorf(codes.InvalidArgument, "invalid profile: %v", err)
 	}
 
+	if err := validateRuleActionOverrides(in); err != nil {
+		return nil, err
+	}
+
 	rulesInProf, err := s.getAndValidateRulesFromProfile(ctx, in, entityCtx)
 	if err != nil {
 		var violation *engine.RuleValidationError
@@ -167,7 +226,7 @@ func (s *Server) CreateProfile(ctx context.Context,
 		Provider:  provider.Name,
 		ProjectID: entityCtx.GetProject().GetID(),
 		Name:      in.GetName(),
-		Remediate: validateActionType(in.GetRemediate()),
+		Remediate: validateRemediateType(in.GetRemediate()),
 		Alert:     validateActionType(in.GetAlert()),
 	}
 
@@ -398,6 +457,16 @@ func getRuleEvalEntityInfo(
 		entityInfo["repository_id"] = rs.RepositoryID.UUID.String()
 	}
 
+	if rs.BuildEnvironmentID.Valid {
+		entityInfo["build_environment_id"] = rs.BuildEnvironmentID.UUID.String()
+		env, err := store.GetBuildEnvironmentByID(ctx, rs.BuildEnvironmentID.UUID)
+		if err != nil {
+			log.Printf("error getting build environment: %v", err)
+		} else {
+			entityInfo["build_environment_name"] = env.Name
+		}
+	}
+
 	if !selector.Valid || !entityType.Valid {
 		return entityInfo
 	}
@@ -516,6 +585,12 @@ func (s *Server) GetProfileStatusByName(ctx context.Context,
 				st.RemediationLastUpdated = timestamppb.New(rs.RemLastUpdated.Time)
 			}
 
+			remState := remediate.StateFromMetadata(rs.RemMetadata)
+			st.RemediationAttempts = int32(remState.Attempts)
+			if remState.NextAttempt != nil {
+				st.RemediationNextAttempt = timestamppb.New(*remState.NextAttempt)
+			}
+
 			rulestats = append(rulestats, st)
 		}
 
@@ -585,6 +660,10 @@ func (s *Server) UpdateProfile(ctx context.Context,
 		return nil, status.Errorf(codes.InvalidArgument, "invalid profile: %v", err)
 	}
 
+	if err := validateRuleActionOverrides(in); err != nil {
+		return nil, err
+	}
+
 	tx, err := s.store.BeginTransaction()
 	if err != nil {
 		log.Printf("error starting transaction: %v", err)
@@ -642,7 +721,7 @@ func (s *Server) UpdateProfile(ctx context.Context,
 	// Update top-level profile db object
 	profile, err := qtx.UpdateProfile(ctx, db.UpdateProfileParams{
 		ID:        oldDBProfile.ID,
-		Remediate: validateActionType(in.GetRemediate()),
+		Remediate: validateRemediateType(in.GetRemediate()),
 		Alert:     validateActionType(in.GetAlert()),
 	})
 	if err != nil {
@@ -729,6 +808,10 @@ func (s *Server) getAndValidateRulesFromProfile(
 			return fmt.Errorf("cannot convert rule type %s to pb: %w", rtdb.Name, err)
 		}
 
+		if err := validateRemediationApproval(prof, r, rtyppb); err != nil {
+			return err
+		}
+
 		rval, err := engine.NewRuleValidator(rtyppb)
 		if err != nil {
 			return fmt.Errorf("error creating rule validator: %w", err)
@@ -0,0 +1,145 @@
+// Copyright 2023 Stacklok, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controlplane
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/stacklok/minder/internal/engine"
+	"github.com/stacklok/minder/internal/engine/actions/remediate/pull_request"
+	"github.com/stacklok/minder/internal/engine/actions/remediate/rest"
+	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+func TestValidateRuleActionOverrides(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name    string
+		rule    *pb.Profile_Rule
+		wantErr bool
+	}{
+		{
+			name: "no overrides",
+			rule: &pb.Profile_Rule{Type: "secret_scanning"},
+		},
+		{
+			name: "valid overrides",
+			rule: &pb.Profile_Rule{Type: "secret_scanning", Remediate: proto.String("on"), Alert: proto.String("off")},
+		},
+		{
+			name: "remediation can require approval",
+			rule: &pb.Profile_Rule{Type: "secret_scanning", Remediate: proto.String("approval_required")},
+		},
+		{
+			name:    "alerts can't require approval",
+			rule:    &pb.Profile_Rule{Type: "secret_scanning", Alert: proto.String("approval_required")},
+			wantErr: true,
+		},
+		{
+			name:    "invalid remediate setting",
+			rule:    &pb.Profile_Rule{Type: "secret_scanning", Remediate: proto.String("always")},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := validateRuleActionOverrides(&pb.Profile{
+				Name:             "acme",
+				BuildEnvironment: []*pb.Profile_Rule{tc.rule},
+			})
+			if !tc.wantErr {
+				assert.NoError(t, err)
+				return
+			}
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		})
+	}
+}
+
+func TestValidateRemediationApproval(t *testing.T) {
+	t.Parallel()
+
+	pullRequestRuleType := &pb.RuleType{
+		Name: "dependabot_configured",
+		Def: &pb.RuleType_Definition{
+			Remediate: &pb.RuleType_Definition_Remediate{Type: pull_request.RemediateType},
+		},
+	}
+	restRuleType := &pb.RuleType{
+		Name: "secret_scanning",
+		Def: &pb.RuleType_Definition{
+			Remediate: &pb.RuleType_Definition_Remediate{Type: rest.RemediateType},
+		},
+	}
+
+	testCases := []struct {
+		name      string
+		remediate string
+		rule      *pb.Profile_Rule
+		ruleType  *pb.RuleType
+		wantErr   bool
+	}{
+		{
+			name:      "pull request remediation of a profile requiring approval",
+			remediate: "approval_required",
+			rule:      &pb.Profile_Rule{Type: "dependabot_configured"},
+			ruleType:  pullRequestRuleType,
+			wantErr:   true,
+		},
+		{
+			name:     "pull request remediation of a rule requiring approval",
+			rule:     &pb.Profile_Rule{Type: "dependabot_configured", Remediate: proto.String("approval_required")},
+			ruleType: pullRequestRuleType,
+			wantErr:  true,
+		},
+		{
+			name:      "rule overriding the approval of its profile",
+			remediate: "approval_required",
+			rule:      &pb.Profile_Rule{Type: "dependabot_configured", Remediate: proto.String("on")},
+			ruleType:  pullRequestRuleType,
+		},
+		{
+			name:      "rest remediation requiring approval",
+			remediate: "approval_required",
+			rule:      &pb.Profile_Rule{Type: "secret_scanning"},
+			ruleType:  restRuleType,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := validateRemediationApproval(&pb.Profile{Name: "acme", Remediate: &tc.remediate}, tc.rule, tc.ruleType)
+			if !tc.wantErr {
+				assert.NoError(t, err)
+				return
+			}
+			var violation *engine.RuleValidationError
+			assert.ErrorAs(t, err, &violation)
+		})
+	}
+}
@@ -0,0 +1,221 @@
+// Copyright 2023 Stacklok, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controlplane
+
+import (
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"encoding/json"
+	"log"
+
+	"golang.org/x/oauth2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/stacklok/minder/internal/db"
+	"github.com/stacklok/minder/internal/providers"
+	"github.com/stacklok/minder/internal/providers/oci"
+	"github.com/stacklok/minder/internal/reconcilers"
+	"github.com/stacklok/minder/internal/util"
+	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+	provifv1 "github.com/stacklok/minder/pkg/providers/v1"
+)
+
+// CreateProvider creates a provider that is configured through the API rather
+// than enrolled through OAuth. Currently only OCI registries are supported.
+func (s *Server) CreateProvider(ctx context.Context,
+	in *pb.CreateProviderRequest) (*pb.CreateProviderResponse, error) {
+	projectID, err := getProjectFromRequestOrDefault(ctx, in)
+	if err != nil {
+		return nil, util.UserVisibleError(codes.InvalidArgument, err.Error())
+	}
+
+	// check if user is authorized
+	if err := AuthorizedOnProject(ctx, projectID); err != nil {
+		return nil, err
+	}
+
+	prov := in.GetProvider()
+	if prov.GetName() == "" {
+		return nil, util.UserVisibleError(codes.InvalidArgument, "provider name is required")
+	}
+
+	for _, impl := range prov.GetImplements() {
+		if impl != string(db.ProviderTypeOci) {
+			return nil, util.UserVisibleError(codes.InvalidArgument,
+				"unsupported provider type %s, only oci providers can be created", impl)
+		}
+	}
+
+	if prov.GetDef().GetOci() == nil {
+		return nil, util.UserVisibleError(codes.InvalidArgument, "provider definition must contain an oci configuration")
+	}
+
+	rawDefinition := map[string]any{"oci": prov.GetDef().GetOci()}
+	if prov.GetDef().GetSigstore() != nil {
+		rawDefinition["sigstore"] = prov.GetDef().GetSigstore()
+	}
+
+	definition, err := json.Marshal(rawDefinition)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error marshalling provider definition: %v", err)
+	}
+
+	// validate the definition the same way the provider builder will parse it
+	if _, err := oci.ParseV1Config(definition); err != nil {
+		return nil, util.UserVisibleError(codes.InvalidArgument, "invalid provider definition: %s", err)
+	}
+	if _, err := providers.ParseSigstoreTrustConfig(definition); err != nil {
+		return nil, util.UserVisibleError(codes.InvalidArgument, "invalid provider definition: %s", err)
+	}
+
+	jsonToken, err := json.Marshal(&oauth2.Token{AccessToken: in.GetAccessToken()})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error marshalling access token: %v", err)
+	}
+
+	encryptedToken, err := s.cryptoEngine.EncryptOAuthToken(jsonToken)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error encrypting access token: %v", err)
+	}
+
+	tx, err := s.store.BeginTransaction()
+	if err != nil {
+		log.Printf("error starting transaction: %v", err)
+		return nil, status.Errorf(codes.Internal, "error creating provider")
+	}
+	defer s.store.Rollback(tx)
+
+	qtx := s.store.GetQuerierWithTransaction(tx)
+
+	created, err := qtx.CreateProvider(ctx, db.CreateProviderParams{
+		Name:       prov.GetName(),
+		ProjectID:  projectID,
+		Implements: oci.Implements,
+		Definition: definition,
+	})
+	if db.ErrIsUniqueViolation(err) {
+		return nil, util.UserVisibleError(codes.AlreadyExists, "provider %s already exists", prov.GetName())
+	} else if err != nil {
+		log.Printf("error creating provider: %v", err)
+		return nil, status.Errorf(codes.Internal, "error creating provider")
+	}
+
+	// the provider builder expects a token even for anonymous access
+	_, err = qtx.CreateAccessToken(ctx, db.CreateAccessTokenParams{
+		ProjectID:      projectID,
+		Provider:       created.Name,
+		EncryptedToken: base64.StdEncoding.EncodeToString(encryptedToken),
+		OwnerFilter:    sql.NullString{Valid: false},
+	})
+	if err != nil {
+		log.Printf("error storing access token: %v", err)
+		return nil, status.Errorf(codes.Internal, "error creating provider")
+	}
+
+	if err := s.store.Commit(tx); err != nil {
+		log.Printf("error committing transaction: %v", err)
+		return nil, status.Errorf(codes.Internal, "error creating provider")
+	}
+
+	// link the images of the registry to the registered repositories they are built from
+	msg, err := reconcilers.NewOCIArtifactsReconcilerMessage(created.Name, projectID)
+	if err != nil {
+		log.Printf("error creating OCI artifacts reconciler event: %v", err)
+	} else if err := s.evt.Publish(reconcilers.InternalOCIArtifactsReconcilerEventTopic, msg); err != nil {
+		// This is a non-fatal error, the registry is reconciled periodically
+		log.Printf("error publishing OCI artifacts reconciler event: %v", err)
+	}
+
+	implements := make([]string, 0, len(created.Implements))
+	for _, impl := range created.Implements {
+		implements = append(implements, string(impl))
+	}
+
+	return &pb.CreateProviderResponse{
+		Provider: &pb.Provider{
+			Name: created.Name,
+			Context: &pb.Provider_Context{
+				Project: projectID.String(),
+			},
+			Version:    provifv1.V1,
+			Implements: implements,
+			Def: &pb.Provider_Definition{
+				Oci:      prov.GetDef().GetOci(),
+				Sigstore: prov.GetDef().GetSigstore(),
+			},
+		},
+	}, nil
+}
+
+// SetSigstoreTrustConfig stores the sigstore instance the signatures of the artifacts
+// of a provider are verified against in the definition of the provider
+func (s *Server) SetSigstoreTrustConfig(ctx context.Context,
+	in *pb.SetSigstoreTrustConfigRequest) (*pb.SetSigstoreTrustConfigResponse, error) {
+	projectID, err := getProjectFromRequestOrDefault(ctx, in)
+	if err != nil {
+		return nil, util.UserVisibleError(codes.InvalidArgument, err.Error())
+	}
+
+	// check if user is authorized
+	if err := AuthorizedOnProject(ctx, projectID); err != nil {
+		return nil, err
+	}
+
+	cfg := in.GetConfig()
+	if cfg != nil && proto.Equal(cfg, &pb.SigstoreTrustConfig{}) {
+		cfg = nil
+	}
+	if cfg != nil {
+		if err := cfg.Validate(); err != nil {
+			return nil, util.UserVisibleError(codes.InvalidArgument, "invalid sigstore configuration: %s", err)
+		}
+	}
+
+	provider, err := s.store.GetProviderByName(ctx, db.GetProviderByNameParams{
+		Name: in.GetProvider(), ProjectID: projectID})
+	if err != nil {
+		return nil, providerError(err)
+	}
+
+	definition := make(map[string]any)
+	if err := json.Unmarshal(provider.Definition, &definition); err != nil {
+		return nil, status.Errorf(codes.Internal, "error parsing provider definition: %v", err)
+	}
+
+	if cfg == nil {
+		delete(definition, "sigstore")
+	} else {
+		definition["sigstore"] = cfg
+	}
+
+	rawDefinition, err := json.Marshal(definition)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error marshalling provider definition: %v", err)
+	}
+
+	_, err = s.store.UpdateProviderDefinition(ctx, db.UpdateProviderDefinitionParams{
+		ID:         provider.ID,
+		ProjectID:  projectID,
+		Definition: rawDefinition,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error updating provider: %v", err)
+	}
+
+	return &pb.SetSigstoreTrustConfigResponse{}, nil
+}
@@ -0,0 +1,281 @@
+// Copyright 2023 Stacklok, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controlplane
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	mockdb "github.com/stacklok/minder/database/mock"
+	"github.com/stacklok/minder/internal/auth"
+	"github.com/stacklok/minder/internal/db"
+	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+func TestCreateProvider(t *testing.T) {
+	t.Parallel()
+
+	orgID := uuid.New()
+	projectID := uuid.New()
+	token := "registry-token"
+
+	ociProvider := func(def *pb.OCIProviderConfig, implements ...string) *pb.Provider {
+		return &pb.Provider{
+			Name:       "dockerhub",
+			Implements: implements,
+			Def:        &pb.Provider_Definition{Oci: def},
+		}
+	}
+
+	testCases := []struct {
+		name         string
+		req          *pb.CreateProviderRequest
+		buildStubs   func(store *mockdb.MockStore)
+		expectedCode codes.Code
+	}{
+		{
+			name: "creates oci provider",
+			req: &pb.CreateProviderRequest{
+				Provider: ociProvider(&pb.OCIProviderConfig{
+					RegistryUrl: "https://index.docker.io",
+					Username:    "stacklok",
+					Namespace:   "stacklok",
+				}, "oci"),
+				AccessToken: &token,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().BeginTransaction().Return(&sql.Tx{}, nil)
+				store.EXPECT().GetQuerierWithTransaction(gomock.Any()).Return(store)
+				store.EXPECT().
+					CreateProvider(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, arg db.CreateProviderParams) (db.Provider, error) {
+						assert.Equal(t, "dockerhub", arg.Name)
+						assert.Equal(t, projectID, arg.ProjectID)
+						assert.Equal(t, []db.ProviderType{db.ProviderTypeOci}, arg.Implements)
+						assert.JSONEq(t,
+							`{"oci": {"registry_url": "https://index.docker.io", "username": "stacklok", "namespace": "stacklok"}}`,
+							string(arg.Definition))
+						return db.Provider{
+							Name:       arg.Name,
+							ProjectID:  arg.ProjectID,
+							Implements: arg.Implements,
+							Definition: arg.Definition,
+						}, nil
+					})
+				store.EXPECT().
+					CreateAccessToken(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, arg db.CreateAccessTokenParams) (db.ProviderAccessToken, error) {
+						assert.Equal(t, "dockerhub", arg.Provider)
+						assert.NotEmpty(t, arg.EncryptedToken)
+						assert.NotContains(t, arg.EncryptedToken, token)
+						return db.ProviderAccessToken{}, nil
+					})
+				store.EXPECT().Commit(gomock.Any())
+				store.EXPECT().Rollback(gomock.Any())
+			},
+			expectedCode: codes.OK,
+		},
+		{
+			name: "provider already exists",
+			req: &pb.CreateProviderRequest{
+				Provider: ociProvider(&pb.OCIProviderConfig{RegistryUrl: "https://quay.io"}),
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().BeginTransaction().Return(&sql.Tx{}, nil)
+				store.EXPECT().GetQuerierWithTransaction(gomock.Any()).Return(store)
+				store.EXPECT().
+					CreateProvider(gomock.Any(), gomock.Any()).
+					Return(db.Provider{}, &pq.Error{Code: "23505"})
+				store.EXPECT().Rollback(gomock.Any())
+			},
+			expectedCode: codes.AlreadyExists,
+		},
+		{
+			name: "missing registry url",
+			req: &pb.CreateProviderRequest{
+				Provider: ociProvider(&pb.OCIProviderConfig{Namespace: "stacklok"}),
+			},
+			buildStubs:   func(_ *mockdb.MockStore) {},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name: "invalid image repository mapping",
+			req: &pb.CreateProviderRequest{
+				Provider: ociProvider(&pb.OCIProviderConfig{
+					RegistryUrl: "https://quay.io",
+					ImageRepositories: []*pb.OCIImageRepository{
+						{Image: "stacklok/minder-server", Repository: "minder"},
+					},
+				}),
+			},
+			buildStubs:   func(_ *mockdb.MockStore) {},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name: "unsupported provider type",
+			req: &pb.CreateProviderRequest{
+				Provider: ociProvider(&pb.OCIProviderConfig{RegistryUrl: "https://quay.io"}, "github"),
+			},
+			buildStubs:   func(_ *mockdb.MockStore) {},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name: "missing oci definition",
+			req: &pb.CreateProviderRequest{
+				Provider: &pb.Provider{Name: "dockerhub"},
+			},
+			buildStubs:   func(_ *mockdb.MockStore) {},
+			expectedCode: codes.InvalidArgument,
+		},
+	}
+
+	ctx := auth.WithPermissionsContext(context.Background(), auth.UserPermissions{
+		UserId:         1,
+		OrganizationId: orgID,
+		ProjectIds:     []uuid.UUID{projectID},
+		IsStaff:        true, // TODO: remove this
+		Roles: []auth.RoleInfo{
+			{RoleID: 1, IsAdmin: true, ProjectID: &projectID, OrganizationID: orgID}},
+	})
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newDefaultServer(t, store)
+
+			res, err := server.CreateProvider(ctx, tc.req)
+			if tc.expectedCode != codes.OK {
+				require.Error(t, err)
+				assert.Equal(t, tc.expectedCode, status.Code(err))
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, "dockerhub", res.GetProvider().GetName())
+			assert.Equal(t, []string{"oci"}, res.GetProvider().GetImplements())
+			assert.Equal(t, projectID.String(), res.GetProvider().GetContext().GetProject())
+
+			def, err := json.Marshal(res.GetProvider().GetDef().GetOci())
+			require.NoError(t, err)
+			assert.Contains(t, string(def), "index.docker.io")
+		})
+	}
+}
+
+func TestSetSigstoreTrustConfig(t *testing.T) {
+	t.Parallel()
+
+	orgID := uuid.New()
+	projectID := uuid.New()
+	providerID := uuid.New()
+
+	testCases := []struct {
+		name               string
+		config             *pb.SigstoreTrustConfig
+		existing           string
+		expectedDefinition string
+		expectedCode       codes.Code
+	}{
+		{
+			name: "sets config",
+			config: &pb.SigstoreTrustConfig{
+				TufMirror: "https://tuf.example.com",
+				TufRoot:   "{}",
+			},
+			existing:           `{"github": {}}`,
+			expectedDefinition: `{"github": {}, "sigstore": {"tuf_mirror": "https://tuf.example.com", "tuf_root": "{}"}}`,
+		},
+		{
+			name:               "empty config restores public good",
+			config:             &pb.SigstoreTrustConfig{},
+			existing:           `{"github": {}, "sigstore": {"rekor_url": "https://rekor.example.com"}}`,
+			expectedDefinition: `{"github": {}}`,
+		},
+		{
+			name:         "invalid config",
+			config:       &pb.SigstoreTrustConfig{TufMirror: "https://tuf.example.com"},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "private instance without trust roots",
+			config:       &pb.SigstoreTrustConfig{RekorUrl: "https://rekor.example.com"},
+			expectedCode: codes.InvalidArgument,
+		},
+	}
+
+	ctx := auth.WithPermissionsContext(context.Background(), auth.UserPermissions{
+		UserId:         1,
+		OrganizationId: orgID,
+		ProjectIds:     []uuid.UUID{projectID},
+		IsStaff:        true, // TODO: remove this
+		Roles: []auth.RoleInfo{
+			{RoleID: 1, IsAdmin: true, ProjectID: &projectID, OrganizationID: orgID}},
+	})
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			if tc.expectedCode == codes.OK {
+				store.EXPECT().
+					GetProviderByName(gomock.Any(), db.GetProviderByNameParams{Name: "github", ProjectID: projectID}).
+					Return(db.Provider{ID: providerID, Name: "github", Definition: json.RawMessage(tc.existing)}, nil)
+				store.EXPECT().
+					UpdateProviderDefinition(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, arg db.UpdateProviderDefinitionParams) (db.Provider, error) {
+						assert.Equal(t, providerID, arg.ID)
+						assert.JSONEq(t, tc.expectedDefinition, string(arg.Definition))
+						return db.Provider{}, nil
+					})
+			}
+
+			server := newDefaultServer(t, store)
+
+			_, err := server.SetSigstoreTrustConfig(ctx, &pb.SetSigstoreTrustConfigRequest{
+				Provider: "github",
+				Config:   tc.config,
+			})
+			if tc.expectedCode != codes.OK {
+				require.Error(t, err)
+				assert.Equal(t, tc.expectedCode, status.Code(err))
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,287 @@
+// Copyright 2023 Stacklok, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controlplane
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/stacklok/minder/internal/auth"
+	"github.com/stacklok/minder/internal/db"
+	"github.com/stacklok/minder/internal/engine"
+	"github.com/stacklok/minder/internal/engine/actions/remediate/rest"
+	"github.com/stacklok/minder/internal/providers"
+	"github.com/stacklok/minder/internal/util"
+	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+// ListRemediationApprovals lists the requests proposed by the remediations of the
+// profiles of a project that require approval
+func (s *Server) ListRemediationApprovals(
+	ctx context.Context,
+	in *pb.ListRemediationApprovalsRequest,
+) (*pb.ListRemediationApprovalsResponse, error) {
+	ctx, err := s.authAndContextValidation(ctx, in.GetContext())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "error ensuring default group: %v", err)
+	}
+
+	entityCtx := engine.EntityFromContext(ctx)
+
+	statusFilter := db.NullRemediationApprovalStatus{}
+	switch st := db.RemediationApprovalStatus(in.GetStatus()); st {
+	case "":
+	case db.RemediationApprovalStatusPending, db.RemediationApprovalStatusApplying,
+		db.RemediationApprovalStatusApplied, db.RemediationApprovalStatusRejected,
+		db.RemediationApprovalStatusFailed:
+		statusFilter = db.NullRemediationApprovalStatus{RemediationApprovalStatus: st, Valid: true}
+	default:
+		return nil, util.UserVisibleError(codes.InvalidArgument, "invalid approval status: %s", in.GetStatus())
+	}
+
+	approvals, err := s.store.ListRemediationApprovalsByProject(ctx, db.ListRemediationApprovalsByProjectParams{
+		ProjectID: entityCtx.Project.ID,
+		Status:    statusFilter,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list remediation approvals: %s", err)
+	}
+
+	res := &pb.ListRemediationApprovalsResponse{
+		Approvals: make([]*pb.RemediationApproval, 0, len(approvals)),
+	}
+	for _, approval := range approvals {
+		pbApproval := remediationApprovalToPb(db.RemediationApproval{
+			ID:         approval.ID,
+			RuleEvalID: approval.RuleEvalID,
+			Method:     approval.Method,
+			Endpoint:   approval.Endpoint,
+			Body:       approval.Body,
+			Status:     approval.Status,
+			Details:    approval.Details,
+			DecidedBy:  approval.DecidedBy,
+			DecidedAt:  approval.DecidedAt,
+			CreatedAt:  approval.CreatedAt,
+			UpdatedAt:  approval.UpdatedAt,
+		})
+		pbApproval.ProfileName = approval.ProfileName
+		pbApproval.RuleTypeName = approval.RuleTypeName
+		pbApproval.Entity = string(approval.Entity)
+		pbApproval.RepoOwner = approval.RepoOwner
+		pbApproval.RepoName = approval.RepoName
+		res.Approvals = append(res.Approvals, pbApproval)
+	}
+
+	return res, nil
+}
+
+// ApproveRemediation performs the request proposed by a remediation and records the
+// outcome. A request that fails can be proposed and approved again.
+func (s *Server) ApproveRemediation(
+	ctx context.Context,
+	in *pb.ApproveRemediationRequest,
+) (*pb.ApproveRemediationResponse, error) {
+	ctx, err := s.authAndContextValidation(ctx, in.GetContext())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "error ensuring default group: %v", err)
+	}
+
+	approval, err := s.getPendingRemediationApproval(ctx, in.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	decidedBy, err := s.getRemediationDecider(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Claim the approval first, so that its request is only performed once even if
+	// it's approved concurrently
+	_, err = s.updateRemediationApprovalStatus(ctx, db.UpdateRemediationApprovalStatusParams{
+		ID:         approval.ID,
+		FromStatus: db.RemediationApprovalStatusPending,
+		Status:     db.RemediationApprovalStatusApplying,
+		DecidedBy:  decidedBy,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	newStatus := db.RemediationApprovalStatusApplied
+	var details string
+	if err := s.performRemediationApproval(ctx, approval); err != nil {
+		newStatus = db.RemediationApprovalStatusFailed
+		details = err.Error()
+	}
+
+	updated, err := s.updateRemediationApprovalStatus(ctx, db.UpdateRemediationApprovalStatusParams{
+		ID:         approval.ID,
+		FromStatus: db.RemediationApprovalStatusApplying,
+		Status:     newStatus,
+		Details:    details,
+		DecidedBy:  decidedBy,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	pbApproval := remediationApprovalToPb(updated)
+	pbApproval.ProfileName = approval.ProfileName
+	return &pb.ApproveRemediationResponse{Approval: pbApproval}, nil
+}
+
+// RejectRemediation rejects the request proposed by a remediation
+func (s *Server) RejectRemediation(
+	ctx context.Context,
+	in *pb.RejectRemediationRequest,
+) (*pb.RejectRemediationResponse, error) {
+	ctx, err := s.authAndContextValidation(ctx, in.GetContext())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "error ensuring default group: %v", err)
+	}
+
+	approval, err := s.getPendingRemediationApproval(ctx, in.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	decidedBy, err := s.getRemediationDecider(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := s.updateRemediationApprovalStatus(ctx, db.UpdateRemediationApprovalStatusParams{
+		ID:         approval.ID,
+		FromStatus: db.RemediationApprovalStatusPending,
+		Status:     db.RemediationApprovalStatusRejected,
+		DecidedBy:  decidedBy,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	pbApproval := remediationApprovalToPb(updated)
+	pbApproval.ProfileName = approval.ProfileName
+	return &pb.RejectRemediationResponse{Approval: pbApproval}, nil
+}
+
+// getPendingRemediationApproval returns the remediation approval of the project in the context,
+// as long as it wasn't decided on yet
+func (s *Server) getPendingRemediationApproval(
+	ctx context.Context,
+	id string,
+) (db.GetRemediationApprovalByIDRow, error) {
+	approvalID, err := uuid.Parse(id)
+	if err != nil {
+		return db.GetRemediationApprovalByIDRow{}, util.UserVisibleError(codes.InvalidArgument, "invalid approval ID")
+	}
+
+	approval, err := s.store.GetRemediationApprovalByID(ctx, db.GetRemediationApprovalByIDParams{
+		ID:        approvalID,
+		ProjectID: engine.EntityFromContext(ctx).Project.ID,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return db.GetRemediationApprovalByIDRow{}, util.UserVisibleError(codes.NotFound, "remediation approval not found")
+	} else if err != nil {
+		return db.GetRemediationApprovalByIDRow{}, status.Errorf(codes.Internal,
+			"failed to get remediation approval: %s", err)
+	}
+
+	if approval.Status != db.RemediationApprovalStatusPending {
+		return db.GetRemediationApprovalByIDRow{}, util.UserVisibleError(codes.FailedPrecondition,
+			"remediation approval is %s, not pending", approval.Status)
+	}
+
+	return approval, nil
+}
+
+// updateRemediationApprovalStatus moves a remediation approval to a new status, as long as
+// it's still in the status it's expected to be in
+func (s *Server) updateRemediationApprovalStatus(
+	ctx context.Context,
+	params db.UpdateRemediationApprovalStatusParams,
+) (db.RemediationApproval, error) {
+	updated, err := s.store.UpdateRemediationApprovalStatus(ctx, params)
+	if errors.Is(err, sql.ErrNoRows) {
+		return db.RemediationApproval{}, util.UserVisibleError(codes.FailedPrecondition,
+			"remediation approval is no longer %s", params.FromStatus)
+	} else if err != nil {
+		return db.RemediationApproval{}, status.Errorf(codes.Internal,
+			"failed to update remediation approval: %s", err)
+	}
+	return updated, nil
+}
+
+// getRemediationDecider returns the identity of the user approving or rejecting a remediation
+func (s *Server) getRemediationDecider(ctx context.Context) (sql.NullString, error) {
+	user, err := s.store.GetUserByID(ctx, auth.GetPermissionsFromContext(ctx).UserId)
+	if err != nil {
+		return sql.NullString{}, status.Errorf(codes.Internal, "failed to get user: %s", err)
+	}
+	return sql.NullString{String: user.IdentitySubject, Valid: true}, nil
+}
+
+// performRemediationApproval performs the proposed request with the provider of the profile
+// the remediation belongs to
+func (s *Server) performRemediationApproval(ctx context.Context, approval db.GetRemediationApprovalByIDRow) error {
+	projectID := engine.EntityFromContext(ctx).Project.ID
+	provider, err := s.store.GetProviderByName(ctx, db.GetProviderByNameParams{
+		Name:      approval.Provider,
+		ProjectID: projectID,
+	})
+	if err != nil {
+		return providerError(err)
+	}
+
+	pbOpts := []providers.ProviderBuilderOption{
+		providers.WithProviderMetrics(s.provMt),
+	}
+	p, err := providers.GetProviderBuilder(ctx, provider, projectID, s.store, s.cryptoEngine, pbOpts...)
+	if err != nil {
+		return err
+	}
+
+	cli, err := p.GetHTTP(ctx)
+	if err != nil {
+		return err
+	}
+
+	return rest.Perform(ctx, cli, approval.Method, approval.Endpoint, []byte(approval.Body))
+}
+
+func remediationApprovalToPb(approval db.RemediationApproval) *pb.RemediationApproval {
+	pbApproval := &pb.RemediationApproval{
+		Id:        approval.ID.String(),
+		Method:    approval.Method,
+		Endpoint:  approval.Endpoint,
+		Body:      approval.Body,
+		Status:    string(approval.Status),
+		Details:   approval.Details,
+		DecidedBy: approval.DecidedBy.String,
+		CreatedAt: timestamppb.New(approval.CreatedAt),
+		UpdatedAt: timestamppb.New(approval.UpdatedAt),
+	}
+	if approval.DecidedAt.Valid {
+		pbApproval.DecidedAt = timestamppb.New(approval.DecidedAt.Time)
+	}
+	return pbApproval
+}
@@ -0,0 +1,249 @@
+// Copyright 2023 Stacklok, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controlplane
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	mockdb "github.com/stacklok/minder/database/mock"
+	"github.com/stacklok/minder/internal/db"
+	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+func remediationApprovalTestContext(store *mockdb.MockStore, projectID uuid.UUID) *pb.Context {
+	projectName := "my-project"
+	store.EXPECT().
+		GetProjectByName(gomock.Any(), projectName).
+		Return(db.Project{ID: projectID, Name: projectName}, nil)
+	store.EXPECT().
+		GetProviderByName(gomock.Any(), db.GetProviderByNameParams{Name: "github", ProjectID: projectID}).
+		Return(db.Provider{ID: uuid.New(), Name: "github", ProjectID: projectID}, nil)
+	return &pb.Context{Provider: "github", Project: &projectName}
+}
+
+func TestListRemediationApprovals(t *testing.T) {
+	t.Parallel()
+
+	projectID := uuid.New()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	pbCtx := remediationApprovalTestContext(store, projectID)
+	store.EXPECT().
+		ListRemediationApprovalsByProject(gomock.Any(), db.ListRemediationApprovalsByProjectParams{
+			ProjectID: projectID,
+			Status: db.NullRemediationApprovalStatus{
+				RemediationApprovalStatus: db.RemediationApprovalStatusPending,
+				Valid:                     true,
+			},
+		}).
+		Return([]db.ListRemediationApprovalsByProjectRow{{
+			ID:           uuid.New(),
+			Method:       "PATCH",
+			Endpoint:     "/repos/stacklok/minder",
+			Body:         `{"allow_auto_merge": false}`,
+			Status:       db.RemediationApprovalStatusPending,
+			ProfileName:  "acme",
+			RuleTypeName: "auto_merge",
+			Entity:       db.EntitiesRepository,
+			RepoOwner:    "stacklok",
+			RepoName:     "minder",
+		}}, nil)
+
+	server := newDefaultServer(t, store)
+	res, err := server.ListRemediationApprovals(artifactRetentionTestContext(projectID),
+		&pb.ListRemediationApprovalsRequest{Context: pbCtx, Status: "pending"})
+	require.NoError(t, err)
+	require.Len(t, res.GetApprovals(), 1)
+
+	approval := res.GetApprovals()[0]
+	assert.Equal(t, "PATCH", approval.GetMethod())
+	assert.Equal(t, "auto_merge", approval.GetRuleTypeName())
+	assert.Equal(t, "minder", approval.GetRepoName())
+	assert.Equal(t, "pending", approval.GetStatus())
+	assert.Nil(t, approval.DecidedAt)
+}
+
+func TestListRemediationApprovalsInvalidStatus(t *testing.T) {
+	t.Parallel()
+
+	projectID := uuid.New()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	pbCtx := remediationApprovalTestContext(store, projectID)
+
+	server := newDefaultServer(t, store)
+	_, err := server.ListRemediationApprovals(artifactRetentionTestContext(projectID),
+		&pb.ListRemediationApprovalsRequest{Context: pbCtx, Status: "approved"})
+	require.Error(t, err)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestRejectRemediation(t *testing.T) {
+	t.Parallel()
+
+	projectID := uuid.New()
+	approvalID := uuid.New()
+
+	testCases := []struct {
+		name         string
+		id           string
+		buildStubs   func(store *mockdb.MockStore)
+		expectedCode codes.Code
+	}{
+		{
+			name: "rejects a pending approval",
+			id:   approvalID.String(),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetRemediationApprovalByID(gomock.Any(), db.GetRemediationApprovalByIDParams{
+						ID: approvalID, ProjectID: projectID}).
+					Return(db.GetRemediationApprovalByIDRow{
+						ID: approvalID, Status: db.RemediationApprovalStatusPending, ProfileName: "acme"}, nil)
+				store.EXPECT().
+					GetUserByID(gomock.Any(), int32(1)).
+					Return(db.User{ID: 1, IdentitySubject: "jdoe"}, nil)
+				store.EXPECT().
+					UpdateRemediationApprovalStatus(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, arg db.UpdateRemediationApprovalStatusParams) (db.RemediationApproval, error) {
+						assert.Equal(t, db.RemediationApprovalStatusPending, arg.FromStatus)
+						assert.Equal(t, db.RemediationApprovalStatusRejected, arg.Status)
+						assert.Equal(t, sql.NullString{String: "jdoe", Valid: true}, arg.DecidedBy)
+						return db.RemediationApproval{ID: arg.ID, Status: arg.Status, DecidedBy: arg.DecidedBy}, nil
+					})
+			},
+			expectedCode: codes.OK,
+		},
+		{
+			name: "an approval that was decided on can't be rejected",
+			id:   approvalID.String(),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetRemediationApprovalByID(gomock.Any(), gomock.Any()).
+					Return(db.GetRemediationApprovalByIDRow{
+						ID: approvalID, Status: db.RemediationApprovalStatusApplied}, nil)
+			},
+			expectedCode: codes.FailedPrecondition,
+		},
+		{
+			name: "an approval that was decided on concurrently can't be rejected",
+			id:   approvalID.String(),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetRemediationApprovalByID(gomock.Any(), gomock.Any()).
+					Return(db.GetRemediationApprovalByIDRow{
+						ID: approvalID, Status: db.RemediationApprovalStatusPending}, nil)
+				store.EXPECT().
+					GetUserByID(gomock.Any(), int32(1)).
+					Return(db.User{ID: 1, IdentitySubject: "jdoe"}, nil)
+				store.EXPECT().
+					UpdateRemediationApprovalStatus(gomock.Any(), gomock.Any()).
+					Return(db.RemediationApproval{}, sql.ErrNoRows)
+			},
+			expectedCode: codes.FailedPrecondition,
+		},
+		{
+			name: "approval of another project is not found",
+			id:   approvalID.String(),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetRemediationApprovalByID(gomock.Any(), gomock.Any()).
+					Return(db.GetRemediationApprovalByIDRow{}, sql.ErrNoRows)
+			},
+			expectedCode: codes.NotFound,
+		},
+		{
+			name:         "malformed approval ID",
+			id:           "not-a-uuid",
+			buildStubs:   func(_ *mockdb.MockStore) {},
+			expectedCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			pbCtx := remediationApprovalTestContext(store, projectID)
+			tc.buildStubs(store)
+
+			server := newDefaultServer(t, store)
+			res, err := server.RejectRemediation(artifactRetentionTestContext(projectID),
+				&pb.RejectRemediationRequest{Context: pbCtx, Id: tc.id})
+			if tc.expectedCode != codes.OK {
+				require.Error(t, err)
+				assert.Equal(t, tc.expectedCode, status.Code(err))
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, "rejected", res.GetApproval().GetStatus())
+			assert.Equal(t, "jdoe", res.GetApproval().GetDecidedBy())
+			assert.Equal(t, "acme", res.GetApproval().GetProfileName())
+		})
+	}
+}
+
+func TestApproveRemediationClaimedConcurrently(t *testing.T) {
+	t.Parallel()
+
+	projectID := uuid.New()
+	approvalID := uuid.New()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	pbCtx := remediationApprovalTestContext(store, projectID)
+	store.EXPECT().
+		GetRemediationApprovalByID(gomock.Any(), gomock.Any()).
+		Return(db.GetRemediationApprovalByIDRow{
+			ID: approvalID, Status: db.RemediationApprovalStatusPending, Provider: "github"}, nil)
+	store.EXPECT().
+		GetUserByID(gomock.Any(), int32(1)).
+		Return(db.User{ID: 1, IdentitySubject: "jdoe"}, nil)
+	// another approval claimed it in the meantime, so the request must not be performed
+	store.EXPECT().
+		UpdateRemediationApprovalStatus(gomock.Any(), db.UpdateRemediationApprovalStatusParams{
+			ID:         approvalID,
+			FromStatus: db.RemediationApprovalStatusPending,
+			Status:     db.RemediationApprovalStatusApplying,
+			DecidedBy:  sql.NullString{String: "jdoe", Valid: true},
+		}).
+		Return(db.RemediationApproval{}, sql.ErrNoRows)
+
+	server := newDefaultServer(t, store)
+	_, err := server.ApproveRemediation(artifactRetentionTestContext(projectID),
+		&pb.ApproveRemediationRequest{Context: pbCtx, Id: approvalID.String()})
+	require.Error(t, err)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
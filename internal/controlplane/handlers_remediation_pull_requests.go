@@ -0,0 +1,73 @@
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controlplane
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/stacklok/minder/internal/util"
+	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+// ListRemediationPullRequests returns the open pull requests that the remediations
+// of the profiles opened in a repository
+func (s *Server) ListRemediationPullRequests(ctx context.Context,
+	in *pb.ListRemediationPullRequestsRequest) (*pb.ListRemediationPullRequestsResponse, error) {
+	parsedRepositoryID, err := uuid.Parse(in.RepositoryId)
+	if err != nil {
+		return nil, util.UserVisibleError(codes.InvalidArgument, "invalid repository ID")
+	}
+
+	repo, err := s.store.GetRepositoryByID(ctx, parsedRepositoryID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "repository not found")
+	} else if err != nil {
+		return nil, status.Errorf(codes.Internal, "cannot read repository: %v", err)
+	}
+
+	// check if user is authorized
+	if err := AuthorizedOnProject(ctx, repo.ProjectID); err != nil {
+		return nil, err
+	}
+
+	prs, err := s.store.ListRemediationPullRequestsByRepository(ctx, repo.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "cannot list remediation pull requests: %v", err)
+	}
+
+	results := make([]*pb.RemediationPullRequest, 0, len(prs))
+	for _, pr := range prs {
+		results = append(results, &pb.RemediationPullRequest{
+			RepositoryId: repo.ID.String(),
+			Owner:        repo.RepoOwner,
+			Name:         repo.RepoName,
+			Number:       pr.PrNumber,
+			Branch:       pr.Branch,
+			ProfileName:  pr.ProfileName,
+			RuleTypeName: pr.RuleTypeName,
+			CreatedAt:    timestamppb.New(pr.CreatedAt),
+			UpdatedAt:    timestamppb.New(pr.UpdatedAt),
+		})
+	}
+
+	return &pb.ListRemediationPullRequestsResponse{Results: results}, nil
+}
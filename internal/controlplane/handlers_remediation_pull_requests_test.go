@@ -0,0 +1,116 @@
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controlplane
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	mockdb "github.com/stacklok/minder/database/mock"
+	"github.com/stacklok/minder/internal/auth"
+	"github.com/stacklok/minder/internal/db"
+	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+func TestListRemediationPullRequests(t *testing.T) {
+	t.Parallel()
+
+	projectID := uuid.New()
+	repoID := uuid.New()
+
+	tests := []struct {
+		name       string
+		repoID     string
+		buildStubs func(store *mockdb.MockStore)
+		expectCode codes.Code
+	}{
+		{
+			name:   "lists the open remediation pull requests",
+			repoID: repoID.String(),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetRepositoryByID(gomock.Any(), repoID).
+					Return(db.Repository{ID: repoID, ProjectID: projectID, RepoOwner: "stacklok", RepoName: "minder"}, nil)
+				store.EXPECT().
+					ListRemediationPullRequestsByRepository(gomock.Any(), repoID).
+					Return([]db.ListRemediationPullRequestsByRepositoryRow{{
+						RepositoryID: repoID,
+						PrNumber:     42,
+						Branch:       "minder_add_dependabot_configuration",
+						ProfileName:  "acme",
+						RuleTypeName: "dependabot_configured",
+					}}, nil)
+			},
+		},
+		{
+			name:   "unknown repository",
+			repoID: repoID.String(),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetRepositoryByID(gomock.Any(), repoID).
+					Return(db.Repository{}, sql.ErrNoRows)
+			},
+			expectCode: codes.NotFound,
+		},
+		{
+			name:       "malformed repository ID",
+			repoID:     "not-a-uuid",
+			buildStubs: func(_ *mockdb.MockStore) {},
+			expectCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := mockdb.NewMockStore(ctrl)
+			tt.buildStubs(mockStore)
+
+			ctx := auth.WithPermissionsContext(context.Background(), auth.UserPermissions{
+				UserId:     1,
+				ProjectIds: []uuid.UUID{projectID},
+				IsStaff:    true,
+			})
+
+			server := &Server{store: mockStore}
+			resp, err := server.ListRemediationPullRequests(ctx,
+				&pb.ListRemediationPullRequestsRequest{RepositoryId: tt.repoID})
+			if tt.expectCode != codes.OK {
+				require.Error(t, err)
+				assert.Equal(t, tt.expectCode, status.Code(err))
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, resp.Results, 1)
+			assert.Equal(t, int64(42), resp.Results[0].Number)
+			assert.Equal(t, "minder", resp.Results[0].Name)
+			assert.Equal(t, "dependabot_configured", resp.Results[0].RuleTypeName)
+		})
+	}
+}
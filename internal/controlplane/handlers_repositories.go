@@ -32,6 +32,7 @@ import (
 	"github.com/stacklok/minder/internal/providers"
 	github "github.com/stacklok/minder/internal/providers/github"
 	"github.com/stacklok/minder/internal/reconcilers"
+	"github.com/stacklok/minder/internal/sbom"
 	"github.com/stacklok/minder/internal/util"
 	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
 )
@@ -105,9 +106,10 @@ func (s *Server) RegisterRepository(ctx context.Context,
 			Int32: int32(r.HookId),
 			Valid: true,
 		},
-		CloneUrl:   r.CloneUrl,
-		WebhookUrl: r.HookUrl,
-		DeployUrl:  r.DeployUrl,
+		CloneUrl:      r.CloneUrl,
+		WebhookUrl:    r.HookUrl,
+		DeployUrl:     r.DeployUrl,
+		DefaultBranch: r.DefaultBranch,
 	})
 	// even if we set the webhook, if we couldn't create it in the database, we'll return an error
 	if err != nil {
@@ -185,16 +187,17 @@ func (s *Server) ListRepositories(ctx context.Context,
 				Project:  &projID,
 				Provider: repo.Provider,
 			},
-			Owner:     repo.RepoOwner,
-			Name:      repo.RepoName,
-			RepoId:    repo.RepoID,
-			IsPrivate: repo.IsPrivate,
-			IsFork:    repo.IsFork,
-			HookUrl:   repo.WebhookUrl,
-			DeployUrl: repo.DeployUrl,
-			CloneUrl:  repo.CloneUrl,
-			CreatedAt: timestamppb.New(repo.CreatedAt),
-			UpdatedAt: timestamppb.New(repo.UpdatedAt),
+			Owner:         repo.RepoOwner,
+			Name:          repo.RepoName,
+			RepoId:        repo.RepoID,
+			IsPrivate:     repo.IsPrivate,
+			IsFork:        repo.IsFork,
+			HookUrl:       repo.WebhookUrl,
+			DeployUrl:     repo.DeployUrl,
+			CloneUrl:      repo.CloneUrl,
+			DefaultBranch: repo.DefaultBranch,
+			CreatedAt:     timestamppb.New(repo.CreatedAt),
+			UpdatedAt:     timestamppb.New(repo.UpdatedAt),
 		})
 	}
 
@@ -235,16 +238,17 @@ func (s *Server) GetRepositoryById(ctx context.Context,
 			Project:  &projID,
 			Provider: repo.Provider,
 		},
-		Owner:     repo.RepoOwner,
-		Name:      repo.RepoName,
-		RepoId:    repo.RepoID,
-		IsPrivate: repo.IsPrivate,
-		IsFork:    repo.IsFork,
-		HookUrl:   repo.WebhookUrl,
-		DeployUrl: repo.DeployUrl,
-		CloneUrl:  repo.CloneUrl,
-		CreatedAt: createdAt,
-		UpdatedAt: updatedat,
+		Owner:         repo.RepoOwner,
+		Name:          repo.RepoName,
+		RepoId:        repo.RepoID,
+		IsPrivate:     repo.IsPrivate,
+		IsFork:        repo.IsFork,
+		HookUrl:       repo.WebhookUrl,
+		DeployUrl:     repo.DeployUrl,
+		CloneUrl:      repo.CloneUrl,
+		DefaultBranch: repo.DefaultBranch,
+		CreatedAt:     createdAt,
+		UpdatedAt:     updatedat,
 	}}, nil
 }
 
@@ -302,16 +306,17 @@ func (s *Server) GetRepositoryByName(ctx context.Context,
 			Project:  &projID,
 			Provider: repo.Provider,
 		},
-		Owner:     repo.RepoOwner,
-		Name:      repo.RepoName,
-		RepoId:    repo.RepoID,
-		IsPrivate: repo.IsPrivate,
-		IsFork:    repo.IsFork,
-		HookUrl:   repo.WebhookUrl,
-		DeployUrl: repo.DeployUrl,
-		CloneUrl:  repo.CloneUrl,
-		CreatedAt: createdAt,
-		UpdatedAt: updatedat,
+		Owner:         repo.RepoOwner,
+		Name:          repo.RepoName,
+		RepoId:        repo.RepoID,
+		IsPrivate:     repo.IsPrivate,
+		IsFork:        repo.IsFork,
+		HookUrl:       repo.WebhookUrl,
+		DeployUrl:     repo.DeployUrl,
+		CloneUrl:      repo.CloneUrl,
+		DefaultBranch: repo.DefaultBranch,
+		CreatedAt:     createdAt,
+		UpdatedAt:     updatedat,
 	}}, nil
 }
 
@@ -398,6 +403,89 @@ func (s *Server) DeleteRepositoryByName(ctx context.Context,
 	}, nil
 }
 
+// GetRepositorySBOM returns a software bill of materials of a registered repository.
+// The SBOM is generated from the dependency files found in a clone of the repository.
+func (s *Server) GetRepositorySBOM(ctx context.Context,
+	in *pb.GetRepositorySBOMRequest) (*pb.GetRepositorySBOMResponse, error) {
+	parsedRepositoryID, err := uuid.Parse(in.RepositoryId)
+	if err != nil {
+		return nil, util.UserVisibleError(codes.InvalidArgument, "invalid repository ID")
+	}
+
+	format, err := sbom.ParseFormat(in.Format)
+	if err != nil {
+		return nil, util.UserVisibleError(codes.InvalidArgument, err.Error())
+	}
+
+	repo, err := s.store.GetRepositoryByID(ctx, parsedRepositoryID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "repository not found")
+	} else if err != nil {
+		return nil, status.Errorf(codes.Internal, "cannot read repository: %v", err)
+	}
+
+	// check if user is authorized
+	if err := AuthorizedOnProject(ctx, repo.ProjectID); err != nil {
+		return nil, err
+	}
+
+	provider, err := s.store.GetProviderByName(ctx, db.GetProviderByNameParams{
+		Name:      repo.Provider,
+		ProjectID: repo.ProjectID,
+	})
+	if err != nil {
+		return nil, providerError(fmt.Errorf("provider error: %w", err))
+	}
+
+	pbOpts := []providers.ProviderBuilderOption{
+		providers.WithProviderMetrics(s.provMt),
+	}
+	p, err := providers.GetProviderBuilder(ctx, provider, repo.ProjectID, s.store, s.cryptoEngine, pbOpts...)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "cannot get provider builder: %v", err)
+	}
+
+	if !p.Implements(db.ProviderTypeGit) {
+		return nil, util.UserVisibleError(codes.Unimplemented, "provider does not implement git")
+	}
+
+	gitClient, err := p.GetGit()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "cannot create git client: %v", err)
+	}
+
+	// an empty branch clones the default branch of the repository
+	branch := in.Branch
+	if branch == "" {
+		branch = repo.DefaultBranch
+	}
+
+	r, err := gitClient.Clone(ctx, repo.CloneUrl, branch)
+	if err != nil {
+		return nil, util.UserVisibleError(codes.FailedPrecondition, "cannot clone repository: %v", err)
+	}
+
+	wt, err := r.Worktree()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "cannot get worktree: %v", err)
+	}
+
+	components, err := sbom.ComponentsFromFilesystem(wt.Filesystem, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "cannot parse dependencies: %v", err)
+	}
+
+	doc, err := sbom.Generate(format, fmt.Sprintf("%s/%s", repo.RepoOwner, repo.RepoName), components)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "cannot generate sbom: %v", err)
+	}
+
+	return &pb.GetRepositorySBOMResponse{
+		Format: string(format),
+		Sbom:   string(doc),
+	}, nil
+}
+
 // ListRemoteRepositoriesFromProvider returns a list of repositories from a provider
 func (s *Server) ListRemoteRepositoriesFromProvider(
 	ctx context.Context,
@@ -167,6 +167,10 @@ func (s *Server) CreateRuleType(
 		return nil, status.Errorf(codes.InvalidArgument, "invalid rule type definition: %v", err)
 	}
 
+	if err := s.encryptWebhookSecret(in, nil); err != nil {
+		return nil, status.Errorf(codes.Internal, "cannot encrypt webhook secret: %v", err)
+	}
+
 	def, err := util.GetBytesFromProto(in.GetDef())
 	if err != nil {
 		return nil, fmt.Errorf("cannot convert rule definition to db: %v", err)
@@ -250,6 +254,10 @@ func (s *Server) UpdateRuleType(
 		return nil, util.UserVisibleError(codes.Unknown, "failed to get profiles used by rule: %s", err)
 	}
 
+	if err := s.encryptWebhookSecret(in, oldrt); err != nil {
+		return nil, status.Errorf(codes.Internal, "cannot encrypt webhook secret: %v", err)
+	}
+
 	def, err := util.GetBytesFromProto(in.GetDef())
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "cannot convert rule definition to db: %s", err)
@@ -273,6 +281,30 @@ func (s *Server) UpdateRuleType(
 	}, nil
 }
 
+// encryptWebhookSecret replaces the secret of a webhook alert with its encrypted version,
+// so that it's never stored nor returned in plain text. An update that doesn't set the
+// secret keeps the secret of the rule type being updated. The encrypted secret is only
+// ever set by the server, so the one sent by the client is ignored.
+func (s *Server) encryptWebhookSecret(rt *minderv1.RuleType, oldrt *minderv1.RuleType) error {
+	webhook := rt.GetDef().GetAlert().GetWebhook()
+	if webhook == nil {
+		return nil
+	}
+
+	if webhook.Secret == "" {
+		webhook.EncryptedSecret = oldrt.GetDef().GetAlert().GetWebhook().GetEncryptedSecret()
+		return nil
+	}
+
+	encrypted, err := s.cryptoEngine.EncryptSecret([]byte(webhook.Secret))
+	if err != nil {
+		return err
+	}
+	webhook.EncryptedSecret = encrypted
+	webhook.Secret = ""
+	return nil
+}
+
 // DeleteRuleType is a method to delete a rule type
 func (s *Server) DeleteRuleType(
 	ctx context.Context,
@@ -0,0 +1,81 @@
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controlplane
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/stacklok/minder/internal/crypto"
+	minderv1 "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+func webhookRuleType(secret, encryptedSecret string) *minderv1.RuleType {
+	return &minderv1.RuleType{
+		Def: &minderv1.RuleType_Definition{
+			Alert: &minderv1.RuleType_Definition_Alert{
+				Type: "webhook",
+				Webhook: &minderv1.RuleType_Definition_Alert_AlertTypeWebhook{
+					Url:             "https://relay.example.com/minder",
+					Secret:          secret,
+					EncryptedSecret: encryptedSecret,
+				},
+			},
+		},
+	}
+}
+
+func TestEncryptWebhookSecret(t *testing.T) {
+	t.Parallel()
+
+	crypeng := crypto.NewEngine("test")
+	server := &Server{cryptoEngine: crypeng}
+
+	rt := webhookRuleType("s3cr3t", "")
+	require.NoError(t, server.encryptWebhookSecret(rt, nil))
+	webhook := rt.GetDef().GetAlert().GetWebhook()
+	assert.Empty(t, webhook.Secret, "the secret shouldn't be stored in plain text")
+	decrypted, err := crypeng.DecryptSecret(webhook.EncryptedSecret)
+	require.NoError(t, err)
+	assert.Equal(t, "s3cr3t", string(decrypted))
+
+	updated := webhookRuleType("", "")
+	require.NoError(t, server.encryptWebhookSecret(updated, rt))
+	assert.Equal(t, webhook.EncryptedSecret, updated.GetDef().GetAlert().GetWebhook().EncryptedSecret,
+		"an update without a secret should keep the previous one")
+
+	// an encrypted secret can't be smuggled in, e.g. one taken from another rule type
+	other, err := crypeng.EncryptSecret([]byte("other"))
+	require.NoError(t, err)
+	smuggled := webhookRuleType("", other)
+	require.NoError(t, server.encryptWebhookSecret(smuggled, rt))
+	assert.Equal(t, webhook.EncryptedSecret, smuggled.GetDef().GetAlert().GetWebhook().EncryptedSecret,
+		"an encrypted secret sent by the client should be ignored")
+	created := webhookRuleType("", other)
+	require.NoError(t, server.encryptWebhookSecret(created, nil))
+	assert.Empty(t, created.GetDef().GetAlert().GetWebhook().EncryptedSecret,
+		"an encrypted secret sent by the client should be ignored")
+
+	rotated := webhookRuleType("n3w", "")
+	require.NoError(t, server.encryptWebhookSecret(rotated, rt))
+	decrypted, err = crypeng.DecryptSecret(rotated.GetDef().GetAlert().GetWebhook().EncryptedSecret)
+	require.NoError(t, err)
+	assert.Equal(t, "n3w", string(decrypted))
+
+	noWebhook := &minderv1.RuleType{Def: &minderv1.RuleType_Definition{}}
+	require.NoError(t, server.encryptWebhookSecret(noWebhook, nil))
+}
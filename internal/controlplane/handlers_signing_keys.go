@@ -0,0 +1,141 @@
+// Copyright 2023 Stacklok, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controlplane
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/stacklok/minder/internal/container"
+	"github.com/stacklok/minder/internal/db"
+	"github.com/stacklok/minder/internal/util"
+	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+// CreateSigningKey registers a public key the signatures of the artifacts of a
+// project are verified against. Only the public key is stored.
+func (s *Server) CreateSigningKey(ctx context.Context,
+	in *pb.CreateSigningKeyRequest) (*pb.CreateSigningKeyResponse, error) {
+	projectID, err := getProjectFromRequestOrDefault(ctx, in)
+	if err != nil {
+		return nil, util.UserVisibleError(codes.InvalidArgument, err.Error())
+	}
+
+	// check if user is authorized
+	if err := AuthorizedOnProject(ctx, projectID); err != nil {
+		return nil, err
+	}
+
+	key, err := container.NewVerificationKey(in.GetKeyIdentifier(), []byte(in.GetPublicKey()))
+	if err != nil {
+		return nil, util.UserVisibleError(codes.InvalidArgument, "invalid public key: %s", err)
+	}
+
+	created, err := s.store.CreateSigningKey(ctx, db.CreateSigningKeyParams{
+		ProjectID:     projectID,
+		PublicKey:     in.GetPublicKey(),
+		PrivateKey:    sql.NullString{Valid: false},
+		Passphrase:    sql.NullString{Valid: false},
+		KeyIdentifier: key.Identifier,
+		CreatedAt:     time.Now(),
+	})
+	if db.ErrIsUniqueViolation(err) {
+		return nil, util.UserVisibleError(codes.AlreadyExists, "signing key %s already exists", key.Identifier)
+	} else if err != nil {
+		log.Printf("error creating signing key: %v", err)
+		return nil, status.Errorf(codes.Internal, "error creating signing key")
+	}
+
+	return &pb.CreateSigningKeyResponse{Key: signingKeyToPb(created)}, nil
+}
+
+// ListSigningKeys lists the public keys registered on a project
+func (s *Server) ListSigningKeys(ctx context.Context,
+	in *pb.ListSigningKeysRequest) (*pb.ListSigningKeysResponse, error) {
+	projectID, err := getProjectFromRequestOrDefault(ctx, in)
+	if err != nil {
+		return nil, util.UserVisibleError(codes.InvalidArgument, err.Error())
+	}
+
+	// check if user is authorized
+	if err := AuthorizedOnProject(ctx, projectID); err != nil {
+		return nil, err
+	}
+
+	keys, err := s.store.ListSigningKeysByProjectID(ctx, projectID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error listing signing keys: %v", err)
+	}
+
+	results := make([]*pb.SigningKey, 0, len(keys))
+	for _, key := range keys {
+		results = append(results, signingKeyToPb(key))
+	}
+
+	return &pb.ListSigningKeysResponse{Keys: results}, nil
+}
+
+// DeleteSigningKey removes a public key from a project
+func (s *Server) DeleteSigningKey(ctx context.Context,
+	in *pb.DeleteSigningKeyRequest) (*pb.DeleteSigningKeyResponse, error) {
+	projectID, err := getProjectFromRequestOrDefault(ctx, in)
+	if err != nil {
+		return nil, util.UserVisibleError(codes.InvalidArgument, err.Error())
+	}
+
+	// check if user is authorized
+	if err := AuthorizedOnProject(ctx, projectID); err != nil {
+		return nil, err
+	}
+
+	if in.GetKeyIdentifier() == "" {
+		return nil, util.UserVisibleError(codes.InvalidArgument, "key identifier is required")
+	}
+
+	_, err = s.store.GetSigningKeyByProjectAndIdentifier(ctx, db.GetSigningKeyByProjectAndIdentifierParams{
+		ProjectID:     projectID,
+		KeyIdentifier: in.GetKeyIdentifier(),
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, util.UserVisibleError(codes.NotFound, "signing key %s not found", in.GetKeyIdentifier())
+	} else if err != nil {
+		return nil, status.Errorf(codes.Internal, "error getting signing key: %v", err)
+	}
+
+	err = s.store.DeleteSigningKey(ctx, db.DeleteSigningKeyParams{
+		ProjectID:     projectID,
+		KeyIdentifier: in.GetKeyIdentifier(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error deleting signing key: %v", err)
+	}
+
+	return &pb.DeleteSigningKeyResponse{}, nil
+}
+
+func signingKeyToPb(key db.SigningKey) *pb.SigningKey {
+	return &pb.SigningKey{
+		KeyIdentifier: key.KeyIdentifier,
+		PublicKey:     key.PublicKey,
+		CreatedAt:     timestamppb.New(key.CreatedAt),
+	}
+}
@@ -0,0 +1,213 @@
+// Copyright 2023 Stacklok, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controlplane
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"database/sql"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/sigstore/sigstore/pkg/cryptoutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	mockdb "github.com/stacklok/minder/database/mock"
+	"github.com/stacklok/minder/internal/auth"
+	"github.com/stacklok/minder/internal/db"
+	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+func TestCreateSigningKey(t *testing.T) {
+	t.Parallel()
+
+	orgID := uuid.New()
+	projectID := uuid.New()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	pemKey, err := cryptoutils.MarshalPublicKeyToPEM(key.Public())
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name               string
+		req                *pb.CreateSigningKeyRequest
+		buildStubs         func(store *mockdb.MockStore)
+		expectedIdentifier string
+		expectedCode       codes.Code
+	}{
+		{
+			name: "creates key with identifier",
+			req:  &pb.CreateSigningKeyRequest{KeyIdentifier: "release", PublicKey: string(pemKey)},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CreateSigningKey(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, arg db.CreateSigningKeyParams) (db.SigningKey, error) {
+						assert.Equal(t, projectID, arg.ProjectID)
+						assert.False(t, arg.PrivateKey.Valid, "only the public key is stored")
+						assert.False(t, arg.Passphrase.Valid, "only the public key is stored")
+						return db.SigningKey{
+							ProjectID:     arg.ProjectID,
+							PublicKey:     arg.PublicKey,
+							KeyIdentifier: arg.KeyIdentifier,
+							CreatedAt:     arg.CreatedAt,
+						}, nil
+					})
+			},
+			expectedIdentifier: "release",
+			expectedCode:       codes.OK,
+		},
+		{
+			name: "defaults identifier to fingerprint",
+			req:  &pb.CreateSigningKeyRequest{PublicKey: string(pemKey)},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CreateSigningKey(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, arg db.CreateSigningKeyParams) (db.SigningKey, error) {
+						return db.SigningKey{KeyIdentifier: arg.KeyIdentifier, PublicKey: arg.PublicKey}, nil
+					})
+			},
+			expectedIdentifier: "sha256:",
+			expectedCode:       codes.OK,
+		},
+		{
+			name: "key already exists",
+			req:  &pb.CreateSigningKeyRequest{KeyIdentifier: "release", PublicKey: string(pemKey)},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CreateSigningKey(gomock.Any(), gomock.Any()).
+					Return(db.SigningKey{}, &pq.Error{Code: "23505"})
+			},
+			expectedCode: codes.AlreadyExists,
+		},
+		{
+			name:         "invalid public key",
+			req:          &pb.CreateSigningKeyRequest{KeyIdentifier: "release", PublicKey: "not a key"},
+			buildStubs:   func(_ *mockdb.MockStore) {},
+			expectedCode: codes.InvalidArgument,
+		},
+	}
+
+	ctx := auth.WithPermissionsContext(context.Background(), auth.UserPermissions{
+		UserId:         1,
+		OrganizationId: orgID,
+		ProjectIds:     []uuid.UUID{projectID},
+		IsStaff:        true, // TODO: remove this
+		Roles: []auth.RoleInfo{
+			{RoleID: 1, IsAdmin: true, ProjectID: &projectID, OrganizationID: orgID}},
+	})
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newDefaultServer(t, store)
+
+			res, err := server.CreateSigningKey(ctx, tc.req)
+			if tc.expectedCode != codes.OK {
+				require.Error(t, err)
+				assert.Equal(t, tc.expectedCode, status.Code(err))
+				return
+			}
+
+			require.NoError(t, err)
+			assert.True(t, strings.HasPrefix(res.GetKey().GetKeyIdentifier(), tc.expectedIdentifier))
+			assert.Equal(t, string(pemKey), res.GetKey().GetPublicKey())
+		})
+	}
+}
+
+func TestDeleteSigningKey(t *testing.T) {
+	t.Parallel()
+
+	orgID := uuid.New()
+	projectID := uuid.New()
+
+	testCases := []struct {
+		name         string
+		buildStubs   func(store *mockdb.MockStore)
+		expectedCode codes.Code
+	}{
+		{
+			name: "deletes the key",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetSigningKeyByProjectAndIdentifier(gomock.Any(), db.GetSigningKeyByProjectAndIdentifierParams{
+						ProjectID:     projectID,
+						KeyIdentifier: "release",
+					}).
+					Return(db.SigningKey{ProjectID: projectID, KeyIdentifier: "release"}, nil)
+				store.EXPECT().
+					DeleteSigningKey(gomock.Any(), db.DeleteSigningKeyParams{
+						ProjectID:     projectID,
+						KeyIdentifier: "release",
+					}).
+					Return(nil)
+			},
+			expectedCode: codes.OK,
+		},
+		{
+			name: "key not found in the project",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetSigningKeyByProjectAndIdentifier(gomock.Any(), gomock.Any()).
+					Return(db.SigningKey{}, sql.ErrNoRows)
+			},
+			expectedCode: codes.NotFound,
+		},
+	}
+
+	ctx := auth.WithPermissionsContext(context.Background(), auth.UserPermissions{
+		UserId:         1,
+		OrganizationId: orgID,
+		ProjectIds:     []uuid.UUID{projectID},
+		IsStaff:        true, // TODO: remove this
+		Roles: []auth.RoleInfo{
+			{RoleID: 1, IsAdmin: true, ProjectID: &projectID, OrganizationID: orgID}},
+	})
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newDefaultServer(t, store)
+
+			_, err := server.DeleteSigningKey(ctx, &pb.DeleteSigningKeyRequest{KeyIdentifier: "release"})
+			assert.Equal(t, tc.expectedCode, status.Code(err))
+		})
+	}
+}
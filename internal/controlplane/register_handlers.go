@@ -42,6 +42,11 @@ func RegisterGatewayHTTPHandlers(ctx context.Context, gwmux *runtime.ServeMux, g
 		log.Fatalf("failed to register gateway: %v", err)
 	}
 
+	// Register the Provider service
+	if err := pb.RegisterProviderServiceHandlerFromEndpoint(ctx, gwmux, grpcAddress, opts); err != nil {
+		log.Fatalf("failed to register gateway: %v", err)
+	}
+
 	// Register the Repository service
 	if err := pb.RegisterRepositoryServiceHandlerFromEndpoint(ctx, gwmux, grpcAddress, opts); err != nil {
 		log.Fatalf("failed to register gateway: %v", err)
@@ -69,6 +74,9 @@ func RegisterGRPCServices(s *Server) {
 	// Register the User service
 	pb.RegisterUserServiceServer(s.grpcServer, s)
 
+	// Register the Provider service
+	pb.RegisterProviderServiceServer(s.grpcServer, s)
+
 	// Register the Repository service
 	pb.RegisterRepositoryServiceServer(s.grpcServer, s)
 
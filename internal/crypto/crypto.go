@@ -192,6 +192,28 @@ func (e *Engine) DecryptOAuthToken(encToken string) (oauth2.Token, error) {
 	return decryptedToken, nil
 }
 
+// EncryptSecret encrypts a secret, such as the secret signing webhook alerts,
+// and returns it base64 encoded so that it can be stored as a string
+func (e *Engine) EncryptSecret(secret []byte) (string, error) {
+	encrypted, err := EncryptBytes(e.encryptionKey, secret)
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(encrypted), nil
+}
+
+// DecryptSecret decrypts a secret encrypted by EncryptSecret
+func (e *Engine) DecryptSecret(encSecret string) ([]byte, error) {
+	decoded, err := base64.StdEncoding.DecodeString(encSecret)
+	if err != nil {
+		return nil, err
+	}
+	if len(decoded) < aes.BlockSize {
+		return nil, errors.New("encrypted secret is too short")
+	}
+	return decryptBytes(e.encryptionKey, decoded)
+}
+
 // decryptBytes decrypts a row of data
 func decryptBytes(key string, ciphertext []byte) ([]byte, error) {
 	block, err := aes.NewCipher(deriveKey(key))
@@ -71,6 +71,21 @@ func TestEncryptDecryptBytes(t *testing.T) {
 	assert.Equal(t, "test", string(decrypted))
 }
 
+func TestEncryptDecryptSecret(t *testing.T) {
+	t.Parallel()
+
+	engine := NewEngine("test")
+	encrypted, err := engine.EncryptSecret([]byte("webhook secret"))
+	assert.Nil(t, err)
+	assert.NotContains(t, encrypted, "webhook secret")
+	decrypted, err := engine.DecryptSecret(encrypted)
+	assert.Nil(t, err)
+	assert.Equal(t, "webhook secret", string(decrypted))
+
+	_, err = engine.DecryptSecret("dGVzdA==")
+	assert.Error(t, err, "the secret is shorter than the IV")
+}
+
 func TestGenerateNonce(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,60 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.24.0
+// source: artifact_retention_policies.sql
+
+package db
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/google/uuid"
+)
+
+const getArtifactRetentionPolicy = `-- name: GetArtifactRetentionPolicy :one
+SELECT project_id, keep_last, max_age_days, created_at, updated_at FROM artifact_retention_policies WHERE project_id = $1
+`
+
+func (q *Queries) GetArtifactRetentionPolicy(ctx context.Context, projectID uuid.UUID) (ArtifactRetentionPolicy, error) {
+	row := q.db.QueryRowContext(ctx, getArtifactRetentionPolicy, projectID)
+	var i ArtifactRetentionPolicy
+	err := row.Scan(
+		&i.ProjectID,
+		&i.KeepLast,
+		&i.MaxAgeDays,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const upsertArtifactRetentionPolicy = `-- name: UpsertArtifactRetentionPolicy :one
+INSERT INTO artifact_retention_policies (project_id, keep_last, max_age_days)
+VALUES ($1, $2, $3)
+ON CONFLICT (project_id)
+DO UPDATE SET
+    keep_last = $2,
+    max_age_days = $3,
+    updated_at = NOW()
+RETURNING project_id, keep_last, max_age_days, created_at, updated_at
+`
+
+type UpsertArtifactRetentionPolicyParams struct {
+	ProjectID  uuid.UUID     `json:"project_id"`
+	KeepLast   sql.NullInt32 `json:"keep_last"`
+	MaxAgeDays sql.NullInt32 `json:"max_age_days"`
+}
+
+func (q *Queries) UpsertArtifactRetentionPolicy(ctx context.Context, arg UpsertArtifactRetentionPolicyParams) (ArtifactRetentionPolicy, error) {
+	row := q.db.QueryRowContext(ctx, upsertArtifactRetentionPolicy, arg.ProjectID, arg.KeepLast, arg.MaxAgeDays)
+	var i ArtifactRetentionPolicy
+	err := row.Scan(
+		&i.ProjectID,
+		&i.KeepLast,
+		&i.MaxAgeDays,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
@@ -0,0 +1,150 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.24.0
+// source: artifact_version_evaluations.sql
+
+package db
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+const deleteStaleArtifactVersionEvaluations = `-- name: DeleteStaleArtifactVersionEvaluations :exec
+DELETE FROM artifact_version_evaluations ave
+USING artifact_versions av
+WHERE ave.artifact_version_id = av.id
+AND ave.rule_eval_id = $1
+AND NOT (av.sha = ANY($2::text[]))
+`
+
+type DeleteStaleArtifactVersionEvaluationsParams struct {
+	RuleEvalID uuid.UUID `json:"rule_eval_id"`
+	Shas       []string  `json:"shas"`
+}
+
+// removes the statuses of a rule evaluation for the versions of the artifact
+// that are not among the given digests
+func (q *Queries) DeleteStaleArtifactVersionEvaluations(ctx context.Context, arg DeleteStaleArtifactVersionEvaluationsParams) error {
+	_, err := q.db.ExecContext(ctx, deleteStaleArtifactVersionEvaluations, arg.RuleEvalID, pq.Array(arg.Shas))
+	return err
+}
+
+const listArtifactVersionEvaluations = `-- name: ListArtifactVersionEvaluations :many
+SELECT
+    av.id AS artifact_version_id,
+    av.version,
+    av.sha,
+    av.tags,
+    av.created_at,
+    p.name AS profile_name,
+    rt.name AS rule_type_name,
+    ave.status,
+    ave.details,
+    ave.last_updated
+FROM artifact_version_evaluations ave
+INNER JOIN artifact_versions av ON av.id = ave.artifact_version_id
+INNER JOIN rule_evaluations re ON re.id = ave.rule_eval_id
+INNER JOIN profiles p ON p.id = re.profile_id
+INNER JOIN rule_type rt ON rt.id = re.rule_type_id
+WHERE av.artifact_id = $1
+AND (p.name = $2 OR $2 IS NULL)
+ORDER BY av.created_at DESC, p.name, rt.name
+`
+
+type ListArtifactVersionEvaluationsParams struct {
+	ArtifactID  uuid.UUID      `json:"artifact_id"`
+	ProfileName sql.NullString `json:"profile_name"`
+}
+
+type ListArtifactVersionEvaluationsRow struct {
+	ArtifactVersionID uuid.UUID       `json:"artifact_version_id"`
+	Version           int64           `json:"version"`
+	Sha               string          `json:"sha"`
+	Tags              sql.NullString  `json:"tags"`
+	CreatedAt         time.Time       `json:"created_at"`
+	ProfileName       string          `json:"profile_name"`
+	RuleTypeName      string          `json:"rule_type_name"`
+	Status            EvalStatusTypes `json:"status"`
+	Details           string          `json:"details"`
+	LastUpdated       time.Time       `json:"last_updated"`
+}
+
+// lists the rule evaluation statuses of the versions of an artifact, optionally
+// restricted to a profile
+func (q *Queries) ListArtifactVersionEvaluations(ctx context.Context, arg ListArtifactVersionEvaluationsParams) ([]ListArtifactVersionEvaluationsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listArtifactVersionEvaluations, arg.ArtifactID, arg.ProfileName)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListArtifactVersionEvaluationsRow{}
+	for rows.Next() {
+		var i ListArtifactVersionEvaluationsRow
+		if err := rows.Scan(
+			&i.ArtifactVersionID,
+			&i.Version,
+			&i.Sha,
+			&i.Tags,
+			&i.CreatedAt,
+			&i.ProfileName,
+			&i.RuleTypeName,
+			&i.Status,
+			&i.Details,
+			&i.LastUpdated,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const upsertArtifactVersionEvaluations = `-- name: UpsertArtifactVersionEvaluations :exec
+INSERT INTO artifact_version_evaluations (
+    artifact_version_id,
+    rule_eval_id,
+    status,
+    details,
+    last_updated
+)
+SELECT av.id, $1, $2, $3, NOW()
+FROM artifact_versions av
+WHERE av.artifact_id = $4 AND av.sha = ANY($5::text[])
+ON CONFLICT (artifact_version_id, rule_eval_id)
+    DO UPDATE SET
+        status = EXCLUDED.status,
+        details = EXCLUDED.details,
+        last_updated = NOW()
+`
+
+type UpsertArtifactVersionEvaluationsParams struct {
+	RuleEvalID uuid.UUID       `json:"rule_eval_id"`
+	Status     EvalStatusTypes `json:"status"`
+	Details    string          `json:"details"`
+	ArtifactID uuid.UUID       `json:"artifact_id"`
+	Shas       []string        `json:"shas"`
+}
+
+// records the status of a rule evaluation for the versions of the artifact
+// with the given digests
+func (q *Queries) UpsertArtifactVersionEvaluations(ctx context.Context, arg UpsertArtifactVersionEvaluationsParams) error {
+	_, err := q.db.ExecContext(ctx, upsertArtifactVersionEvaluations,
+		arg.RuleEvalID,
+		arg.Status,
+		arg.Details,
+		arg.ArtifactID,
+		pq.Array(arg.Shas),
+	)
+	return err
+}
@@ -21,10 +21,12 @@ INSERT INTO artifact_versions (
     tags,
     sha,
     signature_verification,
-    github_workflow, created_at) VALUES ($1, $2, $3, $4,
+    github_workflow,
+    provenance, created_at) VALUES ($1, $2, $3, $4,
     $6::jsonb,
     $7::jsonb,
-    $5) RETURNING id, artifact_id, version, tags, sha, signature_verification, github_workflow, created_at
+    $8::jsonb,
+    $5) RETURNING id, artifact_id, version, tags, sha, signature_verification, github_workflow, created_at, provenance
 `
 
 type CreateArtifactVersionParams struct {
@@ -35,6 +37,7 @@ type CreateArtifactVersionParams struct {
 	CreatedAt             time.Time       `json:"created_at"`
 	SignatureVerification json.RawMessage `json:"signature_verification"`
 	GithubWorkflow        json.RawMessage `json:"github_workflow"`
+	Provenance            json.RawMessage `json:"provenance"`
 }
 
 func (q *Queries) CreateArtifactVersion(ctx context.Context, arg CreateArtifactVersionParams) (ArtifactVersion, error) {
@@ -46,6 +49,7 @@ func (q *Queries) CreateArtifactVersion(ctx context.Context, arg CreateArtifactV
 		arg.CreatedAt,
 		arg.SignatureVerification,
 		arg.GithubWorkflow,
+		arg.Provenance,
 	)
 	var i ArtifactVersion
 	err := row.Scan(
@@ -57,6 +61,7 @@ func (q *Queries) CreateArtifactVersion(ctx context.Context, arg CreateArtifactV
 		&i.SignatureVerification,
 		&i.GithubWorkflow,
 		&i.CreatedAt,
+		&i.Provenance,
 	)
 	return i, err
 }
@@ -86,8 +91,61 @@ func (q *Queries) DeleteOldArtifactVersions(ctx context.Context, arg DeleteOldAr
 	return err
 }
 
+const deletePrunableArtifactVersions = `-- name: DeletePrunableArtifactVersions :many
+DELETE FROM artifact_versions
+WHERE artifact_versions.artifact_id = $1
+AND ($2::timestamp IS NOT NULL OR $3::int IS NOT NULL)
+AND ($2::timestamp IS NULL OR artifact_versions.created_at <= $2::timestamp)
+AND ($3::int IS NULL OR artifact_versions.id NOT IN (
+    SELECT kept.id FROM artifact_versions AS kept
+    WHERE kept.artifact_id = $1
+    ORDER BY kept.created_at DESC
+    LIMIT $3::int))
+RETURNING id, artifact_id, version, tags, sha, signature_verification, github_workflow, created_at, provenance
+`
+
+type DeletePrunableArtifactVersionsParams struct {
+	ArtifactID    uuid.UUID     `json:"artifact_id"`
+	CreatedBefore sql.NullTime  `json:"created_before"`
+	KeepLast      sql.NullInt32 `json:"keep_last"`
+}
+
+// deletes the versions ListPrunableArtifactVersions lists
+func (q *Queries) DeletePrunableArtifactVersions(ctx context.Context, arg DeletePrunableArtifactVersionsParams) ([]ArtifactVersion, error) {
+	rows, err := q.db.QueryContext(ctx, deletePrunableArtifactVersions, arg.ArtifactID, arg.CreatedBefore, arg.KeepLast)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ArtifactVersion{}
+	for rows.Next() {
+		var i ArtifactVersion
+		if err := rows.Scan(
+			&i.ID,
+			&i.ArtifactID,
+			&i.Version,
+			&i.Tags,
+			&i.Sha,
+			&i.SignatureVerification,
+			&i.GithubWorkflow,
+			&i.CreatedAt,
+			&i.Provenance,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getArtifactVersionByID = `-- name: GetArtifactVersionByID :one
-SELECT id, artifact_id, version, tags, sha, signature_verification, github_workflow, created_at FROM artifact_versions WHERE id = $1
+SELECT id, artifact_id, version, tags, sha, signature_verification, github_workflow, created_at, provenance FROM artifact_versions WHERE id = $1
 `
 
 func (q *Queries) GetArtifactVersionByID(ctx context.Context, id uuid.UUID) (ArtifactVersion, error) {
@@ -102,12 +160,13 @@ func (q *Queries) GetArtifactVersionByID(ctx context.Context, id uuid.UUID) (Art
 		&i.SignatureVerification,
 		&i.GithubWorkflow,
 		&i.CreatedAt,
+		&i.Provenance,
 	)
 	return i, err
 }
 
 const getArtifactVersionBySha = `-- name: GetArtifactVersionBySha :one
-SELECT id, artifact_id, version, tags, sha, signature_verification, github_workflow, created_at FROM artifact_versions WHERE sha = $1
+SELECT id, artifact_id, version, tags, sha, signature_verification, github_workflow, created_at, provenance FROM artifact_versions WHERE sha = $1
 `
 
 func (q *Queries) GetArtifactVersionBySha(ctx context.Context, sha string) (ArtifactVersion, error) {
@@ -122,12 +181,13 @@ func (q *Queries) GetArtifactVersionBySha(ctx context.Context, sha string) (Arti
 		&i.SignatureVerification,
 		&i.GithubWorkflow,
 		&i.CreatedAt,
+		&i.Provenance,
 	)
 	return i, err
 }
 
 const listArtifactVersionsByArtifactID = `-- name: ListArtifactVersionsByArtifactID :many
-SELECT id, artifact_id, version, tags, sha, signature_verification, github_workflow, created_at FROM artifact_versions
+SELECT id, artifact_id, version, tags, sha, signature_verification, github_workflow, created_at, provenance FROM artifact_versions
 WHERE artifact_id = $1
 ORDER BY created_at DESC
 LIMIT COALESCE($2::int, 2147483647)
@@ -156,6 +216,7 @@ func (q *Queries) ListArtifactVersionsByArtifactID(ctx context.Context, arg List
 			&i.SignatureVerification,
 			&i.GithubWorkflow,
 			&i.CreatedAt,
+			&i.Provenance,
 		); err != nil {
 			return nil, err
 		}
@@ -171,7 +232,7 @@ func (q *Queries) ListArtifactVersionsByArtifactID(ctx context.Context, arg List
 }
 
 const listArtifactVersionsByArtifactIDAndTag = `-- name: ListArtifactVersionsByArtifactIDAndTag :many
-SELECT id, artifact_id, version, tags, sha, signature_verification, github_workflow, created_at FROM artifact_versions
+SELECT id, artifact_id, version, tags, sha, signature_verification, github_workflow, created_at, provenance FROM artifact_versions
 WHERE artifact_id = $1
 AND $2=ANY(STRING_TO_ARRAY(tags, ','))
 ORDER BY created_at DESC
@@ -202,6 +263,61 @@ func (q *Queries) ListArtifactVersionsByArtifactIDAndTag(ctx context.Context, ar
 			&i.SignatureVerification,
 			&i.GithubWorkflow,
 			&i.CreatedAt,
+			&i.Provenance,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPrunableArtifactVersions = `-- name: ListPrunableArtifactVersions :many
+SELECT id, artifact_id, version, tags, sha, signature_verification, github_workflow, created_at, provenance FROM artifact_versions
+WHERE artifact_versions.artifact_id = $1
+AND ($2::timestamp IS NOT NULL OR $3::int IS NOT NULL)
+AND ($2::timestamp IS NULL OR artifact_versions.created_at <= $2::timestamp)
+AND ($3::int IS NULL OR artifact_versions.id NOT IN (
+    SELECT kept.id FROM artifact_versions AS kept
+    WHERE kept.artifact_id = $1
+    ORDER BY kept.created_at DESC
+    LIMIT $3::int))
+ORDER BY artifact_versions.created_at DESC
+`
+
+type ListPrunableArtifactVersionsParams struct {
+	ArtifactID    uuid.UUID     `json:"artifact_id"`
+	CreatedBefore sql.NullTime  `json:"created_before"`
+	KeepLast      sql.NullInt32 `json:"keep_last"`
+}
+
+// versions older than created_before that are not among the keep_last most
+// recent ones, a NULL argument means no limit
+func (q *Queries) ListPrunableArtifactVersions(ctx context.Context, arg ListPrunableArtifactVersionsParams) ([]ArtifactVersion, error) {
+	rows, err := q.db.QueryContext(ctx, listPrunableArtifactVersions, arg.ArtifactID, arg.CreatedBefore, arg.KeepLast)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ArtifactVersion{}
+	for rows.Next() {
+		var i ArtifactVersion
+		if err := rows.Scan(
+			&i.ID,
+			&i.ArtifactID,
+			&i.Version,
+			&i.Tags,
+			&i.Sha,
+			&i.SignatureVerification,
+			&i.GithubWorkflow,
+			&i.CreatedAt,
+			&i.Provenance,
 		); err != nil {
 			return nil, err
 		}
@@ -224,10 +340,12 @@ INSERT INTO artifact_versions (
     sha,
     signature_verification,
     github_workflow,
+    provenance,
     created_at
 ) VALUES ($1, $2, $3, $4,
     $6::jsonb,
     $7::jsonb,
+    $8::jsonb,
     $5)
 ON CONFLICT (artifact_id, sha)
 DO UPDATE SET
@@ -235,9 +353,10 @@ DO UPDATE SET
     tags = $3,
     signature_verification = $6::jsonb,
     github_workflow = $7::jsonb,
+    provenance = $8::jsonb,
     created_at = $5
 WHERE artifact_versions.artifact_id = $1 AND artifact_versions.sha = $4
-RETURNING id, artifact_id, version, tags, sha, signature_verification, github_workflow, created_at
+RETURNING id, artifact_id, version, tags, sha, signature_verification, github_workflow, created_at, provenance
 `
 
 type UpsertArtifactVersionParams struct {
@@ -248,6 +367,7 @@ type UpsertArtifactVersionParams struct {
 	CreatedAt             time.Time       `json:"created_at"`
 	SignatureVerification json.RawMessage `json:"signature_verification"`
 	GithubWorkflow        json.RawMessage `json:"github_workflow"`
+	Provenance            json.RawMessage `json:"provenance"`
 }
 
 func (q *Queries) UpsertArtifactVersion(ctx context.Context, arg UpsertArtifactVersionParams) (ArtifactVersion, error) {
@@ -259,6 +379,7 @@ func (q *Queries) UpsertArtifactVersion(ctx context.Context, arg UpsertArtifactV
 		arg.CreatedAt,
 		arg.SignatureVerification,
 		arg.GithubWorkflow,
+		arg.Provenance,
 	)
 	var i ArtifactVersion
 	err := row.Scan(
@@ -270,6 +391,7 @@ func (q *Queries) UpsertArtifactVersion(ctx context.Context, arg UpsertArtifactV
 		&i.SignatureVerification,
 		&i.GithubWorkflow,
 		&i.CreatedAt,
+		&i.Provenance,
 	)
 	return i, err
 }
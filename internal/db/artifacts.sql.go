@@ -17,7 +17,7 @@ INSERT INTO artifacts (
     repository_id,
     artifact_name,
     artifact_type,
-    artifact_visibility) VALUES ($1, $2, $3, $4) RETURNING id, repository_id, artifact_name, artifact_type, artifact_visibility, created_at, updated_at
+    artifact_visibility) VALUES ($1, $2, $3, $4) RETURNING id, repository_id, artifact_name, artifact_type, artifact_visibility, created_at, updated_at, provider
 `
 
 type CreateArtifactParams struct {
@@ -43,6 +43,7 @@ func (q *Queries) CreateArtifact(ctx context.Context, arg CreateArtifactParams)
 		&i.ArtifactVisibility,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Provider,
 	)
 	return i, err
 }
@@ -59,7 +60,7 @@ func (q *Queries) DeleteArtifact(ctx context.Context, id uuid.UUID) error {
 
 const getArtifactByID = `-- name: GetArtifactByID :one
 SELECT artifacts.id, artifacts.repository_id, artifacts.artifact_name, artifacts.artifact_type,
-artifacts.artifact_visibility, artifacts.created_at,
+artifacts.artifact_visibility, artifacts.created_at, artifacts.provider AS artifact_provider,
 repositories.provider, repositories.project_id, repositories.repo_owner, repositories.repo_name
 FROM artifacts INNER JOIN repositories ON repositories.id = artifacts.repository_id
 WHERE artifacts.id = $1
@@ -72,6 +73,7 @@ type GetArtifactByIDRow struct {
 	ArtifactType       string    `json:"artifact_type"`
 	ArtifactVisibility string    `json:"artifact_visibility"`
 	CreatedAt          time.Time `json:"created_at"`
+	ArtifactProvider   string    `json:"artifact_provider"`
 	Provider           string    `json:"provider"`
 	ProjectID          uuid.UUID `json:"project_id"`
 	RepoOwner          string    `json:"repo_owner"`
@@ -88,6 +90,7 @@ func (q *Queries) GetArtifactByID(ctx context.Context, id uuid.UUID) (GetArtifac
 		&i.ArtifactType,
 		&i.ArtifactVisibility,
 		&i.CreatedAt,
+		&i.ArtifactProvider,
 		&i.Provider,
 		&i.ProjectID,
 		&i.RepoOwner,
@@ -96,8 +99,91 @@ func (q *Queries) GetArtifactByID(ctx context.Context, id uuid.UUID) (GetArtifac
 	return i, err
 }
 
+const listArtifactsByProjectID = `-- name: ListArtifactsByProjectID :many
+SELECT artifacts.id, artifacts.repository_id, artifacts.artifact_name, artifacts.artifact_type, artifacts.artifact_visibility, artifacts.created_at, artifacts.updated_at, artifacts.provider FROM artifacts
+INNER JOIN repositories ON repositories.id = artifacts.repository_id
+WHERE repositories.project_id = $1
+ORDER BY artifacts.id
+`
+
+func (q *Queries) ListArtifactsByProjectID(ctx context.Context, projectID uuid.UUID) ([]Artifact, error) {
+	rows, err := q.db.QueryContext(ctx, listArtifactsByProjectID, projectID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Artifact{}
+	for rows.Next() {
+		var i Artifact
+		if err := rows.Scan(
+			&i.ID,
+			&i.RepositoryID,
+			&i.ArtifactName,
+			&i.ArtifactType,
+			&i.ArtifactVisibility,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Provider,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listArtifactsByProvider = `-- name: ListArtifactsByProvider :many
+SELECT artifacts.id, artifacts.repository_id, artifacts.artifact_name, artifacts.artifact_type, artifacts.artifact_visibility, artifacts.created_at, artifacts.updated_at, artifacts.provider FROM artifacts
+INNER JOIN repositories ON repositories.id = artifacts.repository_id
+WHERE repositories.project_id = $1 AND artifacts.provider = $2
+ORDER BY artifacts.id
+`
+
+type ListArtifactsByProviderParams struct {
+	ProjectID uuid.UUID `json:"project_id"`
+	Provider  string    `json:"provider"`
+}
+
+func (q *Queries) ListArtifactsByProvider(ctx context.Context, arg ListArtifactsByProviderParams) ([]Artifact, error) {
+	rows, err := q.db.QueryContext(ctx, listArtifactsByProvider, arg.ProjectID, arg.Provider)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Artifact{}
+	for rows.Next() {
+		var i Artifact
+		if err := rows.Scan(
+			&i.ID,
+			&i.RepositoryID,
+			&i.ArtifactName,
+			&i.ArtifactType,
+			&i.ArtifactVisibility,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Provider,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listArtifactsByRepoID = `-- name: ListArtifactsByRepoID :many
-SELECT id, repository_id, artifact_name, artifact_type, artifact_visibility, created_at, updated_at FROM artifacts
+SELECT id, repository_id, artifact_name, artifact_type, artifact_visibility, created_at, updated_at, provider FROM artifacts
 WHERE repository_id = $1
 ORDER BY id
 `
@@ -119,6 +205,7 @@ func (q *Queries) ListArtifactsByRepoID(ctx context.Context, repositoryID uuid.U
 			&i.ArtifactVisibility,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.Provider,
 		); err != nil {
 			return nil, err
 		}
@@ -138,14 +225,16 @@ INSERT INTO artifacts (
     repository_id,
     artifact_name,
     artifact_type,
-    artifact_visibility
-) VALUES ($1, $2, $3, $4)
+    artifact_visibility,
+    provider
+) VALUES ($1, $2, $3, $4, $5)
 ON CONFLICT (repository_id, LOWER(artifact_name))
 DO UPDATE SET
     artifact_type = $3,
-    artifact_visibility = $4
+    artifact_visibility = $4,
+    provider = $5
 WHERE artifacts.repository_id = $1 AND artifacts.artifact_name = $2
-RETURNING id, repository_id, artifact_name, artifact_type, artifact_visibility, created_at, updated_at
+RETURNING id, repository_id, artifact_name, artifact_type, artifact_visibility, created_at, updated_at, provider
 `
 
 type UpsertArtifactParams struct {
@@ -153,6 +242,7 @@ type UpsertArtifactParams struct {
 	ArtifactName       string    `json:"artifact_name"`
 	ArtifactType       string    `json:"artifact_type"`
 	ArtifactVisibility string    `json:"artifact_visibility"`
+	Provider           string    `json:"provider"`
 }
 
 func (q *Queries) UpsertArtifact(ctx context.Context, arg UpsertArtifactParams) (Artifact, error) {
@@ -161,6 +251,7 @@ func (q *Queries) UpsertArtifact(ctx context.Context, arg UpsertArtifactParams)
 		arg.ArtifactName,
 		arg.ArtifactType,
 		arg.ArtifactVisibility,
+		arg.Provider,
 	)
 	var i Artifact
 	err := row.Scan(
@@ -171,6 +262,7 @@ func (q *Queries) UpsertArtifact(ctx context.Context, arg UpsertArtifactParams)
 		&i.ArtifactVisibility,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Provider,
 	)
 	return i, err
 }
@@ -0,0 +1,127 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.24.0
+// source: build_environments.sql
+
+package db
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/google/uuid"
+)
+
+const deleteBuildEnvironment = `-- name: DeleteBuildEnvironment :exec
+DELETE FROM build_environments
+WHERE id = $1
+`
+
+func (q *Queries) DeleteBuildEnvironment(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, deleteBuildEnvironment, id)
+	return err
+}
+
+const deleteBuildEnvironmentByName = `-- name: DeleteBuildEnvironmentByName :exec
+DELETE FROM build_environments
+WHERE repository_id = $1 AND name = $2
+`
+
+type DeleteBuildEnvironmentByNameParams struct {
+	RepositoryID uuid.UUID `json:"repository_id"`
+	Name         string    `json:"name"`
+}
+
+func (q *Queries) DeleteBuildEnvironmentByName(ctx context.Context, arg DeleteBuildEnvironmentByNameParams) error {
+	_, err := q.db.ExecContext(ctx, deleteBuildEnvironmentByName, arg.RepositoryID, arg.Name)
+	return err
+}
+
+const getBuildEnvironmentByID = `-- name: GetBuildEnvironmentByID :one
+SELECT id, repository_id, name, properties, created_at, updated_at FROM build_environments
+WHERE id = $1
+`
+
+func (q *Queries) GetBuildEnvironmentByID(ctx context.Context, id uuid.UUID) (BuildEnvironment, error) {
+	row := q.db.QueryRowContext(ctx, getBuildEnvironmentByID, id)
+	var i BuildEnvironment
+	err := row.Scan(
+		&i.ID,
+		&i.RepositoryID,
+		&i.Name,
+		&i.Properties,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const listBuildEnvironmentsByRepositoryID = `-- name: ListBuildEnvironmentsByRepositoryID :many
+SELECT id, repository_id, name, properties, created_at, updated_at FROM build_environments
+WHERE repository_id = $1
+ORDER BY name
+`
+
+func (q *Queries) ListBuildEnvironmentsByRepositoryID(ctx context.Context, repositoryID uuid.UUID) ([]BuildEnvironment, error) {
+	rows, err := q.db.QueryContext(ctx, listBuildEnvironmentsByRepositoryID, repositoryID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []BuildEnvironment{}
+	for rows.Next() {
+		var i BuildEnvironment
+		if err := rows.Scan(
+			&i.ID,
+			&i.RepositoryID,
+			&i.Name,
+			&i.Properties,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const upsertBuildEnvironment = `-- name: UpsertBuildEnvironment :one
+INSERT INTO build_environments (
+    repository_id,
+    name,
+    properties
+) VALUES ($1, $2, $3::jsonb)
+ON CONFLICT (repository_id, name)
+DO UPDATE SET
+    properties = $3::jsonb,
+    updated_at = NOW()
+WHERE build_environments.repository_id = $1 AND build_environments.name = $2
+RETURNING id, repository_id, name, properties, created_at, updated_at
+`
+
+type UpsertBuildEnvironmentParams struct {
+	RepositoryID uuid.UUID       `json:"repository_id"`
+	Name         string          `json:"name"`
+	Properties   json.RawMessage `json:"properties"`
+}
+
+func (q *Queries) UpsertBuildEnvironment(ctx context.Context, arg UpsertBuildEnvironmentParams) (BuildEnvironment, error) {
+	row := q.db.QueryRowContext(ctx, upsertBuildEnvironment, arg.RepositoryID, arg.Name, arg.Properties)
+	var i BuildEnvironment
+	err := row.Scan(
+		&i.ID,
+		&i.RepositoryID,
+		&i.Name,
+		&i.Properties,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
@@ -16,22 +16,25 @@ INSERT INTO flush_cache(
     entity,
     repository_id,
     artifact_id,
-    pull_request_id
+    pull_request_id,
+    build_environment_id
 ) VALUES(
     $1::entities,
     $2::UUID,
     $3::UUID,
-    $4::UUID
-) ON CONFLICT(entity, repository_id, COALESCE(artifact_id, '00000000-0000-0000-0000-000000000000'::UUID), COALESCE(pull_request_id, '00000000-0000-0000-0000-000000000000'::UUID))
+    $4::UUID,
+    $5::UUID
+) ON CONFLICT(entity, repository_id, COALESCE(artifact_id, '00000000-0000-0000-0000-000000000000'::UUID), COALESCE(pull_request_id, '00000000-0000-0000-0000-000000000000'::UUID), COALESCE(build_environment_id, '00000000-0000-0000-0000-000000000000'::UUID))
 DO NOTHING
-RETURNING id, entity, repository_id, artifact_id, pull_request_id, queued_at
+RETURNING id, entity, repository_id, artifact_id, pull_request_id, queued_at, build_environment_id
 `
 
 type EnqueueFlushParams struct {
-	Entity        Entities      `json:"entity"`
-	RepositoryID  uuid.UUID     `json:"repository_id"`
-	ArtifactID    uuid.NullUUID `json:"artifact_id"`
-	PullRequestID uuid.NullUUID `json:"pull_request_id"`
+	Entity             Entities      `json:"entity"`
+	RepositoryID       uuid.UUID     `json:"repository_id"`
+	ArtifactID         uuid.NullUUID `json:"artifact_id"`
+	PullRequestID      uuid.NullUUID `json:"pull_request_id"`
+	BuildEnvironmentID uuid.NullUUID `json:"build_environment_id"`
 }
 
 func (q *Queries) EnqueueFlush(ctx context.Context, arg EnqueueFlushParams) (FlushCache, error) {
@@ -40,6 +43,7 @@ func (q *Queries) EnqueueFlush(ctx context.Context, arg EnqueueFlushParams) (Flu
 		arg.RepositoryID,
 		arg.ArtifactID,
 		arg.PullRequestID,
+		arg.BuildEnvironmentID,
 	)
 	var i FlushCache
 	err := row.Scan(
@@ -49,6 +53,7 @@ func (q *Queries) EnqueueFlush(ctx context.Context, arg EnqueueFlushParams) (Flu
 		&i.ArtifactID,
 		&i.PullRequestID,
 		&i.QueuedAt,
+		&i.BuildEnvironmentID,
 	)
 	return i, err
 }
@@ -57,15 +62,17 @@ const flushCache = `-- name: FlushCache :one
 DELETE FROM flush_cache
 WHERE entity = $1 AND repository_id = $2 AND
     COALESCE(artifact_id, '00000000-0000-0000-0000-000000000000'::UUID) = COALESCE($3::UUID, '00000000-0000-0000-0000-000000000000'::UUID) AND
-    COALESCE(pull_request_id, '00000000-0000-0000-0000-000000000000'::UUID) = COALESCE($4::UUID, '00000000-0000-0000-0000-000000000000'::UUID)
-RETURNING id, entity, repository_id, artifact_id, pull_request_id, queued_at
+    COALESCE(pull_request_id, '00000000-0000-0000-0000-000000000000'::UUID) = COALESCE($4::UUID, '00000000-0000-0000-0000-000000000000'::UUID) AND
+    COALESCE(build_environment_id, '00000000-0000-0000-0000-000000000000'::UUID) = COALESCE($5::UUID, '00000000-0000-0000-0000-000000000000'::UUID)
+RETURNING id, entity, repository_id, artifact_id, pull_request_id, queued_at, build_environment_id
 `
 
 type FlushCacheParams struct {
-	Entity        Entities      `json:"entity"`
-	RepositoryID  uuid.UUID     `json:"repository_id"`
-	ArtifactID    uuid.NullUUID `json:"artifact_id"`
-	PullRequestID uuid.NullUUID `json:"pull_request_id"`
+	Entity             Entities      `json:"entity"`
+	RepositoryID       uuid.UUID     `json:"repository_id"`
+	ArtifactID         uuid.NullUUID `json:"artifact_id"`
+	PullRequestID      uuid.NullUUID `json:"pull_request_id"`
+	BuildEnvironmentID uuid.NullUUID `json:"build_environment_id"`
 }
 
 func (q *Queries) FlushCache(ctx context.Context, arg FlushCacheParams) (FlushCache, error) {
@@ -74,6 +81,7 @@ func (q *Queries) FlushCache(ctx context.Context, arg FlushCacheParams) (FlushCa
 		arg.RepositoryID,
 		arg.ArtifactID,
 		arg.PullRequestID,
+		arg.BuildEnvironmentID,
 	)
 	var i FlushCache
 	err := row.Scan(
@@ -83,12 +91,13 @@ func (q *Queries) FlushCache(ctx context.Context, arg FlushCacheParams) (FlushCa
 		&i.ArtifactID,
 		&i.PullRequestID,
 		&i.QueuedAt,
+		&i.BuildEnvironmentID,
 	)
 	return i, err
 }
 
 const listFlushCache = `-- name: ListFlushCache :many
-SELECT id, entity, repository_id, artifact_id, pull_request_id, queued_at FROM flush_cache
+SELECT id, entity, repository_id, artifact_id, pull_request_id, queued_at, build_environment_id FROM flush_cache
 `
 
 func (q *Queries) ListFlushCache(ctx context.Context) ([]FlushCache, error) {
@@ -107,6 +116,7 @@ func (q *Queries) ListFlushCache(ctx context.Context) ([]FlushCache, error) {
 			&i.ArtifactID,
 			&i.PullRequestID,
 			&i.QueuedAt,
+			&i.BuildEnvironmentID,
 		); err != nil {
 			return nil, err
 		}
@@ -129,28 +139,31 @@ INSERT INTO entity_execution_lock(
     last_lock_time,
     repository_id,
     artifact_id,
-    pull_request_id
+    pull_request_id,
+    build_environment_id
 ) VALUES(
     $1::entities,
     gen_random_uuid(),
     NOW(),
     $2::UUID,
     $3::UUID,
-    $4::UUID
-) ON CONFLICT(entity, repository_id, COALESCE(artifact_id, '00000000-0000-0000-0000-000000000000'::UUID), COALESCE(pull_request_id, '00000000-0000-0000-0000-000000000000'::UUID))
+    $4::UUID,
+    $5::UUID
+) ON CONFLICT(entity, repository_id, COALESCE(artifact_id, '00000000-0000-0000-0000-000000000000'::UUID), COALESCE(pull_request_id, '00000000-0000-0000-0000-000000000000'::UUID), COALESCE(build_environment_id, '00000000-0000-0000-0000-000000000000'::UUID))
 DO UPDATE SET
     locked_by = gen_random_uuid(),
     last_lock_time = NOW()
-WHERE entity_execution_lock.last_lock_time < (NOW() - ($5::TEXT || ' seconds')::interval)
-RETURNING id, entity, locked_by, last_lock_time, repository_id, artifact_id, pull_request_id
+WHERE entity_execution_lock.last_lock_time < (NOW() - ($6::TEXT || ' seconds')::interval)
+RETURNING id, entity, locked_by, last_lock_time, repository_id, artifact_id, pull_request_id, build_environment_id
 `
 
 type LockIfThresholdNotExceededParams struct {
-	Entity        Entities      `json:"entity"`
-	RepositoryID  uuid.UUID     `json:"repository_id"`
-	ArtifactID    uuid.NullUUID `json:"artifact_id"`
-	PullRequestID uuid.NullUUID `json:"pull_request_id"`
-	Interval      string        `json:"interval"`
+	Entity             Entities      `json:"entity"`
+	RepositoryID       uuid.UUID     `json:"repository_id"`
+	ArtifactID         uuid.NullUUID `json:"artifact_id"`
+	PullRequestID      uuid.NullUUID `json:"pull_request_id"`
+	BuildEnvironmentID uuid.NullUUID `json:"build_environment_id"`
+	Interval           string        `json:"interval"`
 }
 
 // LockIfThresholdNotExceeded is used to lock an entity for execution. It will
@@ -164,6 +177,7 @@ func (q *Queries) LockIfThresholdNotExceeded(ctx context.Context, arg LockIfThre
 		arg.RepositoryID,
 		arg.ArtifactID,
 		arg.PullRequestID,
+		arg.BuildEnvironmentID,
 		arg.Interval,
 	)
 	var i EntityExecutionLock
@@ -175,6 +189,7 @@ func (q *Queries) LockIfThresholdNotExceeded(ctx context.Context, arg LockIfThre
 		&i.RepositoryID,
 		&i.ArtifactID,
 		&i.PullRequestID,
+		&i.BuildEnvironmentID,
 	)
 	return i, err
 }
@@ -185,15 +200,17 @@ DELETE FROM entity_execution_lock
 WHERE entity = $1::entities AND repository_id = $2::UUID AND
     COALESCE(artifact_id, '00000000-0000-0000-0000-000000000000'::UUID) = COALESCE($3::UUID, '00000000-0000-0000-0000-000000000000'::UUID) AND
     COALESCE(pull_request_id, '00000000-0000-0000-0000-000000000000'::UUID) = COALESCE($4::UUID, '00000000-0000-0000-0000-000000000000'::UUID) AND
-    locked_by = $5::UUID
+    COALESCE(build_environment_id, '00000000-0000-0000-0000-000000000000'::UUID) = COALESCE($5::UUID, '00000000-0000-0000-0000-000000000000'::UUID) AND
+    locked_by = $6::UUID
 `
 
 type ReleaseLockParams struct {
-	Entity        Entities      `json:"entity"`
-	RepositoryID  uuid.UUID     `json:"repository_id"`
-	ArtifactID    uuid.NullUUID `json:"artifact_id"`
-	PullRequestID uuid.NullUUID `json:"pull_request_id"`
-	LockedBy      uuid.UUID     `json:"locked_by"`
+	Entity             Entities      `json:"entity"`
+	RepositoryID       uuid.UUID     `json:"repository_id"`
+	ArtifactID         uuid.NullUUID `json:"artifact_id"`
+	PullRequestID      uuid.NullUUID `json:"pull_request_id"`
+	BuildEnvironmentID uuid.NullUUID `json:"build_environment_id"`
+	LockedBy           uuid.UUID     `json:"locked_by"`
 }
 
 // ReleaseLock is used to release a lock on an entity. It will delete the
@@ -205,6 +222,7 @@ func (q *Queries) ReleaseLock(ctx context.Context, arg ReleaseLockParams) error
 		arg.RepositoryID,
 		arg.ArtifactID,
 		arg.PullRequestID,
+		arg.BuildEnvironmentID,
 		arg.LockedBy,
 	)
 	return err
@@ -215,15 +233,17 @@ UPDATE entity_execution_lock SET last_lock_time = NOW()
 WHERE entity = $1 AND repository_id = $2 AND
 COALESCE(artifact_id, '00000000-0000-0000-0000-000000000000'::UUID) = COALESCE($3::UUID, '00000000-0000-0000-0000-000000000000'::UUID) AND
 COALESCE(pull_request_id, '00000000-0000-0000-0000-000000000000'::UUID) = COALESCE($4::UUID, '00000000-0000-0000-0000-000000000000'::UUID) AND
-locked_by = $5::UUID
+COALESCE(build_environment_id, '00000000-0000-0000-0000-000000000000'::UUID) = COALESCE($5::UUID, '00000000-0000-0000-0000-000000000000'::UUID) AND
+locked_by = $6::UUID
 `
 
 type UpdateLeaseParams struct {
-	Entity        Entities      `json:"entity"`
-	RepositoryID  uuid.UUID     `json:"repository_id"`
-	ArtifactID    uuid.NullUUID `json:"artifact_id"`
-	PullRequestID uuid.NullUUID `json:"pull_request_id"`
-	LockedBy      uuid.UUID     `json:"locked_by"`
+	Entity             Entities      `json:"entity"`
+	RepositoryID       uuid.UUID     `json:"repository_id"`
+	ArtifactID         uuid.NullUUID `json:"artifact_id"`
+	PullRequestID      uuid.NullUUID `json:"pull_request_id"`
+	BuildEnvironmentID uuid.NullUUID `json:"build_environment_id"`
+	LockedBy           uuid.UUID     `json:"locked_by"`
 }
 
 func (q *Queries) UpdateLease(ctx context.Context, arg UpdateLeaseParams) error {
@@ -232,6 +252,7 @@ func (q *Queries) UpdateLease(ctx context.Context, arg UpdateLeaseParams) error
 		arg.RepositoryID,
 		arg.ArtifactID,
 		arg.PullRequestID,
+		arg.BuildEnvironmentID,
 		arg.LockedBy,
 	)
 	return err
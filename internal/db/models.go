@@ -18,9 +18,10 @@ import (
 type ActionType string
 
 const (
-	ActionTypeOn     ActionType = "on"
-	ActionTypeOff    ActionType = "off"
-	ActionTypeDryRun ActionType = "dry_run"
+	ActionTypeOn               ActionType = "on"
+	ActionTypeOff              ActionType = "off"
+	ActionTypeDryRun           ActionType = "dry_run"
+	ActionTypeApprovalRequired ActionType = "approval_required"
 )
 
 func (e *ActionType) Scan(src interface{}) error {
@@ -237,6 +238,51 @@ func (ns NullProviderType) Value() (driver.Value, error) {
 	return string(ns.ProviderType), nil
 }
 
+type RemediationApprovalStatus string
+
+const (
+	RemediationApprovalStatusPending  RemediationApprovalStatus = "pending"
+	RemediationApprovalStatusApplied  RemediationApprovalStatus = "applied"
+	RemediationApprovalStatusRejected RemediationApprovalStatus = "rejected"
+	RemediationApprovalStatusFailed   RemediationApprovalStatus = "failed"
+	RemediationApprovalStatusApplying RemediationApprovalStatus = "applying"
+)
+
+func (e *RemediationApprovalStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = RemediationApprovalStatus(s)
+	case string:
+		*e = RemediationApprovalStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for RemediationApprovalStatus: %T", src)
+	}
+	return nil
+}
+
+type NullRemediationApprovalStatus struct {
+	RemediationApprovalStatus RemediationApprovalStatus `json:"remediation_approval_status"`
+	Valid                     bool                      `json:"valid"` // Valid is true if RemediationApprovalStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullRemediationApprovalStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.RemediationApprovalStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.RemediationApprovalStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullRemediationApprovalStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.RemediationApprovalStatus), nil
+}
+
 type RemediationStatusTypes string
 
 const (
@@ -245,6 +291,7 @@ const (
 	RemediationStatusTypesError        RemediationStatusTypes = "error"
 	RemediationStatusTypesSkipped      RemediationStatusTypes = "skipped"
 	RemediationStatusTypesNotAvailable RemediationStatusTypes = "not_available"
+	RemediationStatusTypesPending      RemediationStatusTypes = "pending"
 )
 
 func (e *RemediationStatusTypes) Scan(src interface{}) error {
@@ -290,6 +337,15 @@ type Artifact struct {
 	ArtifactVisibility string    `json:"artifact_visibility"`
 	CreatedAt          time.Time `json:"created_at"`
 	UpdatedAt          time.Time `json:"updated_at"`
+	Provider           string    `json:"provider"`
+}
+
+type ArtifactRetentionPolicy struct {
+	ProjectID  uuid.UUID     `json:"project_id"`
+	KeepLast   sql.NullInt32 `json:"keep_last"`
+	MaxAgeDays sql.NullInt32 `json:"max_age_days"`
+	CreatedAt  time.Time     `json:"created_at"`
+	UpdatedAt  time.Time     `json:"updated_at"`
 }
 
 type ArtifactVersion struct {
@@ -301,6 +357,24 @@ type ArtifactVersion struct {
 	SignatureVerification pqtype.NullRawMessage `json:"signature_verification"`
 	GithubWorkflow        pqtype.NullRawMessage `json:"github_workflow"`
 	CreatedAt             time.Time             `json:"created_at"`
+	Provenance            pqtype.NullRawMessage `json:"provenance"`
+}
+
+type ArtifactVersionEvaluation struct {
+	ArtifactVersionID uuid.UUID       `json:"artifact_version_id"`
+	RuleEvalID        uuid.UUID       `json:"rule_eval_id"`
+	Status            EvalStatusTypes `json:"status"`
+	Details           string          `json:"details"`
+	LastUpdated       time.Time       `json:"last_updated"`
+}
+
+type BuildEnvironment struct {
+	ID           uuid.UUID       `json:"id"`
+	RepositoryID uuid.UUID       `json:"repository_id"`
+	Name         string          `json:"name"`
+	Properties   json.RawMessage `json:"properties"`
+	CreatedAt    time.Time       `json:"created_at"`
+	UpdatedAt    time.Time       `json:"updated_at"`
 }
 
 type Entitlement struct {
@@ -311,13 +385,14 @@ type Entitlement struct {
 }
 
 type EntityExecutionLock struct {
-	ID            uuid.UUID     `json:"id"`
-	Entity        Entities      `json:"entity"`
-	LockedBy      uuid.UUID     `json:"locked_by"`
-	LastLockTime  time.Time     `json:"last_lock_time"`
-	RepositoryID  uuid.UUID     `json:"repository_id"`
-	ArtifactID    uuid.NullUUID `json:"artifact_id"`
-	PullRequestID uuid.NullUUID `json:"pull_request_id"`
+	ID                 uuid.UUID     `json:"id"`
+	Entity             Entities      `json:"entity"`
+	LockedBy           uuid.UUID     `json:"locked_by"`
+	LastLockTime       time.Time     `json:"last_lock_time"`
+	RepositoryID       uuid.UUID     `json:"repository_id"`
+	ArtifactID         uuid.NullUUID `json:"artifact_id"`
+	PullRequestID      uuid.NullUUID `json:"pull_request_id"`
+	BuildEnvironmentID uuid.NullUUID `json:"build_environment_id"`
 }
 
 type EntityProfile struct {
@@ -344,12 +419,13 @@ type Feature struct {
 }
 
 type FlushCache struct {
-	ID            uuid.UUID     `json:"id"`
-	Entity        Entities      `json:"entity"`
-	RepositoryID  uuid.UUID     `json:"repository_id"`
-	ArtifactID    uuid.NullUUID `json:"artifact_id"`
-	PullRequestID uuid.NullUUID `json:"pull_request_id"`
-	QueuedAt      time.Time     `json:"queued_at"`
+	ID                 uuid.UUID     `json:"id"`
+	Entity             Entities      `json:"entity"`
+	RepositoryID       uuid.UUID     `json:"repository_id"`
+	ArtifactID         uuid.NullUUID `json:"artifact_id"`
+	PullRequestID      uuid.NullUUID `json:"pull_request_id"`
+	QueuedAt           time.Time     `json:"queued_at"`
+	BuildEnvironmentID uuid.NullUUID `json:"build_environment_id"`
 }
 
 type Profile struct {
@@ -410,21 +486,59 @@ type PullRequest struct {
 	UpdatedAt    time.Time `json:"updated_at"`
 }
 
+type RemediationApproval struct {
+	ID         uuid.UUID                 `json:"id"`
+	RuleEvalID uuid.UUID                 `json:"rule_eval_id"`
+	Method     string                    `json:"method"`
+	Endpoint   string                    `json:"endpoint"`
+	Body       string                    `json:"body"`
+	Status     RemediationApprovalStatus `json:"status"`
+	Details    string                    `json:"details"`
+	DecidedBy  sql.NullString            `json:"decided_by"`
+	DecidedAt  sql.NullTime              `json:"decided_at"`
+	CreatedAt  time.Time                 `json:"created_at"`
+	UpdatedAt  time.Time                 `json:"updated_at"`
+}
+
+type RemediationPullRequest struct {
+	ID           uuid.UUID `json:"id"`
+	RuleEvalID   uuid.UUID `json:"rule_eval_id"`
+	RepositoryID uuid.UUID `json:"repository_id"`
+	PrNumber     int64     `json:"pr_number"`
+	Branch       string    `json:"branch"`
+	ContentSha   string    `json:"content_sha"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
 type Repository struct {
-	ID         uuid.UUID     `json:"id"`
-	Provider   string        `json:"provider"`
-	ProjectID  uuid.UUID     `json:"project_id"`
-	RepoOwner  string        `json:"repo_owner"`
-	RepoName   string        `json:"repo_name"`
-	RepoID     int32         `json:"repo_id"`
-	IsPrivate  bool          `json:"is_private"`
-	IsFork     bool          `json:"is_fork"`
-	WebhookID  sql.NullInt32 `json:"webhook_id"`
-	WebhookUrl string        `json:"webhook_url"`
-	DeployUrl  string        `json:"deploy_url"`
-	CloneUrl   string        `json:"clone_url"`
-	CreatedAt  time.Time     `json:"created_at"`
-	UpdatedAt  time.Time     `json:"updated_at"`
+	ID            uuid.UUID     `json:"id"`
+	Provider      string        `json:"provider"`
+	ProjectID     uuid.UUID     `json:"project_id"`
+	RepoOwner     string        `json:"repo_owner"`
+	RepoName      string        `json:"repo_name"`
+	RepoID        int32         `json:"repo_id"`
+	IsPrivate     bool          `json:"is_private"`
+	IsFork        bool          `json:"is_fork"`
+	WebhookID     sql.NullInt32 `json:"webhook_id"`
+	WebhookUrl    string        `json:"webhook_url"`
+	DeployUrl     string        `json:"deploy_url"`
+	CloneUrl      string        `json:"clone_url"`
+	CreatedAt     time.Time     `json:"created_at"`
+	UpdatedAt     time.Time     `json:"updated_at"`
+	DefaultBranch string        `json:"default_branch"`
+}
+
+type RepositoryDependency struct {
+	ID            uuid.UUID     `json:"id"`
+	RepositoryID  uuid.UUID     `json:"repository_id"`
+	PullRequestID uuid.NullUUID `json:"pull_request_id"`
+	Ecosystem     string        `json:"ecosystem"`
+	Name          string        `json:"name"`
+	Version       string        `json:"version"`
+	File          string        `json:"file"`
+	CreatedAt     time.Time     `json:"created_at"`
+	UpdatedAt     time.Time     `json:"updated_at"`
 }
 
 type Role struct {
@@ -460,16 +574,18 @@ type RuleDetailsRemediate struct {
 	Status      RemediationStatusTypes `json:"status"`
 	Details     string                 `json:"details"`
 	LastUpdated time.Time              `json:"last_updated"`
+	Metadata    json.RawMessage        `json:"metadata"`
 }
 
 type RuleEvaluation struct {
-	ID            uuid.UUID     `json:"id"`
-	Entity        Entities      `json:"entity"`
-	ProfileID     uuid.UUID     `json:"profile_id"`
-	RuleTypeID    uuid.UUID     `json:"rule_type_id"`
-	RepositoryID  uuid.NullUUID `json:"repository_id"`
-	ArtifactID    uuid.NullUUID `json:"artifact_id"`
-	PullRequestID uuid.NullUUID `json:"pull_request_id"`
+	ID                 uuid.UUID     `json:"id"`
+	Entity             Entities      `json:"entity"`
+	ProfileID          uuid.UUID     `json:"profile_id"`
+	RuleTypeID         uuid.UUID     `json:"rule_type_id"`
+	RepositoryID       uuid.NullUUID `json:"repository_id"`
+	ArtifactID         uuid.NullUUID `json:"artifact_id"`
+	PullRequestID      uuid.NullUUID `json:"pull_request_id"`
+	BuildEnvironmentID uuid.NullUUID `json:"build_environment_id"`
 }
 
 type RuleType struct {
@@ -495,14 +611,14 @@ type SessionStore struct {
 }
 
 type SigningKey struct {
-	ID            int32     `json:"id"`
-	ProjectID     uuid.UUID `json:"project_id"`
-	PrivateKey    string    `json:"private_key"`
-	PublicKey     string    `json:"public_key"`
-	Passphrase    string    `json:"passphrase"`
-	KeyIdentifier string    `json:"key_identifier"`
-	CreatedAt     time.Time `json:"created_at"`
-	UpdatedAt     time.Time `json:"updated_at"`
+	ID            int32          `json:"id"`
+	ProjectID     uuid.UUID      `json:"project_id"`
+	PrivateKey    sql.NullString `json:"private_key"`
+	PublicKey     string         `json:"public_key"`
+	Passphrase    sql.NullString `json:"passphrase"`
+	KeyIdentifier string         `json:"key_identifier"`
+	CreatedAt     time.Time      `json:"created_at"`
+	UpdatedAt     time.Time      `json:"updated_at"`
 }
 
 type User struct {
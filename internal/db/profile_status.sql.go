@@ -151,6 +151,7 @@ WITH
            rule_eval_id,
            status AS rem_status,
            details AS rem_details,
+           metadata AS rem_metadata,
            last_updated AS rem_last_updated
        FROM rule_details_remediate
    ),
@@ -170,12 +171,14 @@ SELECT
     ed.eval_details,
     rd.rem_status,
     rd.rem_details,
+    rd.rem_metadata,
     rd.rem_last_updated,
     ad.alert_status,
     ad.alert_details,
     ad.alert_metadata,
     ad.alert_last_updated,
     res.repository_id,
+    res.build_environment_id,
     res.entity,
     repo.repo_name,
     repo.repo_owner,
@@ -195,6 +198,7 @@ WHERE res.profile_id = $1 AND
             WHEN $2::entities  = 'artifact' AND res.artifact_id = $3::UUID THEN true
             WHEN $2::entities  = 'artifact' AND res.artifact_id = $3::UUID THEN true
             WHEN $2::entities  = 'pull_request' AND res.pull_request_id = $3::UUID THEN true
+            WHEN $2::entities  = 'build_environment' AND res.build_environment_id = $3::UUID THEN true
             WHEN $3::UUID IS NULL THEN true
             ELSE false
             END
@@ -209,23 +213,25 @@ type ListRuleEvaluationsByProfileIdParams struct {
 }
 
 type ListRuleEvaluationsByProfileIdRow struct {
-	EvalStatus       NullEvalStatusTypes        `json:"eval_status"`
-	EvalLastUpdated  sql.NullTime               `json:"eval_last_updated"`
-	EvalDetails      sql.NullString             `json:"eval_details"`
-	RemStatus        NullRemediationStatusTypes `json:"rem_status"`
-	RemDetails       sql.NullString             `json:"rem_details"`
-	RemLastUpdated   sql.NullTime               `json:"rem_last_updated"`
-	AlertStatus      NullAlertStatusTypes       `json:"alert_status"`
-	AlertDetails     sql.NullString             `json:"alert_details"`
-	AlertMetadata    pqtype.NullRawMessage      `json:"alert_metadata"`
-	AlertLastUpdated sql.NullTime               `json:"alert_last_updated"`
-	RepositoryID     uuid.NullUUID              `json:"repository_id"`
-	Entity           Entities                   `json:"entity"`
-	RepoName         string                     `json:"repo_name"`
-	RepoOwner        string                     `json:"repo_owner"`
-	Provider         string                     `json:"provider"`
-	RuleTypeName     string                     `json:"rule_type_name"`
-	RuleTypeID       uuid.UUID                  `json:"rule_type_id"`
+	EvalStatus         NullEvalStatusTypes        `json:"eval_status"`
+	EvalLastUpdated    sql.NullTime               `json:"eval_last_updated"`
+	EvalDetails        sql.NullString             `json:"eval_details"`
+	RemStatus          NullRemediationStatusTypes `json:"rem_status"`
+	RemDetails         sql.NullString             `json:"rem_details"`
+	RemMetadata        pqtype.NullRawMessage      `json:"rem_metadata"`
+	RemLastUpdated     sql.NullTime               `json:"rem_last_updated"`
+	AlertStatus        NullAlertStatusTypes       `json:"alert_status"`
+	AlertDetails       sql.NullString             `json:"alert_details"`
+	AlertMetadata      pqtype.NullRawMessage      `json:"alert_metadata"`
+	AlertLastUpdated   sql.NullTime               `json:"alert_last_updated"`
+	RepositoryID       uuid.NullUUID              `json:"repository_id"`
+	BuildEnvironmentID uuid.NullUUID              `json:"build_environment_id"`
+	Entity             Entities                   `json:"entity"`
+	RepoName           string                     `json:"repo_name"`
+	RepoOwner          string                     `json:"repo_owner"`
+	Provider           string                     `json:"provider"`
+	RuleTypeName       string                     `json:"rule_type_name"`
+	RuleTypeID         uuid.UUID                  `json:"rule_type_id"`
 }
 
 func (q *Queries) ListRuleEvaluationsByProfileId(ctx context.Context, arg ListRuleEvaluationsByProfileIdParams) ([]ListRuleEvaluationsByProfileIdRow, error) {
@@ -248,12 +254,14 @@ func (q *Queries) ListRuleEvaluationsByProfileId(ctx context.Context, arg ListRu
 			&i.EvalDetails,
 			&i.RemStatus,
 			&i.RemDetails,
+			&i.RemMetadata,
 			&i.RemLastUpdated,
 			&i.AlertStatus,
 			&i.AlertDetails,
 			&i.AlertMetadata,
 			&i.AlertLastUpdated,
 			&i.RepositoryID,
+			&i.BuildEnvironmentID,
 			&i.Entity,
 			&i.RepoName,
 			&i.RepoOwner,
@@ -347,13 +355,15 @@ INSERT INTO rule_details_remediate (
     rule_eval_id,
     status,
     details,
+    metadata,
     last_updated
 )
-VALUES ($1, $2, $3, NOW())
+VALUES ($1, $2, $3, $4::jsonb, NOW())
 ON CONFLICT(rule_eval_id)
     DO UPDATE SET
                   status = $2,
                   details = $3,
+                  metadata = $4::jsonb,
                   last_updated = NOW()
     WHERE rule_details_remediate.rule_eval_id = $1
 RETURNING id
@@ -363,10 +373,16 @@ type UpsertRuleDetailsRemediateParams struct {
 	RuleEvalID uuid.UUID              `json:"rule_eval_id"`
 	Status     RemediationStatusTypes `json:"status"`
 	Details    string                 `json:"details"`
+	Metadata   json.RawMessage        `json:"metadata"`
 }
 
 func (q *Queries) UpsertRuleDetailsRemediate(ctx context.Context, arg UpsertRuleDetailsRemediateParams) (uuid.UUID, error) {
-	row := q.db.QueryRowContext(ctx, upsertRuleDetailsRemediate, arg.RuleEvalID, arg.Status, arg.Details)
+	row := q.db.QueryRowContext(ctx, upsertRuleDetailsRemediate,
+		arg.RuleEvalID,
+		arg.Status,
+		arg.Details,
+		arg.Metadata,
+	)
 	var id uuid.UUID
 	err := row.Scan(&id)
 	return id, err
@@ -374,20 +390,21 @@ func (q *Queries) UpsertRuleDetailsRemediate(ctx context.Context, arg UpsertRule
 
 const upsertRuleEvaluations = `-- name: UpsertRuleEvaluations :one
 INSERT INTO rule_evaluations (
-    profile_id, repository_id, artifact_id, pull_request_id, rule_type_id, entity
-) VALUES ($1, $2, $3, $4, $5, $6)
-ON CONFLICT (profile_id, repository_id, COALESCE(artifact_id, '00000000-0000-0000-0000-000000000000'::UUID), COALESCE(pull_request_id, '00000000-0000-0000-0000-000000000000'::UUID), entity, rule_type_id)
+    profile_id, repository_id, artifact_id, pull_request_id, rule_type_id, entity, build_environment_id
+) VALUES ($1, $2, $3, $4, $5, $6, $7)
+ON CONFLICT (profile_id, repository_id, COALESCE(artifact_id, '00000000-0000-0000-0000-000000000000'::UUID), COALESCE(pull_request_id, '00000000-0000-0000-0000-000000000000'::UUID), entity, rule_type_id, COALESCE(build_environment_id, '00000000-0000-0000-0000-000000000000'::UUID))
   DO UPDATE SET profile_id = $1
 RETURNING id
 `
 
 type UpsertRuleEvaluationsParams struct {
-	ProfileID     uuid.UUID     `json:"profile_id"`
-	RepositoryID  uuid.NullUUID `json:"repository_id"`
-	ArtifactID    uuid.NullUUID `json:"artifact_id"`
-	PullRequestID uuid.NullUUID `json:"pull_request_id"`
-	RuleTypeID    uuid.UUID     `json:"rule_type_id"`
-	Entity        Entities      `json:"entity"`
+	ProfileID          uuid.UUID     `json:"profile_id"`
+	RepositoryID       uuid.NullUUID `json:"repository_id"`
+	ArtifactID         uuid.NullUUID `json:"artifact_id"`
+	PullRequestID      uuid.NullUUID `json:"pull_request_id"`
+	RuleTypeID         uuid.UUID     `json:"rule_type_id"`
+	Entity             Entities      `json:"entity"`
+	BuildEnvironmentID uuid.NullUUID `json:"build_environment_id"`
 }
 
 func (q *Queries) UpsertRuleEvaluations(ctx context.Context, arg UpsertRuleEvaluationsParams) (uuid.UUID, error) {
@@ -398,6 +415,7 @@ func (q *Queries) UpsertRuleEvaluations(ctx context.Context, arg UpsertRuleEvalu
 		arg.PullRequestID,
 		arg.RuleTypeID,
 		arg.Entity,
+		arg.BuildEnvironmentID,
 	)
 	var id uuid.UUID
 	err := row.Scan(&id)
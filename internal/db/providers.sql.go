@@ -184,3 +184,30 @@ func (q *Queries) ListProvidersByProjectID(ctx context.Context, projectID uuid.U
 	}
 	return items, nil
 }
+
+const updateProviderDefinition = `-- name: UpdateProviderDefinition :one
+UPDATE providers SET definition = $3::jsonb, updated_at = NOW()
+WHERE id = $1 AND project_id = $2 RETURNING id, name, version, project_id, implements, definition, created_at, updated_at
+`
+
+type UpdateProviderDefinitionParams struct {
+	ID         uuid.UUID       `json:"id"`
+	ProjectID  uuid.UUID       `json:"project_id"`
+	Definition json.RawMessage `json:"definition"`
+}
+
+func (q *Queries) UpdateProviderDefinition(ctx context.Context, arg UpdateProviderDefinitionParams) (Provider, error) {
+	row := q.db.QueryRowContext(ctx, updateProviderDefinition, arg.ID, arg.ProjectID, arg.Definition)
+	var i Provider
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Version,
+		&i.ProjectID,
+		pq.Array(&i.Implements),
+		&i.Definition,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
@@ -36,6 +36,8 @@ type Querier interface {
 	DeleteAccessToken(ctx context.Context, arg DeleteAccessTokenParams) error
 	DeleteArtifact(ctx context.Context, id uuid.UUID) error
 	DeleteArtifactVersion(ctx context.Context, id uuid.UUID) error
+	DeleteBuildEnvironment(ctx context.Context, id uuid.UUID) error
+	DeleteBuildEnvironmentByName(ctx context.Context, arg DeleteBuildEnvironmentByNameParams) error
 	DeleteExpiredSessionStates(ctx context.Context) error
 	DeleteOldArtifactVersions(ctx context.Context, arg DeleteOldArtifactVersionsParams) error
 	DeleteOrganization(ctx context.Context, id uuid.UUID) error
@@ -43,8 +45,19 @@ type Querier interface {
 	DeleteProfileForEntity(ctx context.Context, arg DeleteProfileForEntityParams) error
 	DeleteProject(ctx context.Context, id uuid.UUID) ([]DeleteProjectRow, error)
 	DeleteProvider(ctx context.Context, arg DeleteProviderParams) error
+	// deletes the versions ListPrunableArtifactVersions lists
+	DeletePrunableArtifactVersions(ctx context.Context, arg DeletePrunableArtifactVersionsParams) ([]ArtifactVersion, error)
 	DeletePullRequest(ctx context.Context, arg DeletePullRequestParams) error
+	// DeleteRemediationApproval removes the approval of a rule evaluation whose remediation doesn't
+	// propose a request anymore, so that the next proposal is decided on again. An approval that is
+	// being applied is kept until its request is performed.
+	DeleteRemediationApproval(ctx context.Context, ruleEvalID uuid.UUID) error
+	DeleteRemediationPullRequest(ctx context.Context, ruleEvalID uuid.UUID) error
 	DeleteRepository(ctx context.Context, id uuid.UUID) error
+	// DeleteRepositoryDependencies removes the dependency set of a repository's
+	// default branch, or of one of its pull requests if pull_request_id is set.
+	// It is used to replace the whole set after a new scan.
+	DeleteRepositoryDependencies(ctx context.Context, arg DeleteRepositoryDependenciesParams) error
 	DeleteRole(ctx context.Context, id int32) error
 	DeleteRuleInstantiation(ctx context.Context, arg DeleteRuleInstantiationParams) error
 	// DeleteRuleStatusesForProfileAndRuleType deletes a rule evaluation
@@ -54,6 +67,9 @@ type Querier interface {
 	DeleteSessionState(ctx context.Context, id int32) error
 	DeleteSessionStateByProjectID(ctx context.Context, arg DeleteSessionStateByProjectIDParams) error
 	DeleteSigningKey(ctx context.Context, arg DeleteSigningKeyParams) error
+	// removes the statuses of a rule evaluation for the versions of the artifact
+	// that are not among the given digests
+	DeleteStaleArtifactVersionEvaluations(ctx context.Context, arg DeleteStaleArtifactVersionEvaluationsParams) error
 	DeleteUser(ctx context.Context, id int32) error
 	EnqueueFlush(ctx context.Context, arg EnqueueFlushParams) (FlushCache, error)
 	FlushCache(ctx context.Context, arg FlushCacheParams) (FlushCache, error)
@@ -61,8 +77,10 @@ type Querier interface {
 	GetAccessTokenByProvider(ctx context.Context, provider string) ([]ProviderAccessToken, error)
 	GetAccessTokenSinceDate(ctx context.Context, arg GetAccessTokenSinceDateParams) (ProviderAccessToken, error)
 	GetArtifactByID(ctx context.Context, id uuid.UUID) (GetArtifactByIDRow, error)
+	GetArtifactRetentionPolicy(ctx context.Context, projectID uuid.UUID) (ArtifactRetentionPolicy, error)
 	GetArtifactVersionByID(ctx context.Context, id uuid.UUID) (ArtifactVersion, error)
 	GetArtifactVersionBySha(ctx context.Context, sha string) (ArtifactVersion, error)
+	GetBuildEnvironmentByID(ctx context.Context, id uuid.UUID) (BuildEnvironment, error)
 	GetChildrenProjects(ctx context.Context, id uuid.UUID) ([]GetChildrenProjectsRow, error)
 	GetEntityProfileByProjectAndName(ctx context.Context, arg GetEntityProfileByProjectAndNameParams) ([]GetEntityProfileByProjectAndNameRow, error)
 	// GetFeatureInProject verifies if a feature is available for a specific project.
@@ -88,6 +106,7 @@ type Querier interface {
 	GetProviderByName(ctx context.Context, arg GetProviderByNameParams) (Provider, error)
 	GetPullRequest(ctx context.Context, arg GetPullRequestParams) (PullRequest, error)
 	GetPullRequestByID(ctx context.Context, id uuid.UUID) (PullRequest, error)
+	GetRemediationApprovalByID(ctx context.Context, arg GetRemediationApprovalByIDParams) (GetRemediationApprovalByIDRow, error)
 	GetRepositoryByID(ctx context.Context, id uuid.UUID) (Repository, error)
 	GetRepositoryByIDAndProject(ctx context.Context, arg GetRepositoryByIDAndProjectParams) (Repository, error)
 	GetRepositoryByRepoID(ctx context.Context, repoID int32) (Repository, error)
@@ -100,16 +119,25 @@ type Querier interface {
 	GetSessionState(ctx context.Context, id int32) (SessionStore, error)
 	GetSessionStateByProjectID(ctx context.Context, projectID uuid.UUID) (SessionStore, error)
 	GetSigningKeyByIdentifier(ctx context.Context, keyIdentifier string) (SigningKey, error)
+	GetSigningKeyByProjectAndIdentifier(ctx context.Context, arg GetSigningKeyByProjectAndIdentifierParams) (SigningKey, error)
 	GetSigningKeyByProjectID(ctx context.Context, projectID uuid.UUID) (SigningKey, error)
 	GetUserByID(ctx context.Context, id int32) (User, error)
 	GetUserBySubject(ctx context.Context, identitySubject string) (User, error)
 	GetUserProjects(ctx context.Context, userID int32) ([]GetUserProjectsRow, error)
 	GetUserRoles(ctx context.Context, userID int32) ([]GetUserRolesRow, error)
 	GlobalListProviders(ctx context.Context) ([]Provider, error)
+	ListAllRegisteredRepositories(ctx context.Context) ([]Repository, error)
 	ListAllRepositories(ctx context.Context, provider string) ([]Repository, error)
+	// lists the rule evaluation statuses of the versions of an artifact, optionally
+	// restricted to a profile
+	ListArtifactVersionEvaluations(ctx context.Context, arg ListArtifactVersionEvaluationsParams) ([]ListArtifactVersionEvaluationsRow, error)
 	ListArtifactVersionsByArtifactID(ctx context.Context, arg ListArtifactVersionsByArtifactIDParams) ([]ArtifactVersion, error)
 	ListArtifactVersionsByArtifactIDAndTag(ctx context.Context, arg ListArtifactVersionsByArtifactIDAndTagParams) ([]ArtifactVersion, error)
+	ListArtifactsByProjectID(ctx context.Context, projectID uuid.UUID) ([]Artifact, error)
+	ListArtifactsByProvider(ctx context.Context, arg ListArtifactsByProviderParams) ([]Artifact, error)
 	ListArtifactsByRepoID(ctx context.Context, repositoryID uuid.UUID) ([]Artifact, error)
+	ListBuildEnvironmentsByRepositoryID(ctx context.Context, repositoryID uuid.UUID) ([]BuildEnvironment, error)
+	ListDependencyUsageByPackage(ctx context.Context, arg ListDependencyUsageByPackageParams) ([]ListDependencyUsageByPackageRow, error)
 	ListFlushCache(ctx context.Context) ([]FlushCache, error)
 	ListOrganizations(ctx context.Context, arg ListOrganizationsParams) ([]Project, error)
 	ListProfilesByProjectID(ctx context.Context, projectID uuid.UUID) ([]ListProfilesByProjectIDRow, error)
@@ -118,13 +146,21 @@ type Querier interface {
 	// so we only return the profile information. We also should group the profiles so that we don't get duplicates.
 	ListProfilesInstantiatingRuleType(ctx context.Context, ruleTypeID uuid.UUID) ([]ListProfilesInstantiatingRuleTypeRow, error)
 	ListProvidersByProjectID(ctx context.Context, projectID uuid.UUID) ([]Provider, error)
+	// versions older than created_before that are not among the keep_last most
+	// recent ones, a NULL argument means no limit
+	ListPrunableArtifactVersions(ctx context.Context, arg ListPrunableArtifactVersionsParams) ([]ArtifactVersion, error)
+	ListRegisteredRepositoriesByProjectID(ctx context.Context, projectID uuid.UUID) ([]Repository, error)
 	ListRegisteredRepositoriesByProjectIDAndProvider(ctx context.Context, arg ListRegisteredRepositoriesByProjectIDAndProviderParams) ([]Repository, error)
+	ListRemediationApprovalsByProject(ctx context.Context, arg ListRemediationApprovalsByProjectParams) ([]ListRemediationApprovalsByProjectRow, error)
+	ListRemediationPullRequestsByRepository(ctx context.Context, repositoryID uuid.UUID) ([]ListRemediationPullRequestsByRepositoryRow, error)
 	ListRepositoriesByOwner(ctx context.Context, arg ListRepositoriesByOwnerParams) ([]Repository, error)
 	ListRepositoriesByProjectID(ctx context.Context, arg ListRepositoriesByProjectIDParams) ([]Repository, error)
+	ListRepositoryDependencies(ctx context.Context, repositoryID uuid.UUID) ([]ListRepositoryDependenciesRow, error)
 	ListRoles(ctx context.Context, arg ListRolesParams) ([]Role, error)
 	ListRolesByProjectID(ctx context.Context, arg ListRolesByProjectIDParams) ([]Role, error)
 	ListRuleEvaluationsByProfileId(ctx context.Context, arg ListRuleEvaluationsByProfileIdParams) ([]ListRuleEvaluationsByProfileIdRow, error)
 	ListRuleTypesByProviderAndProject(ctx context.Context, arg ListRuleTypesByProviderAndProjectParams) ([]RuleType, error)
+	ListSigningKeysByProjectID(ctx context.Context, projectID uuid.UUID) ([]SigningKey, error)
 	ListUsers(ctx context.Context, arg ListUsersParams) ([]User, error)
 	ListUsersByOrganization(ctx context.Context, arg ListUsersByOrganizationParams) ([]User, error)
 	ListUsersByProject(ctx context.Context, arg ListUsersByProjectParams) ([]User, error)
@@ -143,15 +179,34 @@ type Querier interface {
 	UpdateLease(ctx context.Context, arg UpdateLeaseParams) error
 	UpdateOrganization(ctx context.Context, arg UpdateOrganizationParams) (Project, error)
 	UpdateProfile(ctx context.Context, arg UpdateProfileParams) (Profile, error)
+	UpdateProviderDefinition(ctx context.Context, arg UpdateProviderDefinitionParams) (Provider, error)
+	// UpdateRemediationApprovalStatus moves a remediation approval from the given status to a
+	// new one. No rows are returned if the approval isn't in the given status (anymore), e.g.
+	// because it was decided on concurrently.
+	UpdateRemediationApprovalStatus(ctx context.Context, arg UpdateRemediationApprovalStatusParams) (RemediationApproval, error)
 	// set clone_url if the value is not an empty string
 	UpdateRepository(ctx context.Context, arg UpdateRepositoryParams) (Repository, error)
 	UpdateRepositoryByID(ctx context.Context, arg UpdateRepositoryByIDParams) (Repository, error)
+	UpdateRepositoryDefaultBranch(ctx context.Context, arg UpdateRepositoryDefaultBranchParams) error
 	UpdateRole(ctx context.Context, arg UpdateRoleParams) (Role, error)
 	UpdateRuleType(ctx context.Context, arg UpdateRuleTypeParams) error
 	UpsertArtifact(ctx context.Context, arg UpsertArtifactParams) (Artifact, error)
+	UpsertArtifactRetentionPolicy(ctx context.Context, arg UpsertArtifactRetentionPolicyParams) (ArtifactRetentionPolicy, error)
 	UpsertArtifactVersion(ctx context.Context, arg UpsertArtifactVersionParams) (ArtifactVersion, error)
+	// records the status of a rule evaluation for the versions of the artifact
+	// with the given digests
+	UpsertArtifactVersionEvaluations(ctx context.Context, arg UpsertArtifactVersionEvaluationsParams) error
+	UpsertBuildEnvironment(ctx context.Context, arg UpsertBuildEnvironmentParams) (BuildEnvironment, error)
 	UpsertProfileForEntity(ctx context.Context, arg UpsertProfileForEntityParams) (EntityProfile, error)
 	UpsertPullRequest(ctx context.Context, arg UpsertPullRequestParams) (PullRequest, error)
+	// UpsertRemediationApproval records the request proposed by the remediation of a rule
+	// evaluation. A proposal that didn't change keeps its decision, unless applying it failed or
+	// it was applied already and is proposed again because the rule still fails.
+	UpsertRemediationApproval(ctx context.Context, arg UpsertRemediationApprovalParams) error
+	// UpsertRemediationPullRequest records the pull request opened by the remediation of a
+	// rule evaluation. A different pull request replaces the one recorded before.
+	UpsertRemediationPullRequest(ctx context.Context, arg UpsertRemediationPullRequestParams) error
+	UpsertRepositoryDependency(ctx context.Context, arg UpsertRepositoryDependencyParams) error
 	UpsertRuleDetailsAlert(ctx context.Context, arg UpsertRuleDetailsAlertParams) (uuid.UUID, error)
 	UpsertRuleDetailsEval(ctx context.Context, arg UpsertRuleDetailsEvalParams) (uuid.UUID, error)
 	UpsertRuleDetailsRemediate(ctx context.Context, arg UpsertRuleDetailsRemediateParams) (uuid.UUID, error)
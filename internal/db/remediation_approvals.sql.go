@@ -0,0 +1,245 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.24.0
+// source: remediation_approvals.sql
+
+package db
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const deleteRemediationApproval = `-- name: DeleteRemediationApproval :exec
+
+DELETE FROM remediation_approvals
+WHERE rule_eval_id = $1 AND status <> 'applying'
+`
+
+// DeleteRemediationApproval removes the approval of a rule evaluation whose remediation doesn't
+// propose a request anymore, so that the next proposal is decided on again. An approval that is
+// being applied is kept until its request is performed.
+func (q *Queries) DeleteRemediationApproval(ctx context.Context, ruleEvalID uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, deleteRemediationApproval, ruleEvalID)
+	return err
+}
+
+const getRemediationApprovalByID = `-- name: GetRemediationApprovalByID :one
+SELECT ra.id, ra.rule_eval_id, ra.method, ra.endpoint, ra.body, ra.status, ra.details, ra.decided_by, ra.decided_at, ra.created_at, ra.updated_at, p.provider, p.name AS profile_name FROM remediation_approvals ra
+INNER JOIN rule_evaluations re ON re.id = ra.rule_eval_id
+INNER JOIN profiles p ON p.id = re.profile_id
+WHERE ra.id = $1 AND p.project_id = $2
+`
+
+type GetRemediationApprovalByIDParams struct {
+	ID        uuid.UUID `json:"id"`
+	ProjectID uuid.UUID `json:"project_id"`
+}
+
+type GetRemediationApprovalByIDRow struct {
+	ID          uuid.UUID                 `json:"id"`
+	RuleEvalID  uuid.UUID                 `json:"rule_eval_id"`
+	Method      string                    `json:"method"`
+	Endpoint    string                    `json:"endpoint"`
+	Body        string                    `json:"body"`
+	Status      RemediationApprovalStatus `json:"status"`
+	Details     string                    `json:"details"`
+	DecidedBy   sql.NullString            `json:"decided_by"`
+	DecidedAt   sql.NullTime              `json:"decided_at"`
+	CreatedAt   time.Time                 `json:"created_at"`
+	UpdatedAt   time.Time                 `json:"updated_at"`
+	Provider    string                    `json:"provider"`
+	ProfileName string                    `json:"profile_name"`
+}
+
+func (q *Queries) GetRemediationApprovalByID(ctx context.Context, arg GetRemediationApprovalByIDParams) (GetRemediationApprovalByIDRow, error) {
+	row := q.db.QueryRowContext(ctx, getRemediationApprovalByID, arg.ID, arg.ProjectID)
+	var i GetRemediationApprovalByIDRow
+	err := row.Scan(
+		&i.ID,
+		&i.RuleEvalID,
+		&i.Method,
+		&i.Endpoint,
+		&i.Body,
+		&i.Status,
+		&i.Details,
+		&i.DecidedBy,
+		&i.DecidedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Provider,
+		&i.ProfileName,
+	)
+	return i, err
+}
+
+const listRemediationApprovalsByProject = `-- name: ListRemediationApprovalsByProject :many
+SELECT ra.id, ra.rule_eval_id, ra.method, ra.endpoint, ra.body, ra.status, ra.details, ra.decided_by, ra.decided_at, ra.created_at, ra.updated_at,
+    p.name AS profile_name,
+    rt.name AS rule_type_name,
+    re.entity,
+    repo.repo_owner,
+    repo.repo_name
+FROM remediation_approvals ra
+INNER JOIN rule_evaluations re ON re.id = ra.rule_eval_id
+INNER JOIN profiles p ON p.id = re.profile_id
+INNER JOIN rule_type rt ON rt.id = re.rule_type_id
+INNER JOIN repositories repo ON repo.id = re.repository_id
+WHERE p.project_id = $1 AND
+    ($2::remediation_approval_status IS NULL OR ra.status = $2::remediation_approval_status)
+ORDER BY ra.created_at
+`
+
+type ListRemediationApprovalsByProjectParams struct {
+	ProjectID uuid.UUID                     `json:"project_id"`
+	Status    NullRemediationApprovalStatus `json:"status"`
+}
+
+type ListRemediationApprovalsByProjectRow struct {
+	ID           uuid.UUID                 `json:"id"`
+	RuleEvalID   uuid.UUID                 `json:"rule_eval_id"`
+	Method       string                    `json:"method"`
+	Endpoint     string                    `json:"endpoint"`
+	Body         string                    `json:"body"`
+	Status       RemediationApprovalStatus `json:"status"`
+	Details      string                    `json:"details"`
+	DecidedBy    sql.NullString            `json:"decided_by"`
+	DecidedAt    sql.NullTime              `json:"decided_at"`
+	CreatedAt    time.Time                 `json:"created_at"`
+	UpdatedAt    time.Time                 `json:"updated_at"`
+	ProfileName  string                    `json:"profile_name"`
+	RuleTypeName string                    `json:"rule_type_name"`
+	Entity       Entities                  `json:"entity"`
+	RepoOwner    string                    `json:"repo_owner"`
+	RepoName     string                    `json:"repo_name"`
+}
+
+func (q *Queries) ListRemediationApprovalsByProject(ctx context.Context, arg ListRemediationApprovalsByProjectParams) ([]ListRemediationApprovalsByProjectRow, error) {
+	rows, err := q.db.QueryContext(ctx, listRemediationApprovalsByProject, arg.ProjectID, arg.Status)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListRemediationApprovalsByProjectRow{}
+	for rows.Next() {
+		var i ListRemediationApprovalsByProjectRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.RuleEvalID,
+			&i.Method,
+			&i.Endpoint,
+			&i.Body,
+			&i.Status,
+			&i.Details,
+			&i.DecidedBy,
+			&i.DecidedAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.ProfileName,
+			&i.RuleTypeName,
+			&i.Entity,
+			&i.RepoOwner,
+			&i.RepoName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateRemediationApprovalStatus = `-- name: UpdateRemediationApprovalStatus :one
+
+UPDATE remediation_approvals
+SET status = $2, details = $3, decided_by = $4, decided_at = NOW(), updated_at = NOW()
+WHERE id = $1 AND status = $5::remediation_approval_status
+RETURNING id, rule_eval_id, method, endpoint, body, status, details, decided_by, decided_at, created_at, updated_at
+`
+
+type UpdateRemediationApprovalStatusParams struct {
+	ID         uuid.UUID                 `json:"id"`
+	Status     RemediationApprovalStatus `json:"status"`
+	Details    string                    `json:"details"`
+	DecidedBy  sql.NullString            `json:"decided_by"`
+	FromStatus RemediationApprovalStatus `json:"from_status"`
+}
+
+// UpdateRemediationApprovalStatus moves a remediation approval from the given status to a
+// new one. No rows are returned if the approval isn't in the given status (anymore), e.g.
+// because it was decided on concurrently.
+func (q *Queries) UpdateRemediationApprovalStatus(ctx context.Context, arg UpdateRemediationApprovalStatusParams) (RemediationApproval, error) {
+	row := q.db.QueryRowContext(ctx, updateRemediationApprovalStatus,
+		arg.ID,
+		arg.Status,
+		arg.Details,
+		arg.DecidedBy,
+		arg.FromStatus,
+	)
+	var i RemediationApproval
+	err := row.Scan(
+		&i.ID,
+		&i.RuleEvalID,
+		&i.Method,
+		&i.Endpoint,
+		&i.Body,
+		&i.Status,
+		&i.Details,
+		&i.DecidedBy,
+		&i.DecidedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const upsertRemediationApproval = `-- name: UpsertRemediationApproval :exec
+
+INSERT INTO remediation_approvals (
+    rule_eval_id,
+    method,
+    endpoint,
+    body
+) VALUES ($1, $2, $3, $4)
+ON CONFLICT (rule_eval_id)
+DO UPDATE SET
+    method = $2,
+    endpoint = $3,
+    body = $4,
+    status = 'pending',
+    details = '',
+    decided_by = NULL,
+    decided_at = NULL,
+    updated_at = NOW()
+WHERE remediation_approvals.status IN ('failed', 'applied') OR
+    (remediation_approvals.status <> 'applying' AND
+    (remediation_approvals.method, remediation_approvals.endpoint, remediation_approvals.body) IS DISTINCT FROM ($2, $3, $4))
+`
+
+type UpsertRemediationApprovalParams struct {
+	RuleEvalID uuid.UUID `json:"rule_eval_id"`
+	Method     string    `json:"method"`
+	Endpoint   string    `json:"endpoint"`
+	Body       string    `json:"body"`
+}
+
+// UpsertRemediationApproval records the request proposed by the remediation of a rule
+// evaluation. A proposal that didn't change keeps its decision, unless applying it failed or
+// it was applied already and is proposed again because the rule still fails.
+func (q *Queries) UpsertRemediationApproval(ctx context.Context, arg UpsertRemediationApprovalParams) error {
+	_, err := q.db.ExecContext(ctx, upsertRemediationApproval,
+		arg.RuleEvalID,
+		arg.Method,
+		arg.Endpoint,
+		arg.Body,
+	)
+	return err
+}
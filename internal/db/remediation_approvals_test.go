@@ -0,0 +1,123 @@
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func listRemediationApprovals(t *testing.T, projectID uuid.UUID) []ListRemediationApprovalsByProjectRow {
+	t.Helper()
+
+	approvals, err := testQueries.ListRemediationApprovalsByProject(context.Background(),
+		ListRemediationApprovalsByProjectParams{ProjectID: projectID})
+	require.NoError(t, err)
+	return approvals
+}
+
+func TestRemediationApprovalIsProposedAgainAfterThePassingRule(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	randomEntities := createTestRandomEntities(t)
+	profile := createRandomProfile(t, randomEntities.prov.Name, randomEntities.proj.ID)
+
+	ruleEvalID, err := testQueries.UpsertRuleEvaluations(ctx, UpsertRuleEvaluationsParams{
+		ProfileID:    profile.ID,
+		RepositoryID: uuid.NullUUID{UUID: randomEntities.repo.ID, Valid: true},
+		RuleTypeID:   randomEntities.ruleType1.ID,
+		Entity:       EntitiesRepository,
+	})
+	require.NoError(t, err)
+
+	proposal := UpsertRemediationApprovalParams{
+		RuleEvalID: ruleEvalID,
+		Method:     "PATCH",
+		Endpoint:   "repos/stacklok/minder",
+		Body:       `{"security_and_analysis": {"secret_scanning": {"status": "enabled"}}}`,
+	}
+
+	// the rule fails and the remediation is proposed, then approved and applied
+	require.NoError(t, testQueries.UpsertRemediationApproval(ctx, proposal))
+	approvals := listRemediationApprovals(t, randomEntities.proj.ID)
+	require.Len(t, approvals, 1)
+	require.Equal(t, RemediationApprovalStatusPending, approvals[0].Status)
+
+	for _, move := range []struct{ from, to RemediationApprovalStatus }{
+		{RemediationApprovalStatusPending, RemediationApprovalStatusApplying},
+		{RemediationApprovalStatusApplying, RemediationApprovalStatusApplied},
+	} {
+		_, err := testQueries.UpdateRemediationApprovalStatus(ctx, UpdateRemediationApprovalStatusParams{
+			ID:         approvals[0].ID,
+			Status:     move.to,
+			DecidedBy:  sql.NullString{String: "octocat", Valid: true},
+			FromStatus: move.from,
+		})
+		require.NoError(t, err)
+	}
+
+	// the rule passes, so no request is proposed anymore
+	require.NoError(t, testQueries.DeleteRemediationApproval(ctx, ruleEvalID))
+	require.Empty(t, listRemediationApprovals(t, randomEntities.proj.ID))
+
+	// the rule fails again with the same request, which awaits approval again
+	require.NoError(t, testQueries.UpsertRemediationApproval(ctx, proposal))
+	approvals = listRemediationApprovals(t, randomEntities.proj.ID)
+	require.Len(t, approvals, 1)
+	require.Equal(t, RemediationApprovalStatusPending, approvals[0].Status)
+	require.False(t, approvals[0].DecidedBy.Valid)
+}
+
+func TestRemediationApprovalBeingAppliedIsKept(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	randomEntities := createTestRandomEntities(t)
+	profile := createRandomProfile(t, randomEntities.prov.Name, randomEntities.proj.ID)
+
+	ruleEvalID, err := testQueries.UpsertRuleEvaluations(ctx, UpsertRuleEvaluationsParams{
+		ProfileID:    profile.ID,
+		RepositoryID: uuid.NullUUID{UUID: randomEntities.repo.ID, Valid: true},
+		RuleTypeID:   randomEntities.ruleType1.ID,
+		Entity:       EntitiesRepository,
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, testQueries.UpsertRemediationApproval(ctx, UpsertRemediationApprovalParams{
+		RuleEvalID: ruleEvalID,
+		Method:     "PUT",
+		Endpoint:   "repos/stacklok/minder/vulnerability-alerts",
+	}))
+	approvals := listRemediationApprovals(t, randomEntities.proj.ID)
+	require.Len(t, approvals, 1)
+
+	_, err = testQueries.UpdateRemediationApprovalStatus(ctx, UpdateRemediationApprovalStatusParams{
+		ID:         approvals[0].ID,
+		Status:     RemediationApprovalStatusApplying,
+		DecidedBy:  sql.NullString{String: "octocat", Valid: true},
+		FromStatus: RemediationApprovalStatusPending,
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, testQueries.DeleteRemediationApproval(ctx, ruleEvalID))
+	approvals = listRemediationApprovals(t, randomEntities.proj.ID)
+	require.Len(t, approvals, 1)
+	require.Equal(t, RemediationApprovalStatusApplying, approvals[0].Status)
+}
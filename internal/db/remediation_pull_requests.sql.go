@@ -0,0 +1,124 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.24.0
+// source: remediation_pull_requests.sql
+
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const deleteRemediationPullRequest = `-- name: DeleteRemediationPullRequest :exec
+DELETE FROM remediation_pull_requests WHERE rule_eval_id = $1
+`
+
+func (q *Queries) DeleteRemediationPullRequest(ctx context.Context, ruleEvalID uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, deleteRemediationPullRequest, ruleEvalID)
+	return err
+}
+
+const listRemediationPullRequestsByRepository = `-- name: ListRemediationPullRequestsByRepository :many
+SELECT rpr.id, rpr.rule_eval_id, rpr.repository_id, rpr.pr_number, rpr.branch, rpr.content_sha, rpr.created_at, rpr.updated_at,
+    p.name AS profile_name,
+    rt.name AS rule_type_name
+FROM remediation_pull_requests rpr
+INNER JOIN rule_evaluations re ON re.id = rpr.rule_eval_id
+INNER JOIN profiles p ON p.id = re.profile_id
+INNER JOIN rule_type rt ON rt.id = re.rule_type_id
+WHERE rpr.repository_id = $1
+ORDER BY rpr.pr_number
+`
+
+type ListRemediationPullRequestsByRepositoryRow struct {
+	ID           uuid.UUID `json:"id"`
+	RuleEvalID   uuid.UUID `json:"rule_eval_id"`
+	RepositoryID uuid.UUID `json:"repository_id"`
+	PrNumber     int64     `json:"pr_number"`
+	Branch       string    `json:"branch"`
+	ContentSha   string    `json:"content_sha"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+	ProfileName  string    `json:"profile_name"`
+	RuleTypeName string    `json:"rule_type_name"`
+}
+
+func (q *Queries) ListRemediationPullRequestsByRepository(ctx context.Context, repositoryID uuid.UUID) ([]ListRemediationPullRequestsByRepositoryRow, error) {
+	rows, err := q.db.QueryContext(ctx, listRemediationPullRequestsByRepository, repositoryID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListRemediationPullRequestsByRepositoryRow{}
+	for rows.Next() {
+		var i ListRemediationPullRequestsByRepositoryRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.RuleEvalID,
+			&i.RepositoryID,
+			&i.PrNumber,
+			&i.Branch,
+			&i.ContentSha,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.ProfileName,
+			&i.RuleTypeName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const upsertRemediationPullRequest = `-- name: UpsertRemediationPullRequest :exec
+
+INSERT INTO remediation_pull_requests (
+    rule_eval_id,
+    repository_id,
+    pr_number,
+    branch,
+    content_sha
+) VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (rule_eval_id)
+DO UPDATE SET
+    repository_id = $2,
+    pr_number = $3,
+    branch = $4,
+    content_sha = $5,
+    created_at = CASE WHEN remediation_pull_requests.pr_number = $3
+        THEN remediation_pull_requests.created_at ELSE NOW() END,
+    updated_at = NOW()
+WHERE (remediation_pull_requests.pr_number, remediation_pull_requests.branch, remediation_pull_requests.content_sha)
+    IS DISTINCT FROM ($3, $4, $5)
+`
+
+type UpsertRemediationPullRequestParams struct {
+	RuleEvalID   uuid.UUID `json:"rule_eval_id"`
+	RepositoryID uuid.UUID `json:"repository_id"`
+	PrNumber     int64     `json:"pr_number"`
+	Branch       string    `json:"branch"`
+	ContentSha   string    `json:"content_sha"`
+}
+
+// UpsertRemediationPullRequest records the pull request opened by the remediation of a
+// rule evaluation. A different pull request replaces the one recorded before.
+func (q *Queries) UpsertRemediationPullRequest(ctx context.Context, arg UpsertRemediationPullRequestParams) error {
+	_, err := q.db.ExecContext(ctx, upsertRemediationPullRequest,
+		arg.RuleEvalID,
+		arg.RepositoryID,
+		arg.PrNumber,
+		arg.Branch,
+		arg.ContentSha,
+	)
+	return err
+}
@@ -0,0 +1,196 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.24.0
+// source: repository_dependencies.sql
+
+package db
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const deleteRepositoryDependencies = `-- name: DeleteRepositoryDependencies :exec
+
+DELETE FROM repository_dependencies
+WHERE repository_id = $1::UUID AND
+    COALESCE(pull_request_id, '00000000-0000-0000-0000-000000000000'::UUID) = COALESCE($2::UUID, '00000000-0000-0000-0000-000000000000'::UUID)
+`
+
+type DeleteRepositoryDependenciesParams struct {
+	RepositoryID  uuid.UUID     `json:"repository_id"`
+	PullRequestID uuid.NullUUID `json:"pull_request_id"`
+}
+
+// DeleteRepositoryDependencies removes the dependency set of a repository's
+// default branch, or of one of its pull requests if pull_request_id is set.
+// It is used to replace the whole set after a new scan.
+func (q *Queries) DeleteRepositoryDependencies(ctx context.Context, arg DeleteRepositoryDependenciesParams) error {
+	_, err := q.db.ExecContext(ctx, deleteRepositoryDependencies, arg.RepositoryID, arg.PullRequestID)
+	return err
+}
+
+const listDependencyUsageByPackage = `-- name: ListDependencyUsageByPackage :many
+SELECT rd.id, rd.repository_id, rd.pull_request_id, rd.ecosystem, rd.name, rd.version, rd.file, rd.created_at, rd.updated_at, r.repo_owner, r.repo_name, r.provider, pr.pr_number FROM repository_dependencies rd
+JOIN repositories r ON r.id = rd.repository_id
+LEFT JOIN pull_requests pr ON pr.id = rd.pull_request_id
+WHERE r.project_id = $1::UUID AND rd.ecosystem = $2 AND rd.name = $3
+ORDER BY r.repo_owner, r.repo_name, rd.version, rd.file
+`
+
+type ListDependencyUsageByPackageParams struct {
+	ProjectID uuid.UUID `json:"project_id"`
+	Ecosystem string    `json:"ecosystem"`
+	Name      string    `json:"name"`
+}
+
+type ListDependencyUsageByPackageRow struct {
+	ID            uuid.UUID     `json:"id"`
+	RepositoryID  uuid.UUID     `json:"repository_id"`
+	PullRequestID uuid.NullUUID `json:"pull_request_id"`
+	Ecosystem     string        `json:"ecosystem"`
+	Name          string        `json:"name"`
+	Version       string        `json:"version"`
+	File          string        `json:"file"`
+	CreatedAt     time.Time     `json:"created_at"`
+	UpdatedAt     time.Time     `json:"updated_at"`
+	RepoOwner     string        `json:"repo_owner"`
+	RepoName      string        `json:"repo_name"`
+	Provider      string        `json:"provider"`
+	PrNumber      sql.NullInt64 `json:"pr_number"`
+}
+
+func (q *Queries) ListDependencyUsageByPackage(ctx context.Context, arg ListDependencyUsageByPackageParams) ([]ListDependencyUsageByPackageRow, error) {
+	rows, err := q.db.QueryContext(ctx, listDependencyUsageByPackage, arg.ProjectID, arg.Ecosystem, arg.Name)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListDependencyUsageByPackageRow{}
+	for rows.Next() {
+		var i ListDependencyUsageByPackageRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.RepositoryID,
+			&i.PullRequestID,
+			&i.Ecosystem,
+			&i.Name,
+			&i.Version,
+			&i.File,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.RepoOwner,
+			&i.RepoName,
+			&i.Provider,
+			&i.PrNumber,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRepositoryDependencies = `-- name: ListRepositoryDependencies :many
+SELECT rd.id, rd.repository_id, rd.pull_request_id, rd.ecosystem, rd.name, rd.version, rd.file, rd.created_at, rd.updated_at, pr.pr_number FROM repository_dependencies rd
+LEFT JOIN pull_requests pr ON pr.id = rd.pull_request_id
+WHERE rd.repository_id = $1
+ORDER BY rd.ecosystem, rd.name, rd.version, rd.file
+`
+
+type ListRepositoryDependenciesRow struct {
+	ID            uuid.UUID     `json:"id"`
+	RepositoryID  uuid.UUID     `json:"repository_id"`
+	PullRequestID uuid.NullUUID `json:"pull_request_id"`
+	Ecosystem     string        `json:"ecosystem"`
+	Name          string        `json:"name"`
+	Version       string        `json:"version"`
+	File          string        `json:"file"`
+	CreatedAt     time.Time     `json:"created_at"`
+	UpdatedAt     time.Time     `json:"updated_at"`
+	PrNumber      sql.NullInt64 `json:"pr_number"`
+}
+
+func (q *Queries) ListRepositoryDependencies(ctx context.Context, repositoryID uuid.UUID) ([]ListRepositoryDependenciesRow, error) {
+	rows, err := q.db.QueryContext(ctx, listRepositoryDependencies, repositoryID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListRepositoryDependenciesRow{}
+	for rows.Next() {
+		var i ListRepositoryDependenciesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.RepositoryID,
+			&i.PullRequestID,
+			&i.Ecosystem,
+			&i.Name,
+			&i.Version,
+			&i.File,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.PrNumber,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const upsertRepositoryDependency = `-- name: UpsertRepositoryDependency :exec
+INSERT INTO repository_dependencies (
+    repository_id,
+    pull_request_id,
+    ecosystem,
+    name,
+    version,
+    file
+) VALUES (
+    $1::UUID,
+    $2::UUID,
+    $3,
+    $4,
+    $5,
+    $6
+) ON CONFLICT(repository_id, COALESCE(pull_request_id, '00000000-0000-0000-0000-000000000000'::UUID), ecosystem, name, version, file)
+DO UPDATE SET
+    updated_at = NOW()
+`
+
+type UpsertRepositoryDependencyParams struct {
+	RepositoryID  uuid.UUID     `json:"repository_id"`
+	PullRequestID uuid.NullUUID `json:"pull_request_id"`
+	Ecosystem     string        `json:"ecosystem"`
+	Name          string        `json:"name"`
+	Version       string        `json:"version"`
+	File          string        `json:"file"`
+}
+
+func (q *Queries) UpsertRepositoryDependency(ctx context.Context, arg UpsertRepositoryDependencyParams) error {
+	_, err := q.db.ExecContext(ctx, upsertRepositoryDependency,
+		arg.RepositoryID,
+		arg.PullRequestID,
+		arg.Ecosystem,
+		arg.Name,
+		arg.Version,
+		arg.File,
+	)
+	return err
+}
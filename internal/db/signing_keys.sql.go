@@ -7,6 +7,7 @@ package db
 
 import (
 	"context"
+	"database/sql"
 	"time"
 
 	"github.com/google/uuid"
@@ -17,12 +18,12 @@ INSERT INTO signing_keys (project_id, private_key, public_key, passphrase, key_i
 `
 
 type CreateSigningKeyParams struct {
-	ProjectID     uuid.UUID `json:"project_id"`
-	PrivateKey    string    `json:"private_key"`
-	PublicKey     string    `json:"public_key"`
-	Passphrase    string    `json:"passphrase"`
-	KeyIdentifier string    `json:"key_identifier"`
-	CreatedAt     time.Time `json:"created_at"`
+	ProjectID     uuid.UUID      `json:"project_id"`
+	PrivateKey    sql.NullString `json:"private_key"`
+	PublicKey     string         `json:"public_key"`
+	Passphrase    sql.NullString `json:"passphrase"`
+	KeyIdentifier string         `json:"key_identifier"`
+	CreatedAt     time.Time      `json:"created_at"`
 }
 
 func (q *Queries) CreateSigningKey(ctx context.Context, arg CreateSigningKeyParams) (SigningKey, error) {
@@ -82,6 +83,31 @@ func (q *Queries) GetSigningKeyByIdentifier(ctx context.Context, keyIdentifier s
 	return i, err
 }
 
+const getSigningKeyByProjectAndIdentifier = `-- name: GetSigningKeyByProjectAndIdentifier :one
+SELECT id, project_id, private_key, public_key, passphrase, key_identifier, created_at, updated_at FROM signing_keys WHERE project_id = $1 AND key_identifier = $2
+`
+
+type GetSigningKeyByProjectAndIdentifierParams struct {
+	ProjectID     uuid.UUID `json:"project_id"`
+	KeyIdentifier string    `json:"key_identifier"`
+}
+
+func (q *Queries) GetSigningKeyByProjectAndIdentifier(ctx context.Context, arg GetSigningKeyByProjectAndIdentifierParams) (SigningKey, error) {
+	row := q.db.QueryRowContext(ctx, getSigningKeyByProjectAndIdentifier, arg.ProjectID, arg.KeyIdentifier)
+	var i SigningKey
+	err := row.Scan(
+		&i.ID,
+		&i.ProjectID,
+		&i.PrivateKey,
+		&i.PublicKey,
+		&i.Passphrase,
+		&i.KeyIdentifier,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
 const getSigningKeyByProjectID = `-- name: GetSigningKeyByProjectID :one
 SELECT id, project_id, private_key, public_key, passphrase, key_identifier, created_at, updated_at FROM signing_keys WHERE project_id = $1
 `
@@ -101,3 +127,39 @@ func (q *Queries) GetSigningKeyByProjectID(ctx context.Context, projectID uuid.U
 	)
 	return i, err
 }
+
+const listSigningKeysByProjectID = `-- name: ListSigningKeysByProjectID :many
+SELECT id, project_id, private_key, public_key, passphrase, key_identifier, created_at, updated_at FROM signing_keys WHERE project_id = $1 ORDER BY key_identifier
+`
+
+func (q *Queries) ListSigningKeysByProjectID(ctx context.Context, projectID uuid.UUID) ([]SigningKey, error) {
+	rows, err := q.db.QueryContext(ctx, listSigningKeysByProjectID, projectID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []SigningKey{}
+	for rows.Next() {
+		var i SigningKey
+		if err := rows.Scan(
+			&i.ID,
+			&i.ProjectID,
+			&i.PrivateKey,
+			&i.PublicKey,
+			&i.Passphrase,
+			&i.KeyIdentifier,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
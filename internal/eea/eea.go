@@ -83,14 +83,15 @@ func (e *EEA) aggregate(msg *message.Message) (*message.Message, error) {
 		return nil, fmt.Errorf("error unmarshalling payload: %w", err)
 	}
 
-	repoID, artifactID, pullRequestID := inf.GetEntityDBIDs()
+	repoID, artifactID, pullRequestID, buildEnvID := inf.GetEntityDBIDs()
 
 	res, err := e.querier.LockIfThresholdNotExceeded(ctx, db.LockIfThresholdNotExceededParams{
-		Entity:        entities.EntityTypeToDB(inf.Type),
-		RepositoryID:  repoID,
-		ArtifactID:    artifactID,
-		PullRequestID: pullRequestID,
-		Interval:      fmt.Sprintf("%d", e.cfg.LockInterval),
+		Entity:             entities.EntityTypeToDB(inf.Type),
+		RepositoryID:       repoID,
+		ArtifactID:         artifactID,
+		PullRequestID:      pullRequestID,
+		BuildEnvironmentID: buildEnvID,
+		Interval:           fmt.Sprintf("%d", e.cfg.LockInterval),
 	})
 
 	logger := zerolog.Ctx(ctx).Info()
@@ -106,16 +107,21 @@ func (e *EEA) aggregate(msg *message.Message) (*message.Message, error) {
 		logger = logger.Str("pull_request_id", pullRequestID.UUID.String())
 	}
 
+	if buildEnvID.Valid {
+		logger = logger.Str("build_environment_id", buildEnvID.UUID.String())
+	}
+
 	// if nothing was retrieved from the database, then we can assume
 	// that the event is not ready to be executed.
 	if err != nil && errors.Is(err, sql.ErrNoRows) {
 		logger.Msg("event not ready to be executed")
 
 		_, err := e.querier.EnqueueFlush(ctx, db.EnqueueFlushParams{
-			Entity:        entities.EntityTypeToDB(inf.Type),
-			RepositoryID:  repoID,
-			ArtifactID:    artifactID,
-			PullRequestID: pullRequestID,
+			Entity:             entities.EntityTypeToDB(inf.Type),
+			RepositoryID:       repoID,
+			ArtifactID:         artifactID,
+			PullRequestID:      pullRequestID,
+			BuildEnvironmentID: buildEnvID,
 		})
 		if err != nil {
 			// We already have this item in the queue.
@@ -147,7 +153,7 @@ func (e *EEA) FlushMessageHandler(msg *message.Message) error {
 		return fmt.Errorf("error unmarshalling payload: %w", err)
 	}
 
-	repoID, artifactID, pullRequestID := inf.GetEntityDBIDs()
+	repoID, artifactID, pullRequestID, buildEnvID := inf.GetEntityDBIDs()
 
 	zerolog.Ctx(ctx).Info().
 		Str("event", msg.UUID).
@@ -155,10 +161,11 @@ func (e *EEA) FlushMessageHandler(msg *message.Message) error {
 		Str("repository_id", repoID.String()).Msg("flushing event")
 
 	_, err = e.querier.FlushCache(ctx, db.FlushCacheParams{
-		Entity:        entities.EntityTypeToDB(inf.Type),
-		RepositoryID:  repoID,
-		ArtifactID:    artifactID,
-		PullRequestID: pullRequestID,
+		Entity:             entities.EntityTypeToDB(inf.Type),
+		RepositoryID:       repoID,
+		ArtifactID:         artifactID,
+		PullRequestID:      pullRequestID,
+		BuildEnvironmentID: buildEnvID,
 	})
 	// Nothing to do here. If we can't flush the cache, it means
 	// that the event has already been executed.
@@ -201,7 +208,7 @@ func (e *EEA) FlushAll(ctx context.Context) error {
 		cache := cache
 
 		eiw, err := e.buildEntityWrapper(ctx, cache.Entity,
-			cache.RepositoryID, cache.ArtifactID, cache.PullRequestID)
+			cache.RepositoryID, cache.ArtifactID, cache.PullRequestID, cache.BuildEnvironmentID)
 		if err != nil && errors.Is(err, sql.ErrNoRows) {
 			continue
 		} else if err != nil {
@@ -227,7 +234,7 @@ func (e *EEA) buildEntityWrapper(
 	ctx context.Context,
 	entity db.Entities,
 	repoID uuid.UUID,
-	artID, prID uuid.NullUUID,
+	artID, prID, buildEnvID uuid.NullUUID,
 ) (*engine.EntityInfoWrapper, error) {
 	switch entity {
 	case db.EntitiesRepository:
@@ -237,7 +244,7 @@ func (e *EEA) buildEntityWrapper(
 	case db.EntitiesPullRequest:
 		return e.buildPullRequestInfoWrapper(ctx, repoID, prID)
 	case db.EntitiesBuildEnvironment:
-		return nil, fmt.Errorf("build environment entity not supported")
+		return e.buildBuildEnvironmentInfoWrapper(ctx, repoID, buildEnvID)
 	default:
 		return nil, fmt.Errorf("unknown entity type: %s", entity)
 	}
@@ -288,3 +295,19 @@ func (e *EEA) buildPullRequestInfoWrapper(
 		WithPullRequest(pr).
 		WithPullRequestID(prID.UUID), nil
 }
+
+func (e *EEA) buildBuildEnvironmentInfoWrapper(
+	ctx context.Context,
+	repoID uuid.UUID,
+	buildEnvID uuid.NullUUID,
+) (*engine.EntityInfoWrapper, error) {
+	env, err := util.GetBuildEnvironment(ctx, e.querier, buildEnvID.UUID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting build environment: %w", err)
+	}
+
+	return engine.NewEntityInfoWrapper().
+		WithRepositoryID(repoID).
+		WithBuildEnvironment(env).
+		WithBuildEnvironmentID(buildEnvID.UUID), nil
+}
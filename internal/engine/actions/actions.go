@@ -22,6 +22,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/rs/zerolog"
 	"github.com/sqlc-dev/pqtype"
@@ -96,7 +97,7 @@ func (rae *RuleActionsEngine) DoActions(
 	}
 
 	// Verify the alert action engine is available and get its status - on/off/dry-run
-	_, ok = rae.actions[alert.ActionType]
+	alertEngine, ok := rae.actions[alert.ActionType]
 	if !ok {
 		logger.Error().Str("action_type", string(alert.ActionType)).Msg("not found")
 		result.AlertErr = fmt.Errorf("%s:%w", alert.ActionType, enginerr.ErrActionNotAvailable)
@@ -104,19 +105,26 @@ func (rae *RuleActionsEngine) DoActions(
 		skipAlert = rae.isSkippable(ctx, alert.ActionType, params.GetEvalErr())
 	}
 
+	// The state of the previous remediations is carried over unless remediation is
+	// attempted or the rule passes, e.g. when the evaluation is skipped
+	if prevMeta := getMeta(params.GetEvalStatusFromDb().RemMetadata); prevMeta != nil {
+		result.RemediateMeta = *prevMeta
+	}
+
 	// Try remediating
 	if !skipRemediate {
-		// Decide if we should remediate
-		cmd := shouldRemediate(params.GetEvalStatusFromDb(), params.GetEvalErr())
-		// Run remediation
-		result.RemediateMeta, result.RemediateErr = rae.processAction(ctx, remediate.ActionType, cmd, ent, params,
-			nil)
+		result.RemediateMeta, result.RemediateErr = rae.remediate(ctx, remediateEngine.Type(), ent, params)
+	} else if remediateEngine != nil && params.GetEvalErr() == nil {
+		// Clean up after the previous remediations now that the rule passes
+		result.RemediateMeta, result.RemediateErr = rae.closeRemediation(ctx, ent, params)
 	}
 
 	// Try alerting
 	if !skipAlert {
 		// Decide if we should alert
-		cmd := shouldAlert(params.GetEvalStatusFromDb(), params.GetEvalErr(), result.RemediateErr, remediateEngine.Type())
+		refresh, _ := alertEngine.(engif.RefreshingAlert)
+		cmd := shouldAlert(params.GetEvalStatusFromDb(), params.GetEvalErr(), result.RemediateErr,
+			remediateEngine.Type(), refresh != nil && refresh.RefreshOnFailure())
 		// Run alerting
 		result.AlertMeta, result.AlertErr = rae.processAction(ctx, alert.ActionType, cmd, ent, params,
 			getMeta(params.GetEvalStatusFromDb().AlertMetadata))
@@ -144,16 +152,82 @@ func (rae *RuleActionsEngine) processAction(
 	return action.Do(ctx, cmd, rae.actionsOnOff[actionType], ent, params, metadata)
 }
 
-// shouldRemediate returns the action command for remediation taking into account previous evaluations
-func shouldRemediate(prevEvalFromDb *db.ListRuleEvaluationsByProfileIdRow, evalErr error) engif.ActionCmd {
-	_ = prevEvalFromDb
-	_ = evalErr
-	// To be used in the future in case we decide to implement specific condition handling for performing a remediation
-	// that is not solely based on the current evaluation error.
-	// Example: Skip remediation if remediate_type is PR, the evalErr is failing, but we already have the last
-	// remediate status set to "success", meaning we created a PR remediation,
-	// it's just not merged yet so no need to create another one
-	return engif.ActionCmdOn
+// remediate runs the remediation if the state of the previous remediation attempts allows it,
+// and returns the updated state as the remediation metadata
+func (rae *RuleActionsEngine) remediate(
+	ctx context.Context,
+	remType string,
+	ent protoreflect.ProtoMessage,
+	params engif.ActionsParams,
+) (json.RawMessage, error) {
+	// Dry runs don't change anything, so they neither depend on nor update the remediation state
+	if rae.actionsOnOff[remediate.ActionType] == engif.ActionOptDryRun {
+		_, err := rae.processAction(ctx, remediate.ActionType, engif.ActionCmdOn, ent, params, nil)
+		if prevMeta := getMeta(params.GetEvalStatusFromDb().RemMetadata); prevMeta != nil {
+			return *prevMeta, err
+		}
+		return getDefaultResult(ctx).RemediateMeta, err
+	}
+
+	now := time.Now()
+	state := remediate.StateFromMetadata(params.GetEvalStatusFromDb().RemMetadata)
+
+	// Decide if we should remediate
+	var remErr error
+	cmd, holdErr := shouldRemediate(state, now)
+	if cmd == engif.ActionCmdOn {
+		// Run remediation
+		var remMeta json.RawMessage
+		remMeta, remErr = rae.processAction(ctx, remediate.ActionType, cmd, ent, params, state.PullRequestMetadata())
+		state.RecordAttempt(now, remType, remMeta, remErr)
+	} else {
+		zerolog.Ctx(ctx).Debug().Err(holdErr).Msg("remediation held back")
+		remErr = holdErr
+	}
+
+	meta, err := state.Metadata()
+	if err != nil {
+		return nil, fmt.Errorf("error marshalling remediation state: %w", err)
+	}
+	return meta, remErr
+}
+
+// closeRemediation turns the remediation off once the rule passes, which closes the pull request
+// opened by a previous pull request remediation, if any, and resets the remediation state. The
+// state is kept if closing the pull request fails, so that it is retried.
+func (rae *RuleActionsEngine) closeRemediation(
+	ctx context.Context,
+	ent protoreflect.ProtoMessage,
+	params engif.ActionsParams,
+) (json.RawMessage, error) {
+	reset := getDefaultResult(ctx)
+	state := remediate.StateFromMetadata(params.GetEvalStatusFromDb().RemMetadata)
+	if state.PullRequest == nil {
+		return reset.RemediateMeta, reset.RemediateErr
+	}
+
+	_, err := rae.processAction(ctx, remediate.ActionType, engif.ActionCmdOff, ent, params, state.PullRequestMetadata())
+	if err != nil {
+		meta, merr := state.Metadata()
+		if merr != nil {
+			return nil, fmt.Errorf("error marshalling remediation state: %w", merr)
+		}
+		return meta, err
+	}
+
+	return reset.RemediateMeta, reset.RemediateErr
+}
+
+// shouldRemediate returns the action command for remediation taking into account the previous
+// remediation attempts since the rule started failing, along with the reason for holding it back
+// if it shouldn't be attempted now. A pull request remediation updates the pull request it opened
+// instead of opening another one, and remediations that didn't fix the rule are retried with an
+// increasing backoff up to a maximum number of attempts.
+func shouldRemediate(state *remediate.State, now time.Time) (engif.ActionCmd, error) {
+	if err := state.HoldBack(now); err != nil {
+		return engif.ActionCmdDoNothing, err
+	}
+	return engif.ActionCmdOn, nil
 }
 
 // shouldAlert returns the action command for alerting taking into account previous evaluations
@@ -162,6 +236,7 @@ func shouldAlert(
 	evalErr error,
 	remErr error,
 	remType string,
+	refreshOnFailure bool,
 ) engif.ActionCmd {
 	// Get current evaluation status
 	newEval := enginerr.ErrorAsEvalStatus(evalErr)
@@ -189,6 +264,11 @@ func shouldAlert(
 		return engif.ActionCmdDoNothing
 	}
 
+	// Some alerts are kept up to date with the violation reported by every failing evaluation
+	if refreshOnFailure && db.EvalStatusTypesFailure == newEval && db.AlertStatusTypesOn == prevAlert {
+		return engif.ActionCmdOn
+	}
+
 	// Case 2 - Do nothing if the evaluation status has not changed
 	if newEval == prevEval && prevAlert != db.AlertStatusTypesError {
 		return engif.ActionCmdDoNothing
@@ -240,7 +320,7 @@ func (rae *RuleActionsEngine) isSkippable(ctx context.Context, actionType engif.
 		// Action is unknown, skip
 		logger.Info().Msg("unknown action option, check your profile definition")
 		return true
-	case engif.ActionOptDryRun, engif.ActionOptOn:
+	case engif.ActionOptDryRun, engif.ActionOptOn, engif.ActionOptApprovalRequired:
 		// Action is on or dry-run, do not skip yet. Check the evaluation error
 		skipRemediation =
 			// rule evaluation was skipped, skip action too
@@ -267,8 +347,8 @@ func getDefaultResult(ctx context.Context) enginerr.ActionsError {
 	// Get logger
 	logger := zerolog.Ctx(ctx)
 
-	// Even though meta is an empty json struct by default, there's no risk of overwriting
-	// any existing meta entry since we don't upsert in case of conflict while skipping
+	// The remediation meta is an empty json struct by default, which resets the remediation
+	// state, so DoActions carries over the stored state whenever it shouldn't start over
 	m, err := json.Marshal(&map[string]any{})
 	if err != nil {
 		logger.Error().Err(err).Msg("error marshaling empty json.RawMessage")
@@ -0,0 +1,216 @@
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package actions
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/sqlc-dev/pqtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/stacklok/minder/internal/db"
+	"github.com/stacklok/minder/internal/engine/actions/alert"
+	"github.com/stacklok/minder/internal/engine/actions/remediate"
+	"github.com/stacklok/minder/internal/engine/actions/remediate/pull_request"
+	enginerr "github.com/stacklok/minder/internal/engine/errors"
+	engif "github.com/stacklok/minder/internal/engine/interfaces"
+	minderv1 "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+// fakeAction is an action that records the metadata it's invoked with and returns it unchanged
+type fakeAction struct {
+	class    engif.ActionType
+	typ      string
+	invoked  []engif.ActionCmd
+	metadata []*json.RawMessage
+}
+
+func (f *fakeAction) Class() engif.ActionType {
+	return f.class
+}
+
+func (f *fakeAction) Type() string {
+	return f.typ
+}
+
+func (*fakeAction) GetOnOffState(*minderv1.Profile) engif.ActionOpt {
+	return engif.ActionOptOn
+}
+
+func (f *fakeAction) Do(
+	_ context.Context,
+	cmd engif.ActionCmd,
+	_ engif.ActionOpt,
+	_ protoreflect.ProtoMessage,
+	_ engif.ActionsParams,
+	metadata *json.RawMessage,
+) (json.RawMessage, error) {
+	f.invoked = append(f.invoked, cmd)
+	f.metadata = append(f.metadata, metadata)
+	if metadata == nil {
+		return nil, nil
+	}
+	return *metadata, nil
+}
+
+func TestDoActionsKeepsRemediationStateOnSkippedEvaluation(t *testing.T) {
+	t.Parallel()
+
+	lastAttempt := time.Now().Add(-time.Hour)
+	prevState := &remediate.State{
+		Attempts:    2,
+		LastAttempt: &lastAttempt,
+		PullRequest: &pull_request.PullRequest{Number: 42, Branch: "minder_rule", ContentSha: "abc"},
+	}
+	prevMeta, err := prevState.Metadata()
+	require.NoError(t, err)
+
+	rem := &fakeAction{class: remediate.ActionType, typ: pull_request.RemediateType}
+	alrt := &fakeAction{class: alert.ActionType, typ: "fake"}
+	rae := &RuleActionsEngine{
+		actions: map[engif.ActionType]engif.Action{
+			rem.Class():  rem,
+			alrt.Class(): alrt,
+		},
+		actionsOnOff: map[engif.ActionType]engif.ActionOpt{
+			rem.Class():  engif.ActionOptOn,
+			alrt.Class(): engif.ActionOptOff,
+		},
+	}
+
+	// the evaluation is skipped, which doesn't attempt any remediation
+	skipped := &engif.EvalStatusParams{
+		EvalStatusFromDb: &db.ListRuleEvaluationsByProfileIdRow{
+			RemMetadata: pqtype.NullRawMessage{RawMessage: prevMeta, Valid: true},
+		},
+	}
+	skipped.SetEvalErr(enginerr.ErrEvaluationSkipped)
+	result := rae.DoActions(context.Background(), nil, skipped)
+	require.ErrorIs(t, result.RemediateErr, enginerr.ErrActionSkipped)
+	require.Empty(t, rem.invoked, "a skipped evaluation must not be remediated")
+	require.JSONEq(t, string(prevMeta), string(result.RemediateMeta),
+		"the remediation state must be carried over")
+
+	// the next evaluation fails, which updates the pull request opened before
+	failing := &engif.EvalStatusParams{
+		EvalStatusFromDb: &db.ListRuleEvaluationsByProfileIdRow{
+			RemMetadata: pqtype.NullRawMessage{RawMessage: result.RemediateMeta, Valid: true},
+		},
+	}
+	failing.SetEvalErr(enginerr.NewErrEvaluationFailed("failed"))
+	result = rae.DoActions(context.Background(), nil, failing)
+	require.NoError(t, result.RemediateErr)
+	require.Equal(t, []engif.ActionCmd{engif.ActionCmdOn}, rem.invoked)
+	require.NotNil(t, rem.metadata[0], "the pull request must be updated instead of opening another one")
+	assert.Equal(t, 42, pull_request.PullRequestFromMetadata(rem.metadata[0]).Number)
+
+	state := remediate.StateFromMetadata(pqtype.NullRawMessage{RawMessage: result.RemediateMeta, Valid: true})
+	assert.Equal(t, 2, state.Attempts, "updating the pull request is not another attempt")
+	require.NotNil(t, state.PullRequest)
+	assert.Equal(t, 42, state.PullRequest.Number)
+}
+
+func TestDoActionsResetsRemediationStateWhenPassing(t *testing.T) {
+	t.Parallel()
+
+	prevState := &remediate.State{
+		Attempts:    1,
+		PullRequest: &pull_request.PullRequest{Number: 42, Branch: "minder_rule", ContentSha: "abc"},
+	}
+	prevMeta, err := prevState.Metadata()
+	require.NoError(t, err)
+
+	rem := &fakeAction{class: remediate.ActionType, typ: pull_request.RemediateType}
+	rae := &RuleActionsEngine{
+		actions: map[engif.ActionType]engif.Action{
+			rem.Class(): rem,
+		},
+		actionsOnOff: map[engif.ActionType]engif.ActionOpt{
+			rem.Class(): engif.ActionOptOn,
+		},
+	}
+
+	passing := &engif.EvalStatusParams{
+		EvalStatusFromDb: &db.ListRuleEvaluationsByProfileIdRow{
+			RemMetadata: pqtype.NullRawMessage{RawMessage: prevMeta, Valid: true},
+		},
+	}
+	result := rae.DoActions(context.Background(), nil, passing)
+	require.Equal(t, []engif.ActionCmd{engif.ActionCmdOff}, rem.invoked, "the pull request must be closed")
+	require.JSONEq(t, `{}`, string(result.RemediateMeta), "the remediation state must start over")
+}
+
+func TestShouldAlert(t *testing.T) {
+	t.Parallel()
+
+	failed := enginerr.NewErrEvaluationFailed("no LICENSE file found")
+	prevFailedOn := &db.ListRuleEvaluationsByProfileIdRow{
+		EvalStatus:  db.NullEvalStatusTypes{EvalStatusTypes: db.EvalStatusTypesFailure, Valid: true},
+		AlertStatus: db.NullAlertStatusTypes{AlertStatusTypes: db.AlertStatusTypesOn, Valid: true},
+	}
+
+	tests := []struct {
+		name     string
+		prev     *db.ListRuleEvaluationsByProfileIdRow
+		evalErr  error
+		refresh  bool
+		expected engif.ActionCmd
+	}{
+		{
+			name:     "refreshes an alert on every failing evaluation",
+			prev:     prevFailedOn,
+			evalErr:  failed,
+			refresh:  true,
+			expected: engif.ActionCmdOn,
+		},
+		{
+			name:     "does nothing while the evaluation keeps failing",
+			prev:     prevFailedOn,
+			evalErr:  failed,
+			expected: engif.ActionCmdDoNothing,
+		},
+		{
+			name:     "turns the alert off once the evaluation passes",
+			prev:     prevFailedOn,
+			refresh:  true,
+			expected: engif.ActionCmdOff,
+		},
+		{
+			name: "does not turn on an alert that was turned off",
+			prev: &db.ListRuleEvaluationsByProfileIdRow{
+				EvalStatus:  db.NullEvalStatusTypes{EvalStatusTypes: db.EvalStatusTypesFailure, Valid: true},
+				AlertStatus: db.NullAlertStatusTypes{AlertStatusTypes: db.AlertStatusTypesOff, Valid: true},
+			},
+			evalErr:  failed,
+			refresh:  true,
+			expected: engif.ActionCmdDoNothing,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			cmd := shouldAlert(tt.prev, tt.evalErr, enginerr.ErrActionSkipped, pull_request.RemediateType, tt.refresh)
+			assert.Equal(t, tt.expected, cmd)
+		})
+	}
+}
@@ -20,8 +20,10 @@ package alert
 import (
 	"fmt"
 
+	"github.com/stacklok/minder/internal/engine/actions/alert/issue"
 	"github.com/stacklok/minder/internal/engine/actions/alert/noop"
 	"github.com/stacklok/minder/internal/engine/actions/alert/security_advisory"
+	"github.com/stacklok/minder/internal/engine/actions/alert/webhook"
 	engif "github.com/stacklok/minder/internal/engine/interfaces"
 	"github.com/stacklok/minder/internal/providers"
 	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
@@ -44,6 +46,16 @@ func NewRuleAlert(rt *pb.RuleType, pbuild *providers.ProviderBuilder) (engif.Act
 			return nil, fmt.Errorf("alert engine missing security-advisory configuration")
 		}
 		return security_advisory.NewSecurityAdvisoryAlert(ActionType, alertCfg.GetSecurityAdvisory(), pbuild)
+	case webhook.AlertType:
+		if alertCfg.GetWebhook() == nil {
+			return nil, fmt.Errorf("alert engine missing webhook configuration")
+		}
+		return webhook.NewWebhookAlert(ActionType, alertCfg.GetWebhook(), pbuild)
+	case issue.AlertType:
+		if alertCfg.GetIssue() == nil {
+			return nil, fmt.Errorf("alert engine missing issue configuration")
+		}
+		return issue.NewIssueAlert(ActionType, alertCfg.GetIssue(), pbuild)
 	}
 
 	return nil, fmt.Errorf("unknown alert type: %s", alertCfg.GetType())
@@ -0,0 +1,395 @@
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package issue provides necessary interfaces and implementations for
+// creating alerts of type issue.
+package issue
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"text/template"
+
+	"github.com/google/go-github/v53/github"
+	"github.com/rs/zerolog"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	enginerr "github.com/stacklok/minder/internal/engine/errors"
+	"github.com/stacklok/minder/internal/engine/interfaces"
+	"github.com/stacklok/minder/internal/providers"
+	ghclient "github.com/stacklok/minder/internal/providers/github"
+	"github.com/stacklok/minder/internal/util"
+	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+	provifv1 "github.com/stacklok/minder/pkg/providers/v1"
+)
+
+const (
+	// AlertType is the type of the issue alert engine
+	AlertType = "issue"
+
+	defaultTitle = `minder: profile {{.Profile}} failed with rule {{.Rule}}`
+	// nolint:lll
+	defaultBody = `Minder has detected that **{{.Repository}}** doesn't comply with the **{{.Rule}}** rule of the **{{.Profile}}** profile.
+
+{{.Description}}
+
+**Guidance**
+
+{{.Guidance}}
+{{with .Details}}
+**Details**
+
+{{.}}
+{{end}}
+This issue will be automatically closed once the **{{.Rule}}** rule passes.
+`
+)
+
+// Alert is the structure backing the issue alert action
+type Alert struct {
+	actionType interfaces.ActionType
+	cli        provifv1.GitHub
+	cfg        *pb.RuleType_Definition_Alert_AlertTypeIssue
+	titleTmpl  *template.Template
+	bodyTmpl   *template.Template
+}
+
+// TemplateParams are the parameters of the title and body templates
+type TemplateParams struct {
+	Profile     string
+	Rule        string
+	Description string
+	Guidance    string
+	// Details are the details of the violation reported by the evaluation
+	Details    string
+	Repository string
+	// Entity is the entity that was evaluated
+	Entity any
+	// Params are the rule instance parameters
+	Params map[string]any
+}
+
+type paramsIssue struct {
+	Owner    string
+	Repo     string
+	Title    string
+	Body     string
+	Metadata *alertMetadata
+}
+
+type alertMetadata struct {
+	Number int `json:"issue_number,omitempty"`
+	// Title and Body are the last rendered title and body of the issue
+	Title string `json:"title,omitempty"`
+	Body  string `json:"body,omitempty"`
+	// Closed is set once minder closed the issue, which is reopened if the rule fails again
+	Closed bool `json:"closed,omitempty"`
+}
+
+// NewIssueAlert creates a new issue alert action
+func NewIssueAlert(
+	actionType interfaces.ActionType,
+	issueCfg *pb.RuleType_Definition_Alert_AlertTypeIssue,
+	pbuild *providers.ProviderBuilder,
+) (*Alert, error) {
+	if actionType == "" {
+		return nil, fmt.Errorf("action type cannot be empty")
+	}
+
+	title := issueCfg.GetTitle()
+	if title == "" {
+		title = defaultTitle
+	}
+	titleT, err := util.ParseNewTextTemplate(&title, "title")
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse title template: %w", err)
+	}
+
+	body := issueCfg.GetBody()
+	if body == "" {
+		body = defaultBody
+	}
+	bodyT, err := util.ParseNewTextTemplate(&body, "body")
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse body template: %w", err)
+	}
+
+	cli, err := pbuild.GetGitHub(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("cannot get http client: %w", err)
+	}
+
+	return &Alert{
+		actionType: actionType,
+		cli:        cli,
+		cfg:        issueCfg,
+		titleTmpl:  titleT,
+		bodyTmpl:   bodyT,
+	}, nil
+}
+
+// Class returns the action type of the issue engine
+func (alert *Alert) Class() interfaces.ActionType {
+	return alert.actionType
+}
+
+// Type returns the action subtype of the issue engine
+func (_ *Alert) Type() string {
+	return AlertType
+}
+
+// RefreshOnFailure returns true, the issue is updated with the details of every failing evaluation
+func (_ *Alert) RefreshOnFailure() bool {
+	return true
+}
+
+// GetOnOffState returns the alert action state read from the profile
+func (_ *Alert) GetOnOffState(p *pb.Profile) interfaces.ActionOpt {
+	return interfaces.ActionOptFromString(p.Alert, interfaces.ActionOptOn)
+}
+
+// Do alerts through an issue
+func (alert *Alert) Do(
+	ctx context.Context,
+	cmd interfaces.ActionCmd,
+	setting interfaces.ActionOpt,
+	entity protoreflect.ProtoMessage,
+	params interfaces.ActionsParams,
+	metadata *json.RawMessage,
+) (json.RawMessage, error) {
+	p, err := alert.getParamsForIssue(ctx, entity, params, metadata)
+	if err != nil {
+		return nil, fmt.Errorf("error extracting details: %w", err)
+	}
+
+	// Process the command based on the action setting
+	switch setting {
+	case interfaces.ActionOptOn:
+		return alert.run(ctx, p, cmd)
+	case interfaces.ActionOptDryRun:
+		return nil, alert.runDry(ctx, p, cmd)
+	case interfaces.ActionOptOff, interfaces.ActionOptUnknown:
+		return nil, fmt.Errorf("unexpected action setting: %w", enginerr.ErrActionFailed)
+	}
+	return nil, enginerr.ErrActionSkipped
+}
+
+// run runs the issue action
+func (alert *Alert) run(ctx context.Context, params *paramsIssue, cmd interfaces.ActionCmd) (json.RawMessage, error) {
+	logger := zerolog.Ctx(ctx)
+
+	switch cmd {
+	// Open an issue, or update the one opened before
+	case interfaces.ActionCmdOn:
+		if params.Metadata != nil && params.Metadata.Number != 0 {
+			if !params.Metadata.Closed && params.Metadata.Title == params.Title && params.Metadata.Body == params.Body {
+				// The issue already reports this violation, so there's nothing to edit
+				logger.Debug().Int("issue_number", params.Metadata.Number).Msg("issue up to date")
+				return json.Marshal(params.Metadata)
+			}
+			_, err := alert.cli.EditIssue(ctx, params.Owner, params.Repo, params.Metadata.Number,
+				alert.issueRequest(params, reopenState(params.Metadata)))
+			if err == nil {
+				logger.Info().Int("issue_number", params.Metadata.Number).Msg("issue updated")
+				return json.Marshal(alertMetadata{Number: params.Metadata.Number, Title: params.Title, Body: params.Body})
+			}
+			if !errors.Is(err, ghclient.ErrNotFound) {
+				return nil, fmt.Errorf("error updating issue: %w, %w", err, enginerr.ErrActionFailed)
+			}
+			// The issue was deleted or transferred, so a new one is opened
+		}
+
+		issue, err := alert.cli.CreateIssue(ctx, params.Owner, params.Repo, alert.issueRequest(params, ""))
+		if err != nil {
+			return nil, fmt.Errorf("error creating issue: %w, %w", err, enginerr.ErrActionFailed)
+		}
+		newMeta, err := json.Marshal(alertMetadata{Number: issue.GetNumber(), Title: params.Title, Body: params.Body})
+		if err != nil {
+			return nil, fmt.Errorf("error marshalling alert metadata json: %w", err)
+		}
+		// Success - return the new metadata for storing the issue number
+		logger.Info().Int("issue_number", issue.GetNumber()).Msg("issue opened")
+		return newMeta, nil
+	// Close the issue
+	case interfaces.ActionCmdOff:
+		if params.Metadata == nil || params.Metadata.Number == 0 {
+			// We cannot do anything without the issue number, so we assume that closing this is a success
+			return nil, fmt.Errorf("no issue number provided: %w", enginerr.ErrActionTurnedOff)
+		}
+		_, err := alert.cli.EditIssue(ctx, params.Owner, params.Repo, params.Metadata.Number, &github.IssueRequest{
+			State:       github.String("closed"),
+			StateReason: github.String("completed"),
+		})
+		if err != nil {
+			if errors.Is(err, ghclient.ErrNotFound) {
+				// There's no such issue anymore, we exit by stating that the action was turned off.
+				return nil, fmt.Errorf("issue already gone: %w, %w", err, enginerr.ErrActionTurnedOff)
+			}
+			return nil, fmt.Errorf("error closing issue: %w, %w", err, enginerr.ErrActionFailed)
+		}
+		logger.Info().Int("issue_number", params.Metadata.Number).Msg("issue closed")
+		// Keep the issue number, so that the issue is reopened if the rule fails again
+		closedMeta, err := json.Marshal(alertMetadata{
+			Number: params.Metadata.Number,
+			Title:  params.Metadata.Title,
+			Body:   params.Metadata.Body,
+			Closed: true,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error marshalling alert metadata json: %w", err)
+		}
+		// Success - return ErrActionTurnedOff to indicate the action was successful
+		return closedMeta, fmt.Errorf("%s : %w", alert.Class(), enginerr.ErrActionTurnedOff)
+	case interfaces.ActionCmdDoNothing:
+		return nil, enginerr.ErrActionSkipped
+	}
+	return nil, enginerr.ErrActionSkipped
+}
+
+// runDry runs the issue action in dry run mode
+func (alert *Alert) runDry(ctx context.Context, params *paramsIssue, cmd interfaces.ActionCmd) error {
+	logger := zerolog.Ctx(ctx)
+
+	var method, endpoint string
+	var req *github.IssueRequest
+	switch cmd {
+	case interfaces.ActionCmdOn:
+		method, endpoint = http.MethodPost, fmt.Sprintf("repos/%v/%v/issues", params.Owner, params.Repo)
+		req = alert.issueRequest(params, "")
+		if params.Metadata != nil && params.Metadata.Number != 0 {
+			method, endpoint = http.MethodPatch, fmt.Sprintf("%s/%d", endpoint, params.Metadata.Number)
+			req = alert.issueRequest(params, reopenState(params.Metadata))
+		}
+	case interfaces.ActionCmdOff:
+		if params.Metadata == nil || params.Metadata.Number == 0 {
+			// We cannot do anything without the issue number, so we assume that closing this is a success
+			return fmt.Errorf("no issue number provided: %w", enginerr.ErrActionTurnedOff)
+		}
+		method = http.MethodPatch
+		endpoint = fmt.Sprintf("repos/%v/%v/issues/%d", params.Owner, params.Repo, params.Metadata.Number)
+		req = &github.IssueRequest{State: github.String("closed"), StateReason: github.String("completed")}
+	case interfaces.ActionCmdDoNothing:
+		return enginerr.ErrActionSkipped
+	}
+
+	body, err := json.Marshal(req)
+	if err != nil {
+		return fmt.Errorf("error marshalling request: %w", err)
+	}
+	curlCmd, err := util.GenerateCurlCommand(method, alert.cli.GetBaseURL(), endpoint, string(body))
+	if err != nil {
+		return fmt.Errorf("cannot generate curl command: %w", err)
+	}
+	logger.Info().Msgf("run the following curl command: \n%s\n", curlCmd)
+	return nil
+}
+
+// reopenState returns the state updating the issue sets. Only an issue closed by minder is
+// reopened, an issue someone closed by hand stays closed.
+func reopenState(meta *alertMetadata) string {
+	if meta.Closed {
+		return "open"
+	}
+	return ""
+}
+
+// issueRequest returns the request opening or updating the issue
+func (alert *Alert) issueRequest(params *paramsIssue, state string) *github.IssueRequest {
+	req := &github.IssueRequest{
+		Title: &params.Title,
+		Body:  &params.Body,
+	}
+	if state != "" {
+		req.State = &state
+	}
+	if len(alert.cfg.GetLabels()) > 0 {
+		labels := alert.cfg.GetLabels()
+		req.Labels = &labels
+	}
+	if len(alert.cfg.GetAssignees()) > 0 {
+		assignees := alert.cfg.GetAssignees()
+		req.Assignees = &assignees
+	}
+	return req
+}
+
+// getParamsForIssue extracts the details from the entity
+func (alert *Alert) getParamsForIssue(
+	ctx context.Context,
+	entity protoreflect.ProtoMessage,
+	params interfaces.ActionsParams,
+	metadata *json.RawMessage,
+) (*paramsIssue, error) {
+	logger := zerolog.Ctx(ctx)
+	result := &paramsIssue{}
+
+	// Get the owner and repo from the entity
+	switch entity := entity.(type) {
+	case *pb.Repository:
+		result.Owner = entity.GetOwner()
+		result.Repo = entity.GetName()
+	case *pb.PullRequest:
+		result.Owner = entity.GetRepoOwner()
+		result.Repo = entity.GetRepoName()
+	case *pb.Artifact:
+		result.Owner = entity.GetOwner()
+		result.Repo = entity.GetRepository()
+	case *pb.BuildEnvironment:
+		result.Owner = entity.GetRepoOwner()
+		result.Repo = entity.GetRepoName()
+	default:
+		return nil, fmt.Errorf("expected repository, pull request, artifact or build environment, got %T", entity)
+	}
+
+	// Unmarshal the existing alert metadata, if any
+	if metadata != nil {
+		meta := &alertMetadata{}
+		err := json.Unmarshal(*metadata, meta)
+		if err != nil {
+			// There's nothing saved apparently, so no need to fail here, but do log the error
+			logger.Debug().Msgf("error unmarshalling alert metadata: %v", err)
+		} else {
+			result.Metadata = meta
+		}
+	}
+
+	tmplParams := &TemplateParams{
+		Profile:     params.GetProfile().GetName(),
+		Rule:        params.GetRuleType().GetName(),
+		Description: params.GetRuleType().GetDescription(),
+		Guidance:    params.GetRuleType().GetGuidance(),
+		Details:     enginerr.ErrorAsEvalDetails(params.GetEvalErr()),
+		Repository:  fmt.Sprintf("%s/%s", result.Owner, result.Repo),
+		Entity:      entity,
+		Params:      params.GetRule().GetParams().AsMap(),
+	}
+
+	var title strings.Builder
+	if err := alert.titleTmpl.Execute(&title, tmplParams); err != nil {
+		return nil, fmt.Errorf("error executing title template: %w", err)
+	}
+	result.Title = title.String()
+
+	var body strings.Builder
+	if err := alert.bodyTmpl.Execute(&body, tmplParams); err != nil {
+		return nil, fmt.Errorf("error executing body template: %w", err)
+	}
+	result.Body = body.String()
+
+	return result, nil
+}
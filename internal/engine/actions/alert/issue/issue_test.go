@@ -0,0 +1,326 @@
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package issue
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-github/v53/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/stacklok/minder/internal/db"
+	enginerr "github.com/stacklok/minder/internal/engine/errors"
+	"github.com/stacklok/minder/internal/engine/interfaces"
+	"github.com/stacklok/minder/internal/providers"
+	ghclient "github.com/stacklok/minder/internal/providers/github"
+	mock_ghclient "github.com/stacklok/minder/internal/providers/github/mock"
+	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+	provifv1 "github.com/stacklok/minder/pkg/providers/v1"
+)
+
+const (
+	repoOwner = "stacklok"
+	repoName  = "minder"
+)
+
+func testGithubProviderBuilder() *providers.ProviderBuilder {
+	return providers.NewProviderBuilder(
+		&db.Provider{
+			Name:       "github",
+			Version:    provifv1.V1,
+			Implements: []db.ProviderType{db.ProviderTypeGithub, db.ProviderTypeRest},
+			Definition: json.RawMessage(`{"github": {"endpoint": "https://api.github.com/"}}`),
+		},
+		db.ProviderAccessToken{},
+		"token",
+	)
+}
+
+func testParams(t *testing.T) *interfaces.EvalStatusParams {
+	t.Helper()
+
+	ruleParams, err := structpb.NewStruct(map[string]any{"team": "security"})
+	require.NoError(t, err)
+
+	params := &interfaces.EvalStatusParams{
+		Profile: &pb.Profile{Name: "hygiene"},
+		Rule:    &pb.Profile_Rule{Type: "license", Params: ruleParams},
+		RuleType: &pb.RuleType{
+			Name:        "license",
+			Description: "Verifies that the repository has a license",
+			Guidance:    "Add a LICENSE file",
+		},
+	}
+	params.SetEvalErr(enginerr.NewErrEvaluationFailed("no LICENSE file found"))
+	return params
+}
+
+// issueRequestMatcher matches the fields of an issue request, pointers to slices confuse gomock
+type issueRequestMatcher struct {
+	check func(*github.IssueRequest) bool
+	desc  string
+}
+
+func (m issueRequestMatcher) Matches(x interface{}) bool {
+	req, ok := x.(*github.IssueRequest)
+	return ok && m.check(req)
+}
+
+func (m issueRequestMatcher) String() string {
+	return m.desc
+}
+
+func openIssueRequest(state string) gomock.Matcher {
+	return issueRequestMatcher{
+		desc: fmt.Sprintf("issue request with state %q", state),
+		check: func(req *github.IssueRequest) bool {
+			return req.GetTitle() == "minder: profile hygiene failed with rule license" &&
+				req.GetState() == state &&
+				assert.ObjectsAreEqual([]string{"compliance"}, req.GetLabels()) &&
+				assert.ObjectsAreEqual([]string{"octocat"}, req.GetAssignees())
+		},
+	}
+}
+
+func closeIssueRequest() gomock.Matcher {
+	return issueRequestMatcher{
+		desc: "issue request closing the issue",
+		check: func(req *github.IssueRequest) bool {
+			return req.GetState() == "closed" && req.GetStateReason() == "completed"
+		},
+	}
+}
+
+func TestIssueAlert(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		cmd      interfaces.ActionCmd
+		metadata string
+		// upToDate stores the rendered title and body of the issue in the metadata
+		upToDate       bool
+		mockSetup      func(*mock_ghclient.MockGitHub)
+		expectedNumber int
+		expectedClosed bool
+		expectedErr    error
+	}{
+		{
+			name: "opens an issue",
+			cmd:  interfaces.ActionCmdOn,
+			mockSetup: func(mockGitHub *mock_ghclient.MockGitHub) {
+				mockGitHub.EXPECT().
+					CreateIssue(gomock.Any(), repoOwner, repoName, openIssueRequest("")).
+					Return(&github.Issue{Number: github.Int(42)}, nil)
+			},
+			expectedNumber: 42,
+		},
+		{
+			name:     "updates the issue without reopening it",
+			cmd:      interfaces.ActionCmdOn,
+			metadata: `{"issue_number": 42}`,
+			mockSetup: func(mockGitHub *mock_ghclient.MockGitHub) {
+				mockGitHub.EXPECT().
+					EditIssue(gomock.Any(), repoOwner, repoName, 42, openIssueRequest("")).
+					Return(&github.Issue{Number: github.Int(42)}, nil)
+			},
+			expectedNumber: 42,
+		},
+		{
+			name:     "reopens the issue it closed",
+			cmd:      interfaces.ActionCmdOn,
+			metadata: `{"issue_number": 42, "closed": true}`,
+			upToDate: true,
+			mockSetup: func(mockGitHub *mock_ghclient.MockGitHub) {
+				mockGitHub.EXPECT().
+					EditIssue(gomock.Any(), repoOwner, repoName, 42, openIssueRequest("open")).
+					Return(&github.Issue{Number: github.Int(42)}, nil)
+			},
+			expectedNumber: 42,
+		},
+		{
+			name:           "skips editing the issue when it is up to date",
+			cmd:            interfaces.ActionCmdOn,
+			metadata:       `{"issue_number": 42}`,
+			upToDate:       true,
+			mockSetup:      func(_ *mock_ghclient.MockGitHub) {},
+			expectedNumber: 42,
+		},
+		{
+			name:     "opens a new issue if the previous one is gone",
+			cmd:      interfaces.ActionCmdOn,
+			metadata: `{"issue_number": 42}`,
+			mockSetup: func(mockGitHub *mock_ghclient.MockGitHub) {
+				mockGitHub.EXPECT().
+					EditIssue(gomock.Any(), repoOwner, repoName, 42, gomock.Any()).
+					Return(nil, ghclient.ErrNotFound)
+				mockGitHub.EXPECT().
+					CreateIssue(gomock.Any(), repoOwner, repoName, openIssueRequest("")).
+					Return(&github.Issue{Number: github.Int(43)}, nil)
+			},
+			expectedNumber: 43,
+		},
+		{
+			name: "fails to open an issue",
+			cmd:  interfaces.ActionCmdOn,
+			mockSetup: func(mockGitHub *mock_ghclient.MockGitHub) {
+				mockGitHub.EXPECT().
+					CreateIssue(gomock.Any(), repoOwner, repoName, gomock.Any()).
+					Return(nil, errors.New("boom"))
+			},
+			expectedErr: enginerr.ErrActionFailed,
+		},
+		{
+			name:     "closes the issue",
+			cmd:      interfaces.ActionCmdOff,
+			metadata: `{"issue_number": 42}`,
+			upToDate: true,
+			mockSetup: func(mockGitHub *mock_ghclient.MockGitHub) {
+				mockGitHub.EXPECT().
+					EditIssue(gomock.Any(), repoOwner, repoName, 42, closeIssueRequest()).
+					Return(&github.Issue{Number: github.Int(42)}, nil)
+			},
+			expectedNumber: 42,
+			expectedClosed: true,
+			expectedErr:    enginerr.ErrActionTurnedOff,
+		},
+		{
+			name:        "nothing to close without an issue",
+			cmd:         interfaces.ActionCmdOff,
+			mockSetup:   func(_ *mock_ghclient.MockGitHub) {},
+			expectedErr: enginerr.ErrActionTurnedOff,
+		},
+		{
+			name:        "does nothing",
+			cmd:         interfaces.ActionCmdDoNothing,
+			metadata:    `{"issue_number": 42}`,
+			mockSetup:   func(_ *mock_ghclient.MockGitHub) {},
+			expectedErr: enginerr.ErrActionSkipped,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			alert, err := NewIssueAlert("alert", &pb.RuleType_Definition_Alert_AlertTypeIssue{
+				Labels:    []string{"compliance"},
+				Assignees: []string{"octocat"},
+			}, testGithubProviderBuilder())
+			require.NoError(t, err)
+
+			mockGitHub := mock_ghclient.NewMockGitHub(ctrl)
+			tt.mockSetup(mockGitHub)
+			alert.cli = mockGitHub
+
+			repo := &pb.Repository{Owner: repoOwner, Name: repoName}
+			rendered, err := alert.getParamsForIssue(context.Background(), repo, testParams(t), nil)
+			require.NoError(t, err)
+
+			var metadata *json.RawMessage
+			if tt.metadata != "" {
+				raw := json.RawMessage(tt.metadata)
+				if tt.upToDate {
+					meta := alertMetadata{}
+					require.NoError(t, json.Unmarshal(raw, &meta))
+					meta.Title, meta.Body = rendered.Title, rendered.Body
+					raw, err = json.Marshal(meta)
+					require.NoError(t, err)
+				}
+				metadata = &raw
+			}
+
+			meta, err := alert.Do(context.Background(), tt.cmd, interfaces.ActionOptOn, repo, testParams(t), metadata)
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				require.NoError(t, err)
+			}
+			if tt.expectedNumber == 0 {
+				return
+			}
+
+			var got alertMetadata
+			require.NoError(t, json.Unmarshal(meta, &got))
+			assert.Equal(t, alertMetadata{
+				Number: tt.expectedNumber,
+				Title:  rendered.Title,
+				Body:   rendered.Body,
+				Closed: tt.expectedClosed,
+			}, got, "the rendered title and body must be stored with the issue number")
+		})
+	}
+}
+
+func TestIssueTemplates(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		cfg      *pb.RuleType_Definition_Alert_AlertTypeIssue
+		title    string
+		contains []string
+	}{
+		{
+			name:  "default templates",
+			cfg:   &pb.RuleType_Definition_Alert_AlertTypeIssue{},
+			title: "minder: profile hygiene failed with rule license",
+			contains: []string{
+				"**stacklok/minder** doesn't comply with the **license** rule of the **hygiene** profile",
+				"Verifies that the repository has a license",
+				"Add a LICENSE file",
+				"no LICENSE file found",
+			},
+		},
+		{
+			name: "custom templates",
+			cfg: &pb.RuleType_Definition_Alert_AlertTypeIssue{
+				Title: "{{ .Rule }} is failing in {{ .Repository }}",
+				Body:  "cc @{{ .Params.team }}: {{ .Details }}",
+			},
+			title:    "license is failing in stacklok/minder",
+			contains: []string{"cc @security: no LICENSE file found"},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			alert, err := NewIssueAlert("alert", tt.cfg, testGithubProviderBuilder())
+			require.NoError(t, err)
+
+			p, err := alert.getParamsForIssue(context.Background(),
+				&pb.Repository{Owner: repoOwner, Name: repoName}, testParams(t), nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.title, p.Title)
+			for _, s := range tt.contains {
+				assert.Contains(t, p.Body, s)
+			}
+		})
+	}
+}
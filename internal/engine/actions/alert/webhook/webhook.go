@@ -0,0 +1,451 @@
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package webhook provides necessary interfaces and implementations for
+// sending alerts to a webhook.
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"syscall"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	enginerr "github.com/stacklok/minder/internal/engine/errors"
+	"github.com/stacklok/minder/internal/engine/interfaces"
+	"github.com/stacklok/minder/internal/providers"
+	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+const (
+	// AlertType is the type of the webhook alert engine
+	AlertType = "webhook"
+
+	// FormatJSON sends the payload as is
+	FormatJSON = "json"
+	// FormatCloudEvents sends the payload as the data of a structured mode CloudEvent
+	FormatCloudEvents = "cloudevents"
+
+	// StatusFiring is the status of the payloads sent when a rule starts failing
+	StatusFiring = "firing"
+	// StatusResolved is the status of the payloads sent when a rule passes again
+	StatusResolved = "resolved"
+
+	// SignatureHeader is the header holding the HMAC-SHA256 signature of the payload,
+	// hex encoded and prefixed with "sha256="
+	SignatureHeader = "X-Minder-Signature-256"
+
+	defaultMaxRetries = 3
+	defaultRetryDelay = time.Second
+	requestTimeout    = 10 * time.Second
+	// sendTimeout bounds the time spent delivering a payload, retries included
+	sendTimeout        = time.Minute
+	maxRedirects       = 3
+	cloudEventsSource  = "minder"
+	cloudEventsTypeFmt = "dev.stacklok.minder.alert.%s"
+)
+
+// Alert is the structure backing the webhook alert action
+type Alert struct {
+	actionType interfaces.ActionType
+	cfg        *pb.RuleType_Definition_Alert_AlertTypeWebhook
+	secret     []byte
+	cli        *http.Client
+	maxRetries int
+	retryDelay time.Duration
+}
+
+// Payload is the description of the alert sent to the webhook
+type Payload struct {
+	// ID identifies the alert, it's the same in the firing and resolved payloads
+	ID string `json:"id"`
+	// Status is either firing or resolved
+	Status      string          `json:"status"`
+	Profile     string          `json:"profile"`
+	RuleType    string          `json:"rule_type"`
+	Description string          `json:"description"`
+	Guidance    string          `json:"guidance"`
+	Details     string          `json:"details,omitempty"`
+	EntityType  string          `json:"entity_type"`
+	EntityName  string          `json:"entity_name"`
+	Entity      json.RawMessage `json:"entity"`
+	Timestamp   time.Time       `json:"timestamp"`
+}
+
+// cloudEvent is a CloudEvent in the structured content mode
+type cloudEvent struct {
+	SpecVersion     string    `json:"specversion"`
+	ID              string    `json:"id"`
+	Source          string    `json:"source"`
+	Type            string    `json:"type"`
+	Subject         string    `json:"subject,omitempty"`
+	Time            time.Time `json:"time"`
+	DataContentType string    `json:"datacontenttype"`
+	Data            *Payload  `json:"data"`
+}
+
+// errAddressNotAllowed is returned when the webhook resolves to an address of a network
+// that alerts must not reach, such as the internal network of the server
+var errAddressNotAllowed = errors.New("webhook address not allowed")
+
+// blockedPrefixes are the ranges of addresses that aren't private, loopback nor link-local,
+// but that webhooks must not reach either
+var blockedPrefixes = []netip.Prefix{
+	// shared address space, which hosts the metadata endpoint of some cloud providers
+	netip.MustParsePrefix("100.64.0.0/10"),
+	// "this network"
+	netip.MustParsePrefix("0.0.0.0/8"),
+}
+
+type alertMetadata struct {
+	ID string `json:"webhook_alert_id,omitempty"`
+}
+
+// NewWebhookAlert creates a new webhook alert action
+func NewWebhookAlert(
+	actionType interfaces.ActionType,
+	whCfg *pb.RuleType_Definition_Alert_AlertTypeWebhook,
+	pbuild *providers.ProviderBuilder,
+) (*Alert, error) {
+	if actionType == "" {
+		return nil, fmt.Errorf("action type cannot be empty")
+	}
+
+	if err := whCfg.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid webhook alert: %w", err)
+	}
+
+	// payloads aren't signed if there's no secret
+	var secret []byte
+	if whCfg.EncryptedSecret != "" {
+		var err error
+		secret, err = pbuild.DecryptSecret(whCfg.EncryptedSecret)
+		if err != nil {
+			return nil, fmt.Errorf("cannot decrypt webhook secret: %w", err)
+		}
+	}
+
+	maxRetries := defaultMaxRetries
+	if whCfg.MaxRetries != nil {
+		maxRetries = int(whCfg.GetMaxRetries())
+	}
+
+	return &Alert{
+		actionType: actionType,
+		cfg:        whCfg,
+		secret:     secret,
+		cli:        newClient(),
+		maxRetries: maxRetries,
+		retryDelay: defaultRetryDelay,
+	}, nil
+}
+
+// Class returns the action type of the webhook engine
+func (alert *Alert) Class() interfaces.ActionType {
+	return alert.actionType
+}
+
+// Type returns the action subtype of the webhook engine
+func (_ *Alert) Type() string {
+	return AlertType
+}
+
+// GetOnOffState returns the alert action state read from the profile
+func (_ *Alert) GetOnOffState(p *pb.Profile) interfaces.ActionOpt {
+	return interfaces.ActionOptFromString(p.Alert, interfaces.ActionOptOn)
+}
+
+// Do alerts through the webhook
+func (alert *Alert) Do(
+	ctx context.Context,
+	cmd interfaces.ActionCmd,
+	setting interfaces.ActionOpt,
+	entity protoreflect.ProtoMessage,
+	params interfaces.ActionsParams,
+	metadata *json.RawMessage,
+) (json.RawMessage, error) {
+	if cmd == interfaces.ActionCmdDoNothing {
+		return nil, enginerr.ErrActionSkipped
+	}
+
+	meta := &alertMetadata{}
+	if metadata != nil {
+		if err := json.Unmarshal(*metadata, meta); err != nil {
+			// There's nothing saved apparently, so no need to fail here, but do log the error
+			zerolog.Ctx(ctx).Debug().Msgf("error unmarshalling alert metadata: %v", err)
+		}
+	}
+
+	if cmd == interfaces.ActionCmdOff && meta.ID == "" {
+		// Nothing was sent when the rule failed, so there's nothing to resolve
+		return nil, fmt.Errorf("no webhook alert was sent: %w", enginerr.ErrActionTurnedOff)
+	}
+
+	payload, err := newPayload(cmd, entity, params, meta.ID)
+	if err != nil {
+		return nil, fmt.Errorf("error building webhook payload: %w", err)
+	}
+
+	body, contentType, err := alert.encode(payload)
+	if err != nil {
+		return nil, fmt.Errorf("error encoding webhook payload: %w", err)
+	}
+
+	// Process the command based on the action setting
+	switch setting {
+	case interfaces.ActionOptOn:
+		return alert.run(ctx, payload, body, contentType)
+	case interfaces.ActionOptDryRun:
+		zerolog.Ctx(ctx).Info().Str("url", alert.cfg.Url).RawJSON("payload", body).
+			Msg("dry run, the webhook alert was not sent")
+		if cmd == interfaces.ActionCmdOff {
+			return nil, enginerr.ErrActionSkipped
+		}
+		return nil, nil
+	case interfaces.ActionOptOff, interfaces.ActionOptUnknown:
+		return nil, fmt.Errorf("unexpected action setting: %w", enginerr.ErrActionFailed)
+	}
+	return nil, enginerr.ErrActionSkipped
+}
+
+// run sends the payload and returns the metadata of the alert
+func (alert *Alert) run(ctx context.Context, payload *Payload, body []byte, contentType string) (json.RawMessage, error) {
+	logger := zerolog.Ctx(ctx)
+
+	if err := alert.send(ctx, body, contentType); err != nil {
+		return nil, fmt.Errorf("error sending webhook alert: %w, %w", err, enginerr.ErrActionFailed)
+	}
+	logger.Info().Str("alert_id", payload.ID).Str("status", payload.Status).Msg("webhook alert sent")
+
+	if payload.Status == StatusResolved {
+		// Success - return ErrActionTurnedOff to indicate the action was successful
+		return nil, fmt.Errorf("%s : %w", alert.Class(), enginerr.ErrActionTurnedOff)
+	}
+
+	newMeta, err := json.Marshal(alertMetadata{ID: payload.ID})
+	if err != nil {
+		return nil, fmt.Errorf("error marshalling alert metadata json: %w", err)
+	}
+	return newMeta, nil
+}
+
+// send POSTs the payload to the webhook, retrying with an exponential backoff
+// if the webhook can't be reached or fails to process it
+func (alert *Alert) send(ctx context.Context, body []byte, contentType string) error {
+	ctx, cancel := context.WithTimeout(ctx, sendTimeout)
+	defer cancel()
+
+	delay := alert.retryDelay
+	for attempt := 0; ; attempt++ {
+		retry, err := alert.post(ctx, body, contentType)
+		if err == nil || !retry || attempt >= alert.maxRetries {
+			return err
+		}
+
+		zerolog.Ctx(ctx).Debug().Err(err).Int("attempt", attempt+1).Msg("retrying webhook alert")
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(delay):
+		}
+		delay *= 2
+	}
+}
+
+// post POSTs the payload once and returns whether a failure is worth retrying
+func (alert *Alert) post(ctx context.Context, body []byte, contentType string) (bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, alert.cfg.Url, bytes.NewReader(body))
+	if err != nil {
+		return false, fmt.Errorf("cannot create request: %w", err)
+	}
+	req.Header.Set("Content-Type", contentType)
+	req.Header.Set("User-Agent", "minder")
+	if len(alert.secret) > 0 {
+		req.Header.Set(SignatureHeader, Sign(alert.secret, body))
+	}
+
+	resp, err := alert.cli.Do(req)
+	if err != nil {
+		return true, err
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			zerolog.Ctx(ctx).Debug().Err(err).Msg("cannot close response body")
+		}
+	}()
+
+	switch {
+	case resp.StatusCode >= 200 && resp.StatusCode < 300:
+		return false, nil
+	case resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500:
+		return true, fmt.Errorf("webhook responded with %s", resp.Status)
+	default:
+		return false, fmt.Errorf("webhook responded with %s", resp.Status)
+	}
+}
+
+// newClient returns an HTTP client that only connects to public addresses over https, so that
+// webhooks can't be used to reach the internal network of the server. The addresses are checked
+// when dialing, after the host name was resolved, so that a host name resolving to an internal
+// address is refused too.
+func newClient() *http.Client {
+	dialer := &net.Dialer{
+		Timeout: requestTimeout,
+		Control: allowPublicAddress,
+	}
+	return &http.Client{
+		Timeout: requestTimeout,
+		Transport: &http.Transport{
+			// no proxy, the webhook itself is dialed
+			Proxy:               nil,
+			DialContext:         dialer.DialContext,
+			TLSHandshakeTimeout: requestTimeout,
+			ForceAttemptHTTP2:   true,
+		},
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if req.URL.Scheme != "https" {
+				return fmt.Errorf("redirect to %s: an https url is required", req.URL)
+			}
+			if len(via) >= maxRedirects {
+				return fmt.Errorf("stopped after %d redirects", maxRedirects)
+			}
+			return nil
+		},
+	}
+}
+
+// allowPublicAddress refuses to connect to private, loopback, link-local and other internal
+// addresses, which include the metadata endpoints of cloud providers
+func allowPublicAddress(_, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return fmt.Errorf("%w: %s", errAddressNotAllowed, address)
+	}
+	addr, err := netip.ParseAddr(host)
+	if err != nil {
+		return fmt.Errorf("%w: %s", errAddressNotAllowed, address)
+	}
+
+	addr = addr.Unmap()
+	if !addr.IsGlobalUnicast() || addr.IsPrivate() {
+		return fmt.Errorf("%w: %s", errAddressNotAllowed, addr)
+	}
+	for _, prefix := range blockedPrefixes {
+		if prefix.Contains(addr) {
+			return fmt.Errorf("%w: %s", errAddressNotAllowed, addr)
+		}
+	}
+	return nil
+}
+
+// encode encodes the payload in the format of the webhook
+func (alert *Alert) encode(payload *Payload) ([]byte, string, error) {
+	if alert.cfg.Format != FormatCloudEvents {
+		body, err := json.Marshal(payload)
+		return body, "application/json", err
+	}
+
+	body, err := json.Marshal(&cloudEvent{
+		SpecVersion:     "1.0",
+		ID:              uuid.New().String(),
+		Source:          cloudEventsSource,
+		Type:            fmt.Sprintf(cloudEventsTypeFmt, payload.Status),
+		Subject:         payload.EntityName,
+		Time:            payload.Timestamp,
+		DataContentType: "application/json",
+		Data:            payload,
+	})
+	return body, "application/cloudevents+json", err
+}
+
+// Sign returns the value of the signature header of a payload, so that
+// receivers can verify that the payload was sent by minder
+func Sign(secret, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	// writing to a hash never fails
+	_, _ = mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// newPayload describes the alert. A firing alert that wasn't sent before gets a new ID.
+func newPayload(
+	cmd interfaces.ActionCmd,
+	entity protoreflect.ProtoMessage,
+	params interfaces.ActionsParams,
+	id string,
+) (*Payload, error) {
+	status := StatusFiring
+	if cmd == interfaces.ActionCmdOff {
+		status = StatusResolved
+	}
+	if id == "" {
+		id = uuid.New().String()
+	}
+
+	name, err := entityName(entity)
+	if err != nil {
+		return nil, err
+	}
+
+	ent, err := protojson.Marshal(entity)
+	if err != nil {
+		return nil, fmt.Errorf("error marshalling entity: %w", err)
+	}
+
+	p := &Payload{
+		ID:          id,
+		Status:      status,
+		Profile:     params.GetProfile().GetName(),
+		RuleType:    params.GetRuleType().GetName(),
+		Description: params.GetRuleType().GetDescription(),
+		Guidance:    params.GetRuleType().GetGuidance(),
+		EntityType:  params.GetRuleType().GetDef().GetInEntity(),
+		EntityName:  name,
+		Entity:      ent,
+		Timestamp:   time.Now().UTC(),
+	}
+	if status == StatusFiring && params.GetEvalErr() != nil {
+		p.Details = enginerr.ErrorAsEvalDetails(params.GetEvalErr())
+	}
+	return p, nil
+}
+
+func entityName(entity protoreflect.ProtoMessage) (string, error) {
+	switch entity := entity.(type) {
+	case *pb.Repository:
+		return fmt.Sprintf("%s/%s", entity.GetOwner(), entity.GetName()), nil
+	case *pb.PullRequest:
+		return fmt.Sprintf("%s/%s#%d", entity.GetRepoOwner(), entity.GetRepoName(), entity.GetNumber()), nil
+	case *pb.Artifact:
+		return fmt.Sprintf("%s/%s", entity.GetOwner(), entity.GetName()), nil
+	case *pb.BuildEnvironment:
+		return fmt.Sprintf("%s/%s:%s", entity.GetRepoOwner(), entity.GetRepoName(), entity.GetName()), nil
+	default:
+		return "", errors.New("unsupported entity")
+	}
+}
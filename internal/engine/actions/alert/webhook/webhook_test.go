@@ -0,0 +1,347 @@
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	enginerr "github.com/stacklok/minder/internal/engine/errors"
+	"github.com/stacklok/minder/internal/engine/interfaces"
+	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+const testSecret = "s3cr3t"
+
+type request struct {
+	contentType string
+	signature   string
+	body        []byte
+}
+
+// webhookServer records the requests it receives and responds with the given status codes in turn
+type webhookServer struct {
+	mu       sync.Mutex
+	statuses []int
+	requests []request
+}
+
+func (s *webhookServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	body, _ := io.ReadAll(r.Body)
+	s.requests = append(s.requests, request{
+		contentType: r.Header.Get("Content-Type"),
+		signature:   r.Header.Get(SignatureHeader),
+		body:        body,
+	})
+
+	status := http.StatusOK
+	if len(s.statuses) > 0 {
+		status, s.statuses = s.statuses[0], s.statuses[1:]
+	}
+	w.WriteHeader(status)
+}
+
+func newTestAlert(url, format string) *Alert {
+	return &Alert{
+		actionType: "alert",
+		cfg:        &pb.RuleType_Definition_Alert_AlertTypeWebhook{Url: url, Format: format},
+		secret:     []byte(testSecret),
+		cli:        http.DefaultClient,
+		maxRetries: 2,
+		retryDelay: time.Millisecond,
+	}
+}
+
+func testParams() *interfaces.EvalStatusParams {
+	params := &interfaces.EvalStatusParams{
+		Profile: &pb.Profile{Name: "hygiene"},
+		RuleType: &pb.RuleType{
+			Name:        "secret_scanning",
+			Description: "Verifies that secret scanning is enabled",
+			Guidance:    "Enable secret scanning",
+			Def:         &pb.RuleType_Definition{InEntity: "repository"},
+		},
+	}
+	params.SetEvalErr(enginerr.NewErrEvaluationFailed("secret scanning is disabled"))
+	return params
+}
+
+var testRepo = &pb.Repository{Owner: "stacklok", Name: "minder"}
+
+func TestWebhookAlertFiring(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		format      string
+		contentType string
+	}{
+		{
+			name:        "json",
+			format:      FormatJSON,
+			contentType: "application/json",
+		},
+		{
+			name:        "cloudevents",
+			format:      FormatCloudEvents,
+			contentType: "application/cloudevents+json",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			srv := &webhookServer{}
+			ts := httptest.NewServer(srv)
+			defer ts.Close()
+
+			alert := newTestAlert(ts.URL, tt.format)
+			meta, err := alert.Do(context.Background(), interfaces.ActionCmdOn, interfaces.ActionOptOn,
+				testRepo, testParams(), nil)
+			require.NoError(t, err)
+
+			var m alertMetadata
+			require.NoError(t, json.Unmarshal(meta, &m))
+			require.NotEmpty(t, m.ID)
+
+			require.Len(t, srv.requests, 1)
+			req := srv.requests[0]
+			assert.Equal(t, tt.contentType, req.contentType)
+			assert.Equal(t, Sign([]byte(testSecret), req.body), req.signature)
+
+			var payload Payload
+			if tt.format == FormatCloudEvents {
+				var event struct {
+					SpecVersion string  `json:"specversion"`
+					Type        string  `json:"type"`
+					Subject     string  `json:"subject"`
+					Data        Payload `json:"data"`
+				}
+				require.NoError(t, json.Unmarshal(req.body, &event))
+				assert.Equal(t, "1.0", event.SpecVersion)
+				assert.Equal(t, "dev.stacklok.minder.alert.firing", event.Type)
+				assert.Equal(t, "stacklok/minder", event.Subject)
+				payload = event.Data
+			} else {
+				require.NoError(t, json.Unmarshal(req.body, &payload))
+			}
+
+			assert.Equal(t, m.ID, payload.ID)
+			assert.Equal(t, StatusFiring, payload.Status)
+			assert.Equal(t, "hygiene", payload.Profile)
+			assert.Equal(t, "secret_scanning", payload.RuleType)
+			assert.Equal(t, "Enable secret scanning", payload.Guidance)
+			assert.Equal(t, "secret scanning is disabled", payload.Details)
+			assert.Equal(t, "repository", payload.EntityType)
+			assert.Equal(t, "stacklok/minder", payload.EntityName)
+			assert.JSONEq(t, `{"owner": "stacklok", "name": "minder"}`, string(payload.Entity))
+		})
+	}
+}
+
+func TestWebhookAlertResolved(t *testing.T) {
+	t.Parallel()
+
+	srv := &webhookServer{}
+	ts := httptest.NewServer(srv)
+	defer ts.Close()
+
+	alert := newTestAlert(ts.URL, FormatJSON)
+	meta := json.RawMessage(`{"webhook_alert_id": "42"}`)
+	_, err := alert.Do(context.Background(), interfaces.ActionCmdOff, interfaces.ActionOptOn,
+		testRepo, testParams(), &meta)
+	require.ErrorIs(t, err, enginerr.ErrActionTurnedOff)
+
+	require.Len(t, srv.requests, 1)
+	var payload Payload
+	require.NoError(t, json.Unmarshal(srv.requests[0].body, &payload))
+	assert.Equal(t, "42", payload.ID, "the resolved payload should refer to the firing one")
+	assert.Equal(t, StatusResolved, payload.Status)
+	assert.Empty(t, payload.Details)
+}
+
+func TestWebhookAlertResolvedWithoutFiring(t *testing.T) {
+	t.Parallel()
+
+	srv := &webhookServer{}
+	ts := httptest.NewServer(srv)
+	defer ts.Close()
+
+	alert := newTestAlert(ts.URL, FormatJSON)
+	_, err := alert.Do(context.Background(), interfaces.ActionCmdOff, interfaces.ActionOptOn,
+		testRepo, testParams(), nil)
+	require.ErrorIs(t, err, enginerr.ErrActionTurnedOff)
+	assert.Empty(t, srv.requests)
+}
+
+func TestWebhookAlertRetries(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		statuses []int
+		requests int
+		wantErr  bool
+	}{
+		{
+			name:     "succeeds after server errors",
+			statuses: []int{http.StatusBadGateway, http.StatusTooManyRequests, http.StatusOK},
+			requests: 3,
+		},
+		{
+			name:     "gives up after the retries",
+			statuses: []int{http.StatusBadGateway, http.StatusBadGateway, http.StatusBadGateway},
+			requests: 3,
+			wantErr:  true,
+		},
+		{
+			name:     "doesn't retry client errors",
+			statuses: []int{http.StatusUnauthorized},
+			requests: 1,
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			srv := &webhookServer{statuses: tt.statuses}
+			ts := httptest.NewServer(srv)
+			defer ts.Close()
+
+			alert := newTestAlert(ts.URL, FormatJSON)
+			_, err := alert.Do(context.Background(), interfaces.ActionCmdOn, interfaces.ActionOptOn,
+				testRepo, testParams(), nil)
+			if tt.wantErr {
+				require.ErrorIs(t, err, enginerr.ErrActionFailed)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Len(t, srv.requests, tt.requests)
+		})
+	}
+}
+
+func TestWebhookAlertDryRun(t *testing.T) {
+	t.Parallel()
+
+	srv := &webhookServer{}
+	ts := httptest.NewServer(srv)
+	defer ts.Close()
+
+	alert := newTestAlert(ts.URL, FormatJSON)
+	meta, err := alert.Do(context.Background(), interfaces.ActionCmdOn, interfaces.ActionOptDryRun,
+		testRepo, testParams(), nil)
+	require.NoError(t, err)
+	assert.Nil(t, meta)
+	assert.Empty(t, srv.requests)
+}
+
+func TestNewWebhookAlertInvalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		cfg  *pb.RuleType_Definition_Alert_AlertTypeWebhook
+	}{
+		{
+			name: "plain http url",
+			cfg:  &pb.RuleType_Definition_Alert_AlertTypeWebhook{Url: "http://relay.example.com/minder"},
+		},
+		{
+			name: "too many retries",
+			cfg: &pb.RuleType_Definition_Alert_AlertTypeWebhook{
+				Url: "https://relay.example.com/minder", MaxRetries: proto.Int32(pb.MaxWebhookRetries + 1),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := NewWebhookAlert("alert", tt.cfg, nil)
+			require.ErrorContains(t, err, "invalid webhook alert")
+		})
+	}
+}
+
+func TestAllowPublicAddress(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		address string
+		allowed bool
+	}{
+		{address: "140.82.112.3:443", allowed: true},
+		{address: "[2606:50c0:8000::153]:443", allowed: true},
+		{address: "127.0.0.1:443"},
+		{address: "[::1]:443"},
+		{address: "10.0.0.1:443"},
+		{address: "192.168.1.1:443"},
+		{address: "169.254.169.254:80"},
+		{address: "[fd00:ec2::254]:80"},
+		{address: "100.100.100.200:80"},
+		{address: "[::ffff:10.0.0.1]:443"},
+		{address: "0.0.0.0:443"},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.address, func(t *testing.T) {
+			t.Parallel()
+
+			err := allowPublicAddress("tcp", tt.address, nil)
+			if tt.allowed {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, errAddressNotAllowed)
+			}
+		})
+	}
+}
+
+func TestWebhookClientRefusesInternalAddresses(t *testing.T) {
+	t.Parallel()
+
+	srv := &webhookServer{}
+	ts := httptest.NewTLSServer(srv)
+	defer ts.Close()
+
+	resp, err := newClient().Get(ts.URL)
+	if resp != nil {
+		_ = resp.Body.Close()
+	}
+	require.ErrorIs(t, err, errAddressNotAllowed)
+	assert.Empty(t, srv.requests)
+}
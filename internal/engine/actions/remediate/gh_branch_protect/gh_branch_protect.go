@@ -30,6 +30,7 @@ import (
 	"github.com/rs/zerolog"
 	"google.golang.org/protobuf/reflect/protoreflect"
 
+	enginerr "github.com/stacklok/minder/internal/engine/errors"
 	"github.com/stacklok/minder/internal/engine/interfaces"
 	"github.com/stacklok/minder/internal/providers"
 	"github.com/stacklok/minder/internal/util"
@@ -155,6 +156,8 @@ func (r *GhBranchProtectRemediator) Do(
 		err = r.cli.UpdateBranchProtection(ctx, repo.Owner, repo.Name, branch, updatedRequest)
 	case interfaces.ActionOptDryRun:
 		err = dryRun(r.cli.GetBaseURL(), repo.Owner, repo.Name, branch, updatedRequest)
+	case interfaces.ActionOptApprovalRequired:
+		err = proposal(repo.Owner, repo.Name, branch, updatedRequest)
 	case interfaces.ActionOptOff, interfaces.ActionOptUnknown:
 		err = errors.New("unexpected action")
 	}
@@ -167,7 +170,7 @@ func dryRun(baseUrl, owner, repo, branch string, req *github.ProtectionRequest)
 		log.Fatalf("Error marshalling data: %v", err)
 	}
 
-	endpoint := fmt.Sprintf("repos/%v/%v/branches/%v/protection", owner, repo, branch)
+	endpoint := protectionEndpoint(owner, repo, branch)
 	curlCmd, err := util.GenerateCurlCommand(http.MethodPut, baseUrl, endpoint, string(jsonReq))
 	if err != nil {
 		return fmt.Errorf("cannot generate curl command: %w", err)
@@ -177,6 +180,24 @@ func dryRun(baseUrl, owner, repo, branch string, req *github.ProtectionRequest)
 	return nil
 }
 
+// proposal returns the request updating the branch protection, to be performed once approved
+func proposal(owner, repo, branch string, req *github.ProtectionRequest) error {
+	jsonReq, err := json.Marshal(req)
+	if err != nil {
+		return fmt.Errorf("error marshalling request: %w", err)
+	}
+
+	return &enginerr.RemediationProposal{
+		Method:   http.MethodPut,
+		Endpoint: protectionEndpoint(owner, repo, branch),
+		Body:     string(jsonReq),
+	}
+}
+
+func protectionEndpoint(owner, repo, branch string) string {
+	return fmt.Sprintf("repos/%v/%v/branches/%v/protection", owner, repo, branch)
+}
+
 func patchRequest(
 	req *github.ProtectionRequest,
 	patch []byte,
@@ -0,0 +1,328 @@
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package gh_ruleset provides the github ruleset remediation engine
+package gh_ruleset
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"text/template"
+
+	jsonpatch "github.com/evanphx/json-patch/v5"
+	"github.com/rs/zerolog"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/stacklok/minder/internal/engine/actions/remediate/rest"
+	enginerr "github.com/stacklok/minder/internal/engine/errors"
+	"github.com/stacklok/minder/internal/engine/interfaces"
+	"github.com/stacklok/minder/internal/providers"
+	"github.com/stacklok/minder/internal/util"
+	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+	provifv1 "github.com/stacklok/minder/pkg/providers/v1"
+)
+
+const (
+	// RemediateType is the type of the GitHub ruleset remediation engine
+	RemediateType = "gh_ruleset"
+
+	// LevelRepository remediates a ruleset of the repository
+	LevelRepository = "repository"
+	// LevelOrganization remediates a ruleset of the organization owning the repository
+	LevelOrganization = "organization"
+)
+
+// repositoryConditions are the conditions that select the repositories an organization
+// ruleset applies to, one of which is required by the API
+var repositoryConditions = []string{"repository_name", "repository_id", "repository_property"}
+
+// readOnlyFields are the fields of a ruleset returned by the API that can't be sent back
+var readOnlyFields = []string{
+	"id", "source", "source_type", "node_id", "_links", "created_at", "updated_at", "current_user_can_bypass",
+}
+
+// GhRulesetRemediator keeps the status for a rule type that uses GH API to remediate rulesets
+type GhRulesetRemediator struct {
+	actionType    interfaces.ActionType
+	cli           provifv1.GitHub
+	level         string
+	nameTemplate  *template.Template
+	patchTemplate *template.Template
+}
+
+// NewGhRulesetRemediator creates a new remediation engine that uses the GitHub API for rulesets
+func NewGhRulesetRemediator(
+	actionType interfaces.ActionType,
+	ghr *pb.RuleType_Definition_Remediate_GhRulesetType,
+	pbuild *providers.ProviderBuilder,
+) (*GhRulesetRemediator, error) {
+	if actionType == "" {
+		return nil, fmt.Errorf("action type cannot be empty")
+	}
+
+	level := ghr.GetLevel()
+	switch level {
+	case "":
+		level = LevelRepository
+	case LevelRepository, LevelOrganization:
+	default:
+		return nil, fmt.Errorf("unknown ruleset level: %s", level)
+	}
+
+	nameTemplate, err := util.ParseNewTextTemplate(&ghr.Name, "name")
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse name template: %w", err)
+	}
+
+	patchTemplate, err := util.ParseNewTextTemplate(&ghr.Patch, "patch")
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse patch template: %w", err)
+	}
+
+	cli, err := pbuild.GetGitHub(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("cannot get http client: %w", err)
+	}
+	return &GhRulesetRemediator{
+		actionType:    actionType,
+		cli:           cli,
+		level:         level,
+		nameTemplate:  nameTemplate,
+		patchTemplate: patchTemplate,
+	}, nil
+}
+
+// TemplateParams is the parameters for the name and patch templates
+type TemplateParams struct {
+	// Entity is the entity to be evaluated
+	Entity any
+	// Profile are the parameters to be used in the template
+	Profile map[string]any
+	// Params are the rule instance parameters to be used in the template
+	Params map[string]any
+}
+
+// Class returns the action type of the remediation engine
+func (r *GhRulesetRemediator) Class() interfaces.ActionType {
+	return r.actionType
+}
+
+// Type returns the action subtype of the remediation engine
+func (_ *GhRulesetRemediator) Type() string {
+	return RemediateType
+}
+
+// GetOnOffState returns the alert action state read from the profile
+func (_ *GhRulesetRemediator) GetOnOffState(p *pb.Profile) interfaces.ActionOpt {
+	return interfaces.ActionOptFromString(p.Remediate, interfaces.ActionOptOff)
+}
+
+// Do perform the remediation
+func (r *GhRulesetRemediator) Do(
+	ctx context.Context,
+	_ interfaces.ActionCmd,
+	remAction interfaces.ActionOpt,
+	ent protoreflect.ProtoMessage,
+	params interfaces.ActionsParams,
+	_ *json.RawMessage,
+) (json.RawMessage, error) {
+	tmplParams := &TemplateParams{
+		Entity:  ent,
+		Profile: params.GetRule().Def.AsMap(),
+		Params:  params.GetRule().Params.AsMap(),
+	}
+
+	repo, ok := ent.(*pb.Repository)
+	if !ok {
+		return nil, fmt.Errorf("expected repository, got %T", ent)
+	}
+
+	var name bytes.Buffer
+	if err := r.nameTemplate.Execute(&name, tmplParams); err != nil {
+		return nil, fmt.Errorf("cannot execute name template: %w", err)
+	}
+	if name.Len() == 0 {
+		return nil, errors.New("the name of the ruleset is empty")
+	}
+
+	var patch bytes.Buffer
+	if err := r.patchTemplate.Execute(&patch, tmplParams); err != nil {
+		return nil, fmt.Errorf("cannot execute patch template: %w", err)
+	}
+
+	zerolog.Ctx(ctx).Debug().Str("ruleset", name.String()).Str("patch", patch.String()).Msg("patch")
+
+	id, current, err := r.findRuleset(ctx, repo, name.String())
+	if err != nil {
+		return nil, fmt.Errorf("error getting ruleset: %w", err)
+	}
+
+	body, err := patchRuleset(current, patch.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("error patching ruleset: %w", err)
+	}
+	if id == 0 && r.level == LevelOrganization && !hasRepositoryCondition(body) {
+		return nil, errors.New("the patch of a new organization ruleset must set the repositories " +
+			"it applies to in its conditions")
+	}
+
+	method, endpoint := http.MethodPost, r.rulesetsEndpoint(repo)
+	if id != 0 {
+		method, endpoint = http.MethodPut, fmt.Sprintf("%s/%d", endpoint, id)
+	}
+
+	switch remAction {
+	case interfaces.ActionOptOn:
+		err = rest.Perform(ctx, r.cli, method, endpoint, body)
+	case interfaces.ActionOptDryRun:
+		err = dryRun(r.cli.GetBaseURL(), method, endpoint, body)
+	case interfaces.ActionOptApprovalRequired:
+		err = &enginerr.RemediationProposal{
+			Method:   method,
+			Endpoint: endpoint,
+			Body:     string(body),
+		}
+	case interfaces.ActionOptOff, interfaces.ActionOptUnknown:
+		err = errors.New("unexpected action")
+	}
+	return nil, err
+}
+
+func (r *GhRulesetRemediator) rulesetsEndpoint(repo *pb.Repository) string {
+	if r.level == LevelOrganization {
+		return fmt.Sprintf("orgs/%v/rulesets", repo.Owner)
+	}
+	return fmt.Sprintf("repos/%v/%v/rulesets", repo.Owner, repo.Name)
+}
+
+// findRuleset returns the ID and the contents of the ruleset with the given name. If there's
+// no such ruleset, it returns an ID of 0 and the contents of a new active branch ruleset that
+// applies to the default branch.
+func (r *GhRulesetRemediator) findRuleset(
+	ctx context.Context, repo *pb.Repository, name string,
+) (int64, json.RawMessage, error) {
+	var summaries []json.RawMessage
+	var err error
+	if r.level == LevelOrganization {
+		summaries, err = r.cli.ListOrganizationRulesets(ctx, repo.Owner)
+	} else {
+		// the rulesets of the organization can't be edited through the repository
+		summaries, err = r.cli.ListRulesets(ctx, repo.Owner, repo.Name, false)
+	}
+	if err != nil {
+		return 0, nil, err
+	}
+
+	for _, summary := range summaries {
+		var s struct {
+			ID   int64  `json:"id"`
+			Name string `json:"name"`
+		}
+		if err := json.Unmarshal(summary, &s); err != nil {
+			return 0, nil, fmt.Errorf("error parsing ruleset: %w", err)
+		}
+		if s.Name != name {
+			continue
+		}
+
+		var ruleset json.RawMessage
+		if r.level == LevelOrganization {
+			ruleset, err = r.cli.GetOrganizationRuleset(ctx, repo.Owner, s.ID)
+		} else {
+			ruleset, err = r.cli.GetRuleset(ctx, repo.Owner, repo.Name, s.ID, false)
+		}
+		if err != nil {
+			return 0, nil, err
+		}
+		return s.ID, ruleset, nil
+	}
+
+	ruleset, err := json.Marshal(map[string]any{
+		"name":        name,
+		"target":      "branch",
+		"enforcement": "active",
+		"conditions": map[string]any{
+			"ref_name": map[string]any{
+				"include": []string{"~DEFAULT_BRANCH"},
+				"exclude": []string{},
+			},
+		},
+		"rules": []any{},
+	})
+	if err != nil {
+		return 0, nil, err
+	}
+	return 0, ruleset, nil
+}
+
+// patchRuleset applies the patch to the ruleset and returns the request body updating it.
+// A patch that is a JSON object is applied as a JSON merge patch, which replaces the list
+// of rules as a whole, while a patch that is a JSON array is applied as a JSON patch, which
+// can add or edit a single rule.
+func patchRuleset(ruleset json.RawMessage, patch []byte) ([]byte, error) {
+	var doc map[string]any
+	if err := json.Unmarshal(ruleset, &doc); err != nil {
+		return nil, fmt.Errorf("error unmarshalling ruleset: %w", err)
+	}
+	for _, field := range readOnlyFields {
+		delete(doc, field)
+	}
+
+	original, err := json.Marshal(doc)
+	if err != nil {
+		return nil, fmt.Errorf("error marshalling ruleset: %w", err)
+	}
+
+	patch = bytes.TrimSpace(patch)
+	if len(patch) > 0 && patch[0] == '[' {
+		p, err := jsonpatch.DecodePatch(patch)
+		if err != nil {
+			return nil, fmt.Errorf("error decoding patch: %w", err)
+		}
+		return p.Apply(original)
+	}
+
+	return jsonpatch.MergePatch(original, patch)
+}
+
+// hasRepositoryCondition returns whether the ruleset selects the repositories it applies to
+func hasRepositoryCondition(ruleset []byte) bool {
+	var doc struct {
+		Conditions map[string]any `json:"conditions"`
+	}
+	if err := json.Unmarshal(ruleset, &doc); err != nil {
+		return false
+	}
+	for _, cond := range repositoryConditions {
+		if doc.Conditions[cond] != nil {
+			return true
+		}
+	}
+	return false
+}
+
+func dryRun(baseUrl, method, endpoint string, body []byte) error {
+	curlCmd, err := util.GenerateCurlCommand(method, baseUrl, endpoint, string(body))
+	if err != nil {
+		return fmt.Errorf("cannot generate curl command: %w", err)
+	}
+
+	log.Printf("run the following curl command: \n%s\n", curlCmd)
+	return nil
+}
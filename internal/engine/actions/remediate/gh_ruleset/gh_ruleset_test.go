@@ -0,0 +1,265 @@
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gh_ruleset
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	enginerr "github.com/stacklok/minder/internal/engine/errors"
+	"github.com/stacklok/minder/internal/engine/interfaces"
+	mock_ghclient "github.com/stacklok/minder/internal/providers/github/mock"
+	"github.com/stacklok/minder/internal/util"
+	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+const (
+	repoOwner = "stacklok"
+	repoName  = "minder"
+
+	signaturesPatch = `{"rules": [{"type": "required_signatures"}]}`
+	reviewsPatch    = `[{"op": "add", "path": "/rules/-", "value": {"type": "pull_request", "parameters":
+{"required_approving_review_count": {{ .Profile.required_approving_review_count }}}}}]`
+
+	existingRuleset = `{"id": 42, "name": "main", "node_id": "RRS_1", "source_type": "Repository",
+"source": "stacklok/minder", "target": "branch", "enforcement": "active",
+"_links": {"self": {"href": "https://api.github.com/repos/stacklok/minder/rulesets/42"}},
+"rules": [{"type": "deletion"}]}`
+)
+
+func TestGhRulesetRemediate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		ghr         *pb.RuleType_Definition_Remediate_GhRulesetType
+		mockSetup   func(*mock_ghclient.MockGitHub)
+		expected    *enginerr.RemediationProposal
+		expectedErr string
+	}{
+		{
+			name: "creates a missing repository ruleset",
+			ghr:  &pb.RuleType_Definition_Remediate_GhRulesetType{Name: "{{ .Params.ruleset }}", Patch: signaturesPatch},
+			mockSetup: func(mockGitHub *mock_ghclient.MockGitHub) {
+				mockGitHub.EXPECT().
+					ListRulesets(gomock.Any(), repoOwner, repoName, false).
+					Return([]json.RawMessage{json.RawMessage(`{"id": 1, "name": "release"}`)}, nil)
+			},
+			expected: &enginerr.RemediationProposal{
+				Method:   http.MethodPost,
+				Endpoint: "repos/stacklok/minder/rulesets",
+				Body: `{"enforcement":"active","name":"main","rules":[{"type":"required_signatures"}],` +
+					`"conditions":{"ref_name":{"include":["~DEFAULT_BRANCH"],"exclude":[]}},"target":"branch"}`,
+			},
+		},
+		{
+			name: "creates a missing organization ruleset for the repositories of the patch",
+			ghr: &pb.RuleType_Definition_Remediate_GhRulesetType{
+				Name: "main", Level: LevelOrganization,
+				Patch: `{"conditions": {"repository_name": {"include": ["~ALL"], "exclude": []}},` +
+					`"rules": [{"type": "required_signatures"}]}`,
+			},
+			mockSetup: func(mockGitHub *mock_ghclient.MockGitHub) {
+				mockGitHub.EXPECT().
+					ListOrganizationRulesets(gomock.Any(), repoOwner).
+					Return([]json.RawMessage{}, nil)
+			},
+			expected: &enginerr.RemediationProposal{
+				Method:   http.MethodPost,
+				Endpoint: "orgs/stacklok/rulesets",
+				Body: `{"enforcement":"active","name":"main","rules":[{"type":"required_signatures"}],` +
+					`"conditions":{"ref_name":{"include":["~DEFAULT_BRANCH"],"exclude":[]},` +
+					`"repository_name":{"include":["~ALL"],"exclude":[]}},"target":"branch"}`,
+			},
+		},
+		{
+			name: "a missing organization ruleset needs the repositories it applies to",
+			ghr: &pb.RuleType_Definition_Remediate_GhRulesetType{
+				Name: "main", Patch: signaturesPatch, Level: LevelOrganization,
+			},
+			mockSetup: func(mockGitHub *mock_ghclient.MockGitHub) {
+				mockGitHub.EXPECT().
+					ListOrganizationRulesets(gomock.Any(), repoOwner).
+					Return([]json.RawMessage{}, nil)
+			},
+			expectedErr: "must set the repositories it applies to",
+		},
+		{
+			name: "patches an existing repository ruleset",
+			ghr:  &pb.RuleType_Definition_Remediate_GhRulesetType{Name: "main", Patch: reviewsPatch},
+			mockSetup: func(mockGitHub *mock_ghclient.MockGitHub) {
+				mockGitHub.EXPECT().
+					ListRulesets(gomock.Any(), repoOwner, repoName, false).
+					Return([]json.RawMessage{json.RawMessage(`{"id": 42, "name": "main"}`)}, nil)
+				mockGitHub.EXPECT().
+					GetRuleset(gomock.Any(), repoOwner, repoName, int64(42), false).
+					Return(json.RawMessage(existingRuleset), nil)
+			},
+			expected: &enginerr.RemediationProposal{
+				Method:   http.MethodPut,
+				Endpoint: "repos/stacklok/minder/rulesets/42",
+				Body: `{"enforcement":"active","name":"main","rules":[{"type":"deletion"},` +
+					`{"parameters":{"required_approving_review_count":2},"type":"pull_request"}],"target":"branch"}`,
+			},
+		},
+		{
+			name: "patches an existing organization ruleset",
+			ghr: &pb.RuleType_Definition_Remediate_GhRulesetType{
+				Name: "main", Patch: signaturesPatch, Level: LevelOrganization,
+			},
+			mockSetup: func(mockGitHub *mock_ghclient.MockGitHub) {
+				mockGitHub.EXPECT().
+					ListOrganizationRulesets(gomock.Any(), repoOwner).
+					Return([]json.RawMessage{json.RawMessage(`{"id": 42, "name": "main"}`)}, nil)
+				mockGitHub.EXPECT().
+					GetOrganizationRuleset(gomock.Any(), repoOwner, int64(42)).
+					Return(json.RawMessage(existingRuleset), nil)
+			},
+			expected: &enginerr.RemediationProposal{
+				Method:   http.MethodPut,
+				Endpoint: "orgs/stacklok/rulesets/42",
+				Body: `{"enforcement":"active","name":"main","rules":[{"type":"required_signatures"}],` +
+					`"target":"branch"}`,
+			},
+		},
+		{
+			name: "listing fails",
+			ghr:  &pb.RuleType_Definition_Remediate_GhRulesetType{Name: "main", Patch: signaturesPatch},
+			mockSetup: func(mockGitHub *mock_ghclient.MockGitHub) {
+				mockGitHub.EXPECT().
+					ListRulesets(gomock.Any(), repoOwner, repoName, false).
+					Return(nil, errors.New("boom"))
+			},
+			expectedErr: "error getting ruleset",
+		},
+		{
+			name:        "name template refers to a missing parameter",
+			ghr:         &pb.RuleType_Definition_Remediate_GhRulesetType{Name: "{{ .Params.missing }}", Patch: signaturesPatch},
+			mockSetup:   func(_ *mock_ghclient.MockGitHub) {},
+			expectedErr: "cannot execute name template",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockGitHub := mock_ghclient.NewMockGitHub(ctrl)
+			tt.mockSetup(mockGitHub)
+
+			r := newTestRemediator(t, tt.ghr, mockGitHub)
+			_, err := r.Do(context.Background(), interfaces.ActionCmdOn, interfaces.ActionOptApprovalRequired,
+				&pb.Repository{Owner: repoOwner, Name: repoName}, testParams(t), nil)
+			if tt.expectedErr != "" {
+				require.ErrorContains(t, err, tt.expectedErr)
+				return
+			}
+
+			var proposal *enginerr.RemediationProposal
+			require.ErrorAs(t, err, &proposal)
+			assert.Equal(t, tt.expected.Method, proposal.Method)
+			assert.Equal(t, tt.expected.Endpoint, proposal.Endpoint)
+			assert.JSONEq(t, tt.expected.Body, proposal.Body)
+		})
+	}
+}
+
+func TestGhRulesetRemediatePerformsRequest(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGitHub := mock_ghclient.NewMockGitHub(ctrl)
+	mockGitHub.EXPECT().
+		ListRulesets(gomock.Any(), repoOwner, repoName, false).
+		Return([]json.RawMessage{}, nil)
+	mockGitHub.EXPECT().
+		NewRequest(http.MethodPost, "repos/stacklok/minder/rulesets", gomock.Any()).
+		Return(&http.Request{}, nil)
+	mockGitHub.EXPECT().
+		Do(gomock.Any(), gomock.Any()).
+		Return(&http.Response{StatusCode: http.StatusCreated, Body: io.NopCloser(strings.NewReader(""))}, nil)
+
+	r := newTestRemediator(t, &pb.RuleType_Definition_Remediate_GhRulesetType{
+		Name: "main", Patch: signaturesPatch,
+	}, mockGitHub)
+	_, err := r.Do(context.Background(), interfaces.ActionCmdOn, interfaces.ActionOptOn,
+		&pb.Repository{Owner: repoOwner, Name: repoName}, testParams(t), nil)
+	require.NoError(t, err)
+}
+
+func TestNewGhRulesetRemediatorUnknownLevel(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewGhRulesetRemediator("remediate-test", &pb.RuleType_Definition_Remediate_GhRulesetType{
+		Name: "main", Patch: signaturesPatch, Level: "enterprise",
+	}, nil)
+	require.ErrorContains(t, err, "unknown ruleset level")
+}
+
+func newTestRemediator(
+	t *testing.T, ghr *pb.RuleType_Definition_Remediate_GhRulesetType, cli *mock_ghclient.MockGitHub,
+) *GhRulesetRemediator {
+	t.Helper()
+
+	level := ghr.GetLevel()
+	if level == "" {
+		level = LevelRepository
+	}
+
+	nameTemplate, err := util.ParseNewTextTemplate(&ghr.Name, "name")
+	require.NoError(t, err)
+	patchTemplate, err := util.ParseNewTextTemplate(&ghr.Patch, "patch")
+	require.NoError(t, err)
+
+	return &GhRulesetRemediator{
+		actionType:    "remediate-test",
+		cli:           cli,
+		level:         level,
+		nameTemplate:  nameTemplate,
+		patchTemplate: patchTemplate,
+	}
+}
+
+func testParams(t *testing.T) *interfaces.EvalStatusParams {
+	t.Helper()
+
+	def, err := structpb.NewStruct(map[string]any{"required_approving_review_count": 2})
+	require.NoError(t, err)
+	params, err := structpb.NewStruct(map[string]any{"ruleset": "main"})
+	require.NoError(t, err)
+
+	return &interfaces.EvalStatusParams{
+		Rule: &pb.Profile_Rule{
+			Def:    def,
+			Params: params,
+		},
+	}
+}
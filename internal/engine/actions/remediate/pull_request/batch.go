@@ -0,0 +1,429 @@
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pull_request
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/go-git/go-billy/v5/memfs"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/google/go-github/v53/github"
+	"github.com/rs/zerolog"
+
+	enginerr "github.com/stacklok/minder/internal/engine/errors"
+	"github.com/stacklok/minder/internal/engine/interfaces"
+	ghclient "github.com/stacklok/minder/internal/providers/github"
+	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+const (
+	batchBranch  = dflBranchBaseName + "_remediations"
+	batchPrTitle = "Remediate the rules of Minder profiles"
+
+	batchMagicComment = `<!-- minder: pr-remediation-batch -->`
+	batchPrIntro      = "This pull request combines the remediations of the rules that fail for this repository. " +
+		"It's updated as rules start or stop failing."
+
+	batchSectionStart = `<!-- minder: pr-remediation-section: %s -->`
+	batchSectionEnd   = `<!-- minder: pr-remediation-section-end -->`
+)
+
+var batchSectionRegexp = regexp.MustCompile(
+	`(?s)<!-- minder: pr-remediation-section: (\{.*?\}) -->\n(.*?)\n<!-- minder: pr-remediation-section-end -->`)
+
+// batchSection is the section of a batched pull request that describes the remediation of
+// a single rule
+type batchSection struct {
+	// Rule identifies the rule of the profile the section belongs to
+	Rule string
+	// ContentSha is the digest of the contents last pushed for the rule
+	ContentSha string
+	// Files are the paths of the files the remediation of the rule modifies
+	Files []string `json:",omitempty"`
+
+	text string
+}
+
+// batchRule identifies the rule being remediated within the batched pull request
+func batchRule(params interfaces.ActionsParams) string {
+	return fmt.Sprintf("%s/%s", params.GetProfile().GetName(), params.GetRule().GetType())
+}
+
+// parseBatchSections returns the sections of the body of a batched pull request
+func parseBatchSections(body string) []batchSection {
+	matches := batchSectionRegexp.FindAllStringSubmatch(body, -1)
+	sections := make([]batchSection, 0, len(matches))
+	for _, m := range matches {
+		var section batchSection
+		if err := json.Unmarshal([]byte(m[1]), &section); err != nil || section.Rule == "" {
+			continue
+		}
+		section.text = m[2]
+		sections = append(sections, section)
+	}
+	return sections
+}
+
+// renderBatchBody renders the body of a batched pull request with the given sections
+func renderBatchBody(sections []batchSection) (string, error) {
+	var buf bytes.Buffer
+	buf.WriteString(batchMagicComment + "\n\n" + batchPrIntro)
+	for _, section := range sections {
+		header, err := json.Marshal(section)
+		if err != nil {
+			return "", fmt.Errorf("cannot marshal section header: %w", err)
+		}
+		fmt.Fprintf(&buf, "\n\n"+batchSectionStart+"\n%s\n%s", header, section.text, batchSectionEnd)
+	}
+	return buf.String(), nil
+}
+
+// upsertBatchSection replaces the section of the same rule, or appends the section if the
+// rule has none yet
+func upsertBatchSection(sections []batchSection, section batchSection) []batchSection {
+	for i := range sections {
+		if sections[i].Rule == section.Rule {
+			sections[i] = section
+			return sections
+		}
+	}
+	return append(sections, section)
+}
+
+// joinBatchedPullRequest adds the contents of the remediation to the batched pull request
+// of the repository, opening it if there is none yet, and keeps the section of the rule in
+// its body up to date
+func (r *Remediator) joinBatchedPullRequest(
+	ctx context.Context,
+	ingested *interfaces.Result,
+	repo *pb.Repository,
+	params interfaces.ActionsParams,
+	tracked *PullRequest,
+	title string,
+	tmplParams *PrTemplateParams,
+) (json.RawMessage, error) {
+	body := new(bytes.Buffer)
+	if err := r.bodyTemplate.Execute(body, tmplParams); err != nil {
+		return nil, fmt.Errorf("cannot execute body template: %w", err)
+	}
+
+	contentSha, err := r.contentSha1()
+	if err != nil {
+		return nil, fmt.Errorf("cannot get content sha1: %w", err)
+	}
+
+	section := batchSection{
+		Rule:       batchRule(params),
+		ContentSha: contentSha,
+		Files:      r.entryPaths(),
+		text:       fmt.Sprintf("### %s\n\n%s", title, body.String()),
+	}
+
+	var pr *github.PullRequest
+	if tracked != nil {
+		pr, err = r.ghCli.GetPullRequest(ctx, repo.GetOwner(), repo.GetName(), tracked.Number)
+		if err != nil {
+			meta, _ := tracked.metadata()
+			return meta, fmt.Errorf("cannot get pull request #%d: %w", tracked.Number, err)
+		}
+		if pr.GetState() != "open" {
+			return nil, notOpenError(pr, tracked.Number)
+		}
+	} else {
+		pr, err = findOpenPullRequest(ctx, r.ghCli, repo, batchMagicComment)
+		if err != nil {
+			return nil, fmt.Errorf("cannot check if the batched PR already exists: %w", err)
+		}
+	}
+
+	if pr == nil {
+		return r.openBatchedPullRequest(ctx, ingested, repo, section, title)
+	}
+
+	joined := &PullRequest{
+		Number:     pr.GetNumber(),
+		Branch:     pr.GetHead().GetRef(),
+		ContentSha: contentSha,
+	}
+
+	sections := parseBatchSections(pr.GetBody())
+	for _, s := range sections {
+		if s.Rule == section.Rule && s.ContentSha == contentSha {
+			meta, err := joined.metadata()
+			if err != nil || tracked == nil {
+				// the batched pull request already had the contents before the rule tracked it
+				return meta, err
+			}
+			return meta, enginerr.NewErrActionPending("pull request #%d is awaiting merge", joined.Number)
+		}
+	}
+
+	// the files are modified on top of their contents in the batched pull request, so that the
+	// modifications other rules made to the same files are kept
+	if err := r.modifyBatchedContents(ctx, repo, pr); err != nil {
+		return nil, err
+	}
+
+	headCommit, err := ingestedHead(ingested.Fs, ingested.Storer)
+	if err != nil {
+		return nil, err
+	}
+	treeEntries, err := r.createTreeEntries(ctx, repo.GetOwner(), repo.GetName(), headCommit)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.pushToBatchedPullRequest(ctx, repo, pr, treeEntries, title); err != nil {
+		return nil, err
+	}
+
+	if err := r.updateBatchedPullRequest(ctx, repo, pr, upsertBatchSection(sections, section)); err != nil {
+		return nil, err
+	}
+
+	zerolog.Ctx(ctx).Info().Int("pr_number", joined.Number).Str("rule", section.Rule).
+		Msg("Batched pull request updated")
+	return joined.metadata()
+}
+
+// openBatchedPullRequest opens the batched pull request of the repository with the contents
+// of the first rule that fails
+func (r *Remediator) openBatchedPullRequest(
+	ctx context.Context,
+	ingested *interfaces.Result,
+	repo *pb.Repository,
+	section batchSection,
+	title string,
+) (json.RawMessage, error) {
+	// a branch left over by a batched pull request that was closed is started over
+	if err := r.runApi(ctx, ingested.Fs, ingested.Storer, repo, batchBranch, title); err != nil {
+		return nil, err
+	}
+
+	body, err := renderBatchBody([]batchSection{section})
+	if err != nil {
+		return nil, err
+	}
+
+	pr, err := r.ghCli.CreatePullRequest(
+		ctx, repo.GetOwner(), repo.GetName(),
+		batchPrTitle, body,
+		refFromBranch(batchBranch),
+		dflBranchTo,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("cannot create pull request: %w", err)
+	}
+
+	zerolog.Ctx(ctx).Info().Int("pr_number", pr.GetNumber()).Str("rule", section.Rule).
+		Msg("Batched pull request created")
+	return (&PullRequest{
+		Number:     pr.GetNumber(),
+		Branch:     batchBranch,
+		ContentSha: section.ContentSha,
+	}).metadata()
+}
+
+// leaveBatchedPullRequest removes the rule that passes now from the batched pull request by
+// reverting the files no other rule modifies and dropping its section. The batched pull
+// request is closed once the last rule leaves it.
+func (r *Remediator) leaveBatchedPullRequest(
+	ctx context.Context,
+	repo *pb.Repository,
+	params interfaces.ActionsParams,
+	tracked *PullRequest,
+) error {
+	if tracked == nil {
+		return nil
+	}
+
+	pr, err := r.ghCli.GetPullRequest(ctx, repo.GetOwner(), repo.GetName(), tracked.Number)
+	if err != nil {
+		return fmt.Errorf("cannot get pull request #%d: %w", tracked.Number, err)
+	}
+	if pr.GetState() != "open" {
+		// the rules left in a batched pull request that was merged or closed notice by themselves
+		return nil
+	}
+
+	rule := batchRule(params)
+	sections := parseBatchSections(pr.GetBody())
+	remaining := make([]batchSection, 0, len(sections))
+	for _, s := range sections {
+		if s.Rule != rule {
+			remaining = append(remaining, s)
+		}
+	}
+
+	switch {
+	case len(remaining) == len(sections):
+		return nil
+	case len(remaining) == 0:
+		return r.closeAndDeleteBranch(ctx, repo, pr, tracked)
+	}
+
+	// the files of the rule are reverted if they were ingested, the section is dropped anyway.
+	// The files the remaining rules modify too are kept, so that their modifications aren't lost.
+	if ingested := params.GetIngestResult(); ingested != nil && ingested.Fs != nil && ingested.Storer != nil {
+		headCommit, err := ingestedHead(ingested.Fs, ingested.Storer)
+		if err != nil {
+			return err
+		}
+		kept := make(map[string]bool)
+		for _, s := range remaining {
+			for _, path := range s.Files {
+				kept[path] = true
+			}
+		}
+		treeEntries, err := r.revertTreeEntries(headCommit, kept)
+		if err != nil {
+			return err
+		}
+		if len(treeEntries) > 0 {
+			if err := r.pushToBatchedPullRequest(ctx, repo, pr, treeEntries,
+				fmt.Sprintf("Revert the remediation of %s", rule)); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := r.updateBatchedPullRequest(ctx, repo, pr, remaining); err != nil {
+		return err
+	}
+
+	zerolog.Ctx(ctx).Info().Int("pr_number", tracked.Number).Str("rule", rule).
+		Msg("Rule removed from batched pull request")
+	return nil
+}
+
+// pushToBatchedPullRequest adds a commit of the tree entries to the branch of the batched
+// pull request
+func (r *Remediator) pushToBatchedPullRequest(
+	ctx context.Context,
+	repo *pb.Repository,
+	pr *github.PullRequest,
+	treeEntries []*github.TreeEntry,
+	title string,
+) error {
+	headSha := pr.GetHead().GetSHA()
+	head, err := r.ghCli.GetCommit(ctx, repo.GetOwner(), repo.GetName(), headSha)
+	if err != nil {
+		return fmt.Errorf("cannot get the head commit of pull request #%d: %w", pr.GetNumber(), err)
+	}
+
+	sha, err := r.createApiCommit(ctx, repo, treeEntries, headSha, head.GetTree().GetSHA(), title)
+	if err != nil {
+		return err
+	}
+
+	// the branch is not forced, so that commits pushed to it in the meantime are not lost
+	if _, err := r.ghCli.UpdateRef(ctx, repo.GetOwner(), repo.GetName(),
+		refFromBranch(pr.GetHead().GetRef()), sha, false); err != nil {
+		return fmt.Errorf("cannot update branch: %w", err)
+	}
+	return nil
+}
+
+func (r *Remediator) updateBatchedPullRequest(
+	ctx context.Context,
+	repo *pb.Repository,
+	pr *github.PullRequest,
+	sections []batchSection,
+) error {
+	body, err := renderBatchBody(sections)
+	if err != nil {
+		return err
+	}
+
+	if _, err := r.ghCli.UpdatePullRequest(ctx, repo.GetOwner(), repo.GetName(), pr.GetNumber(),
+		batchPrTitle, body); err != nil {
+		return fmt.Errorf("cannot update pull request #%d: %w", pr.GetNumber(), err)
+	}
+	return nil
+}
+
+// revertTreeEntries returns the tree entries that restore the files of the remediation to
+// their contents in the given commit, except for the kept ones
+func (r *Remediator) revertTreeEntries(base *object.Commit, kept map[string]bool) ([]*github.TreeEntry, error) {
+	treeEntries := make([]*github.TreeEntry, 0, len(r.entries))
+	for _, entry := range r.entries {
+		if kept[entry.Path] {
+			continue
+		}
+
+		treeEntry := &github.TreeEntry{
+			Path: github.String(entry.Path),
+			Mode: github.String(entry.Mode),
+			Type: github.String("blob"),
+		}
+
+		f, err := base.File(entry.Path)
+		switch {
+		case err == nil:
+			treeEntry.Mode = github.String(strings.TrimPrefix(f.Mode.String(), "0"))
+			treeEntry.SHA = github.String(f.Hash.String())
+		case errors.Is(err, object.ErrFileNotFound) && entry.Action == ActionDelete:
+			// a file that didn't exist wasn't deleted either
+			continue
+		case errors.Is(err, object.ErrFileNotFound):
+			// an entry with neither a SHA nor contents deletes the file the remediation created
+		default:
+			return nil, fmt.Errorf("cannot get file %s: %w", entry.Path, err)
+		}
+		treeEntries = append(treeEntries, treeEntry)
+	}
+	return treeEntries, nil
+}
+
+// modifyBatchedContents computes the contents of the files after applying the actions of the
+// entries to the files of the branch of the batched pull request
+func (r *Remediator) modifyBatchedContents(ctx context.Context, repo *pb.Repository, pr *github.PullRequest) error {
+	branchFs := memfs.New()
+	for _, entry := range r.entries {
+		// replacing or deleting a file doesn't depend on its contents
+		if entry.Action == ActionReplace || entry.Action == ActionDelete {
+			continue
+		}
+
+		contents, err := r.ghCli.GetFileContents(ctx, repo.GetOwner(), repo.GetName(), entry.Path, pr.GetHead().GetSHA())
+		if errors.Is(err, ghclient.ErrNotFound) {
+			continue
+		} else if err != nil {
+			return fmt.Errorf("cannot get file %s of pull request #%d: %w", entry.Path, pr.GetNumber(), err)
+		}
+		if err := writeEntry(prEntry{Path: entry.Path, Result: contents}, branchFs); err != nil {
+			return fmt.Errorf("cannot write file %s of pull request #%d: %w", entry.Path, pr.GetNumber(), err)
+		}
+	}
+
+	if err := r.modifyContents(ctx, branchFs); err != nil {
+		return fmt.Errorf("cannot modify contents of pull request #%d: %w", pr.GetNumber(), err)
+	}
+	return nil
+}
+
+// entryPaths returns the paths of the files the remediation modifies
+func (r *Remediator) entryPaths() []string {
+	paths := make([]string, 0, len(r.entries))
+	for _, entry := range r.entries {
+		paths = append(paths, entry.Path)
+	}
+	return paths
+}
@@ -0,0 +1,494 @@
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pull_request
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-github/v53/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	enginerr "github.com/stacklok/minder/internal/engine/errors"
+	"github.com/stacklok/minder/internal/engine/interfaces"
+	mock_ghclient "github.com/stacklok/minder/internal/providers/github/mock"
+	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+const (
+	batchedRule = "acme/dependabot_configured"
+	otherRule   = "acme/security_md"
+	batchPrNum  = 7
+	batchHead   = "head-sha"
+
+	// the digest of the contents of the dependabot remediation, the same as the one
+	// in the magic comment of the pull request it opens when it isn't batched
+	batchedContentSha = "1041e57c2fac284bdb7827ce55c6e3cb609e97b9"
+)
+
+func TestBatchSections(t *testing.T) {
+	t.Parallel()
+
+	body, err := renderBatchBody([]batchSection{
+		{Rule: batchedRule, ContentSha: "abc", text: "### Add Dependabot\n\nAdds Dependabot"},
+		{Rule: otherRule, ContentSha: "def", text: "### Add SECURITY.md\n\nAdds a security policy"},
+	})
+	require.NoError(t, err)
+	assert.Contains(t, body, batchMagicComment)
+
+	sections := parseBatchSections(body)
+	require.Len(t, sections, 2)
+	assert.Equal(t, batchedRule, sections[0].Rule)
+	assert.Equal(t, "abc", sections[0].ContentSha)
+	assert.Equal(t, "### Add Dependabot\n\nAdds Dependabot", sections[0].text)
+	assert.Equal(t, otherRule, sections[1].Rule)
+
+	sections = upsertBatchSection(sections, batchSection{Rule: batchedRule, ContentSha: "ghi", text: "updated"})
+	require.Len(t, sections, 2)
+	assert.Equal(t, "ghi", sections[0].ContentSha)
+
+	sections = upsertBatchSection(sections, batchSection{Rule: "acme/other", ContentSha: "jkl", text: "new"})
+	require.Len(t, sections, 3)
+}
+
+func batchedTestEngine(t *testing.T, ctrl *gomock.Controller) (*Remediator, *mock_ghclient.MockGitHub) {
+	t.Helper()
+
+	prRem := dependabotPrRem()
+	prRem.Batch = github.Bool(true)
+	engine, err := NewPullRequestRemediate(TestActionTypeValid, prRem, testGithubProviderBuilder(ghApiUrl))
+	require.NoError(t, err, "unexpected error creating remediate engine")
+	require.Equal(t, CommitMethodApi, engine.commitMethod, "batched pull requests are committed through the API")
+
+	mockClient := mock_ghclient.NewMockGitHub(ctrl)
+	engine.ghCli = mockClient
+	return engine, mockClient
+}
+
+func batchedTestParams(t *testing.T) *interfaces.EvalStatusParams {
+	t.Helper()
+
+	remArgs := createTestRemArgs()
+	structPol, err := structpb.NewStruct(remArgs.pol)
+	require.NoError(t, err)
+	structParams, err := structpb.NewStruct(remArgs.params)
+	require.NoError(t, err)
+	evalParams := &interfaces.EvalStatusParams{
+		Profile: &pb.Profile{Name: "acme"},
+		Rule: &pb.Profile_Rule{
+			Type:   "dependabot_configured",
+			Def:    structPol,
+			Params: structParams,
+		},
+	}
+
+	testrepo, err := defaultMockRepoSetup(t)
+	require.NoError(t, err, "unexpected error creating test repo")
+	testWt, err := testrepo.Worktree()
+	require.NoError(t, err, "unexpected error creating test worktree")
+	evalParams.SetIngestResult(&interfaces.Result{
+		Fs:     testWt.Filesystem,
+		Storer: testrepo.Storer,
+	})
+	return evalParams
+}
+
+func batchedTestBody(t *testing.T, sections ...batchSection) string {
+	t.Helper()
+
+	body, err := renderBatchBody(sections)
+	require.NoError(t, err)
+	return body
+}
+
+func TestJoinBatchedPullRequest(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		tracked    *PullRequest
+		mockSetup  func(*testing.T, *mock_ghclient.MockGitHub)
+		wantErrIs  error
+		wantNumber int
+	}{
+		{
+			name: "the first failing rule opens the batched PR",
+			mockSetup: func(t *testing.T, mockGitHub *mock_ghclient.MockGitHub) {
+				t.Helper()
+				mockGitHub.EXPECT().
+					ListPullRequests(gomock.Any(), repoOwner, repoName, gomock.Any()).
+					Return([]*github.PullRequest{{Body: github.String(prBody)}}, nil)
+				mockGitHub.EXPECT().
+					CreateBlob(gomock.Any(), repoOwner, repoName, gomock.Any()).
+					Return(&github.Blob{SHA: github.String("blob-sha")}, nil).
+					Times(2)
+				mockGitHub.EXPECT().
+					CreateTree(gomock.Any(), repoOwner, repoName, gomock.Any(), gomock.Any()).
+					Return(&github.Tree{SHA: github.String("tree-sha")}, nil)
+				mockGitHub.EXPECT().
+					CreateCommit(gomock.Any(), repoOwner, repoName, commitTitle, gomock.Any(), gomock.Any(), nil).
+					Return(&github.Commit{SHA: github.String("commit-sha")}, nil)
+				mockGitHub.EXPECT().
+					GetRef(gomock.Any(), repoOwner, repoName, refFromBranch(batchBranch)).
+					Return(&github.Reference{}, nil)
+				mockGitHub.EXPECT().
+					UpdateRef(gomock.Any(), repoOwner, repoName, refFromBranch(batchBranch), "commit-sha", true).
+					Return(&github.Reference{}, nil)
+				mockGitHub.EXPECT().
+					CreatePullRequest(gomock.Any(), repoOwner, repoName, batchPrTitle, gomock.Any(),
+						refFromBranch(batchBranch), dflBranchTo).
+					DoAndReturn(func(_ context.Context, _, _, _, body, _, _ string) (*github.PullRequest, error) {
+						sections := parseBatchSections(body)
+						require.Len(t, sections, 1)
+						assert.Equal(t, batchedRule, sections[0].Rule)
+						assert.Contains(t, sections[0].text, commitTitle)
+						return &github.PullRequest{Number: github.Int(batchPrNum)}, nil
+					})
+			},
+			wantNumber: batchPrNum,
+		},
+		{
+			name: "a rule joins the batched PR opened by another rule",
+			mockSetup: func(t *testing.T, mockGitHub *mock_ghclient.MockGitHub) {
+				t.Helper()
+				mockGitHub.EXPECT().
+					ListPullRequests(gomock.Any(), repoOwner, repoName, gomock.Any()).
+					Return([]*github.PullRequest{batchedTestPr(t, "open",
+						batchSection{Rule: otherRule, ContentSha: "def", text: "other"})}, nil)
+				mockPushToBatchedPullRequest(mockGitHub, 2)
+				mockGitHub.EXPECT().
+					UpdatePullRequest(gomock.Any(), repoOwner, repoName, batchPrNum, batchPrTitle, gomock.Any()).
+					DoAndReturn(func(_ context.Context, _, _ string, _ int, _, body string) (*github.PullRequest, error) {
+						sections := parseBatchSections(body)
+						require.Len(t, sections, 2)
+						assert.Equal(t, otherRule, sections[0].Rule)
+						assert.Equal(t, batchedRule, sections[1].Rule)
+						assert.Equal(t, []string{".github/dependabot.yml", "README.md"}, sections[1].Files)
+						return &github.PullRequest{}, nil
+					})
+			},
+			wantNumber: batchPrNum,
+		},
+		{
+			name:    "a tracked rule whose contents didn't change is awaiting merge",
+			tracked: &PullRequest{Number: batchPrNum, Branch: batchBranch},
+			mockSetup: func(t *testing.T, mockGitHub *mock_ghclient.MockGitHub) {
+				t.Helper()
+				mockGitHub.EXPECT().
+					GetPullRequest(gomock.Any(), repoOwner, repoName, batchPrNum).
+					Return(batchedTestPr(t, "open",
+						batchSection{Rule: batchedRule, ContentSha: batchedContentSha, text: "dependabot"},
+						batchSection{Rule: otherRule, ContentSha: "def", text: "other"}), nil)
+			},
+			wantErrIs:  enginerr.ErrActionPending,
+			wantNumber: batchPrNum,
+		},
+		{
+			name:    "a batched PR closed without being merged is no longer tracked",
+			tracked: &PullRequest{Number: batchPrNum, Branch: batchBranch},
+			mockSetup: func(t *testing.T, mockGitHub *mock_ghclient.MockGitHub) {
+				t.Helper()
+				mockGitHub.EXPECT().
+					GetPullRequest(gomock.Any(), repoOwner, repoName, batchPrNum).
+					Return(batchedTestPr(t, "closed"), nil)
+			},
+			wantErrIs: enginerr.ErrActionFailed,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			engine, mockClient := batchedTestEngine(t, ctrl)
+			tt.mockSetup(t, mockClient)
+
+			var tracked *json.RawMessage
+			if tt.tracked != nil {
+				meta, err := tt.tracked.metadata()
+				require.NoError(t, err)
+				tracked = &meta
+			}
+
+			remArgs := createTestRemArgs()
+			retMeta, err := engine.Do(context.Background(), interfaces.ActionCmdOn, remArgs.remAction,
+				remArgs.ent, batchedTestParams(t), tracked)
+			if tt.wantErrIs != nil {
+				require.ErrorIs(t, err, tt.wantErrIs)
+			} else {
+				require.NoError(t, err, "unexpected error running remediate engine")
+			}
+
+			pr := PullRequestFromMetadata(&retMeta)
+			if tt.wantNumber == 0 {
+				require.Nil(t, pr, "expected the batched pull request not to be tracked")
+				return
+			}
+			require.NotNil(t, pr, "expected the batched pull request to be tracked")
+			assert.Equal(t, tt.wantNumber, pr.Number)
+			assert.Equal(t, batchBranch, pr.Branch)
+			assert.Equal(t, batchedContentSha, pr.ContentSha)
+		})
+	}
+}
+
+func TestLeaveBatchedPullRequest(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		mockSetup func(*testing.T, *mock_ghclient.MockGitHub)
+	}{
+		{
+			name: "the files and the section of a rule that passes are removed",
+			mockSetup: func(t *testing.T, mockGitHub *mock_ghclient.MockGitHub) {
+				t.Helper()
+				mockGitHub.EXPECT().
+					GetPullRequest(gomock.Any(), repoOwner, repoName, batchPrNum).
+					Return(batchedTestPr(t, "open",
+						batchSection{Rule: batchedRule, ContentSha: "abc", text: "dependabot"},
+						batchSection{Rule: otherRule, ContentSha: "def", text: "other"}), nil)
+				mockGitHub.EXPECT().
+					GetCommit(gomock.Any(), repoOwner, repoName, batchHead).
+					Return(&github.Commit{Tree: &github.Tree{SHA: github.String("head-tree-sha")}}, nil)
+				mockGitHub.EXPECT().
+					CreateTree(gomock.Any(), repoOwner, repoName, "head-tree-sha", gomock.Any()).
+					DoAndReturn(func(_ context.Context, _, _, _ string, entries []*github.TreeEntry) (*github.Tree, error) {
+						// neither file exists in the ingested repository, so both are deleted
+						require.Len(t, entries, 2)
+						for _, entry := range entries {
+							assert.Nil(t, entry.SHA)
+						}
+						return &github.Tree{SHA: github.String("tree-sha")}, nil
+					})
+				mockGitHub.EXPECT().
+					CreateCommit(gomock.Any(), repoOwner, repoName, "Revert the remediation of "+batchedRule,
+						gomock.Any(), batchHead, nil).
+					Return(&github.Commit{SHA: github.String("commit-sha")}, nil)
+				mockGitHub.EXPECT().
+					UpdateRef(gomock.Any(), repoOwner, repoName, refFromBranch(batchBranch), "commit-sha", false).
+					Return(&github.Reference{}, nil)
+				mockGitHub.EXPECT().
+					UpdatePullRequest(gomock.Any(), repoOwner, repoName, batchPrNum, batchPrTitle, gomock.Any()).
+					DoAndReturn(func(_ context.Context, _, _ string, _ int, _, body string) (*github.PullRequest, error) {
+						sections := parseBatchSections(body)
+						require.Len(t, sections, 1)
+						assert.Equal(t, otherRule, sections[0].Rule)
+						return &github.PullRequest{}, nil
+					})
+			},
+		},
+		{
+			name: "the files other rules modify too are not reverted",
+			mockSetup: func(t *testing.T, mockGitHub *mock_ghclient.MockGitHub) {
+				t.Helper()
+				mockGitHub.EXPECT().
+					GetPullRequest(gomock.Any(), repoOwner, repoName, batchPrNum).
+					Return(batchedTestPr(t, "open",
+						batchSection{Rule: batchedRule, ContentSha: "abc", text: "dependabot",
+							Files: []string{".github/dependabot.yml", "README.md"}},
+						batchSection{Rule: otherRule, ContentSha: "def", text: "other",
+							Files: []string{"README.md"}}), nil)
+				mockGitHub.EXPECT().
+					GetCommit(gomock.Any(), repoOwner, repoName, batchHead).
+					Return(&github.Commit{Tree: &github.Tree{SHA: github.String("head-tree-sha")}}, nil)
+				mockGitHub.EXPECT().
+					CreateTree(gomock.Any(), repoOwner, repoName, "head-tree-sha", gomock.Any()).
+					DoAndReturn(func(_ context.Context, _, _, _ string, entries []*github.TreeEntry) (*github.Tree, error) {
+						require.Len(t, entries, 1)
+						assert.Equal(t, ".github/dependabot.yml", entries[0].GetPath())
+						return &github.Tree{SHA: github.String("tree-sha")}, nil
+					})
+				mockGitHub.EXPECT().
+					CreateCommit(gomock.Any(), repoOwner, repoName, "Revert the remediation of "+batchedRule,
+						gomock.Any(), batchHead, nil).
+					Return(&github.Commit{SHA: github.String("commit-sha")}, nil)
+				mockGitHub.EXPECT().
+					UpdateRef(gomock.Any(), repoOwner, repoName, refFromBranch(batchBranch), "commit-sha", false).
+					Return(&github.Reference{}, nil)
+				mockGitHub.EXPECT().
+					UpdatePullRequest(gomock.Any(), repoOwner, repoName, batchPrNum, batchPrTitle, gomock.Any()).
+					Return(&github.PullRequest{}, nil)
+			},
+		},
+		{
+			name: "the batched PR is closed when the last rule passes",
+			mockSetup: func(t *testing.T, mockGitHub *mock_ghclient.MockGitHub) {
+				t.Helper()
+				mockGitHub.EXPECT().
+					GetPullRequest(gomock.Any(), repoOwner, repoName, batchPrNum).
+					Return(batchedTestPr(t, "open",
+						batchSection{Rule: batchedRule, ContentSha: "abc", text: "dependabot"}), nil)
+				mockGitHub.EXPECT().
+					CreateComment(gomock.Any(), repoOwner, repoName, batchPrNum, prClosedComment).
+					Return(nil)
+				mockGitHub.EXPECT().
+					ClosePullRequest(gomock.Any(), repoOwner, repoName, batchPrNum).
+					Return(nil)
+				mockGitHub.EXPECT().
+					DeleteRef(gomock.Any(), repoOwner, repoName, refFromBranch(batchBranch)).
+					Return(errors.New("already deleted"))
+			},
+		},
+		{
+			name: "a batched PR that was merged is left alone",
+			mockSetup: func(t *testing.T, mockGitHub *mock_ghclient.MockGitHub) {
+				t.Helper()
+				mockGitHub.EXPECT().
+					GetPullRequest(gomock.Any(), repoOwner, repoName, batchPrNum).
+					Return(batchedTestPr(t, "closed",
+						batchSection{Rule: batchedRule, ContentSha: "abc", text: "dependabot"}), nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			engine, mockClient := batchedTestEngine(t, ctrl)
+			tt.mockSetup(t, mockClient)
+
+			tracked, err := (&PullRequest{Number: batchPrNum, Branch: batchBranch}).metadata()
+			require.NoError(t, err)
+
+			remArgs := createTestRemArgs()
+			retMeta, err := engine.Do(context.Background(), interfaces.ActionCmdOff, remArgs.remAction,
+				remArgs.ent, batchedTestParams(t), &tracked)
+			require.NoError(t, err)
+			require.Nil(t, retMeta)
+		})
+	}
+}
+
+func TestJoinBatchedPullRequestSharedFile(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// the rule makes the package private, another rule of the batched PR set its license
+	prRem := &pb.RuleType_Definition_Remediate_PullRequestRemediation{
+		Title: "Make the package private",
+		Body:  "Makes the package private",
+		Contents: []*pb.RuleType_Definition_Remediate_PullRequestRemediation_Content{
+			{Path: "package.json", Action: ActionJq, Content: ".private = true"},
+		},
+		Batch: github.Bool(true),
+	}
+	engine, err := NewPullRequestRemediate(TestActionTypeValid, prRem, testGithubProviderBuilder(ghApiUrl))
+	require.NoError(t, err, "unexpected error creating remediate engine")
+	mockGitHub := mock_ghclient.NewMockGitHub(ctrl)
+	engine.ghCli = mockGitHub
+
+	mockGitHub.EXPECT().
+		ListPullRequests(gomock.Any(), repoOwner, repoName, gomock.Any()).
+		Return([]*github.PullRequest{batchedTestPr(t, "open",
+			batchSection{Rule: otherRule, ContentSha: "def", text: "other", Files: []string{"package.json"}})}, nil)
+	mockGitHub.EXPECT().
+		GetFileContents(gomock.Any(), repoOwner, repoName, "package.json", batchHead).
+		Return(`{"name": "acme", "license": "Apache-2.0"}`, nil)
+	mockGitHub.EXPECT().
+		GetCommit(gomock.Any(), repoOwner, repoName, batchHead).
+		Return(&github.Commit{Tree: &github.Tree{SHA: github.String("head-tree-sha")}}, nil)
+	mockGitHub.EXPECT().
+		CreateBlob(gomock.Any(), repoOwner, repoName, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _, _ string, blob *github.Blob) (*github.Blob, error) {
+			// the modifications of both rules are kept
+			assert.JSONEq(t, `{"name": "acme", "license": "Apache-2.0", "private": true}`, blob.GetContent())
+			return &github.Blob{SHA: github.String("blob-sha")}, nil
+		})
+	mockGitHub.EXPECT().
+		CreateTree(gomock.Any(), repoOwner, repoName, "head-tree-sha", gomock.Any()).
+		Return(&github.Tree{SHA: github.String("tree-sha")}, nil)
+	mockGitHub.EXPECT().
+		CreateCommit(gomock.Any(), repoOwner, repoName, "Make the package private", gomock.Any(), batchHead, nil).
+		Return(&github.Commit{SHA: github.String("commit-sha")}, nil)
+	mockGitHub.EXPECT().
+		UpdateRef(gomock.Any(), repoOwner, repoName, refFromBranch(batchBranch), "commit-sha", false).
+		Return(&github.Reference{}, nil)
+	mockGitHub.EXPECT().
+		UpdatePullRequest(gomock.Any(), repoOwner, repoName, batchPrNum, batchPrTitle, gomock.Any()).
+		Return(&github.PullRequest{}, nil)
+
+	testrepo, err := defaultMockRepoSetup(t)
+	require.NoError(t, err, "unexpected error creating test repo")
+	testWt, err := testrepo.Worktree()
+	require.NoError(t, err, "unexpected error creating test worktree")
+	err = writeEntry(prEntry{Path: "package.json", Result: `{"name": "acme"}`}, testWt.Filesystem)
+	require.NoError(t, err, "unexpected error writing the ingested package.json")
+
+	params := batchedTestParams(t)
+	params.SetIngestResult(&interfaces.Result{
+		Fs:     testWt.Filesystem,
+		Storer: testrepo.Storer,
+	})
+
+	remArgs := createTestRemArgs()
+	retMeta, err := engine.Do(context.Background(), interfaces.ActionCmdOn, remArgs.remAction,
+		remArgs.ent, params, nil)
+	require.NoError(t, err, "unexpected error running remediate engine")
+	pr := PullRequestFromMetadata(&retMeta)
+	require.NotNil(t, pr, "expected the batched pull request to be tracked")
+	assert.Equal(t, batchPrNum, pr.Number)
+}
+
+func batchedTestPr(t *testing.T, state string, sections ...batchSection) *github.PullRequest {
+	t.Helper()
+
+	return &github.PullRequest{
+		Number: github.Int(batchPrNum),
+		State:  github.String(state),
+		Body:   github.String(batchedTestBody(t, sections...)),
+		Head: &github.PullRequestBranch{
+			Ref: github.String(batchBranch),
+			SHA: github.String(batchHead),
+		},
+	}
+}
+
+func mockPushToBatchedPullRequest(mockGitHub *mock_ghclient.MockGitHub, blobs int) {
+	mockGitHub.EXPECT().
+		GetCommit(gomock.Any(), repoOwner, repoName, batchHead).
+		Return(&github.Commit{Tree: &github.Tree{SHA: github.String("head-tree-sha")}}, nil)
+	mockGitHub.EXPECT().
+		CreateBlob(gomock.Any(), repoOwner, repoName, gomock.Any()).
+		Return(&github.Blob{SHA: github.String("blob-sha")}, nil).
+		Times(blobs)
+	mockGitHub.EXPECT().
+		CreateTree(gomock.Any(), repoOwner, repoName, "head-tree-sha", gomock.Any()).
+		Return(&github.Tree{SHA: github.String("tree-sha")}, nil)
+	mockGitHub.EXPECT().
+		CreateCommit(gomock.Any(), repoOwner, repoName, commitTitle, gomock.Any(), batchHead, nil).
+		Return(&github.Commit{SHA: github.String("commit-sha")}, nil)
+	mockGitHub.EXPECT().
+		UpdateRef(gomock.Any(), repoOwner, repoName, refFromBranch(batchBranch), "commit-sha", false).
+		Return(&github.Reference{}, nil)
+}
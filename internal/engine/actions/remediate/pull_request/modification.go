@@ -0,0 +1,198 @@
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pull_request
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/bluekeyes/go-gitdiff/gitdiff"
+	jsonpatch "github.com/evanphx/json-patch/v5"
+	"github.com/go-git/go-billy/v5"
+	"gopkg.in/yaml.v3"
+
+	"github.com/stacklok/minder/internal/util"
+)
+
+const (
+	// ActionReplace writes the content to the file, replacing it if it exists
+	ActionReplace = "replace"
+	// ActionPatch applies the content to the file as a unified diff
+	ActionPatch = "patch"
+	// ActionJSONPatch applies the content to a JSON or YAML file as a JSON patch
+	ActionJSONPatch = "jsonpatch"
+	// ActionJq replaces a JSON or YAML file with the result of the content as a jq expression
+	ActionJq = "jq"
+	// ActionDelete deletes the file
+	ActionDelete = "delete"
+)
+
+// modify computes the contents of the file of the entry after its modification, given the
+// filesystem the modification is applied to
+func (e *prEntry) modify(ctx context.Context, fs billy.Filesystem) error {
+	switch e.Action {
+	case ActionReplace:
+		e.Result = e.Content
+		return nil
+	case ActionDelete:
+		e.Result = ""
+		return nil
+	}
+
+	current, exists, err := readFile(fs, e.Path)
+	if err != nil {
+		return err
+	}
+
+	switch e.Action {
+	case ActionPatch:
+		e.Result, err = applyUnifiedDiff(current, e.Content)
+	case ActionJSONPatch, ActionJq:
+		if !exists {
+			return fmt.Errorf("file %s doesn't exist", e.Path)
+		}
+		e.Result, err = editDocument(ctx, e.Path, current, e.Action, e.Content)
+	default:
+		return fmt.Errorf("unknown action %q", e.Action)
+	}
+	return err
+}
+
+// readFile returns the contents of a file, and whether it exists at all
+func readFile(fs billy.Filesystem, path string) (string, bool, error) {
+	f, err := fs.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return "", false, nil
+	} else if err != nil {
+		return "", false, fmt.Errorf("cannot open file: %w", err)
+	}
+	defer f.Close()
+
+	contents, err := io.ReadAll(f)
+	if err != nil {
+		return "", false, fmt.Errorf("cannot read file: %w", err)
+	}
+	return string(contents), true, nil
+}
+
+// applyUnifiedDiff applies the unified diff of a single file to its current contents
+func applyUnifiedDiff(current, diff string) (string, error) {
+	files, _, err := gitdiff.Parse(strings.NewReader(diff))
+	if err != nil {
+		return "", fmt.Errorf("cannot parse diff: %w", err)
+	}
+	if len(files) != 1 {
+		return "", fmt.Errorf("expected a diff of a single file, got %d", len(files))
+	}
+
+	var out bytes.Buffer
+	if err := gitdiff.Apply(&out, strings.NewReader(current), files[0]); err != nil {
+		return "", fmt.Errorf("cannot apply diff: %w", err)
+	}
+	return out.String(), nil
+}
+
+// editDocument edits a JSON or YAML document with a JSON patch or a jq expression. The
+// document is written back in its original format, but the formatting and comments of a
+// YAML document are not preserved.
+func editDocument(ctx context.Context, path, current, action, edit string) (string, error) {
+	isYAML := isYAMLFile(path)
+
+	doc, err := documentAsJSON(current, isYAML)
+	if err != nil {
+		return "", err
+	}
+
+	var edited []byte
+	switch action {
+	case ActionJSONPatch:
+		patch, err := jsonpatch.DecodePatch([]byte(edit))
+		if err != nil {
+			return "", fmt.Errorf("cannot decode JSON patch: %w", err)
+		}
+		edited, err = patch.Apply(doc)
+		if err != nil {
+			return "", fmt.Errorf("cannot apply JSON patch: %w", err)
+		}
+	case ActionJq:
+		var obj any
+		if err := json.Unmarshal(doc, &obj); err != nil {
+			return "", fmt.Errorf("cannot parse document: %w", err)
+		}
+		res, err := util.JQReadFrom[any](ctx, edit, obj)
+		if err != nil {
+			return "", fmt.Errorf("cannot evaluate jq expression: %w", err)
+		}
+		edited, err = json.Marshal(res)
+		if err != nil {
+			return "", fmt.Errorf("cannot marshal jq result: %w", err)
+		}
+	}
+
+	return documentFromJSON(edited, isYAML)
+}
+
+func isYAMLFile(path string) bool {
+	ext := strings.ToLower(filepath.Ext(path))
+	return ext == ".yml" || ext == ".yaml"
+}
+
+func documentAsJSON(contents string, isYAML bool) ([]byte, error) {
+	if !isYAML {
+		return []byte(contents), nil
+	}
+
+	var obj any
+	if err := yaml.Unmarshal([]byte(contents), &obj); err != nil {
+		return nil, fmt.Errorf("cannot parse YAML document: %w", err)
+	}
+	doc, err := json.Marshal(obj)
+	if err != nil {
+		return nil, fmt.Errorf("cannot convert YAML document to JSON: %w", err)
+	}
+	return doc, nil
+}
+
+func documentFromJSON(doc []byte, isYAML bool) (string, error) {
+	var obj any
+	if err := json.Unmarshal(doc, &obj); err != nil {
+		return "", fmt.Errorf("cannot parse edited document: %w", err)
+	}
+
+	var out bytes.Buffer
+	if isYAML {
+		enc := yaml.NewEncoder(&out)
+		enc.SetIndent(2)
+		if err := enc.Encode(obj); err != nil {
+			return "", fmt.Errorf("cannot marshal YAML document: %w", err)
+		}
+		return out.String(), nil
+	}
+
+	enc := json.NewEncoder(&out)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(obj); err != nil {
+		return "", fmt.Errorf("cannot marshal JSON document: %w", err)
+	}
+	return out.String(), nil
+}
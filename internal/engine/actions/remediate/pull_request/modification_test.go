@@ -0,0 +1,201 @@
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pull_request
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-git/go-billy/v5/memfs"
+	"github.com/go-git/go-billy/v5/util"
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/storage/memory"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+const existingDependabotConfig = `version: 2
+updates:
+  - package-ecosystem: npm
+    directory: /
+    schedule:
+      interval: weekly
+`
+
+func TestPrEntryModify(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		entry       prEntry
+		files       map[string]string
+		expected    string
+		expectedErr string
+	}{
+		{
+			name:     "replace writes the content",
+			entry:    prEntry{Path: "README.md", Action: ActionReplace, Content: "hello\n"},
+			files:    map[string]string{"README.md": "bye\n"},
+			expected: "hello\n",
+		},
+		{
+			name: "patch applies a unified diff",
+			entry: prEntry{Path: "README.md", Action: ActionPatch, Content: `--- a/README.md
++++ b/README.md
+@@ -1,2 +1,2 @@
+ # minder
+-hello
++bye
+`},
+			files:    map[string]string{"README.md": "# minder\nhello\n"},
+			expected: "# minder\nbye\n",
+		},
+		{
+			name: "patch that doesn't apply fails",
+			entry: prEntry{Path: "README.md", Action: ActionPatch, Content: `--- a/README.md
++++ b/README.md
+@@ -1,2 +1,2 @@
+ # minder
+-hello
++bye
+`},
+			files:       map[string]string{"README.md": "# other\nhello\n"},
+			expectedErr: "cannot apply diff",
+		},
+		{
+			name: "jsonpatch edits a YAML file",
+			entry: prEntry{Path: ".github/dependabot.yml", Action: ActionJSONPatch, Content: `[
+  {"op": "add", "path": "/updates/-", "value": {"package-ecosystem": "gomod", "directory": "/"}}
+]`},
+			files: map[string]string{".github/dependabot.yml": existingDependabotConfig},
+			expected: `updates:
+  - directory: /
+    package-ecosystem: npm
+    schedule:
+      interval: weekly
+  - directory: /
+    package-ecosystem: gomod
+version: 2
+`,
+		},
+		{
+			name:     "jq edits a JSON file",
+			entry:    prEntry{Path: "package.json", Action: ActionJq, Content: `.private = true`},
+			files:    map[string]string{"package.json": `{"name": "minder"}`},
+			expected: "{\n  \"name\": \"minder\",\n  \"private\": true\n}\n",
+		},
+		{
+			name:        "jq requires the file to exist",
+			entry:       prEntry{Path: "package.json", Action: ActionJq, Content: `.private = true`},
+			expectedErr: "doesn't exist",
+		},
+		{
+			name:     "delete has no content",
+			entry:    prEntry{Path: "README.md", Action: ActionDelete},
+			files:    map[string]string{"README.md": "hello\n"},
+			expected: "",
+		},
+		{
+			name:        "unknown action",
+			entry:       prEntry{Path: "README.md", Action: "append", Content: "hello\n"},
+			expectedErr: "unknown action",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			fs := memfs.New()
+			for path, contents := range tt.files {
+				require.NoError(t, util.WriteFile(fs, path, []byte(contents), 0644))
+			}
+
+			entry := tt.entry
+			err := entry.modify(context.Background(), fs)
+			if tt.expectedErr != "" {
+				require.ErrorContains(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, entry.Result)
+		})
+	}
+}
+
+func TestPrTemplateParamsReadFile(t *testing.T) {
+	t.Parallel()
+
+	fs := memfs.New()
+	require.NoError(t, util.WriteFile(fs, "go.mod", []byte("module github.com/stacklok/minder\n"), 0644))
+
+	params := &PrTemplateParams{fs: fs}
+	contents, err := params.ReadFile("go.mod")
+	require.NoError(t, err)
+	assert.Equal(t, "module github.com/stacklok/minder\n", contents)
+
+	contents, err = params.ReadFile("package.json")
+	require.NoError(t, err)
+	assert.Empty(t, contents, "a file that doesn't exist is empty")
+}
+
+func TestStageEntriesDeletesFiles(t *testing.T) {
+	t.Parallel()
+
+	fs := memfs.New()
+	repo, err := git.Init(memory.NewStorage(), fs)
+	require.NoError(t, err)
+	wt, err := repo.Worktree()
+	require.NoError(t, err)
+
+	require.NoError(t, util.WriteFile(fs, "README.md", []byte("hello\n"), 0644))
+	_, err = wt.Add("README.md")
+	require.NoError(t, err)
+	_, err = wt.Commit("initial commit", &git.CommitOptions{
+		Author: &object.Signature{Name: "minder", Email: "minder@stacklok.com"},
+	})
+	require.NoError(t, err)
+
+	r := &Remediator{entries: []prEntry{
+		{Path: "README.md", Action: ActionDelete},
+		{Path: "NOTICE", Action: ActionDelete},
+	}}
+	require.NoError(t, r.createEntries(fs))
+	require.NoError(t, r.stageEntries(wt), "a file that doesn't exist has nothing to delete")
+
+	status, err := wt.Status()
+	require.NoError(t, err)
+	assert.Equal(t, git.Deleted, status.File("README.md").Staging)
+}
+
+func TestPrConfigToEntriesDeleteWithoutContent(t *testing.T) {
+	t.Parallel()
+
+	entries, err := prConfigToEntries(&pb.RuleType_Definition_Remediate_PullRequestRemediation{
+		Contents: []*pb.RuleType_Definition_Remediate_PullRequestRemediation_Content{
+			{Path: "README.md", Action: ActionDelete},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+
+	r := &Remediator{entries: entries}
+	require.NoError(t, r.expandContents(&PrTemplateParams{}))
+	assert.Empty(t, r.entries[0].Content)
+}
@@ -35,6 +35,7 @@ import (
 	"github.com/go-git/go-git/v5"
 	"github.com/go-git/go-git/v5/config"
 	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/format/index"
 	"github.com/go-git/go-git/v5/plumbing/object"
 	githttp "github.com/go-git/go-git/v5/plumbing/transport/http"
 	"github.com/go-git/go-git/v5/storage"
@@ -42,6 +43,7 @@ import (
 	"github.com/rs/zerolog"
 	"google.golang.org/protobuf/reflect/protoreflect"
 
+	enginerr "github.com/stacklok/minder/internal/engine/errors"
 	"github.com/stacklok/minder/internal/engine/interfaces"
 	"github.com/stacklok/minder/internal/providers"
 	"github.com/stacklok/minder/internal/util"
@@ -62,6 +64,14 @@ const (
 	dflBranchTo       = "main"
 )
 
+const (
+	// CommitMethodGit creates the commit of the pull request locally and pushes it
+	CommitMethodGit = "git"
+	// CommitMethodApi creates the commit of the pull request through the provider's API,
+	// which signs it
+	CommitMethodApi = "api"
+)
+
 const (
 	prMagicTemplateName = "prMagicComment"
 	prBodyMagicTemplate = `<!-- minder: pr-remediation-body: { "ContentSha": "{{.ContentSha}}" } -->`
@@ -69,21 +79,60 @@ const (
 	prTemplateName = "prBody"
 	prBodyTmplStr  = "{{.MagicComment}}\n\n{{.PrText}}"
 
+	prClosedComment = "Closing this pull request, the rule it remediates passes now."
+
 	dryRunTemplateName = "dryRun"
 	dryRunTmpl         = `{{- range . }}
 Path: {{ .Path }}
-Content: {{ .Content }}
+Action: {{ .Action }}
+Content: {{ .Result }}
 Mode: {{ .Mode }}
 --------------------------
 {{- end }}
 `
 )
 
+// PullRequest is a pull request opened by the remediation. It's kept in the remediation
+// metadata so that the pull request is updated when the contents of the remediation change
+// and closed once the rule passes.
+type PullRequest struct {
+	// Number is the number of the pull request
+	Number int `json:"number"`
+	// Branch is the branch the contents of the remediation are pushed to
+	Branch string `json:"branch"`
+	// ContentSha is the digest of the contents last pushed to the branch
+	ContentSha string `json:"content_sha"`
+}
+
+func (pr *PullRequest) metadata() (json.RawMessage, error) {
+	meta, err := json.Marshal(pr)
+	if err != nil {
+		return nil, fmt.Errorf("cannot marshal pull request metadata: %w", err)
+	}
+	return meta, nil
+}
+
+// PullRequestFromMetadata returns the pull request tracked in the metadata, or nil if none is
+func PullRequestFromMetadata(metadata *json.RawMessage) *PullRequest {
+	if metadata == nil || len(*metadata) == 0 {
+		return nil
+	}
+
+	var pr PullRequest
+	if err := json.Unmarshal(*metadata, &pr); err != nil || pr.Number == 0 {
+		return nil
+	}
+	return &pr
+}
+
 type prEntry struct {
 	Path            string
+	Action          string
 	contentTemplate *template.Template
 	Content         string
 	Mode            string
+	// Result is the content of the file after the action was applied
+	Result string
 }
 
 // Remediator is the remediation engine for the Pull Request remediation type
@@ -95,6 +144,10 @@ type Remediator struct {
 	titleTemplate *htmltemplate.Template
 	bodyTemplate  *htmltemplate.Template
 	entries       []prEntry
+
+	commitMethod string
+	commitAuthor *pb.RuleType_Definition_Remediate_PullRequestRemediation_CommitAuthor
+	batch        bool
 }
 
 // NewPullRequestRemediate creates a new PR remediation engine
@@ -133,6 +186,17 @@ func NewPullRequestRemediate(
 		return nil, fmt.Errorf("cannot create PR entries: %w", err)
 	}
 
+	commitMethod := prCfg.GetCommitMethod()
+	switch {
+	case commitMethod != "":
+	case prCfg.GetBatch():
+		// a batched pull request is built up commit by commit on its branch, which is
+		// not ingested
+		commitMethod = CommitMethodApi
+	default:
+		commitMethod = CommitMethodGit
+	}
+
 	return &Remediator{
 		ghCli:      ghCli,
 		gitCli:     gitCli,
@@ -141,6 +205,10 @@ func NewPullRequestRemediate(
 		titleTemplate: titleTmpl,
 		bodyTemplate:  bodyTmpl,
 		entries:       entries,
+
+		commitMethod: commitMethod,
+		commitAuthor: prCfg.CommitAuthor,
+		batch:        prCfg.GetBatch(),
 	}, nil
 }
 
@@ -149,9 +217,19 @@ func prConfigToEntries(prCfg *pb.RuleType_Definition_Remediate_PullRequestRemedi
 	for i := range prCfg.Contents {
 		cnt := prCfg.Contents[i]
 
-		contentTemplate, err := util.ParseNewTextTemplate(&cnt.Content, fmt.Sprintf("Content[%d]", i))
-		if err != nil {
-			return nil, fmt.Errorf("cannot parse content template (index %d): %w", i, err)
+		action := cnt.Action
+		if action == "" {
+			action = ActionReplace
+		}
+
+		// deleting a file doesn't need any content
+		var contentTemplate *template.Template
+		if action != ActionDelete {
+			var err error
+			contentTemplate, err = util.ParseNewTextTemplate(&cnt.Content, fmt.Sprintf("Content[%d]", i))
+			if err != nil {
+				return nil, fmt.Errorf("cannot parse content template (index %d): %w", i, err)
+			}
 		}
 
 		mode := ghModeNonExecFile
@@ -161,6 +239,7 @@ func prConfigToEntries(prCfg *pb.RuleType_Definition_Remediate_PullRequestRemedi
 
 		entries[i] = prEntry{
 			Path:            cnt.Path,
+			Action:          action,
 			Mode:            mode,
 			contentTemplate: contentTemplate,
 		}
@@ -177,6 +256,22 @@ type PrTemplateParams struct {
 	Profile map[string]any
 	// Params are the rule instance parameters
 	Params map[string]any
+	// Ingested is the object ingested for the evaluation of the rule, if any
+	Ingested any
+
+	fs billy.Filesystem
+}
+
+// ReadFile returns the contents of a file of the filesystem ingested for the evaluation
+// of the rule, or an empty string if the file doesn't exist, e.g. to include parts of
+// an existing file in the contents of the remediation
+func (p *PrTemplateParams) ReadFile(path string) (string, error) {
+	if p.fs == nil {
+		return "", errors.New("no filesystem was ingested")
+	}
+
+	contents, _, err := readFile(p.fs, path)
+	return contents, err
 }
 
 // Class returns the action type of the remediation engine
@@ -194,24 +289,28 @@ func (_ *Remediator) GetOnOffState(p *pb.Profile) interfaces.ActionOpt {
 	return interfaces.ActionOptFromString(p.Remediate, interfaces.ActionOptOff)
 }
 
-// Do performs the remediation
+// Do performs the remediation. The metadata is the pull request opened by a previous
+// remediation, if any, which is updated when the desired contents change and closed
+// when the remediation is turned off because the rule passes.
 func (r *Remediator) Do(
 	ctx context.Context,
-	_ interfaces.ActionCmd,
+	cmd interfaces.ActionCmd,
 	remAction interfaces.ActionOpt,
 	ent protoreflect.ProtoMessage,
 	params interfaces.ActionsParams,
-	_ *json.RawMessage,
+	metadata *json.RawMessage,
 ) (json.RawMessage, error) {
 	repo, ok := ent.(*pb.Repository)
 	if !ok {
 		return nil, fmt.Errorf("expected repository, got %T", ent)
 	}
 
-	tmplParams := &PrTemplateParams{
-		Entity:  ent,
-		Profile: params.GetRule().Def.AsMap(),
-		Params:  params.GetRule().Params.AsMap(),
+	tracked := PullRequestFromMetadata(metadata)
+	if cmd == interfaces.ActionCmdOff {
+		if r.batch {
+			return nil, r.leaveBatchedPullRequest(ctx, repo, params, tracked)
+		}
+		return nil, r.closePullRequest(ctx, repo, tracked)
 	}
 
 	ingested := params.GetIngestResult()
@@ -219,6 +318,14 @@ func (r *Remediator) Do(
 		return nil, errors.New("ingested filesystem is nil or no git repo was ingested")
 	}
 
+	tmplParams := &PrTemplateParams{
+		Entity:   ent,
+		Profile:  params.GetRule().Def.AsMap(),
+		Params:   params.GetRule().Params.AsMap(),
+		Ingested: ingested.Object,
+		fs:       ingested.Fs,
+	}
+
 	title := new(bytes.Buffer)
 	if err := r.titleTemplate.Execute(title, tmplParams); err != nil {
 		return nil, fmt.Errorf("cannot execute title template: %w", err)
@@ -228,30 +335,186 @@ func (r *Remediator) Do(
 		return nil, fmt.Errorf("cannot expand contents: %w", err)
 	}
 
+	if err := r.modifyContents(ctx, ingested.Fs); err != nil {
+		return nil, fmt.Errorf("cannot modify contents: %w", err)
+	}
+
 	prFullBodyText, magicComment, err := r.getPrBodyText(tmplParams)
 	if err != nil {
 		return nil, fmt.Errorf("cannot create PR full body text: %w", err)
 	}
 
-	var remErr error
 	switch remAction {
 	case interfaces.ActionOptOn:
-		alreadyExists, err := prAlreadyExists(ctx, r.ghCli, repo, magicComment)
-		if err != nil {
-			return nil, fmt.Errorf("cannot check if PR already exists: %w", err)
+		if r.batch {
+			return r.joinBatchedPullRequest(ctx, ingested, repo, params, tracked, title.String(), tmplParams)
 		}
-		if alreadyExists {
-			zerolog.Ctx(ctx).Info().Msg("PR already exists, won't create a new one")
-			return nil, nil
+		if tracked != nil {
+			return r.updatePullRequest(ctx, ingested, repo, tracked, title.String(), prFullBodyText)
 		}
-		remErr = r.runGit(ctx, ingested.Fs, ingested.Storer, repo, title.String(), prFullBodyText)
+		return r.openPullRequest(ctx, ingested, repo, title.String(), prFullBodyText, magicComment)
 	case interfaces.ActionOptDryRun:
 		dryRun(title.String(), prFullBodyText, r.entries)
-		remErr = nil
+		return nil, nil
+	case interfaces.ActionOptApprovalRequired:
+		// a pull request is reviewed before being merged instead, profiles requiring approval are rejected
+		return nil, fmt.Errorf("pull request remediations can't require approval: %w", enginerr.ErrActionNotAvailable)
 	case interfaces.ActionOptOff, interfaces.ActionOptUnknown:
-		remErr = errors.New("unexpected action")
 	}
-	return nil, remErr
+	return nil, errors.New("unexpected action")
+}
+
+// openPullRequest opens a pull request with the contents of the remediation, unless
+// an open pull request with the same contents already exists, and returns it as the
+// metadata to track it with
+func (r *Remediator) openPullRequest(
+	ctx context.Context,
+	ingested *interfaces.Result,
+	repo *pb.Repository,
+	title, body, magicComment string,
+) (json.RawMessage, error) {
+	contentSha, err := r.contentSha1()
+	if err != nil {
+		return nil, fmt.Errorf("cannot get content sha1: %w", err)
+	}
+
+	existing, err := findOpenPullRequest(ctx, r.ghCli, repo, magicComment)
+	if err != nil {
+		return nil, fmt.Errorf("cannot check if PR already exists: %w", err)
+	}
+	if existing != nil {
+		// a pull request opened before it was tracked is tracked from now on
+		zerolog.Ctx(ctx).Info().Int("pr_number", existing.GetNumber()).
+			Msg("PR already exists, won't create a new one")
+		return (&PullRequest{
+			Number:     existing.GetNumber(),
+			Branch:     existing.GetHead().GetRef(),
+			ContentSha: contentSha,
+		}).metadata()
+	}
+
+	branch := branchBaseName(title)
+	if err := r.pushContents(ctx, ingested, repo, branch, title); err != nil {
+		return nil, err
+	}
+
+	pr, err := r.ghCli.CreatePullRequest(
+		ctx, repo.GetOwner(), repo.GetName(),
+		title, body,
+		refFromBranch(branch),
+		dflBranchTo,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("cannot create pull request: %w", err)
+	}
+
+	zerolog.Ctx(ctx).Info().Int("pr_number", pr.GetNumber()).Msg("Pull request created")
+	return (&PullRequest{
+		Number:     pr.GetNumber(),
+		Branch:     branch,
+		ContentSha: contentSha,
+	}).metadata()
+}
+
+// updatePullRequest force-pushes the contents of the remediation to the branch of the
+// tracked pull request if they changed since it was opened. A pull request that was
+// closed or merged without fixing the rule is no longer tracked and the remediation
+// fails, so that a new one is opened once the remediation is attempted again.
+func (r *Remediator) updatePullRequest(
+	ctx context.Context,
+	ingested *interfaces.Result,
+	repo *pb.Repository,
+	tracked *PullRequest,
+	title, body string,
+) (json.RawMessage, error) {
+	pr, err := r.ghCli.GetPullRequest(ctx, repo.GetOwner(), repo.GetName(), tracked.Number)
+	if err != nil {
+		meta, _ := tracked.metadata()
+		return meta, fmt.Errorf("cannot get pull request #%d: %w", tracked.Number, err)
+	}
+
+	if pr.GetState() != "open" {
+		return nil, notOpenError(pr, tracked.Number)
+	}
+
+	meta, err := tracked.metadata()
+	if err != nil {
+		return nil, err
+	}
+
+	contentSha, err := r.contentSha1()
+	if err != nil {
+		return meta, fmt.Errorf("cannot get content sha1: %w", err)
+	}
+	if contentSha == tracked.ContentSha {
+		return meta, enginerr.NewErrActionPending("pull request #%d is awaiting merge", tracked.Number)
+	}
+
+	if err := r.pushContents(ctx, ingested, repo, tracked.Branch, title); err != nil {
+		return meta, err
+	}
+
+	if _, err := r.ghCli.UpdatePullRequest(ctx, repo.GetOwner(), repo.GetName(), tracked.Number,
+		title, body); err != nil {
+		return meta, fmt.Errorf("cannot update pull request #%d: %w", tracked.Number, err)
+	}
+
+	zerolog.Ctx(ctx).Info().Int("pr_number", tracked.Number).Msg("Pull request updated")
+	tracked.ContentSha = contentSha
+	return tracked.metadata()
+}
+
+// notOpenError returns the reason for not tracking a pull request that was merged or
+// closed while the rule still fails
+func notOpenError(pr *github.PullRequest, number int) error {
+	if pr.GetMerged() {
+		return enginerr.NewErrActionFailed("pull request #%d was merged, but the rule still fails", number)
+	}
+	return enginerr.NewErrActionFailed("pull request #%d was closed without being merged", number)
+}
+
+// closePullRequest closes the tracked pull request, if it's still open, and deletes its
+// branch now that the rule passes
+func (r *Remediator) closePullRequest(ctx context.Context, repo *pb.Repository, tracked *PullRequest) error {
+	if tracked == nil {
+		return nil
+	}
+
+	pr, err := r.ghCli.GetPullRequest(ctx, repo.GetOwner(), repo.GetName(), tracked.Number)
+	if err != nil {
+		return fmt.Errorf("cannot get pull request #%d: %w", tracked.Number, err)
+	}
+
+	return r.closeAndDeleteBranch(ctx, repo, pr, tracked)
+}
+
+// closeAndDeleteBranch closes the pull request, if it's still open, and deletes its branch
+func (r *Remediator) closeAndDeleteBranch(
+	ctx context.Context,
+	repo *pb.Repository,
+	pr *github.PullRequest,
+	tracked *PullRequest,
+) error {
+	logger := zerolog.Ctx(ctx).With().Int("pr_number", tracked.Number).Logger()
+
+	if pr.GetState() == "open" {
+		if err := r.ghCli.CreateComment(ctx, repo.GetOwner(), repo.GetName(), tracked.Number,
+			prClosedComment); err != nil {
+			// the comment is only informative
+			logger.Warn().Err(err).Msg("cannot comment on pull request")
+		}
+		if err := r.ghCli.ClosePullRequest(ctx, repo.GetOwner(), repo.GetName(), tracked.Number); err != nil {
+			return fmt.Errorf("cannot close pull request #%d: %w", tracked.Number, err)
+		}
+		logger.Info().Msg("Pull request closed")
+	}
+
+	// the branch may have been deleted already, e.g. when the pull request was merged
+	if err := r.ghCli.DeleteRef(ctx, repo.GetOwner(), repo.GetName(), refFromBranch(tracked.Branch)); err != nil {
+		logger.Debug().Err(err).Str("branch", tracked.Branch).Msg("cannot delete branch")
+	}
+
+	return nil
 }
 
 func dryRun(title, body string, entries []prEntry) {
@@ -268,12 +531,26 @@ func dryRun(title, body string, entries []prEntry) {
 	}
 }
 
+// pushContents points the branch to a commit of the contents of the remediation on top
+// of the ingested commit, replacing whatever the branch pointed to before
+func (r *Remediator) pushContents(
+	ctx context.Context,
+	ingested *interfaces.Result,
+	repo *pb.Repository,
+	branch, title string,
+) error {
+	if r.commitMethod == CommitMethodApi {
+		return r.runApi(ctx, ingested.Fs, ingested.Storer, repo, branch, title)
+	}
+	return r.runGit(ctx, ingested.Fs, ingested.Storer, repo, branch, title)
+}
+
 func (r *Remediator) runGit(
 	ctx context.Context,
 	fs billy.Filesystem,
 	storer storage.Storer,
 	pbRepo *pb.Repository,
-	title, body string,
+	branch, title string,
 ) error {
 	logger := zerolog.Ctx(ctx).With().Str("repo", pbRepo.String()).Logger()
 
@@ -293,9 +570,9 @@ func (r *Remediator) runGit(
 		return fmt.Errorf("cannot get authenticated user: %w", err)
 	}
 
-	logger.Debug().Str("branch", branchBaseName(title)).Msg("Checking out branch")
+	logger.Debug().Str("branch", branch).Msg("Checking out branch")
 	err = wt.Checkout(&git.CheckoutOptions{
-		Branch: plumbing.NewBranchReferenceName(branchBaseName(title)),
+		Branch: plumbing.NewBranchReferenceName(branch),
 		Create: true,
 	})
 	if err != nil {
@@ -308,25 +585,29 @@ func (r *Remediator) runGit(
 	}
 
 	logger.Debug().Msg("Staging changes")
-	for _, entry := range r.entries {
-		if _, err := wt.Add(entry.Path); err != nil {
-			return fmt.Errorf("cannot add file %s: %w", entry.Path, err)
-		}
+	if err := r.stageEntries(wt); err != nil {
+		return err
+	}
+
+	author := &object.Signature{
+		Name:  u.GetName(),
+		Email: u.GetEmail(),
+		When:  time.Now(),
+	}
+	if r.commitAuthor != nil {
+		author.Name = r.commitAuthor.GetName()
+		author.Email = r.commitAuthor.GetEmail()
 	}
 
 	logger.Debug().Msg("Committing changes")
 	_, err = wt.Commit(title, &git.CommitOptions{
-		Author: &object.Signature{
-			Name:  u.GetName(),
-			Email: u.GetEmail(),
-			When:  time.Now(),
-		},
+		Author: author,
 	})
 	if err != nil {
 		return fmt.Errorf("cannot commit: %w", err)
 	}
 
-	refspec := refFromBranch(branchBaseName(title))
+	refspec := refFromBranch(branch)
 
 	var b bytes.Buffer
 	err = repo.PushContext(ctx,
@@ -348,21 +629,143 @@ func (r *Remediator) runGit(
 		return fmt.Errorf("cannot push: %w", err)
 	}
 	zerolog.Ctx(ctx).Debug().Msgf("Push output: %s", b.String())
+	return nil
+}
 
-	_, err = r.ghCli.CreatePullRequest(
-		ctx, pbRepo.GetOwner(), pbRepo.GetName(),
-		title, body,
-		refspec,
-		dflBranchTo,
-	)
+// runApi creates the commit through the GitHub API instead of pushing a commit created locally,
+// so that the commit is signed by GitHub and passes branch rules that require signed commits
+func (r *Remediator) runApi(
+	ctx context.Context,
+	fs billy.Filesystem,
+	storer storage.Storer,
+	pbRepo *pb.Repository,
+	branch, title string,
+) error {
+	logger := zerolog.Ctx(ctx).With().Str("repo", pbRepo.String()).Logger()
+
+	headCommit, err := ingestedHead(fs, storer)
+	if err != nil {
+		return err
+	}
+
+	logger.Debug().Msg("Creating blobs")
+	treeEntries, err := r.createTreeEntries(ctx, pbRepo.GetOwner(), pbRepo.GetName(), headCommit)
+	if err != nil {
+		return err
+	}
+
+	sha, err := r.createApiCommit(ctx, pbRepo, treeEntries, headCommit.Hash.String(),
+		headCommit.TreeHash.String(), title)
 	if err != nil {
-		return fmt.Errorf("cannot create pull request: %w", err)
+		return err
+	}
+
+	ref := refFromBranch(branch)
+	logger.Debug().Str("branch", branch).Msg("Updating branch")
+	if _, err := r.ghCli.GetRef(ctx, pbRepo.GetOwner(), pbRepo.GetName(), ref); err != nil {
+		if _, err := r.ghCli.CreateRef(ctx, pbRepo.GetOwner(), pbRepo.GetName(), ref, sha); err != nil {
+			return fmt.Errorf("cannot create branch: %w", err)
+		}
+		return nil
 	}
 
-	zerolog.Ctx(ctx).Info().Msg("Pull request created")
+	if _, err := r.ghCli.UpdateRef(ctx, pbRepo.GetOwner(), pbRepo.GetName(), ref, sha, true); err != nil {
+		return fmt.Errorf("cannot update branch: %w", err)
+	}
 	return nil
 }
 
+// createApiCommit creates a commit of the tree entries on top of the given parent commit and
+// tree through the GitHub API, and returns its SHA
+func (r *Remediator) createApiCommit(
+	ctx context.Context,
+	pbRepo *pb.Repository,
+	treeEntries []*github.TreeEntry,
+	parentSha, baseTreeSha, title string,
+) (string, error) {
+	logger := zerolog.Ctx(ctx).With().Str("repo", pbRepo.String()).Logger()
+
+	logger.Debug().Msg("Creating tree")
+	tree, err := r.ghCli.CreateTree(ctx, pbRepo.GetOwner(), pbRepo.GetName(), baseTreeSha, treeEntries)
+	if err != nil {
+		return "", fmt.Errorf("cannot create tree: %w", err)
+	}
+
+	var author *github.CommitAuthor
+	if r.commitAuthor != nil {
+		author = &github.CommitAuthor{
+			Name:  github.String(r.commitAuthor.GetName()),
+			Email: github.String(r.commitAuthor.GetEmail()),
+		}
+	}
+
+	logger.Debug().Msg("Creating commit")
+	commit, err := r.ghCli.CreateCommit(ctx, pbRepo.GetOwner(), pbRepo.GetName(), title, tree, parentSha, author)
+	if err != nil {
+		return "", fmt.Errorf("cannot create commit: %w", err)
+	}
+	return commit.GetSHA(), nil
+}
+
+// ingestedHead returns the commit checked out in the ingested repository
+func ingestedHead(fs billy.Filesystem, storer storage.Storer) (*object.Commit, error) {
+	repo, err := git.Open(storer, fs)
+	if err != nil {
+		return nil, fmt.Errorf("cannot open git repo: %w", err)
+	}
+
+	head, err := repo.Head()
+	if err != nil {
+		return nil, fmt.Errorf("cannot get HEAD: %w", err)
+	}
+
+	headCommit, err := repo.CommitObject(head.Hash())
+	if err != nil {
+		return nil, fmt.Errorf("cannot get HEAD commit: %w", err)
+	}
+	return headCommit, nil
+}
+
+// createTreeEntries creates a blob for every file of the remediation and returns the tree
+// entries that replace the files of the given commit with them
+func (r *Remediator) createTreeEntries(
+	ctx context.Context,
+	owner, name string,
+	base *object.Commit,
+) ([]*github.TreeEntry, error) {
+	treeEntries := make([]*github.TreeEntry, 0, len(r.entries))
+	for _, entry := range r.entries {
+		treeEntry := &github.TreeEntry{
+			Path: github.String(entry.Path),
+			Mode: github.String(entry.Mode),
+			Type: github.String("blob"),
+		}
+
+		if entry.Action == ActionDelete {
+			// a file that doesn't exist in the repository has nothing to delete
+			if _, err := base.File(entry.Path); errors.Is(err, object.ErrFileNotFound) {
+				continue
+			} else if err != nil {
+				return nil, fmt.Errorf("cannot get file %s: %w", entry.Path, err)
+			}
+			// an entry with neither a SHA nor contents deletes the file
+			treeEntries = append(treeEntries, treeEntry)
+			continue
+		}
+
+		blob, err := r.ghCli.CreateBlob(ctx, owner, name, &github.Blob{
+			Content:  github.String(entry.Result),
+			Encoding: github.String("utf-8"),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("cannot create blob for %s: %w", entry.Path, err)
+		}
+		treeEntry.SHA = blob.SHA
+		treeEntries = append(treeEntries, treeEntry)
+	}
+	return treeEntries, nil
+}
+
 func guessRemote(gitRepo *git.Repository) string {
 	remotes, err := gitRepo.Remotes()
 	if err != nil {
@@ -391,7 +794,30 @@ func (r *Remediator) createEntries(fs billy.Filesystem) error {
 	return nil
 }
 
+func (r *Remediator) stageEntries(wt *git.Worktree) error {
+	for _, entry := range r.entries {
+		if entry.Action == ActionDelete {
+			// a file that doesn't exist in the repository has nothing to delete
+			if _, err := wt.Remove(entry.Path); err != nil && !errors.Is(err, index.ErrEntryNotFound) {
+				return fmt.Errorf("cannot remove file %s: %w", entry.Path, err)
+			}
+			continue
+		}
+		if _, err := wt.Add(entry.Path); err != nil {
+			return fmt.Errorf("cannot add file %s: %w", entry.Path, err)
+		}
+	}
+	return nil
+}
+
 func writeEntry(entry prEntry, fs billy.Filesystem) error {
+	if entry.Action == ActionDelete {
+		if err := fs.Remove(entry.Path); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("cannot delete file: %w", err)
+		}
+		return nil
+	}
+
 	if err := fs.MkdirAll(filepath.Dir(entry.Path), 0755); err != nil {
 		return fmt.Errorf("cannot create directory: %w", err)
 	}
@@ -402,7 +828,7 @@ func writeEntry(entry prEntry, fs billy.Filesystem) error {
 	}
 	defer f.Close()
 
-	_, err = io.WriteString(f, entry.Content)
+	_, err = io.WriteString(f, entry.Result)
 	if err != nil {
 		return fmt.Errorf("cannot write to file: %w", err)
 	}
@@ -419,6 +845,9 @@ func (r *Remediator) expandContents(
 ) error {
 	for i := range r.entries {
 		entry := &r.entries[i]
+		if entry.contentTemplate == nil {
+			continue
+		}
 		content := new(bytes.Buffer)
 		if err := entry.contentTemplate.Execute(content, tmplParams); err != nil {
 			return fmt.Errorf("cannot execute content template (index %d): %w", i, err)
@@ -429,6 +858,20 @@ func (r *Remediator) expandContents(
 	return nil
 }
 
+// modifyContents computes the contents of the files after applying the actions of the
+// entries to the given filesystem, which the expanded contents of the entries are
+// applied to as e.g. a diff or a JSON patch
+func (r *Remediator) modifyContents(ctx context.Context, fs billy.Filesystem) error {
+	for i := range r.entries {
+		entry := &r.entries[i]
+		if err := entry.modify(ctx, fs); err != nil {
+			return fmt.Errorf("cannot apply %s to %s (index %d): %w", entry.Action, entry.Path, i, err)
+		}
+	}
+
+	return nil
+}
+
 func branchBaseName(prTitle string) string {
 	baseName := dflBranchBaseName
 	normalizedPrTitle := strings.ReplaceAll(strings.ToLower(prTitle), " ", "_")
@@ -439,11 +882,13 @@ func (r *Remediator) contentSha1() (string, error) {
 	var combinedContents string
 
 	for i := range r.entries {
-		if len(r.entries[i].Content) == 0 {
+		if len(r.entries[i].Content) == 0 && r.entries[i].Action != ActionDelete {
 			// just making sure we call contentSha1() after expandContents()
 			return "", fmt.Errorf("content (index %d) is empty", i)
 		}
-		combinedContents += r.entries[i].Path + r.entries[i].Content
+		// the resulting contents are compared, so that a change to the files the
+		// actions are applied to also changes the digest
+		combinedContents += r.entries[i].Path + r.entries[i].Result
 	}
 
 	// #nosec G401 - we're not using sha1 for crypto, only to quickly compare contents
@@ -518,23 +963,23 @@ func createReviewBody(prText, magicComment string) (string, error) {
 	return buf.String(), nil
 }
 
-// returns true if an open PR with the magic comment already exists
-func prAlreadyExists(
+// returns the open PR with the magic comment, if any
+func findOpenPullRequest(
 	ctx context.Context,
 	cli provifv1.GitHub,
 	repo *pb.Repository,
 	magicComment string,
-) (bool, error) {
+) (*github.PullRequest, error) {
 	// TODO(jakub): pagination
 	openPrs, err := cli.ListPullRequests(ctx, repo.GetOwner(), repo.GetName(), &github.PullRequestListOptions{})
 	if err != nil {
-		return false, fmt.Errorf("cannot list pull requests: %w", err)
+		return nil, fmt.Errorf("cannot list pull requests: %w", err)
 	}
 
 	for _, pr := range openPrs {
 		if strings.Contains(pr.GetBody(), magicComment) {
-			return true, nil
+			return pr, nil
 		}
 	}
-	return false, nil
+	return nil, nil
 }
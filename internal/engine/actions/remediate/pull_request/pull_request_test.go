@@ -18,6 +18,7 @@ package pull_request
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"strings"
 	"testing"
@@ -37,6 +38,7 @@ import (
 	"google.golang.org/protobuf/types/known/structpb"
 
 	"github.com/stacklok/minder/internal/db"
+	enginerr "github.com/stacklok/minder/internal/engine/errors"
 	"github.com/stacklok/minder/internal/engine/interfaces"
 	"github.com/stacklok/minder/internal/providers"
 	mock_ghclient "github.com/stacklok/minder/internal/providers/github/mock"
@@ -412,3 +414,262 @@ func TestPullRequestRemediate(t *testing.T) {
 		})
 	}
 }
+
+func TestPullRequestRemediateTrackedPullRequest(t *testing.T) {
+	t.Parallel()
+
+	const (
+		prNumber   = 42
+		contentSha = "1041e57c2fac284bdb7827ce55c6e3cb609e97b9"
+	)
+
+	tests := []struct {
+		name           string
+		trackedSha     string
+		mockSetup      func(*mock_ghclient.MockGitHub)
+		wantErrIs      error
+		wantTracked    bool
+		wantContentSha string
+	}{
+		{
+			name:       "an open PR with the same content is awaiting merge",
+			trackedSha: contentSha,
+			mockSetup: func(mockGitHub *mock_ghclient.MockGitHub) {
+				mockGitHub.EXPECT().
+					GetPullRequest(gomock.Any(), repoOwner, repoName, prNumber).
+					Return(&github.PullRequest{State: github.String("open")}, nil)
+			},
+			wantErrIs:      enginerr.ErrActionPending,
+			wantTracked:    true,
+			wantContentSha: contentSha,
+		},
+		{
+			name:       "an open PR with outdated content is force-pushed to and updated",
+			trackedSha: "outdated",
+			mockSetup: func(mockGitHub *mock_ghclient.MockGitHub) {
+				mockGitHub.EXPECT().
+					GetPullRequest(gomock.Any(), repoOwner, repoName, prNumber).
+					Return(&github.PullRequest{State: github.String("open")}, nil)
+				mockGitHub.EXPECT().
+					GetAuthenticatedUser(gomock.Any()).Return(&github.User{
+					Email: github.String("test@stacklok.com"),
+					Login: github.String("stacklok-bot"),
+				}, nil)
+				mockGitHub.EXPECT().
+					GetToken().Return("token")
+				mockGitHub.EXPECT().
+					UpdatePullRequest(gomock.Any(), repoOwner, repoName, prNumber, commitTitle, prBody).
+					Return(&github.PullRequest{}, nil)
+			},
+			wantTracked:    true,
+			wantContentSha: contentSha,
+		},
+		{
+			name:       "a PR closed without being merged is no longer tracked",
+			trackedSha: contentSha,
+			mockSetup: func(mockGitHub *mock_ghclient.MockGitHub) {
+				mockGitHub.EXPECT().
+					GetPullRequest(gomock.Any(), repoOwner, repoName, prNumber).
+					Return(&github.PullRequest{State: github.String("closed")}, nil)
+			},
+			wantErrIs: enginerr.ErrActionFailed,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			engine, err := NewPullRequestRemediate(TestActionTypeValid, dependabotPrRem(),
+				testGithubProviderBuilder(ghApiUrl))
+			require.NoError(t, err, "unexpected error creating remediate engine")
+			mockClient := mock_ghclient.NewMockGitHub(ctrl)
+			engine.ghCli = mockClient
+			tt.mockSetup(mockClient)
+
+			remArgs := createTestRemArgs()
+			structPol, err := structpb.NewStruct(remArgs.pol)
+			require.NoError(t, err)
+			structParams, err := structpb.NewStruct(remArgs.params)
+			require.NoError(t, err)
+			evalParams := &interfaces.EvalStatusParams{
+				Rule: &pb.Profile_Rule{
+					Def:    structPol,
+					Params: structParams,
+				},
+			}
+
+			testrepo, err := mockRepoSetupWithBranch(t)
+			require.NoError(t, err, "unexpected error creating test repo")
+			testWt, err := testrepo.Worktree()
+			require.NoError(t, err, "unexpected error creating test worktree")
+			evalParams.SetIngestResult(&interfaces.Result{
+				Fs:     testWt.Filesystem,
+				Storer: testrepo.Storer,
+			})
+
+			tracked, err := (&PullRequest{
+				Number:     prNumber,
+				Branch:     branchBaseName(commitTitle),
+				ContentSha: tt.trackedSha,
+			}).metadata()
+			require.NoError(t, err)
+
+			retMeta, err := engine.Do(context.Background(),
+				interfaces.ActionCmdOn,
+				remArgs.remAction,
+				remArgs.ent,
+				evalParams,
+				&tracked)
+			if tt.wantErrIs != nil {
+				require.ErrorIs(t, err, tt.wantErrIs)
+			} else {
+				require.NoError(t, err, "unexpected error running remediate engine")
+			}
+
+			pr := PullRequestFromMetadata(&retMeta)
+			if !tt.wantTracked {
+				require.Nil(t, pr, "expected the pull request not to be tracked anymore")
+				return
+			}
+			require.NotNil(t, pr, "expected the pull request to be tracked")
+			require.Equal(t, prNumber, pr.Number)
+			require.Equal(t, tt.wantContentSha, pr.ContentSha)
+		})
+	}
+}
+
+func TestPullRequestRemediateCloseOnPass(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	engine, err := NewPullRequestRemediate(TestActionTypeValid, dependabotPrRem(), testGithubProviderBuilder(ghApiUrl))
+	require.NoError(t, err, "unexpected error creating remediate engine")
+	mockClient := mock_ghclient.NewMockGitHub(ctrl)
+	engine.ghCli = mockClient
+
+	branch := branchBaseName(commitTitle)
+	mockClient.EXPECT().
+		GetPullRequest(gomock.Any(), repoOwner, repoName, 42).
+		Return(&github.PullRequest{State: github.String("open")}, nil)
+	mockClient.EXPECT().
+		CreateComment(gomock.Any(), repoOwner, repoName, 42, gomock.Any()).
+		Return(nil)
+	mockClient.EXPECT().
+		ClosePullRequest(gomock.Any(), repoOwner, repoName, 42).
+		Return(nil)
+	mockClient.EXPECT().
+		DeleteRef(gomock.Any(), repoOwner, repoName, refFromBranch(branch)).
+		Return(nil)
+
+	tracked, err := (&PullRequest{Number: 42, Branch: branch, ContentSha: "abc"}).metadata()
+	require.NoError(t, err)
+
+	retMeta, err := engine.Do(context.Background(),
+		interfaces.ActionCmdOff,
+		interfaces.ActionOptOn,
+		createTestRemArgs().ent,
+		&interfaces.EvalStatusParams{},
+		&tracked)
+	require.NoError(t, err)
+	require.Nil(t, retMeta)
+}
+
+func TestPullRequestRemediateWithApiCommit(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	prRem := dependabotPrRem()
+	prRem.CommitMethod = github.String(CommitMethodApi)
+	prRem.CommitAuthor = &pb.RuleType_Definition_Remediate_PullRequestRemediation_CommitAuthor{
+		Name:  "Minder",
+		Email: "minder@stacklok.com",
+	}
+	prRem.Contents = append(prRem.Contents,
+		&pb.RuleType_Definition_Remediate_PullRequestRemediation_Content{Path: ".gitignore", Action: ActionDelete},
+		&pb.RuleType_Definition_Remediate_PullRequestRemediation_Content{Path: "NOTICE", Action: ActionDelete},
+	)
+
+	engine, err := NewPullRequestRemediate(TestActionTypeValid, prRem, testGithubProviderBuilder(ghApiUrl))
+	require.NoError(t, err, "unexpected error creating remediate engine")
+	mockClient := mock_ghclient.NewMockGitHub(ctrl)
+	engine.ghCli = mockClient
+
+	testrepo, err := defaultMockRepoSetup(t)
+	require.NoError(t, err, "unexpected error creating test repo")
+	head, err := testrepo.Head()
+	require.NoError(t, err)
+	headCommit, err := testrepo.CommitObject(head.Hash())
+	require.NoError(t, err)
+
+	branch := branchBaseName(commitTitle)
+	tree := &github.Tree{SHA: github.String("tree-sha")}
+	// the commit is created through the API, so neither the token nor the user is needed to push
+	mockClient.EXPECT().
+		ListPullRequests(gomock.Any(), repoOwner, repoName, gomock.Any()).Return([]*github.PullRequest{}, nil)
+	mockClient.EXPECT().
+		CreateBlob(gomock.Any(), repoOwner, repoName, gomock.Any()).
+		Return(&github.Blob{SHA: github.String("blob-sha")}, nil).
+		Times(2)
+	mockClient.EXPECT().
+		CreateTree(gomock.Any(), repoOwner, repoName, headCommit.TreeHash.String(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _, _, _ string, entries []*github.TreeEntry) (*github.Tree, error) {
+			require.Len(t, entries, 3, "a file that doesn't exist has nothing to delete")
+			require.Equal(t, ".gitignore", entries[2].GetPath())
+			require.Nil(t, entries[2].SHA, "an entry without a SHA deletes the file")
+			return tree, nil
+		})
+	mockClient.EXPECT().
+		CreateCommit(gomock.Any(), repoOwner, repoName, commitTitle, tree, head.Hash().String(),
+			&github.CommitAuthor{Name: github.String("Minder"), Email: github.String("minder@stacklok.com")}).
+		Return(&github.Commit{SHA: github.String("commit-sha")}, nil)
+	mockClient.EXPECT().
+		GetRef(gomock.Any(), repoOwner, repoName, refFromBranch(branch)).
+		Return(nil, errors.New("not found"))
+	mockClient.EXPECT().
+		CreateRef(gomock.Any(), repoOwner, repoName, refFromBranch(branch), "commit-sha").
+		Return(&github.Reference{}, nil)
+	mockClient.EXPECT().
+		CreatePullRequest(gomock.Any(), repoOwner, repoName, commitTitle, gomock.Any(),
+			refFromBranch(branch), dflBranchTo).
+		Return(&github.PullRequest{Number: github.Int(42)}, nil)
+
+	remArgs := createTestRemArgs()
+	structPol, err := structpb.NewStruct(remArgs.pol)
+	require.NoError(t, err)
+	structParams, err := structpb.NewStruct(remArgs.params)
+	require.NoError(t, err)
+	evalParams := &interfaces.EvalStatusParams{
+		Rule: &pb.Profile_Rule{
+			Def:    structPol,
+			Params: structParams,
+		},
+	}
+	testWt, err := testrepo.Worktree()
+	require.NoError(t, err, "unexpected error creating test worktree")
+	evalParams.SetIngestResult(&interfaces.Result{
+		Fs:     testWt.Filesystem,
+		Storer: testrepo.Storer,
+	})
+
+	retMeta, err := engine.Do(context.Background(),
+		interfaces.ActionCmdOn,
+		remArgs.remAction,
+		remArgs.ent,
+		evalParams,
+		nil)
+	require.NoError(t, err, "unexpected error running remediate engine")
+
+	pr := PullRequestFromMetadata(&retMeta)
+	require.NotNil(t, pr, "expected the pull request to be tracked")
+	require.Equal(t, branch, pr.Branch)
+}
@@ -21,6 +21,7 @@ import (
 	"fmt"
 
 	"github.com/stacklok/minder/internal/engine/actions/remediate/gh_branch_protect"
+	"github.com/stacklok/minder/internal/engine/actions/remediate/gh_ruleset"
 	"github.com/stacklok/minder/internal/engine/actions/remediate/noop"
 	"github.com/stacklok/minder/internal/engine/actions/remediate/pull_request"
 	"github.com/stacklok/minder/internal/engine/actions/remediate/rest"
@@ -53,6 +54,12 @@ func NewRuleRemediator(rt *pb.RuleType, pbuild *providers.ProviderBuilder) (engi
 		}
 		return gh_branch_protect.NewGhBranchProtectRemediator(ActionType, rem.GetGhBranchProtection(), pbuild)
 
+	case gh_ruleset.RemediateType:
+		if rem.GetGhRuleset() == nil {
+			return nil, fmt.Errorf("remediations engine missing gh_ruleset configuration")
+		}
+		return gh_ruleset.NewGhRulesetRemediator(ActionType, rem.GetGhRuleset(), pbuild)
+
 	case pull_request.RemediateType:
 		if rem.GetPullRequest() == nil {
 			return nil, fmt.Errorf("remediations engine missing pull request configuration")
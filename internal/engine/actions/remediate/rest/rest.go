@@ -149,6 +149,8 @@ func (r *Remediator) Do(
 		err = r.run(ctx, endpoint.String(), body.Bytes())
 	case interfaces.ActionOptDryRun:
 		err = r.dryRun(endpoint.String(), body.String())
+	case interfaces.ActionOptApprovalRequired:
+		err = &engerrors.RemediationProposal{Method: r.method, Endpoint: endpoint.String(), Body: body.String()}
 	case interfaces.ActionOptOff, interfaces.ActionOptUnknown:
 		err = errors.New("unexpected action")
 	}
@@ -156,6 +158,12 @@ func (r *Remediator) Do(
 }
 
 func (r *Remediator) run(ctx context.Context, endpoint string, body []byte) error {
+	return Perform(ctx, r.cli, r.method, endpoint, body)
+}
+
+// Perform performs the request of a REST remediation. It's also used to perform the
+// proposed requests of remediations that required approval.
+func Perform(ctx context.Context, cli provifv1.REST, method, endpoint string, body []byte) error {
 	// create an empty map, not a nil map to avoid passing nil to NewRequest
 	bodyJson := make(map[string]any)
 
@@ -166,12 +174,12 @@ func (r *Remediator) run(ctx context.Context, endpoint string, body []byte) erro
 		}
 	}
 
-	req, err := r.cli.NewRequest(r.method, endpoint, bodyJson)
+	req, err := cli.NewRequest(method, endpoint, bodyJson)
 	if err != nil {
 		return fmt.Errorf("cannot create request: %w", err)
 	}
 
-	resp, err := r.cli.Do(ctx, req)
+	resp, err := cli.Do(ctx, req)
 	if err != nil {
 		var respErr *github.ErrorResponse
 		if errors.As(err, &respErr) {
@@ -190,7 +198,7 @@ func (r *Remediator) run(ctx context.Context, endpoint string, body []byte) erro
 	}()
 	// Translate the http status code response to an error
 	if engerrors.HTTPErrorCodeToErr(resp.StatusCode) != nil {
-		return engerrors.NewErrActionFailed("remediation failed: %s", err)
+		return engerrors.NewErrActionFailed("remediation failed: %s", resp.Status)
 	}
 	return nil
 }
@@ -31,6 +31,7 @@ import (
 	"google.golang.org/protobuf/types/known/structpb"
 
 	"github.com/stacklok/minder/internal/db"
+	engerrors "github.com/stacklok/minder/internal/engine/errors"
 	"github.com/stacklok/minder/internal/engine/interfaces"
 	"github.com/stacklok/minder/internal/providers"
 	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
@@ -455,3 +456,34 @@ func TestRestRemediate(t *testing.T) {
 		})
 	}
 }
+
+func TestRestRemediateApprovalRequired(t *testing.T) {
+	t.Parallel()
+
+	testServer := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, _ *http.Request) {
+		assert.Fail(t, "the request is only performed once approved")
+	}))
+	defer testServer.Close()
+
+	engine, err := NewRestRemediate(TestActionTypeValid, &pb.RestType{
+		Endpoint: "/repos/{{.Entity.Owner}}/{{.Entity.Name}}/actions/permissions",
+		Body:     &bodyTemplateWithVars,
+	}, testGithubProviderBuilder(testServer.URL))
+	require.NoError(t, err, "unexpected error creating remediate engine")
+
+	structPol, err := structpb.NewStruct(map[string]any{"allowed_actions": "selected"})
+	require.NoError(t, err)
+	evalParams := &interfaces.EvalStatusParams{
+		Rule: &pb.Profile_Rule{Def: structPol, Params: &structpb.Struct{}},
+	}
+
+	_, err = engine.Do(context.Background(), interfaces.ActionCmdOn, interfaces.ActionOptApprovalRequired,
+		&pb.Repository{Owner: "OwnerVar", Name: "NameVar"}, evalParams, nil)
+
+	var proposal *engerrors.RemediationProposal
+	require.ErrorAs(t, err, &proposal)
+	assert.ErrorIs(t, err, engerrors.ErrActionPending)
+	assert.Equal(t, http.MethodPatch, proposal.Method)
+	assert.Equal(t, "/repos/OwnerVar/NameVar/actions/permissions", proposal.Endpoint)
+	assert.JSONEq(t, `{"enabled": true, "allowed_actions": "selected"}`, proposal.Body)
+}
@@ -0,0 +1,148 @@
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remediate
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/sqlc-dev/pqtype"
+
+	"github.com/stacklok/minder/internal/engine/actions/remediate/pull_request"
+	enginerr "github.com/stacklok/minder/internal/engine/errors"
+)
+
+const (
+	// MaxAttempts is the number of remediation attempts after which the remediation
+	// of a rule is given up until the rule passes again
+	MaxAttempts = 5
+
+	// backoffBase is the time to wait before attempting a remediation again after
+	// the first attempt didn't fix the rule, doubled with every further attempt
+	backoffBase = 5 * time.Minute
+	// backoffMax is the longest time to wait between two remediation attempts
+	backoffMax = 24 * time.Hour
+)
+
+// State is the state of the remediation of a rule for an entity. It is stored in the
+// metadata of the remediation details of the rule evaluation and starts over once the
+// rule passes.
+type State struct {
+	// Attempts is the number of remediation attempts since the rule started failing
+	Attempts int `json:"attempts,omitempty"`
+	// LastAttempt is the time of the last remediation attempt
+	LastAttempt *time.Time `json:"last_attempt,omitempty"`
+	// NextAttempt is the earliest time the remediation is attempted again
+	NextAttempt *time.Time `json:"next_attempt,omitempty"`
+	// PullRequest is the pull request opened by a pull request remediation. While it's
+	// open, it's updated instead of opening another one, and it's closed once the rule passes.
+	PullRequest *pull_request.PullRequest `json:"pull_request,omitempty"`
+}
+
+// StateFromMetadata returns the remediation state stored in the metadata of the remediation
+// details, or an empty state if there is none
+func StateFromMetadata(meta pqtype.NullRawMessage) *State {
+	st := &State{}
+	if !meta.Valid || len(meta.RawMessage) == 0 {
+		return st
+	}
+
+	// a state that can't be parsed starts over
+	if err := json.Unmarshal(meta.RawMessage, st); err != nil {
+		return &State{}
+	}
+	return st
+}
+
+// HoldBack returns the reason for not attempting the remediation at the given time,
+// or nil if the remediation should be attempted
+func (s *State) HoldBack(now time.Time) error {
+	switch {
+	case s.PullRequest != nil:
+		// the remediation updates the pull request it opened if needed
+		return nil
+	case s.Attempts >= MaxAttempts:
+		return enginerr.NewErrActionFailed("giving up after %d attempts that didn't fix the rule", s.Attempts)
+	case s.NextAttempt != nil && now.Before(*s.NextAttempt):
+		return enginerr.NewErrActionPending("backing off after %d attempts, next attempt at %s",
+			s.Attempts, s.NextAttempt.UTC().Format(time.RFC3339))
+	}
+	return nil
+}
+
+// RecordAttempt records a remediation attempt of the given remediation type at the given
+// time along with its metadata and result
+func (s *State) RecordAttempt(now time.Time, remType string, remMeta json.RawMessage, remErr error) {
+	// the pull request remediation returns the pull request it keeps track of, if any
+	tracked := s.PullRequest
+	if remType == pull_request.RemediateType {
+		s.PullRequest = pull_request.PullRequestFromMetadata(&remMeta)
+	}
+
+	// nothing was attempted, or the remediation is awaiting approval or merge
+	if errors.Is(remErr, enginerr.ErrActionNotAvailable) || errors.Is(remErr, enginerr.ErrActionSkipped) ||
+		errors.Is(remErr, enginerr.ErrActionPending) {
+		return
+	}
+
+	// updating the pull request that is still tracked isn't another attempt, only opening it was
+	if tracked != nil && s.PullRequest != nil && tracked.Number == s.PullRequest.Number {
+		return
+	}
+
+	s.Attempts++
+	s.LastAttempt = &now
+
+	// an open pull request is awaiting review, there is nothing to back off from
+	if remErr == nil && s.PullRequest != nil {
+		s.NextAttempt = nil
+		return
+	}
+
+	// The rule is still failing whenever the remediation is attempted again, whether this attempt
+	// failed or succeeded without being effective yet, so wait longer after every attempt
+	next := now.Add(backoff(s.Attempts))
+	s.NextAttempt = &next
+}
+
+// PullRequestMetadata returns the metadata the pull request remediation keeps track of
+// its pull request with, or nil if it didn't open any
+func (s *State) PullRequestMetadata() *json.RawMessage {
+	if s.PullRequest == nil {
+		return nil
+	}
+
+	meta, err := json.Marshal(s.PullRequest)
+	if err != nil {
+		return nil
+	}
+	raw := json.RawMessage(meta)
+	return &raw
+}
+
+// Metadata returns the state to be stored in the metadata of the remediation details
+func (s *State) Metadata() (json.RawMessage, error) {
+	return json.Marshal(s)
+}
+
+// backoff returns the time to wait before the next attempt after the given number of attempts
+func backoff(attempts int) time.Duration {
+	d := backoffBase
+	for i := 1; i < attempts && d < backoffMax; i++ {
+		d *= 2
+	}
+	return min(d, backoffMax)
+}
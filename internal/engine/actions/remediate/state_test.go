@@ -0,0 +1,181 @@
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remediate_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/sqlc-dev/pqtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/stacklok/minder/internal/engine/actions/remediate"
+	"github.com/stacklok/minder/internal/engine/actions/remediate/pull_request"
+	"github.com/stacklok/minder/internal/engine/actions/remediate/rest"
+	enginerr "github.com/stacklok/minder/internal/engine/errors"
+)
+
+func TestStateFromMetadata(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		meta     pqtype.NullRawMessage
+		attempts int
+	}{
+		{
+			name: "no metadata",
+			meta: pqtype.NullRawMessage{},
+		},
+		{
+			name: "empty metadata",
+			meta: pqtype.NullRawMessage{RawMessage: json.RawMessage(`{}`), Valid: true},
+		},
+		{
+			name:     "stored state",
+			meta:     pqtype.NullRawMessage{RawMessage: json.RawMessage(`{"attempts": 3}`), Valid: true},
+			attempts: 3,
+		},
+		{
+			name: "invalid metadata starts over",
+			meta: pqtype.NullRawMessage{RawMessage: json.RawMessage(`[1, 2]`), Valid: true},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			st := remediate.StateFromMetadata(tt.meta)
+			require.NotNil(t, st)
+			assert.Equal(t, tt.attempts, st.Attempts)
+		})
+	}
+}
+
+func TestStateBacksOffAfterAttempts(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2023, 12, 1, 10, 0, 0, 0, time.UTC)
+	st := &remediate.State{}
+	require.NoError(t, st.HoldBack(now), "the first attempt is not held back")
+
+	st.RecordAttempt(now, rest.RemediateType, nil, nil)
+	assert.Equal(t, 1, st.Attempts)
+	require.NotNil(t, st.NextAttempt)
+	assert.Equal(t, now.Add(5*time.Minute), *st.NextAttempt)
+	assert.ErrorIs(t, st.HoldBack(now.Add(time.Minute)), enginerr.ErrActionPending,
+		"a successful attempt that didn't fix the rule yet is not repeated right away")
+
+	now = now.Add(5 * time.Minute)
+	require.NoError(t, st.HoldBack(now))
+	st.RecordAttempt(now, rest.RemediateType, nil, enginerr.NewErrActionFailed("boom"))
+	assert.Equal(t, now.Add(10*time.Minute), *st.NextAttempt)
+
+	for st.Attempts < remediate.MaxAttempts {
+		now = *st.NextAttempt
+		require.NoError(t, st.HoldBack(now))
+		st.RecordAttempt(now, rest.RemediateType, nil, enginerr.NewErrActionFailed("boom"))
+	}
+	err := st.HoldBack(st.NextAttempt.Add(time.Hour))
+	assert.ErrorIs(t, err, enginerr.ErrActionFailed, "remediation is given up after the maximum attempts")
+}
+
+func TestStateBackoffIsCapped(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2023, 12, 1, 10, 0, 0, 0, time.UTC)
+	st := &remediate.State{Attempts: 20}
+	st.RecordAttempt(now, rest.RemediateType, nil, nil)
+	assert.Equal(t, now.Add(24*time.Hour), *st.NextAttempt)
+}
+
+func TestStateTracksPullRequest(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2023, 12, 1, 10, 0, 0, 0, time.UTC)
+	prMeta := json.RawMessage(`{"number": 42, "branch": "minder_fix", "content_sha": "abc"}`)
+
+	st := &remediate.State{}
+	st.RecordAttempt(now, pull_request.RemediateType, prMeta, nil)
+	require.NotNil(t, st.PullRequest)
+	assert.Equal(t, 42, st.PullRequest.Number)
+	assert.Nil(t, st.NextAttempt)
+	assert.NoError(t, st.HoldBack(now.Add(time.Minute)),
+		"the remediation keeps the pull request it opened up to date")
+
+	// the pull request is awaiting merge
+	st.RecordAttempt(now.Add(time.Minute), pull_request.RemediateType, prMeta,
+		enginerr.NewErrActionPending("pull request #42 is awaiting merge"))
+	assert.Equal(t, 1, st.Attempts, "waiting for the pull request to be merged is not an attempt")
+
+	meta, err := st.Metadata()
+	require.NoError(t, err)
+	stored := remediate.StateFromMetadata(pqtype.NullRawMessage{RawMessage: meta, Valid: true})
+	require.NotNil(t, stored.PullRequest)
+	assert.Equal(t, "minder_fix", stored.PullRequest.Branch)
+	assert.Equal(t, 1, stored.Attempts)
+	assert.JSONEq(t, string(prMeta), string(*stored.PullRequestMetadata()))
+
+	// the pull request was closed without being merged
+	stored.RecordAttempt(now.Add(time.Hour), pull_request.RemediateType, nil,
+		enginerr.NewErrActionFailed("pull request #42 was closed without being merged"))
+	assert.Nil(t, stored.PullRequest)
+	assert.Nil(t, stored.PullRequestMetadata())
+	assert.ErrorIs(t, stored.HoldBack(now.Add(time.Hour+time.Minute)), enginerr.ErrActionPending,
+		"a new pull request is opened only after backing off")
+}
+
+func TestStateDoesNotCountPullRequestUpdates(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2023, 12, 1, 10, 0, 0, 0, time.UTC)
+	prMeta := json.RawMessage(`{"number": 42, "branch": "minder_fix", "content_sha": "abc"}`)
+
+	st := &remediate.State{}
+	st.RecordAttempt(now, pull_request.RemediateType, prMeta, nil)
+	require.Equal(t, 1, st.Attempts, "opening the pull request is an attempt")
+
+	// the pull request is updated on every evaluation while it's in review
+	for i := 1; i <= 2*remediate.MaxAttempts; i++ {
+		at := now.Add(time.Duration(i) * time.Hour)
+		require.NoError(t, st.HoldBack(at))
+		updated := json.RawMessage(fmt.Sprintf(`{"number": 42, "branch": "minder_fix", "content_sha": "%d"}`, i))
+		st.RecordAttempt(at, pull_request.RemediateType, updated, nil)
+	}
+	assert.Equal(t, 1, st.Attempts, "updating the pull request is not an attempt")
+
+	// the pull request was closed without being merged
+	closedAt := now.Add(time.Duration(2*remediate.MaxAttempts+1) * time.Hour)
+	st.RecordAttempt(closedAt, pull_request.RemediateType, nil,
+		enginerr.NewErrActionFailed("pull request #42 was closed without being merged"))
+	assert.Equal(t, 2, st.Attempts)
+	assert.ErrorIs(t, st.HoldBack(closedAt.Add(time.Minute)), enginerr.ErrActionPending,
+		"the remediation backs off instead of giving up")
+	assert.NoError(t, st.HoldBack(*st.NextAttempt))
+}
+
+func TestStateIgnoresUnavailableRemediations(t *testing.T) {
+	t.Parallel()
+
+	st := &remediate.State{}
+	st.RecordAttempt(time.Now(), "", nil, enginerr.ErrActionNotAvailable)
+	assert.Zero(t, st.Attempts)
+	assert.Nil(t, st.LastAttempt)
+}
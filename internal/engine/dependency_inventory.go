@@ -0,0 +1,170 @@
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+
+	"github.com/stacklok/minder/internal/db"
+	"github.com/stacklok/minder/internal/engine/ingester/diff"
+	engif "github.com/stacklok/minder/internal/engine/interfaces"
+	"github.com/stacklok/minder/internal/sbom"
+	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+// dependencyInventory persists the dependencies parsed while ingesting data for
+// the rules of an entity, so that we can later tell which repositories use a
+// given package. The ingest results are shared between rules through the ingest
+// cache, so every result is only recorded once.
+type dependencyInventory struct {
+	store    db.Store
+	recorded map[*engif.Result]struct{}
+}
+
+func newDependencyInventory(store db.Store) *dependencyInventory {
+	return &dependencyInventory{
+		store:    store,
+		recorded: make(map[*engif.Result]struct{}),
+	}
+}
+
+// record updates the inventory from the ingest result of the rule evaluation.
+// Dependencies from a pull request diff replace the set recorded for that pull
+// request, dependencies parsed from a clone of the default branch of the
+// repository replace the set recorded for the repository itself.
+func (di *dependencyInventory) record(ctx context.Context, inf *EntityInfoWrapper, params *engif.EvalStatusParams) {
+	result := params.GetIngestResult()
+	if result == nil {
+		return
+	}
+	if _, ok := di.recorded[result]; ok {
+		return
+	}
+
+	deps, prID, ok := dependenciesFromResult(inf, params, result)
+	if !ok {
+		return
+	}
+	di.recorded[result] = struct{}{}
+
+	logger := zerolog.Ctx(ctx).With().
+		Str("repo_id", params.RepoID.String()).
+		Logger()
+
+	if err := di.replace(ctx, params.RepoID, prID, deps); err != nil {
+		logger.Err(err).Msg("error updating dependency inventory")
+		return
+	}
+
+	logger.Debug().Int("dependencies", len(deps)).Msg("dependency inventory updated")
+}
+
+func dependenciesFromResult(
+	inf *EntityInfoWrapper,
+	params *engif.EvalStatusParams,
+	result *engif.Result,
+) ([]*pb.PrDependencies_ContextualDependency, uuid.NullUUID, bool) {
+	if prdeps, ok := result.Object.(*pb.PrDependencies); ok {
+		if !params.PullRequestID.Valid {
+			return nil, uuid.NullUUID{}, false
+		}
+		return prdeps.GetDeps(), params.PullRequestID, true
+	}
+
+	// a clone of the repository, as produced by the git or sbom ingesters
+	repo, ok := inf.Entity.(*pb.Repository)
+	if result.Fs != nil && ok && isDefaultBranchClone(repo, result) {
+		deps, err := diff.ParseFilesystem(result.Fs, sbom.DefaultEcosystems)
+		if err != nil {
+			return nil, uuid.NullUUID{}, false
+		}
+		return deps, uuid.NullUUID{}, true
+	}
+
+	return nil, uuid.NullUUID{}, false
+}
+
+// isDefaultBranchClone returns true if the result holds a clone of the default
+// branch of the repository. Rules may clone other branches or even other
+// repositories, which don't tell what the repository depends on.
+func isDefaultBranchClone(repo *pb.Repository, result *engif.Result) bool {
+	if result.Storer == nil || repo.GetDefaultBranch() == "" {
+		return false
+	}
+
+	cfg, err := result.Storer.Config()
+	if err != nil {
+		return false
+	}
+	origin, ok := cfg.Remotes[git.DefaultRemoteName]
+	if !ok || len(origin.URLs) == 0 || origin.URLs[0] != repo.GetCloneUrl() {
+		return false
+	}
+
+	head, err := result.Storer.Reference(plumbing.HEAD)
+	if err != nil || head.Type() != plumbing.SymbolicReference {
+		return false
+	}
+	return head.Target() == plumbing.NewBranchReferenceName(repo.GetDefaultBranch())
+}
+
+func (di *dependencyInventory) replace(
+	ctx context.Context,
+	repoID uuid.UUID,
+	prID uuid.NullUUID,
+	deps []*pb.PrDependencies_ContextualDependency,
+) error {
+	tx, err := di.store.BeginTransaction()
+	if err != nil {
+		return fmt.Errorf("error starting transaction: %w", err)
+	}
+	defer di.store.Rollback(tx)
+
+	qtx := di.store.GetQuerierWithTransaction(tx)
+
+	if err := qtx.DeleteRepositoryDependencies(ctx, db.DeleteRepositoryDependenciesParams{
+		RepositoryID:  repoID,
+		PullRequestID: prID,
+	}); err != nil {
+		return fmt.Errorf("error deleting previous dependencies: %w", err)
+	}
+
+	for _, d := range deps {
+		dep := d.GetDep()
+		eco := diff.DependencyEcosystemFromProto(dep.GetEcosystem())
+		if eco == diff.DepEcosystemNone || dep.GetName() == "" {
+			continue
+		}
+
+		if err := qtx.UpsertRepositoryDependency(ctx, db.UpsertRepositoryDependencyParams{
+			RepositoryID:  repoID,
+			PullRequestID: prID,
+			Ecosystem:     string(eco),
+			Name:          dep.GetName(),
+			Version:       dep.GetVersion(),
+			File:          d.GetFile().GetName(),
+		}); err != nil {
+			return fmt.Errorf("error storing dependency %s: %w", dep.GetName(), err)
+		}
+	}
+
+	return di.store.Commit(tx)
+}
@@ -0,0 +1,64 @@
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"testing"
+
+	"github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/storage/memory"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	engif "github.com/stacklok/minder/internal/engine/interfaces"
+	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+func TestIsDefaultBranchClone(t *testing.T) {
+	t.Parallel()
+
+	const cloneURL = "https://github.com/stacklok/minder.git"
+
+	tests := []struct {
+		name          string
+		defaultBranch string
+		url           string
+		branch        string
+		expected      bool
+	}{
+		{name: "default branch", defaultBranch: "main", url: cloneURL, branch: "main", expected: true},
+		{name: "other branch", defaultBranch: "main", url: cloneURL, branch: "feature"},
+		{name: "other repository", defaultBranch: "main", url: "https://github.com/stacklok/other.git", branch: "main"},
+		{name: "unknown default branch", url: cloneURL, branch: "main"},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			storer := memory.NewStorage()
+			cfg := config.NewConfig()
+			cfg.Remotes["origin"] = &config.RemoteConfig{Name: "origin", URLs: []string{tt.url}}
+			require.NoError(t, storer.SetConfig(cfg))
+			require.NoError(t, storer.SetReference(
+				plumbing.NewSymbolicReference(plumbing.HEAD, plumbing.NewBranchReferenceName(tt.branch))))
+
+			repo := &pb.Repository{CloneUrl: cloneURL, DefaultBranch: tt.defaultBranch}
+			assert.Equal(t, tt.expected, isDefaultBranchClone(repo, &engif.Result{Storer: storer}))
+		})
+	}
+}
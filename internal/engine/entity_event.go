@@ -40,12 +40,14 @@ import (
 // - ProjectIDEventKey - project_id
 // - RepositoryIDEventKey - repository_id
 // - ArtifactIDEventKey - artifact_id (only for versioned artifacts)
+// - BuildEnvironmentIDEventKey - build_environment_id (only for build environments)
 //
 // Entity type is used to determine the type of the protobuf message
 // and the entity type in the database. It may be one of the following:
 //
 // - RepositoryEventEntityType - repository
 // - VersionedArtifactEventEntityType - versioned_artifact
+// - BuildEnvironmentEventEntityType - build_environment
 type EntityInfoWrapper struct {
 	Provider      string
 	ProjectID     *uuid.UUID
@@ -62,6 +64,8 @@ const (
 	VersionedArtifactEventEntityType = "versioned_artifact"
 	// PullRequestEventEntityType is the entity type for pull requests
 	PullRequestEventEntityType = "pull_request"
+	// BuildEnvironmentEventEntityType is the entity type for build environments
+	BuildEnvironmentEventEntityType = "build_environment"
 )
 
 const (
@@ -77,6 +81,8 @@ const (
 	ArtifactIDEventKey = "artifact_id"
 	// PullRequestIDEventKey is the key for the pull request ID
 	PullRequestIDEventKey = "pull_request_id"
+	// BuildEnvironmentIDEventKey is the key for the build environment ID
+	BuildEnvironmentIDEventKey = "build_environment_id"
 	// ExecutionIDKey is the key for the execution ID. This is set when acquiring a lock.
 	ExecutionIDKey = "execution_id"
 )
@@ -119,6 +125,14 @@ func (eiw *EntityInfoWrapper) WithPullRequest(p *minderv1.PullRequest) *EntityIn
 	return eiw
 }
 
+// WithBuildEnvironment sets the entity to a build environment
+func (eiw *EntityInfoWrapper) WithBuildEnvironment(b *minderv1.BuildEnvironment) *EntityInfoWrapper {
+	eiw.Type = minderv1.Entity_ENTITY_BUILD_ENVIRONMENTS
+	eiw.Entity = b
+
+	return eiw
+}
+
 // WithProjectID sets the project ID
 func (eiw *EntityInfoWrapper) WithProjectID(id uuid.UUID) *EntityInfoWrapper {
 	eiw.ProjectID = &id
@@ -147,6 +161,13 @@ func (eiw *EntityInfoWrapper) WithPullRequestID(id uuid.UUID) *EntityInfoWrapper
 	return eiw
 }
 
+// WithBuildEnvironmentID sets the build environment ID
+func (eiw *EntityInfoWrapper) WithBuildEnvironmentID(id uuid.UUID) *EntityInfoWrapper {
+	eiw.withID(BuildEnvironmentIDEventKey, id.String())
+
+	return eiw
+}
+
 // WithExecutionID sets the execution ID
 func (eiw *EntityInfoWrapper) WithExecutionID(id uuid.UUID) *EntityInfoWrapper {
 	eiw.ExecutionID = &id
@@ -176,6 +197,12 @@ func (eiw *EntityInfoWrapper) AsPullRequest() {
 	eiw.Entity = &minderv1.PullRequest{}
 }
 
+// AsBuildEnvironment sets the entity type to a build environment
+func (eiw *EntityInfoWrapper) AsBuildEnvironment() {
+	eiw.Type = minderv1.Entity_ENTITY_BUILD_ENVIRONMENTS
+	eiw.Entity = &minderv1.BuildEnvironment{}
+}
+
 // BuildMessage builds a message.Message from the information
 func (eiw *EntityInfoWrapper) BuildMessage() (*message.Message, error) {
 	id, err := uuid.NewUUID()
@@ -238,9 +265,14 @@ func (eiw *EntityInfoWrapper) ToMessage(msg *message.Message) error {
 	return nil
 }
 
-// GetEntityDBIDs returns the repository, artifact and pull request IDs
-// from the ownership data
-func (eiw *EntityInfoWrapper) GetEntityDBIDs() (repoID uuid.UUID, artifactID uuid.NullUUID, pullRequestID uuid.NullUUID) {
+// GetEntityDBIDs returns the repository, artifact, pull request and build
+// environment IDs from the ownership data
+func (eiw *EntityInfoWrapper) GetEntityDBIDs() (
+	repoID uuid.UUID,
+	artifactID uuid.NullUUID,
+	pullRequestID uuid.NullUUID,
+	buildEnvironmentID uuid.NullUUID,
+) {
 	repoID = uuid.MustParse(eiw.OwnershipData[RepositoryIDEventKey])
 
 	strArtifactID, ok := eiw.OwnershipData[ArtifactIDEventKey]
@@ -259,7 +291,15 @@ func (eiw *EntityInfoWrapper) GetEntityDBIDs() (repoID uuid.UUID, artifactID uui
 		}
 	}
 
-	return repoID, artifactID, pullRequestID
+	strBuildEnvironmentID, ok := eiw.OwnershipData[BuildEnvironmentIDEventKey]
+	if ok {
+		buildEnvironmentID = uuid.NullUUID{
+			UUID:  uuid.MustParse(strBuildEnvironmentID),
+			Valid: true,
+		}
+	}
+
+	return repoID, artifactID, pullRequestID, buildEnvironmentID
 }
 
 func (eiw *EntityInfoWrapper) withProjectIDFromMessage(msg *message.Message) error {
@@ -299,6 +339,10 @@ func (eiw *EntityInfoWrapper) withPullRequestIDFromMessage(msg *message.Message)
 	return eiw.withIDFromMessage(msg, PullRequestIDEventKey)
 }
 
+func (eiw *EntityInfoWrapper) withBuildEnvironmentIDFromMessage(msg *message.Message) error {
+	return eiw.withIDFromMessage(msg, BuildEnvironmentIDEventKey)
+}
+
 func (eiw *EntityInfoWrapper) withExecutionIDFromMessage(msg *message.Message) error {
 	executionID := msg.Metadata.Get(ExecutionIDKey)
 	if executionID == "" {
@@ -341,7 +385,7 @@ func pbEntityTypeToString(t minderv1.Entity) (string, error) {
 	case minderv1.Entity_ENTITY_PULL_REQUESTS:
 		return PullRequestEventEntityType, nil
 	case minderv1.Entity_ENTITY_BUILD_ENVIRONMENTS:
-		return "", fmt.Errorf("build environments not yet supported")
+		return BuildEnvironmentEventEntityType, nil
 	case minderv1.Entity_ENTITY_UNSPECIFIED:
 		return "", fmt.Errorf("entity type unspecified")
 	default:
@@ -391,6 +435,11 @@ func ParseEntityEvent(msg *message.Message) (*EntityInfoWrapper, error) {
 		if err := out.withPullRequestIDFromMessage(msg); err != nil {
 			return nil, err
 		}
+	case BuildEnvironmentEventEntityType:
+		out.AsBuildEnvironment()
+		if err := out.withBuildEnvironmentIDFromMessage(msg); err != nil {
+			return nil, err
+		}
 	default:
 		return nil, fmt.Errorf("unknown entity type: %s", typ)
 	}
@@ -33,6 +33,7 @@ func Test_parseEntityEvent(t *testing.T) {
 	projectID := uuid.New()
 	repoID := uuid.NewString()
 	artifactID := uuid.NewString()
+	buildEnvID := uuid.NewString()
 
 	type args struct {
 		ent       protoreflect.ProtoMessage
@@ -142,6 +143,39 @@ func Test_parseEntityEvent(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "build_environment event",
+			args: args{
+				ent: &pb.BuildEnvironment{
+					Id:        &buildEnvID,
+					Name:      "production",
+					RepoOwner: "jakubtestorg",
+					RepoName:  "bad-npm",
+				},
+				entType:   BuildEnvironmentEventEntityType,
+				projectID: projectID,
+				provider:  "github",
+				ownership: map[string]string{
+					BuildEnvironmentIDEventKey: buildEnvID,
+					RepositoryIDEventKey:       repoID,
+				},
+			},
+			want: &EntityInfoWrapper{
+				ProjectID: &projectID,
+				Entity: &pb.BuildEnvironment{
+					Id:        &buildEnvID,
+					Name:      "production",
+					RepoOwner: "jakubtestorg",
+					RepoName:  "bad-npm",
+				},
+				Provider: "github",
+				Type:     pb.Entity_ENTITY_BUILD_ENVIRONMENTS,
+				OwnershipData: map[string]string{
+					BuildEnvironmentIDEventKey: buildEnvID,
+					RepositoryIDEventKey:       repoID,
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		tt := tt
@@ -161,6 +195,8 @@ func Test_parseEntityEvent(t *testing.T) {
 				msg.Metadata.Set(ArtifactIDEventKey, tt.args.ownership["artifact_id"])
 			} else if tt.args.entType == PullRequestEventEntityType {
 				msg.Metadata.Set(PullRequestIDEventKey, tt.args.ownership["pull_request_id"])
+			} else if tt.args.entType == BuildEnvironmentEventEntityType {
+				msg.Metadata.Set(BuildEnvironmentIDEventKey, tt.args.ownership["build_environment_id"])
 			}
 
 			got, err := ParseEntityEvent(msg)
@@ -74,9 +74,41 @@ func NewErrEvaluationSkipSilently(sfmt string, args ...any) error {
 // the evaluation passed and the action was not needed.
 var ErrActionSkipped = errors.New("action not performed")
 
+// ErrActionPending is an error code that indicates that the action was held back, e.g. because a previous
+// attempt is not effective yet or the action is backing off after failed attempts.
+var ErrActionPending = errors.New("action pending")
+
+// NewErrActionPending creates a new pending action error
+func NewErrActionPending(sfmt string, args ...any) error {
+	msg := fmt.Sprintf(sfmt, args...)
+	return fmt.Errorf("%w: %s", ErrActionPending, msg)
+}
+
+// RemediationProposal is returned by a remediation that requires approval instead of performing
+// its request. It holds the request, which is performed once approved.
+type RemediationProposal struct {
+	// Method is the HTTP method of the request
+	Method string
+	// Endpoint is the endpoint of the request, relative to the base URL of the provider
+	Endpoint string
+	// Body is the body of the request, if any
+	Body string
+}
+
+// Error implements the error interface
+func (p *RemediationProposal) Error() string {
+	return fmt.Sprintf("%s: awaiting approval of %s %s", ErrActionPending, p.Method, p.Endpoint)
+}
+
+// Unwrap returns ErrActionPending, a proposal is pending until approved
+func (_ *RemediationProposal) Unwrap() error {
+	return ErrActionPending
+}
+
 // IsActionInformativeError returns true if the error is an informative error that should not be reported to the user
 func IsActionInformativeError(err error) bool {
-	return errors.Is(err, ErrActionSkipped) || errors.Is(err, ErrActionNotAvailable) || errors.Is(err, ErrActionTurnedOff)
+	return errors.Is(err, ErrActionSkipped) || errors.Is(err, ErrActionNotAvailable) ||
+		errors.Is(err, ErrActionTurnedOff) || errors.Is(err, ErrActionPending)
 }
 
 // IsActionFatalError returns true if the error is a fatal error that should stop be reported to the user
@@ -144,6 +176,8 @@ func ErrorAsRemediationStatus(err error) db.RemediationStatusTypes {
 		return db.RemediationStatusTypesSkipped
 	case errors.Is(err, ErrActionNotAvailable):
 		return db.RemediationStatusTypesNotAvailable
+	case errors.Is(err, ErrActionPending):
+		return db.RemediationStatusTypesPending
 	}
 	return db.RemediationStatusTypesError
 }
@@ -22,8 +22,10 @@ import (
 	"os"
 
 	"github.com/stacklok/minder/internal/engine/eval/jq"
+	"github.com/stacklok/minder/internal/engine/eval/license"
 	"github.com/stacklok/minder/internal/engine/eval/rego"
 	"github.com/stacklok/minder/internal/engine/eval/trusty"
+	"github.com/stacklok/minder/internal/engine/eval/typosquat"
 	"github.com/stacklok/minder/internal/engine/eval/vulncheck"
 	engif "github.com/stacklok/minder/internal/engine/interfaces"
 	"github.com/stacklok/minder/internal/providers"
@@ -58,6 +60,10 @@ func NewRuleEvaluator(rt *pb.RuleType, cli *providers.ProviderBuilder) (engif.Ev
 			trustyEvalConfig.Endpoint = os.Getenv("MINDER_UNSTABLE_TRUSTY_ENDPOINT")
 		}
 		return trusty.NewTrustyEvaluator(trustyEvalConfig, cli)
+	case license.LicenseEvalType:
+		return license.NewLicenseEvaluator(e.GetLicense(), cli)
+	case typosquat.TyposquatEvalType:
+		return typosquat.NewTyposquatEvaluator(e.GetTyposquat(), cli)
 	default:
 		return nil, fmt.Errorf("unsupported rule type engine: %s", rt.Def.Eval.Type)
 	}
@@ -0,0 +1,109 @@
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package license
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/mitchellh/mapstructure"
+
+	"github.com/stacklok/minder/internal/engine/eval/pr_actions"
+	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+type packageRepository struct {
+	Url string `json:"url" mapstructure:"url" validate:"required"`
+}
+
+type ecosystemConfig struct {
+	Name string `json:"name" mapstructure:"name" validate:"required"`
+	//nolint:lll
+	PackageRepository packageRepository `json:"package_repository" mapstructure:"package_repository" validate:"required"`
+	// Depfile is only used when evaluating a full checkout of the repository
+	// and selects the dependency files to parse, e.g. package-lock.json
+	Depfile string `json:"depfile" mapstructure:"depfile"`
+}
+
+// config is the configuration for the license evaluator
+type config struct {
+	Action pr_actions.Action `json:"action" mapstructure:"action" validate:"required"`
+	// Allow is the list of SPDX license identifiers that are allowed. If set,
+	// any license not on the list is a violation.
+	Allow []string `json:"allow" mapstructure:"allow"`
+	// Deny is the list of SPDX license identifiers that are never allowed.
+	Deny []string `json:"deny" mapstructure:"deny"`
+	// AllowUnknown controls whether dependencies whose license could not be
+	// resolved pass the evaluation.
+	AllowUnknown    bool              `json:"allow_unknown" mapstructure:"allow_unknown"`
+	EcosystemConfig []ecosystemConfig `json:"ecosystem_config" mapstructure:"ecosystem_config" validate:"required"`
+}
+
+func parseConfig(ruleCfg map[string]any) (*config, error) {
+	if ruleCfg == nil {
+		return nil, errors.New("config was missing")
+	}
+
+	var conf config
+	validate := validator.New(validator.WithRequiredStructEnabled())
+
+	if err := mapstructure.Decode(ruleCfg, &conf); err != nil {
+		return nil, fmt.Errorf("could not parse config: %w", err)
+	}
+
+	if err := validate.Struct(&conf); err != nil {
+		return nil, fmt.Errorf("config failed validation: %w", err)
+	}
+
+	if len(conf.Allow) == 0 && len(conf.Deny) == 0 {
+		return nil, errors.New("config failed validation: at least one of allow or deny must be set")
+	}
+
+	return &conf, nil
+}
+
+func (c *config) getEcosystemConfig(ecosystem pb.DepEcosystem) *ecosystemConfig {
+	sEco := ecosystem.AsString()
+	if sEco == "" {
+		return nil
+	}
+	sEco = strings.ToLower(sEco)
+
+	for _, eco := range c.EcosystemConfig {
+		if strings.ToLower(eco.Name) == sEco {
+			return &eco
+		}
+	}
+
+	return nil
+}
+
+// depfiles returns the dependency file patterns for the ecosystems that have
+// one configured, in the form the diff ingester expects them.
+func (c *config) depfiles() []*pb.DiffType_Ecosystem {
+	ecosystems := make([]*pb.DiffType_Ecosystem, 0, len(c.EcosystemConfig))
+	for _, eco := range c.EcosystemConfig {
+		if eco.Depfile == "" {
+			continue
+		}
+		ecosystems = append(ecosystems, &pb.DiffType_Ecosystem{
+			Name:    strings.ToLower(eco.Name),
+			Depfile: eco.Depfile,
+		})
+	}
+	return ecosystems
+}
@@ -0,0 +1,181 @@
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package license provides the dependency license compliance evaluator
+package license
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/rs/zerolog"
+
+	evalerrors "github.com/stacklok/minder/internal/engine/errors"
+	"github.com/stacklok/minder/internal/engine/eval/pr_actions"
+	"github.com/stacklok/minder/internal/engine/ingester/diff"
+	engif "github.com/stacklok/minder/internal/engine/interfaces"
+	"github.com/stacklok/minder/internal/providers"
+	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+	provifv1 "github.com/stacklok/minder/pkg/providers/v1"
+)
+
+const (
+	// LicenseEvalType is the type of the license evaluator
+	LicenseEvalType = "license"
+)
+
+// Evaluator is the license evaluator
+type Evaluator struct {
+	cli provifv1.GitHub
+}
+
+// NewLicenseEvaluator creates a new license evaluator
+func NewLicenseEvaluator(_ *pb.RuleType_Definition_Eval_License, pbuild *providers.ProviderBuilder) (*Evaluator, error) {
+	if pbuild == nil {
+		return nil, fmt.Errorf("provider builder is nil")
+	}
+
+	ghcli, err := pbuild.GetGitHub(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get github client: %w", err)
+	}
+
+	return &Evaluator{
+		cli: ghcli,
+	}, nil
+}
+
+// Eval implements the Evaluator interface. It accepts either the dependencies
+// ingested by the diff ingester from a pull request, or a filesystem ingested
+// by the git ingester, in which case the dependency files configured in the
+// profile are parsed in full.
+func (e *Evaluator) Eval(ctx context.Context, pol map[string]any, res *engif.Result) error {
+	ruleConfig, err := parseConfig(pol)
+	if err != nil {
+		return fmt.Errorf("failed to parse config: %w", err)
+	}
+
+	var deps []*pb.PrDependencies_ContextualDependency
+	var prReplyHandler pr_actions.StatusHandler
+
+	if prdeps, ok := res.Object.(*pb.PrDependencies); ok {
+		deps = prdeps.Deps
+		prReplyHandler, err = pr_actions.NewStatusHandler(ctx, ruleConfig.Action, prdeps.Pr, e.cli, reviewConfig)
+		if err != nil {
+			return fmt.Errorf("failed to create pr action: %w", err)
+		}
+	} else if res.Fs != nil {
+		depfiles := ruleConfig.depfiles()
+		if len(depfiles) == 0 {
+			return fmt.Errorf("no depfile configured for any ecosystem, can't evaluate a repository")
+		}
+		deps, err = diff.ParseFilesystem(res.Fs, depfiles)
+		if err != nil {
+			return fmt.Errorf("failed to parse dependency files: %w", err)
+		}
+		// there is no PR to report to, just return the evaluation status
+		prReplyHandler = pr_actions.NewProfileOnlyHandler()
+	} else {
+		return fmt.Errorf("invalid object type for license evaluator")
+	}
+
+	if len(deps) == 0 {
+		return nil
+	}
+
+	violations, err := e.checkDependencies(ctx, ruleConfig, deps, prReplyHandler)
+	if err != nil {
+		return err
+	}
+
+	if err := prReplyHandler.Submit(ctx); err != nil {
+		return fmt.Errorf("failed to submit pr action: %w", err)
+	}
+
+	if len(violations) > 0 {
+		return evalerrors.NewErrEvaluationFailed(
+			fmt.Sprintf("dependencies with disallowed licenses: %s", strings.Join(violations, ",")))
+	}
+	return nil
+}
+
+func (_ *Evaluator) checkDependencies(
+	ctx context.Context,
+	ruleConfig *config,
+	deps []*pb.PrDependencies_ContextualDependency,
+	prReplyHandler pr_actions.StatusHandler,
+) ([]string, error) {
+	logger := zerolog.Ctx(ctx)
+	policy := newLicensePolicy(ruleConfig)
+	resolvers := newResolverCache()
+
+	violations := make([]string, 0)
+	// the same dependency is often listed several times, e.g. twice in go.sum
+	seen := make(map[string]struct{})
+
+	for _, dep := range deps {
+		if dep.Dep == nil || dep.Dep.Version == "" {
+			continue
+		}
+
+		key := fmt.Sprintf("%s/%s@%s", dep.Dep.Ecosystem.AsString(), dep.Dep.Name, dep.Dep.Version)
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+
+		ecoConfig := ruleConfig.getEcosystemConfig(dep.Dep.Ecosystem)
+		if ecoConfig == nil {
+			logger.Info().
+				Str("dependency", dep.Dep.Name).
+				Str("ecosystem", dep.Dep.Ecosystem.AsString()).
+				Msg("no config for ecosystem, skipping")
+			continue
+		}
+
+		resolver, err := resolvers.newResolver(ecoConfig)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create license resolver: %w", err)
+		}
+
+		lic, err := resolver.Resolve(ctx, dep.Dep)
+		if errors.Is(err, errPackageNotFound) {
+			// e.g. private packages, treat the license as unknown
+			logger.Info().
+				Str("dependency", dep.Dep.Name).
+				Str("version", dep.Dep.Version).
+				Msg("package not found in registry")
+		} else if err != nil {
+			return nil, fmt.Errorf("failed to resolve license of %s: %w", dep.Dep.Name, err)
+		}
+
+		if policy.isCompliant(lic) {
+			continue
+		}
+
+		violation := &licenseViolation{
+			Dependency: dep.Dep,
+			License:    lic,
+		}
+		violations = append(violations, fmt.Sprintf("%s (%s)", dep.Dep.Name, violation.licenseString()))
+
+		if err := prReplyHandler.Track(ctx, violation.finding(dep)); err != nil {
+			return nil, fmt.Errorf("failed to track license violation: %w", err)
+		}
+	}
+
+	return violations, nil
+}
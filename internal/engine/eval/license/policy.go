@@ -0,0 +1,233 @@
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package license
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	spdxNoAssertion = "NOASSERTION"
+	spdxNone        = "NONE"
+)
+
+// licensePolicy decides whether a license expression is acceptable according to
+// the allow and deny lists from the profile. License identifiers are compared
+// case-insensitively, as the SPDX specification mandates.
+type licensePolicy struct {
+	allow        map[string]struct{}
+	deny         map[string]struct{}
+	allowUnknown bool
+}
+
+func newLicensePolicy(cfg *config) *licensePolicy {
+	return &licensePolicy{
+		allow:        toLicenseSet(cfg.Allow),
+		deny:         toLicenseSet(cfg.Deny),
+		allowUnknown: cfg.AllowUnknown,
+	}
+}
+
+func toLicenseSet(ids []string) map[string]struct{} {
+	set := make(map[string]struct{}, len(ids))
+	for _, id := range ids {
+		set[strings.ToUpper(strings.TrimSpace(id))] = struct{}{}
+	}
+	return set
+}
+
+// isUnknown returns true if the license expression does not carry any usable
+// license information.
+func isUnknown(expr string) bool {
+	e := strings.ToUpper(strings.TrimSpace(expr))
+	return e == "" || e == spdxNoAssertion || e == spdxNone
+}
+
+// isCompliant evaluates the license expression against the policy. Licenses
+// combined with OR are compliant if any alternative is, licenses combined with
+// AND only if all of them are. Strings that are not valid SPDX expressions are
+// treated as a single license identifier.
+func (p *licensePolicy) isCompliant(expr string) bool {
+	if isUnknown(expr) {
+		return p.allowUnknown
+	}
+
+	node, err := parseExpression(expr)
+	if err != nil {
+		return p.isIdCompliant(expr)
+	}
+
+	return node.eval(p.isIdCompliant)
+}
+
+func (p *licensePolicy) isIdCompliant(id string) bool {
+	key := strings.ToUpper(strings.TrimSpace(id))
+
+	if _, denied := p.deny[key]; denied {
+		return false
+	}
+	// a license with an exception is also denied if the license itself is denied
+	base, _, hasException := strings.Cut(key, " WITH ")
+	if hasException {
+		if _, denied := p.deny[base]; denied {
+			return false
+		}
+	}
+
+	if len(p.allow) == 0 {
+		return true
+	}
+
+	if _, allowed := p.allow[key]; allowed {
+		return true
+	}
+	if hasException {
+		_, allowed := p.allow[base]
+		return allowed
+	}
+	return false
+}
+
+// exprNode is a node of a parsed SPDX license expression
+type exprNode struct {
+	// op is either "AND", "OR" or empty for a leaf
+	op       string
+	id       string
+	children []*exprNode
+}
+
+func (n *exprNode) eval(isIdCompliant func(string) bool) bool {
+	switch n.op {
+	case "AND":
+		for _, c := range n.children {
+			if !c.eval(isIdCompliant) {
+				return false
+			}
+		}
+		return true
+	case "OR":
+		for _, c := range n.children {
+			if c.eval(isIdCompliant) {
+				return true
+			}
+		}
+		return false
+	default:
+		return isIdCompliant(n.id)
+	}
+}
+
+// parseExpression parses an SPDX license expression such as
+// "(MIT OR Apache-2.0) AND GPL-2.0-only WITH Classpath-exception-2.0".
+// AND binds tighter than OR.
+func parseExpression(expr string) (*exprNode, error) {
+	p := &exprParser{tokens: tokenizeExpression(expr)}
+	node, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if p.pos != len(p.tokens) {
+		return nil, fmt.Errorf("unexpected token %q", p.tokens[p.pos])
+	}
+	return node, nil
+}
+
+func tokenizeExpression(expr string) []string {
+	expr = strings.ReplaceAll(expr, "(", " ( ")
+	expr = strings.ReplaceAll(expr, ")", " ) ")
+	return strings.Fields(expr)
+}
+
+type exprParser struct {
+	tokens []string
+	pos    int
+}
+
+func (p *exprParser) peek() string {
+	if p.pos >= len(p.tokens) {
+		return ""
+	}
+	return p.tokens[p.pos]
+}
+
+func (p *exprParser) parseOr() (*exprNode, error) {
+	return p.parseBinary("OR", p.parseAnd)
+}
+
+func (p *exprParser) parseAnd() (*exprNode, error) {
+	return p.parseBinary("AND", p.parseTerm)
+}
+
+func (p *exprParser) parseBinary(op string, operand func() (*exprNode, error)) (*exprNode, error) {
+	first, err := operand()
+	if err != nil {
+		return nil, err
+	}
+
+	children := []*exprNode{first}
+	for strings.EqualFold(p.peek(), op) {
+		p.pos++
+		next, err := operand()
+		if err != nil {
+			return nil, err
+		}
+		children = append(children, next)
+	}
+
+	if len(children) == 1 {
+		return first, nil
+	}
+	return &exprNode{op: op, children: children}, nil
+}
+
+func (p *exprParser) parseTerm() (*exprNode, error) {
+	tok := p.peek()
+	switch {
+	case tok == "":
+		return nil, fmt.Errorf("unexpected end of expression")
+	case tok == "(":
+		p.pos++
+		node, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if p.peek() != ")" {
+			return nil, fmt.Errorf("missing closing parenthesis")
+		}
+		p.pos++
+		return node, nil
+	case tok == ")" || isOperator(tok):
+		return nil, fmt.Errorf("unexpected token %q", tok)
+	}
+
+	p.pos++
+	id := tok
+	if strings.EqualFold(p.peek(), "WITH") {
+		p.pos++
+		exception := p.peek()
+		if exception == "" || exception == "(" || exception == ")" || isOperator(exception) {
+			return nil, fmt.Errorf("missing license exception after WITH")
+		}
+		p.pos++
+		id = id + " WITH " + exception
+	}
+
+	return &exprNode{id: id}, nil
+}
+
+func isOperator(tok string) bool {
+	return strings.EqualFold(tok, "AND") || strings.EqualFold(tok, "OR") || strings.EqualFold(tok, "WITH")
+}
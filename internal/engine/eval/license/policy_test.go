@@ -0,0 +1,166 @@
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package license
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLicensePolicy(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		cfg       *config
+		license   string
+		compliant bool
+	}{
+		{
+			name:      "allowed license",
+			cfg:       &config{Allow: []string{"MIT", "Apache-2.0"}},
+			license:   "MIT",
+			compliant: true,
+		},
+		{
+			name:      "allow list is case insensitive",
+			cfg:       &config{Allow: []string{"apache-2.0"}},
+			license:   "Apache-2.0",
+			compliant: true,
+		},
+		{
+			name:      "license not on the allow list",
+			cfg:       &config{Allow: []string{"MIT"}},
+			license:   "GPL-3.0-only",
+			compliant: false,
+		},
+		{
+			name:      "denied license without allow list",
+			cfg:       &config{Deny: []string{"AGPL-3.0-only"}},
+			license:   "AGPL-3.0-only",
+			compliant: false,
+		},
+		{
+			name:      "license not on the deny list",
+			cfg:       &config{Deny: []string{"AGPL-3.0-only"}},
+			license:   "BSD-3-Clause",
+			compliant: true,
+		},
+		{
+			name:      "deny wins over allow",
+			cfg:       &config{Allow: []string{"GPL-3.0-only"}, Deny: []string{"GPL-3.0-only"}},
+			license:   "GPL-3.0-only",
+			compliant: false,
+		},
+		{
+			name:      "OR expression with one allowed alternative",
+			cfg:       &config{Allow: []string{"MIT"}},
+			license:   "MIT OR GPL-3.0-only",
+			compliant: true,
+		},
+		{
+			name:      "AND expression with one disallowed license",
+			cfg:       &config{Allow: []string{"MIT"}},
+			license:   "MIT AND GPL-3.0-only",
+			compliant: false,
+		},
+		{
+			name:      "nested expression",
+			cfg:       &config{Allow: []string{"MIT", "BSD-3-Clause"}},
+			license:   "(GPL-2.0-only OR MIT) AND BSD-3-Clause",
+			compliant: true,
+		},
+		{
+			name:      "exception inherits the base license",
+			cfg:       &config{Allow: []string{"GPL-2.0-only"}},
+			license:   "GPL-2.0-only WITH Classpath-exception-2.0",
+			compliant: true,
+		},
+		{
+			name:      "exception of a denied license",
+			cfg:       &config{Deny: []string{"GPL-2.0-only"}},
+			license:   "GPL-2.0-only WITH Classpath-exception-2.0",
+			compliant: false,
+		},
+		{
+			name:      "invalid expression is treated as a single identifier",
+			cfg:       &config{Allow: []string{"Apache 2.0"}},
+			license:   "Apache 2.0",
+			compliant: true,
+		},
+		{
+			name:      "unknown license is not allowed by default",
+			cfg:       &config{Deny: []string{"GPL-3.0-only"}},
+			license:   "",
+			compliant: false,
+		},
+		{
+			name:      "NOASSERTION is unknown",
+			cfg:       &config{Deny: []string{"GPL-3.0-only"}},
+			license:   "NOASSERTION",
+			compliant: false,
+		},
+		{
+			name:      "unknown license is allowed if configured",
+			cfg:       &config{Deny: []string{"GPL-3.0-only"}, AllowUnknown: true},
+			license:   "",
+			compliant: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			policy := newLicensePolicy(tt.cfg)
+			assert.Equal(t, tt.compliant, policy.isCompliant(tt.license))
+		})
+	}
+}
+
+func TestParseExpressionErrors(t *testing.T) {
+	t.Parallel()
+
+	for _, expr := range []string{
+		"MIT OR",
+		"(MIT",
+		"MIT)",
+		"AND MIT",
+		"GPL-2.0-only WITH",
+		"MIT Apache-2.0",
+	} {
+		expr := expr
+		t.Run(expr, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := parseExpression(expr)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestJoinExpression(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "", joinExpression(nil, "OR"))
+	assert.Equal(t, "MIT", joinExpression([]string{"MIT", "MIT"}, "OR"))
+	assert.Equal(t, "Apache-2.0 OR MIT", joinExpression([]string{"MIT", "Apache-2.0"}, "OR"))
+	assert.Equal(t,
+		"(GPL-2.0-only WITH Classpath-exception-2.0) AND MIT",
+		joinExpression([]string{"MIT", "GPL-2.0-only WITH Classpath-exception-2.0"}, "AND"))
+}
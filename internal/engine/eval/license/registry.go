@@ -0,0 +1,409 @@
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package license
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/google/licensecheck"
+	"github.com/puzpuzpuz/xsync"
+	"golang.org/x/mod/module"
+
+	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+const (
+	// maxModuleZipSize is the largest Go module zip we are willing to download
+	// to look for the license file
+	maxModuleZipSize = 64 << 20
+	// minLicenseCoverage is the minimum percentage of a LICENSE file that must
+	// match a known license for the license to be reported
+	minLicenseCoverage = 75
+)
+
+// errPackageNotFound is returned when the registry does not know the package
+var errPackageNotFound = errors.New("package not found")
+
+// licenseResolver resolves the SPDX license expression of a dependency from
+// the metadata of the package registry of its ecosystem. An empty expression
+// means that the registry does not provide license information.
+type licenseResolver interface {
+	Resolve(ctx context.Context, dep *pb.Dependency) (string, error)
+}
+
+type resolverCache struct {
+	cache *xsync.MapOf[string, licenseResolver]
+}
+
+func newResolverCache() *resolverCache {
+	return &resolverCache{
+		cache: xsync.NewMapOf[licenseResolver](),
+	}
+}
+
+func (rc *resolverCache) newResolver(ecoConfig *ecosystemConfig) (licenseResolver, error) {
+	if res, exists := rc.cache.Load(ecoConfig.Name); exists {
+		return res, nil
+	}
+
+	var res licenseResolver
+	switch strings.ToLower(ecoConfig.Name) {
+	case "npm":
+		res = newNpmResolver(ecoConfig.PackageRepository.Url)
+	case "go":
+		res = newGoProxyResolver(ecoConfig.PackageRepository.Url)
+	case "pypi":
+		res = newPyPIResolver(ecoConfig.PackageRepository.Url)
+	default:
+		return nil, fmt.Errorf("unknown ecosystem: %s", ecoConfig.Name)
+	}
+
+	rc.cache.Store(ecoConfig.Name, res)
+	return res, nil
+}
+
+func getJSON(ctx context.Context, cli *http.Client, u *url.URL, into any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return fmt.Errorf("could not create request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := cli.Do(req)
+	if err != nil {
+		return fmt.Errorf("could not send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return errPackageNotFound
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("received non-200 response: %d", resp.StatusCode)
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(into); err != nil {
+		return fmt.Errorf("could not unmarshal response: %w", err)
+	}
+	return nil
+}
+
+// check that npm resolver implements licenseResolver
+var _ licenseResolver = (*npmResolver)(nil)
+
+type npmResolver struct {
+	client   *http.Client
+	endpoint string
+}
+
+func newNpmResolver(endpoint string) *npmResolver {
+	return &npmResolver{
+		client:   &http.Client{},
+		endpoint: endpoint,
+	}
+}
+
+// npmLicense is either a plain string or, in legacy packages, an object
+// with a type field
+type npmLicense struct {
+	Type string
+}
+
+func (l *npmLicense) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		l.Type = s
+		return nil
+	}
+
+	var obj struct {
+		Type string `json:"type"`
+	}
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return err
+	}
+	l.Type = obj.Type
+	return nil
+}
+
+type npmVersionReply struct {
+	License  *npmLicense  `json:"license"`
+	Licenses []npmLicense `json:"licenses"`
+}
+
+func (n *npmResolver) Resolve(ctx context.Context, dep *pb.Dependency) (string, error) {
+	u, err := url.Parse(n.endpoint)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse endpoint: %w", err)
+	}
+	u = u.JoinPath(dep.Name, dep.Version)
+
+	var reply npmVersionReply
+	if err := getJSON(ctx, n.client, u, &reply); err != nil {
+		return "", err
+	}
+
+	if reply.License != nil && reply.License.Type != "" {
+		return reply.License.Type, nil
+	}
+
+	// the deprecated licenses array lists alternatives the user may choose from
+	ids := make([]string, 0, len(reply.Licenses))
+	for _, l := range reply.Licenses {
+		if l.Type != "" {
+			ids = append(ids, l.Type)
+		}
+	}
+	return joinExpression(ids, "OR"), nil
+}
+
+// check that pypi resolver implements licenseResolver
+var _ licenseResolver = (*pypiResolver)(nil)
+
+type pypiResolver struct {
+	client   *http.Client
+	endpoint string
+}
+
+func newPyPIResolver(endpoint string) *pypiResolver {
+	return &pypiResolver{
+		client:   &http.Client{},
+		endpoint: endpoint,
+	}
+}
+
+type pypiVersionReply struct {
+	Info struct {
+		License           string   `json:"license"`
+		LicenseExpression string   `json:"license_expression"`
+		Classifiers       []string `json:"classifiers"`
+	} `json:"info"`
+}
+
+// pypiClassifierLicenses maps the trove classifiers of the most common licenses
+// to SPDX identifiers
+var pypiClassifierLicenses = map[string]string{
+	"License :: OSI Approved :: Apache Software License":                             "Apache-2.0",
+	"License :: OSI Approved :: MIT License":                                         "MIT",
+	"License :: OSI Approved :: MIT No Attribution License (MIT-0)":                  "MIT-0",
+	"License :: OSI Approved :: BSD License":                                         "BSD-3-Clause",
+	"License :: OSI Approved :: ISC License (ISCL)":                                  "ISC",
+	"License :: OSI Approved :: Mozilla Public License 2.0 (MPL 2.0)":                "MPL-2.0",
+	"License :: OSI Approved :: Python Software Foundation License":                  "PSF-2.0",
+	"License :: OSI Approved :: The Unlicense (Unlicense)":                           "Unlicense",
+	"License :: OSI Approved :: GNU General Public License v2 (GPLv2)":               "GPL-2.0-only",
+	"License :: OSI Approved :: GNU General Public License v2 or later (GPLv2+)":     "GPL-2.0-or-later",
+	"License :: OSI Approved :: GNU General Public License v3 (GPLv3)":               "GPL-3.0-only",
+	"License :: OSI Approved :: GNU General Public License v3 or later (GPLv3+)":     "GPL-3.0-or-later",
+	"License :: OSI Approved :: GNU Lesser General Public License v2 (LGPLv2)":       "LGPL-2.0-only",
+	"License :: OSI Approved :: GNU Lesser General Public License v3 (LGPLv3)":       "LGPL-3.0-only",
+	"License :: OSI Approved :: GNU Affero General Public License v3":                "AGPL-3.0-only",
+	"License :: OSI Approved :: GNU Library or Lesser General Public License (LGPL)": "LGPL-2.0-or-later",
+}
+
+func (p *pypiResolver) Resolve(ctx context.Context, dep *pb.Dependency) (string, error) {
+	u, err := url.Parse(p.endpoint)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse endpoint: %w", err)
+	}
+	u = u.JoinPath(dep.Name, dep.Version, "json")
+
+	var reply pypiVersionReply
+	if err := getJSON(ctx, p.client, u, &reply); err != nil {
+		return "", err
+	}
+
+	// PEP 639 metadata is already an SPDX expression
+	if reply.Info.LicenseExpression != "" {
+		return reply.Info.LicenseExpression, nil
+	}
+
+	ids := make([]string, 0)
+	for _, classifier := range reply.Info.Classifiers {
+		if id, ok := pypiClassifierLicenses[classifier]; ok {
+			ids = append(ids, id)
+		}
+	}
+	if len(ids) > 0 {
+		return joinExpression(ids, "OR"), nil
+	}
+
+	// the free-form license field sometimes contains the whole license text,
+	// only use it if it looks like an identifier
+	lic := strings.TrimSpace(reply.Info.License)
+	if lic != "" && !strings.ContainsAny(lic, "\n") && len(lic) <= 64 {
+		return lic, nil
+	}
+
+	return classifyLicenseText([]byte(lic)), nil
+}
+
+// check that the go proxy resolver implements licenseResolver
+var _ licenseResolver = (*goProxyResolver)(nil)
+
+type goProxyResolver struct {
+	client   *http.Client
+	endpoint string
+}
+
+func newGoProxyResolver(endpoint string) *goProxyResolver {
+	return &goProxyResolver{
+		client:   &http.Client{},
+		endpoint: endpoint,
+	}
+}
+
+// Resolve downloads the module zip from the Go module proxy and classifies the
+// LICENSE file at the root of the module. The Go module proxy does not serve
+// any license metadata on its own.
+func (g *goProxyResolver) Resolve(ctx context.Context, dep *pb.Dependency) (string, error) {
+	escapedPath, err := module.EscapePath(dep.Name)
+	if err != nil {
+		return "", fmt.Errorf("could not escape module path: %w", err)
+	}
+	escapedVersion, err := module.EscapeVersion(dep.Version)
+	if err != nil {
+		return "", fmt.Errorf("could not escape module version: %w", err)
+	}
+
+	u, err := url.Parse(g.endpoint)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse endpoint: %w", err)
+	}
+	u = u.JoinPath(escapedPath, "@v", escapedVersion+".zip")
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return "", fmt.Errorf("could not create request: %w", err)
+	}
+
+	resp, err := g.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("could not send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusGone {
+		return "", errPackageNotFound
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("received non-200 response: %d", resp.StatusCode)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxModuleZipSize+1))
+	if err != nil {
+		return "", fmt.Errorf("could not read module zip: %w", err)
+	}
+	if len(data) > maxModuleZipSize {
+		return "", fmt.Errorf("module zip is larger than %d bytes", maxModuleZipSize)
+	}
+
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return "", fmt.Errorf("could not open module zip: %w", err)
+	}
+
+	// files in the module zip are prefixed with module@version/
+	root := dep.Name + "@" + dep.Version
+	ids := make([]string, 0)
+	for _, f := range zr.File {
+		if path.Dir(f.Name) != root || !isLicenseFileName(path.Base(f.Name)) {
+			continue
+		}
+
+		text, err := readZipFile(f)
+		if err != nil {
+			return "", fmt.Errorf("could not read %s: %w", f.Name, err)
+		}
+
+		if id := classifyLicenseText(text); id != "" {
+			ids = append(ids, id)
+		}
+	}
+
+	return joinExpression(ids, "AND"), nil
+}
+
+func isLicenseFileName(name string) bool {
+	upper := strings.ToUpper(name)
+	return strings.HasPrefix(upper, "LICENSE") || strings.HasPrefix(upper, "LICENCE") || strings.HasPrefix(upper, "COPYING")
+}
+
+func readZipFile(f *zip.File) ([]byte, error) {
+	rc, err := f.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+
+	return io.ReadAll(rc)
+}
+
+// classifyLicenseText returns the SPDX identifier of the license that best
+// matches the text or an empty string if no known license matches well enough.
+func classifyLicenseText(text []byte) string {
+	if len(text) == 0 {
+		return ""
+	}
+
+	cov := licensecheck.Scan(text)
+	if cov.Percent < minLicenseCoverage || len(cov.Match) == 0 {
+		return ""
+	}
+
+	// a single file may contain several licenses, e.g. a dual-licensed project
+	ids := make([]string, 0, len(cov.Match))
+	for _, m := range cov.Match {
+		if !m.IsURL {
+			ids = append(ids, m.ID)
+		}
+	}
+	return joinExpression(ids, "AND")
+}
+
+// joinExpression combines license identifiers with the given operator,
+// removing duplicates.
+func joinExpression(ids []string, op string) string {
+	seen := make(map[string]struct{}, len(ids))
+	unique := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		unique = append(unique, id)
+	}
+	sort.Strings(unique)
+
+	if len(unique) > 1 {
+		for i, id := range unique {
+			if strings.Contains(id, " ") {
+				unique[i] = "(" + id + ")"
+			}
+		}
+	}
+	return strings.Join(unique, " "+op+" ")
+}
@@ -0,0 +1,210 @@
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package license
+
+import (
+	"archive/zip"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+const mitLicenseText = `MIT License
+
+Copyright (c) 2023 Example Authors
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+`
+
+func TestNpmResolver(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		reply       string
+		status      int
+		expected    string
+		expectError error
+	}{
+		{
+			name:     "license string",
+			reply:    `{"name": "lodash", "version": "4.17.21", "license": "MIT"}`,
+			status:   http.StatusOK,
+			expected: "MIT",
+		},
+		{
+			name:     "legacy license object",
+			reply:    `{"license": {"type": "BSD-3-Clause", "url": "https://example.com"}}`,
+			status:   http.StatusOK,
+			expected: "BSD-3-Clause",
+		},
+		{
+			name:     "legacy licenses array",
+			reply:    `{"licenses": [{"type": "MIT"}, {"type": "Apache-2.0"}]}`,
+			status:   http.StatusOK,
+			expected: "Apache-2.0 OR MIT",
+		},
+		{
+			name:     "no license",
+			reply:    `{"name": "lodash"}`,
+			status:   http.StatusOK,
+			expected: "",
+		},
+		{
+			name:        "not found",
+			status:      http.StatusNotFound,
+			expectError: errPackageNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/lodash/4.17.21", r.URL.Path, "unexpected path")
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.reply))
+			}))
+			defer server.Close()
+
+			res := newNpmResolver(server.URL)
+			lic, err := res.Resolve(context.Background(), &pb.Dependency{
+				Ecosystem: pb.DepEcosystem_DEP_ECOSYSTEM_NPM,
+				Name:      "lodash",
+				Version:   "4.17.21",
+			})
+			if tt.expectError != nil {
+				require.ErrorIs(t, err, tt.expectError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, lic)
+		})
+	}
+}
+
+func TestPyPIResolver(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		reply    string
+		expected string
+	}{
+		{
+			name:     "license expression",
+			reply:    `{"info": {"license_expression": "MIT OR Apache-2.0", "license": "whatever"}}`,
+			expected: "MIT OR Apache-2.0",
+		},
+		{
+			name: "classifiers",
+			reply: `{"info": {"license": "", "classifiers": [
+				"Programming Language :: Python :: 3",
+				"License :: OSI Approved :: Apache Software License"
+			]}}`,
+			expected: "Apache-2.0",
+		},
+		{
+			name:     "short license field",
+			reply:    `{"info": {"license": "BSD-2-Clause", "classifiers": []}}`,
+			expected: "BSD-2-Clause",
+		},
+		{
+			name:     "nothing usable",
+			reply:    `{"info": {"license": "", "classifiers": []}}`,
+			expected: "",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/requests/2.31.0/json", r.URL.Path, "unexpected path")
+				w.WriteHeader(http.StatusOK)
+				_, _ = w.Write([]byte(tt.reply))
+			}))
+			defer server.Close()
+
+			res := newPyPIResolver(server.URL)
+			lic, err := res.Resolve(context.Background(), &pb.Dependency{
+				Ecosystem: pb.DepEcosystem_DEP_ECOSYSTEM_PYPI,
+				Name:      "requests",
+				Version:   "2.31.0",
+			})
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, lic)
+		})
+	}
+}
+
+func TestGoProxyResolver(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// upper case letters are escaped by the module proxy protocol
+		assert.Equal(t, "/github.com/!burnt!sushi/toml/@v/v1.3.2.zip", r.URL.Path, "unexpected path")
+
+		zw := zip.NewWriter(w)
+		files := map[string]string{
+			"github.com/BurntSushi/toml@v1.3.2/LICENSE":          mitLicenseText,
+			"github.com/BurntSushi/toml@v1.3.2/toml.go":          "package toml",
+			"github.com/BurntSushi/toml@v1.3.2/internal/LICENSE": "not at the root of the module",
+		}
+		for name, contents := range files {
+			f, err := zw.Create(name)
+			require.NoError(t, err)
+			_, err = f.Write([]byte(contents))
+			require.NoError(t, err)
+		}
+		require.NoError(t, zw.Close())
+	}))
+	defer server.Close()
+
+	res := newGoProxyResolver(server.URL)
+	lic, err := res.Resolve(context.Background(), &pb.Dependency{
+		Ecosystem: pb.DepEcosystem_DEP_ECOSYSTEM_GO,
+		Name:      "github.com/BurntSushi/toml",
+		Version:   "v1.3.2",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "MIT", lic)
+}
@@ -0,0 +1,126 @@
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package license
+
+import (
+	"fmt"
+	htmltemplate "html/template"
+	"strings"
+
+	"github.com/stacklok/minder/internal/engine/eval/pr_actions"
+	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+const (
+	reviewBodyMagicComment = "<!-- minder: pr-license-review-body -->"
+	commitStatusContext    = "minder.stacklok.dev/pr-license"
+	violationsFoundText    = `
+Minder found dependencies whose license is not allowed by the license policy of
+this project. Please replace them or ask the project administrators to update
+the policy.
+`
+	violationsFoundTextShort = "Dependencies with disallowed licenses found."
+	noViolationsFoundText    = `
+Minder analyzed this PR and found no dependencies with disallowed licenses.
+`
+	unknownLicenseText = "unknown"
+)
+
+const (
+	tableLicensesName = "licensesTable"
+	tableLicenses     = `### Summary of dependency licenses
+Minder found the following dependencies whose license is not allowed by the license policy of this project:
+<table>
+  <tr>
+    <th>Ecosystem</th>
+    <th>Name</th>
+    <th>Version</th>
+    <th>License</th>
+    <th>File</th>
+  </tr>
+  {{ range . }}
+  <tr>
+    <td>{{ .Ecosystem }}</td>
+    <td>{{ .Name }}</td>
+    <td>{{ .Version }}</td>
+    <td>{{ .License }}</td>
+    <td>{{ .File }}</td>
+  </tr>
+  {{ end }}
+</table>
+`
+)
+
+var reviewConfig = &pr_actions.ReviewConfig{
+	MagicComment:      reviewBodyMagicComment,
+	StatusContext:     commitStatusContext,
+	FoundText:         violationsFoundText,
+	NotFoundText:      noViolationsFoundText,
+	StatusFailureText: violationsFoundTextShort,
+	StatusSuccessText: strings.TrimSpace(noViolationsFoundText),
+	SummaryTemplate:   htmltemplate.Must(htmltemplate.New(tableLicensesName).Parse(tableLicenses)),
+}
+
+// licenseViolation describes a dependency whose license does not comply with
+// the policy
+type licenseViolation struct {
+	Dependency *pb.Dependency
+	// License is the resolved SPDX expression, empty if it could not be resolved
+	License string
+}
+
+func (v *licenseViolation) licenseString() string {
+	if isUnknown(v.License) {
+		return unknownLicenseText
+	}
+	return v.License
+}
+
+func (v *licenseViolation) reviewComment() string {
+	if isUnknown(v.License) {
+		return fmt.Sprintf(
+			"The license of `%s@%s` could not be determined and the license policy does not allow unknown licenses.",
+			v.Dependency.Name, v.Dependency.Version)
+	}
+	return fmt.Sprintf(
+		"`%s@%s` is licensed under `%s`, which is not allowed by the license policy of this project.",
+		v.Dependency.Name, v.Dependency.Version, v.License)
+}
+
+type summaryRow struct {
+	Ecosystem string
+	Name      string
+	Version   string
+	License   string
+	File      string
+}
+
+// finding returns how the violation is reported on the PR
+func (v *licenseViolation) finding(dep *pb.PrDependencies_ContextualDependency) *pr_actions.Finding {
+	return &pr_actions.Finding{
+		Dependency: dep,
+		ReviewComment: func(*pr_actions.Location) (string, int) {
+			return v.reviewComment(), 0
+		},
+		Description: fmt.Sprintf("`%s@%s`: `%s`", v.Dependency.Name, v.Dependency.Version, v.licenseString()),
+		SummaryRow: summaryRow{
+			Ecosystem: dep.Dep.Ecosystem.AsString(),
+			Name:      dep.Dep.Name,
+			Version:   dep.Dep.Version,
+			License:   v.licenseString(),
+			File:      dep.File.GetName(),
+		},
+	}
+}
@@ -0,0 +1,543 @@
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pr_actions
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	htmltemplate "html/template"
+	"io"
+	"strings"
+
+	"github.com/google/go-github/v53/github"
+	"github.com/rs/zerolog"
+
+	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+	provifv1 "github.com/stacklok/minder/pkg/providers/v1"
+)
+
+// ReviewDismissText is the message of the dismissal of a previous review
+const ReviewDismissText = `
+Previous Minder review was dismissed because the PR was updated.
+`
+
+// ReviewConfig holds what tells the PR reviews, commit statuses and summaries
+// of an evaluator apart from the ones of the other evaluators
+type ReviewConfig struct {
+	// MagicComment starts the body of the reviews, previous reviews are found by it
+	MagicComment string
+	// StatusContext is the context of the commit status
+	StatusContext string
+	// FoundText is the review body if there are findings
+	FoundText string
+	// NotFoundText is the review body and the summary if there are no findings
+	NotFoundText string
+	// StatusFailureText is the description of a failed commit status
+	StatusFailureText string
+	// StatusSuccessText is the description of a successful commit status
+	StatusSuccessText string
+	// SummaryTemplate renders the summary comment from the summary rows of the findings
+	SummaryTemplate *htmltemplate.Template
+}
+
+// ReviewBody returns the body of a review, starting with the magic comment
+func (c *ReviewConfig) ReviewBody(text string) string {
+	return fmt.Sprintf("%s\n\n%s", c.MagicComment, text)
+}
+
+// Location is where a dependency is declared in the patch of a PR file
+type Location struct {
+	// Line is the content of the line
+	Line string
+	// Number is the line number in the patch, starting at 1
+	Number int
+	// LeadingWhitespace is the indentation of the line
+	LeadingWhitespace int
+}
+
+// Finding is a dependency of a PR an evaluator reports on
+type Finding struct {
+	Dependency *pb.PrDependencies_ContextualDependency
+	// LineHasDependency returns true if a line of the file patch declares the
+	// dependency. The dependency name and version are looked for if it is nil.
+	LineHasDependency func(line string) bool
+	// ReviewComment returns the body of the review comment on the located
+	// dependency and the number of lines it spans after the located one
+	ReviewComment func(loc *Location) (string, int)
+	// Description is listed in the review body, nothing is listed if it is empty
+	Description string
+	// SummaryRow is passed to the summary template
+	SummaryRow any
+}
+
+func (f *Finding) lineHasDependency(line string) bool {
+	if f.LineHasDependency != nil {
+		return f.LineHasDependency(line)
+	}
+	return lineHasDependency(line, f.Dependency.Dep)
+}
+
+// StatusHandler reports the findings of an evaluator on a PR
+type StatusHandler interface {
+	Track(ctx context.Context, finding *Finding) error
+	Submit(ctx context.Context) error
+}
+
+// NewStatusHandler returns the handler reporting the findings the way the action asks for
+func NewStatusHandler(
+	ctx context.Context,
+	action Action,
+	pr *pb.PullRequest,
+	cli provifv1.GitHub,
+	cfg *ReviewConfig,
+) (StatusHandler, error) {
+	switch action {
+	case ActionReviewPr:
+		return NewReviewHandler(ctx, pr, cli, cfg)
+	case ActionCommitStatus:
+		return NewCommitStatusHandler(ctx, pr, cli, cfg)
+	case ActionComment:
+		return NewReviewHandler(ctx, pr, cli, cfg, WithFailStatus(github.String("COMMENT")))
+	case ActionProfileOnly:
+		return NewProfileOnlyHandler(), nil
+	case ActionSummary:
+		return NewSummaryHandler(ctx, pr, cli, cfg)
+	default:
+		return nil, fmt.Errorf("unknown action: %s", action)
+	}
+}
+
+// lineHasDependency returns true if the line of a dependency file declares the dependency
+func lineHasDependency(line string, dep *pb.Dependency) bool {
+	switch dep.Ecosystem {
+	case pb.DepEcosystem_DEP_ECOSYSTEM_NPM:
+		return strings.Contains(line, fmt.Sprintf(`%s": {`, dep.Name))
+	case pb.DepEcosystem_DEP_ECOSYSTEM_GO:
+		return strings.HasPrefix(strings.TrimSpace(line), dep.Name+" "+dep.Version)
+	case pb.DepEcosystem_DEP_ECOSYSTEM_PYPI:
+		normalized := strings.ToLower(strings.ReplaceAll(strings.TrimSpace(line), "_", "-"))
+		return strings.HasPrefix(normalized, dep.Name)
+	case pb.DepEcosystem_DEP_ECOSYSTEM_UNSPECIFIED:
+		return false
+	default:
+		return false
+	}
+}
+
+func countLeadingWhitespace(line string) int {
+	count := 0
+	for _, ch := range line {
+		if ch != ' ' && ch != '\t' {
+			return count
+		}
+		count++
+	}
+	return count
+}
+
+// locateDepInPr returns where the dependency is declared in the patch of the
+// file it was found in or nil if it could not be located
+func locateDepInPr(
+	ctx context.Context,
+	client provifv1.GitHub,
+	finding *Finding,
+) (*Location, error) {
+	req, err := client.NewRequest("GET", finding.Dependency.File.PatchUrl, nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not create request: %w", err)
+	}
+	resp, err := client.Do(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("could not send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	content, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("could not read response body: %w", err)
+	}
+
+	for i, line := range strings.Split(string(content), "\n") {
+		if finding.lineHasDependency(line) {
+			return &Location{
+				Line:              line,
+				Number:            i + 1,
+				LeadingWhitespace: countLeadingWhitespace(line),
+			}, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// ReviewHandler reports the findings as a PR review with a comment on the
+// line of each dependency
+type ReviewHandler struct {
+	cli provifv1.GitHub
+	pr  *pb.PullRequest
+	cfg *ReviewConfig
+
+	minderReview *github.PullRequestReview
+	failStatus   *string
+
+	findings []*Finding
+	comments []*github.DraftReviewComment
+	status   *string
+	text     string
+
+	logger zerolog.Logger
+}
+
+// ReviewHandlerOption is an option of the ReviewHandler
+type ReviewHandlerOption func(*ReviewHandler)
+
+// WithFailStatus sets the review status used if there are findings
+func WithFailStatus(status *string) ReviewHandlerOption {
+	return func(r *ReviewHandler) {
+		r.failStatus = status
+	}
+}
+
+// NewReviewHandler returns a handler that reviews the PR
+func NewReviewHandler(
+	ctx context.Context,
+	pr *pb.PullRequest,
+	cli provifv1.GitHub,
+	cfg *ReviewConfig,
+	opts ...ReviewHandlerOption,
+) (*ReviewHandler, error) {
+	if pr == nil {
+		return nil, fmt.Errorf("pr was nil, can't review")
+	}
+
+	logger := zerolog.Ctx(ctx).With().
+		Int32("pull-number", pr.Number).
+		Str("repo-owner", pr.RepoOwner).
+		Str("repo-name", pr.RepoName).
+		Logger()
+
+	cliUser, err := cli.GetAuthenticatedUser(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not get authenticated user: %w", err)
+	}
+
+	// if the user wants minder to request changes on a pull request, they need to
+	// be different identities
+	var failStatus *string
+	if pr.AuthorId == cliUser.GetID() {
+		failStatus = github.String("COMMENT")
+		logger.Debug().Msg("author is the same as the authenticated user, can only comment")
+	} else {
+		failStatus = github.String("REQUEST_CHANGES")
+		logger.Debug().Msg("author is different than the authenticated user, can request changes")
+	}
+
+	handler := &ReviewHandler{
+		cli:        cli,
+		pr:         pr,
+		cfg:        cfg,
+		comments:   []*github.DraftReviewComment{},
+		logger:     logger,
+		failStatus: failStatus,
+	}
+
+	for _, opt := range opts {
+		opt(handler)
+	}
+
+	return handler, nil
+}
+
+// Track adds a review comment on the line declaring the dependency
+func (ra *ReviewHandler) Track(ctx context.Context, finding *Finding) error {
+	ra.findings = append(ra.findings, finding)
+
+	location, err := locateDepInPr(ctx, ra.cli, finding)
+	if err != nil {
+		return fmt.Errorf("could not locate dependency in PR: %w", err)
+	}
+
+	// the finding still counts for the review status if we can't comment on the line
+	if location == nil {
+		ra.logger.Debug().
+			Str("dep-name", finding.Dependency.Dep.Name).
+			Msg("could not locate dependency in PR, not adding a line comment")
+		return nil
+	}
+
+	body, lineTo := finding.ReviewComment(location)
+	reviewComment := &github.DraftReviewComment{
+		Path: github.String(finding.Dependency.File.Name),
+		Body: github.String(body),
+	}
+
+	if lineTo > 0 {
+		reviewComment.StartLine = github.Int(location.Number)
+		reviewComment.Line = github.Int(location.Number + lineTo)
+	} else {
+		reviewComment.Line = github.Int(location.Number)
+	}
+
+	ra.comments = append(ra.comments, reviewComment)
+
+	ra.logger.Debug().
+		Str("dep-name", finding.Dependency.Dep.Name).
+		Msg("dependency finding tracked")
+
+	return nil
+}
+
+// Submit dismisses the previous review and submits a new one
+func (ra *ReviewHandler) Submit(ctx context.Context) error {
+	if err := ra.findPreviousReview(ctx); err != nil {
+		return fmt.Errorf("could not find previous review: %w", err)
+	}
+
+	if ra.minderReview != nil {
+		if err := ra.dismissReview(ctx); err != nil {
+			ra.logger.Error().Err(err).
+				Int64("review-id", ra.minderReview.GetID()).
+				Msg("could not dismiss previous review")
+		} else {
+			ra.logger.Debug().
+				Int64("review-id", ra.minderReview.GetID()).
+				Msg("dismissed previous review")
+		}
+	}
+
+	// either there are changes to request or just send the first review mentioning that everything is ok
+	ra.setStatus()
+	if err := ra.submitReview(ctx); err != nil {
+		return fmt.Errorf("could not submit review: %w", err)
+	}
+	ra.logger.Debug().Msg("submitted review")
+	return nil
+}
+
+func (ra *ReviewHandler) setStatus() {
+	if len(ra.findings) > 0 {
+		var sb strings.Builder
+		sb.WriteString(ra.cfg.FoundText)
+		listed := false
+		for _, f := range ra.findings {
+			if f.Description == "" {
+				continue
+			}
+			if !listed {
+				sb.WriteString("\n")
+				listed = true
+			}
+			sb.WriteString(fmt.Sprintf("- %s\n", f.Description))
+		}
+		ra.text = sb.String()
+		ra.status = ra.failStatus
+	} else {
+		ra.text = ra.cfg.NotFoundText
+		ra.status = github.String("COMMENT")
+	}
+
+	ra.logger.Debug().Str("status", *ra.status).Msg("will set review status")
+}
+
+func (ra *ReviewHandler) findPreviousReview(ctx context.Context) error {
+	reviews, err := ra.cli.ListReviews(ctx, ra.pr.RepoOwner, ra.pr.RepoName, int(ra.pr.Number), nil)
+	if err != nil {
+		return fmt.Errorf("could not list reviews: %w", err)
+	}
+
+	ra.minderReview = nil
+	for _, r := range reviews {
+		if strings.HasPrefix(r.GetBody(), ra.cfg.MagicComment) && r.GetState() != "DISMISSED" {
+			ra.minderReview = r
+			break
+		}
+	}
+
+	return nil
+}
+
+func (ra *ReviewHandler) submitReview(ctx context.Context) error {
+	review := &github.PullRequestReviewRequest{
+		CommitID: github.String(ra.pr.CommitSha),
+		Event:    ra.status,
+		Comments: ra.comments,
+		Body:     github.String(ra.cfg.ReviewBody(ra.text)),
+	}
+
+	_, err := ra.cli.CreateReview(
+		ctx,
+		ra.pr.RepoOwner,
+		ra.pr.RepoName,
+		int(ra.pr.Number),
+		review,
+	)
+	if err != nil {
+		return fmt.Errorf("could not create review: %w", err)
+	}
+
+	return nil
+}
+
+func (ra *ReviewHandler) dismissReview(ctx context.Context) error {
+	dismissReview := &github.PullRequestReviewDismissalRequest{
+		Message: github.String(ReviewDismissText),
+	}
+
+	_, err := ra.cli.DismissReview(
+		ctx,
+		ra.pr.RepoOwner,
+		ra.pr.RepoName,
+		int(ra.pr.Number),
+		ra.minderReview.GetID(),
+		dismissReview)
+	if err != nil {
+		return fmt.Errorf("could not dismiss review: %w", err)
+	}
+	return nil
+}
+
+// CommitStatusHandler reviews the PR without blocking it and sets a commit
+// status that fails if there are findings
+type CommitStatusHandler struct {
+	// embed the ReviewHandler to automatically satisfy the StatusHandler interface
+	ReviewHandler
+}
+
+// NewCommitStatusHandler returns a handler that sets the commit status of the PR
+func NewCommitStatusHandler(
+	ctx context.Context,
+	pr *pb.PullRequest,
+	cli provifv1.GitHub,
+	cfg *ReviewConfig,
+) (*CommitStatusHandler, error) {
+	rph, err := NewReviewHandler(ctx, pr, cli, cfg, WithFailStatus(github.String("COMMENT")))
+	if err != nil {
+		return nil, fmt.Errorf("could not create review handler: %w", err)
+	}
+
+	return &CommitStatusHandler{
+		ReviewHandler: *rph,
+	}, nil
+}
+
+// Submit submits the review and sets the commit status
+func (csh *CommitStatusHandler) Submit(ctx context.Context) error {
+	// first submit the review, the status is forced to COMMENT to not block
+	if err := csh.ReviewHandler.Submit(ctx); err != nil {
+		return fmt.Errorf("could not submit review: %w", err)
+	}
+
+	commitStatus := &github.RepoStatus{
+		Context: github.String(csh.cfg.StatusContext),
+	}
+
+	if len(csh.findings) > 0 {
+		commitStatus.State = github.String("failure")
+		commitStatus.Description = github.String(csh.cfg.StatusFailureText)
+	} else {
+		commitStatus.State = github.String("success")
+		commitStatus.Description = github.String(csh.cfg.StatusSuccessText)
+	}
+
+	csh.logger.Debug().
+		Str("commit-status", commitStatus.String()).
+		Str("commit-sha", csh.pr.CommitSha).
+		Msg("setting commit status")
+
+	_, err := csh.cli.SetCommitStatus(ctx, csh.pr.RepoOwner, csh.pr.RepoName, csh.pr.CommitSha, commitStatus)
+	if err != nil {
+		return fmt.Errorf("could not set commit status: %w", err)
+	}
+	return nil
+}
+
+// SummaryHandler adds a summary of the findings to the PR as a comment
+type SummaryHandler struct {
+	cli provifv1.GitHub
+	pr  *pb.PullRequest
+	cfg *ReviewConfig
+
+	rows []any
+}
+
+// NewSummaryHandler returns a handler that comments a summary of the findings on the PR
+func NewSummaryHandler(
+	_ context.Context,
+	pr *pb.PullRequest,
+	cli provifv1.GitHub,
+	cfg *ReviewConfig,
+) (*SummaryHandler, error) {
+	if cfg.SummaryTemplate == nil {
+		return nil, fmt.Errorf("no summary template configured")
+	}
+
+	return &SummaryHandler{
+		cli:  cli,
+		pr:   pr,
+		cfg:  cfg,
+		rows: make([]any, 0),
+	}, nil
+}
+
+// Track adds the summary row of the finding
+func (sph *SummaryHandler) Track(_ context.Context, finding *Finding) error {
+	sph.rows = append(sph.rows, finding.SummaryRow)
+	return nil
+}
+
+func (sph *SummaryHandler) generateSummary() (string, error) {
+	if len(sph.rows) == 0 {
+		return sph.cfg.NotFoundText, nil
+	}
+
+	var buf bytes.Buffer
+	if err := sph.cfg.SummaryTemplate.Execute(&buf, sph.rows); err != nil {
+		return "", fmt.Errorf("could not execute template: %w", err)
+	}
+	return buf.String(), nil
+}
+
+// Submit comments the summary on the PR
+func (sph *SummaryHandler) Submit(ctx context.Context) error {
+	summary, err := sph.generateSummary()
+	if err != nil {
+		return fmt.Errorf("could not generate summary: %w", err)
+	}
+
+	err = sph.cli.CreateComment(ctx, sph.pr.GetRepoOwner(), sph.pr.GetRepoName(), int(sph.pr.GetNumber()), summary)
+	if err != nil {
+		return fmt.Errorf("could not create comment: %w", err)
+	}
+
+	return nil
+}
+
+// just satisfies the interface but really does nothing. Used for profile_only
+// and when evaluating a full repository checkout where there is no PR.
+type profileOnlyHandler struct{}
+
+func (profileOnlyHandler) Track(_ context.Context, _ *Finding) error {
+	return nil
+}
+
+func (profileOnlyHandler) Submit(_ context.Context) error {
+	return nil
+}
+
+// NewProfileOnlyHandler returns a handler that doesn't report anything
+func NewProfileOnlyHandler() StatusHandler {
+	return &profileOnlyHandler{}
+}
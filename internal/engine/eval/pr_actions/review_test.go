@@ -0,0 +1,132 @@
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pr_actions
+
+import (
+	"context"
+	htmltemplate "html/template"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-github/v53/github"
+	"github.com/stretchr/testify/require"
+
+	mock_ghclient "github.com/stacklok/minder/internal/providers/github/mock"
+	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+const patchURL = "https://example.com/patch"
+
+var testConfig = &ReviewConfig{
+	MagicComment:      "<!-- minder: test -->",
+	StatusContext:     "minder.stacklok.dev/test",
+	FoundText:         "Found problems.\n",
+	NotFoundText:      "No problems.\n",
+	StatusFailureText: "Problems found.",
+	StatusSuccessText: "No problems.",
+	SummaryTemplate:   htmltemplate.Must(htmltemplate.New("summary").Parse(`{{ range . }}{{ . }};{{ end }}`)),
+}
+
+func testPr() *pb.PullRequest {
+	return &pb.PullRequest{
+		CommitSha: "27d6810b861c81e8c61e09c651875f5a976781d1",
+		Number:    43,
+		RepoOwner: "stacklok",
+		RepoName:  "minder",
+		AuthorId:  1,
+	}
+}
+
+func testFinding(name string) *Finding {
+	return &Finding{
+		Dependency: &pb.PrDependencies_ContextualDependency{
+			Dep: &pb.Dependency{Ecosystem: pb.DepEcosystem_DEP_ECOSYSTEM_NPM, Name: name, Version: "1.0.0"},
+			File: &pb.PrDependencies_ContextualDependency_FilePatch{
+				Name:     "package-lock.json",
+				PatchUrl: patchURL,
+			},
+		},
+		ReviewComment: func(*Location) (string, int) {
+			return name + " is bad", 0
+		},
+		Description: "`" + name + "`",
+		SummaryRow:  name,
+	}
+}
+
+func TestReviewHandlerFindings(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mock_ghclient.NewMockGitHub(ctrl)
+	pr := testPr()
+
+	mockClient.EXPECT().GetAuthenticatedUser(gomock.Any()).Return(&github.User{ID: github.Int64(2)}, nil)
+	handler, err := NewStatusHandler(context.Background(), ActionReviewPr, pr, mockClient, testConfig)
+	require.NoError(t, err)
+
+	// the first dependency is in the patch, the second one can't be located
+	for _, patch := range []string{"+    \"left-pad\": {", "+    \"something\": {"} {
+		mockClient.EXPECT().NewRequest("GET", patchURL, nil).Return(http.NewRequest("GET", patchURL, nil))
+		mockClient.EXPECT().Do(gomock.Any(), gomock.Any()).Return(&http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(patch)),
+		}, nil)
+	}
+	require.NoError(t, handler.Track(context.Background(), testFinding("left-pad")))
+	require.NoError(t, handler.Track(context.Background(), testFinding("is-odd")))
+
+	mockClient.EXPECT().
+		ListReviews(gomock.Any(), pr.RepoOwner, pr.RepoName, int(pr.Number), nil).
+		Return([]*github.PullRequestReview{}, nil)
+	mockClient.EXPECT().
+		CreateReview(gomock.Any(), pr.RepoOwner, pr.RepoName, int(pr.Number), &github.PullRequestReviewRequest{
+			CommitID: github.String(pr.CommitSha),
+			Event:    github.String("REQUEST_CHANGES"),
+			Body:     github.String(testConfig.ReviewBody("Found problems.\n\n- `left-pad`\n- `is-odd`\n")),
+			Comments: []*github.DraftReviewComment{
+				{
+					Path: github.String("package-lock.json"),
+					Line: github.Int(1),
+					Body: github.String("left-pad is bad"),
+				},
+			},
+		})
+	require.NoError(t, handler.Submit(context.Background()))
+}
+
+func TestSummaryHandler(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mock_ghclient.NewMockGitHub(ctrl)
+	pr := testPr()
+
+	handler, err := NewStatusHandler(context.Background(), ActionSummary, pr, mockClient, testConfig)
+	require.NoError(t, err)
+
+	require.NoError(t, handler.Track(context.Background(), testFinding("left-pad")))
+	require.NoError(t, handler.Track(context.Background(), testFinding("is-odd")))
+
+	mockClient.EXPECT().CreateComment(gomock.Any(), pr.RepoOwner, pr.RepoName, int(pr.Number), "left-pad;is-odd;")
+	require.NoError(t, handler.Submit(context.Background()))
+}
@@ -29,6 +29,9 @@ import (
 
 const (
 	noLowScoresText = "Minder analyzed this PR and found no low scores for any of the dependencies."
+	unscoredText    = `
+Minder could not check the following dependencies because Trusty was unavailable:
+`
 
 	tableHeaderTmplName = "alternativesTableHeader"
 	tableTemplateHeader = `### Summary of packages with low scores
@@ -71,6 +74,7 @@ type summaryPrHandler struct {
 	trustyUrl string
 
 	trackedAlternatives []dependencyAlternatives
+	unscored            []*pb.Dependency
 	headerTmpl          *htmltemplate.Template
 	rowsTmpl            *htmltemplate.Template
 }
@@ -85,6 +89,12 @@ func (sph *summaryPrHandler) trackAlternatives(
 	})
 }
 
+// trackUnscored records a dependency that could not be scored because the
+// Trusty API was unavailable
+func (sph *summaryPrHandler) trackUnscored(dep *pb.PrDependencies_ContextualDependency) {
+	sph.unscored = append(sph.unscored, dep.Dep)
+}
+
 func (sph *summaryPrHandler) submit(ctx context.Context) error {
 	summary, err := sph.generateSummary()
 	if err != nil {
@@ -103,6 +113,7 @@ func (sph *summaryPrHandler) generateSummary() (string, error) {
 	var summary strings.Builder
 	if len(sph.trackedAlternatives) == 0 {
 		summary.WriteString(noLowScoresText)
+		sph.writeUnscored(&summary)
 		return summary.String(), nil
 	}
 
@@ -140,10 +151,23 @@ func (sph *summaryPrHandler) generateSummary() (string, error) {
 		summary.WriteString(rowBuf.String())
 	}
 	summary.WriteString(tableFooter)
+	sph.writeUnscored(&summary)
 
 	return summary.String(), nil
 }
 
+func (sph *summaryPrHandler) writeUnscored(summary *strings.Builder) {
+	if len(sph.unscored) == 0 {
+		return
+	}
+
+	summary.WriteString("\n")
+	summary.WriteString(unscoredText)
+	for _, dep := range sph.unscored {
+		summary.WriteString(fmt.Sprintf("- %s: `%s`\n", strings.ToLower(dep.Ecosystem.AsString()), dep.Name))
+	}
+}
+
 func newSummaryPrHandler(
 	pr *pb.PullRequest,
 	cli provifv1.GitHub,
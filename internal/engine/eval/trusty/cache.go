@@ -0,0 +1,98 @@
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trusty
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+// maxCachedReplies bounds the number of replies kept in the cache
+const maxCachedReplies = 10000
+
+// replyCache caches the replies of the Trusty API. It is shared by all the
+// evaluations of the process, scores change slowly and the same dependencies
+// are added to many pull requests.
+type replyCache struct {
+	mu      sync.Mutex
+	entries map[string]cachedReply
+	now     func() time.Time
+}
+
+type cachedReply struct {
+	reply     *Reply
+	fetchedAt time.Time
+}
+
+// sharedReplyCache is the cache used by all trusty evaluators
+var sharedReplyCache = newReplyCache()
+
+func newReplyCache() *replyCache {
+	return &replyCache{
+		entries: make(map[string]cachedReply),
+		now:     time.Now,
+	}
+}
+
+func replyCacheKey(endpoint string, dep *pb.Dependency) string {
+	return strings.Join([]string{endpoint, strings.ToLower(dep.Ecosystem.AsString()), dep.Name}, "|")
+}
+
+// get returns the cached reply if it was fetched less than ttl ago. The TTL is
+// passed by the caller because each profile can configure its own.
+func (c *replyCache) get(endpoint string, dep *pb.Dependency, ttl time.Duration) (*Reply, bool) {
+	if ttl <= 0 {
+		return nil, false
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[replyCacheKey(endpoint, dep)]
+	if !ok || c.now().Sub(entry.fetchedAt) >= ttl {
+		return nil, false
+	}
+	return entry.reply, true
+}
+
+func (c *replyCache) put(endpoint string, dep *pb.Dependency, reply *Reply, ttl time.Duration) {
+	if ttl <= 0 {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.now()
+	if len(c.entries) >= maxCachedReplies {
+		for key, entry := range c.entries {
+			if now.Sub(entry.fetchedAt) >= ttl {
+				delete(c.entries, key)
+			}
+		}
+		// everything is still fresh, start over rather than grow unbounded
+		if len(c.entries) >= maxCachedReplies {
+			c.entries = make(map[string]cachedReply)
+		}
+	}
+
+	c.entries[replyCacheKey(endpoint, dep)] = cachedReply{
+		reply:     reply,
+		fetchedAt: now,
+	}
+}
@@ -19,6 +19,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/mitchellh/mapstructure"
@@ -27,16 +28,48 @@ import (
 	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
 )
 
+// unavailableMode is what the evaluator does when the Trusty API can't be reached
+type unavailableMode string
+
+const (
+	// unavailableSkip skips the dependencies that could not be scored
+	unavailableSkip unavailableMode = "skip"
+	// unavailableWarn skips the dependencies that could not be scored, but
+	// lists them in the PR summary
+	unavailableWarn unavailableMode = "warn"
+	// unavailableFail fails the evaluation
+	unavailableFail unavailableMode = "fail"
+)
+
+const (
+	// defaultCacheTTL is how long Trusty scores are cached if not configured
+	defaultCacheTTL = time.Hour
+)
+
 type ecosystemConfig struct {
 	Name string `json:"name" mapstructure:"name" validate:"required"`
-	//nolint:lll
-	Score float64 `json:"score" mapstructure:"score" validate:"required"`
+	// Score is the minimum score of the ecosystem. The top-level score is
+	// used if not set.
+	Score float64 `json:"score" mapstructure:"score" validate:"gte=0,lte=10"`
+	// Allow lists packages of the ecosystem that are never reported
+	Allow []string `json:"allow" mapstructure:"allow"`
 }
 
-// config is the configuration for the vulncheck evaluator
+// config is the configuration for the trusty evaluator
 type config struct {
-	Action          pr_actions.Action `json:"action" mapstructure:"action" validate:"required"`
-	EcosystemConfig []ecosystemConfig `json:"ecosystem_config" mapstructure:"ecosystem_config" validate:"required"`
+	Action pr_actions.Action `json:"action" mapstructure:"action" validate:"required"`
+	// Score is the default minimum score of all ecosystems
+	Score float64 `json:"score" mapstructure:"score" validate:"gte=0,lte=10"`
+	// Allow lists packages that are never reported, regardless of their ecosystem
+	Allow []string `json:"allow" mapstructure:"allow"`
+	// CacheTTL is how long the score of a package is reused, e.g. "30m". Set
+	// to "0" to disable caching.
+	CacheTTL string `json:"cache_ttl" mapstructure:"cache_ttl"`
+	//nolint:lll
+	OnUnavailable   unavailableMode   `json:"on_unavailable" mapstructure:"on_unavailable" validate:"omitempty,oneof=skip warn fail"`
+	EcosystemConfig []ecosystemConfig `json:"ecosystem_config" mapstructure:"ecosystem_config" validate:"required,dive"`
+
+	cacheTTL time.Duration
 }
 
 func parseConfig(ruleCfg map[string]any) (*config, error) {
@@ -55,6 +88,29 @@ func parseConfig(ruleCfg map[string]any) (*config, error) {
 		return nil, fmt.Errorf("config failed validation: %w", err)
 	}
 
+	conf.cacheTTL = defaultCacheTTL
+	if conf.CacheTTL != "" {
+		ttl, err := time.ParseDuration(conf.CacheTTL)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse cache_ttl: %w", err)
+		}
+		if ttl < 0 {
+			return nil, fmt.Errorf("cache_ttl must not be negative")
+		}
+		conf.cacheTTL = ttl
+	}
+
+	// failing was the only behavior before the mode was configurable
+	if conf.OnUnavailable == "" {
+		conf.OnUnavailable = unavailableFail
+	}
+
+	for _, eco := range conf.EcosystemConfig {
+		if eco.Score == 0 && conf.Score == 0 {
+			return nil, fmt.Errorf("no score threshold configured for ecosystem %s", eco.Name)
+		}
+	}
+
 	return &conf, nil
 }
 
@@ -73,3 +129,23 @@ func (c *config) getEcosystemConfig(ecosystem pb.DepEcosystem) *ecosystemConfig
 
 	return nil
 }
+
+// threshold returns the minimum score of the ecosystem
+func (c *config) threshold(eco *ecosystemConfig) float64 {
+	if eco.Score != 0 {
+		return eco.Score
+	}
+	return c.Score
+}
+
+// isAllowed returns true if the package must not be reported
+func (c *config) isAllowed(eco *ecosystemConfig, name string) bool {
+	for _, list := range [][]string{c.Allow, eco.Allow} {
+		for _, a := range list {
+			if strings.EqualFold(a, name) {
+				return true
+			}
+		}
+	}
+	return false
+}
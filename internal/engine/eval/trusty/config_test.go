@@ -0,0 +1,117 @@
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trusty
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+func TestParseConfig(t *testing.T) {
+	t.Parallel()
+
+	t.Run("defaults", func(t *testing.T) {
+		t.Parallel()
+
+		conf, err := parseConfig(map[string]any{
+			"action": "summary",
+			"ecosystem_config": []map[string]any{
+				{"name": "npm", "score": 5},
+			},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, defaultCacheTTL, conf.cacheTTL)
+		assert.Equal(t, unavailableFail, conf.OnUnavailable)
+	})
+
+	t.Run("thresholds and allow lists", func(t *testing.T) {
+		t.Parallel()
+
+		conf, err := parseConfig(map[string]any{
+			"action":         "summary",
+			"score":          4,
+			"allow":          []string{"left-pad"},
+			"cache_ttl":      "0",
+			"on_unavailable": "warn",
+			"ecosystem_config": []map[string]any{
+				{"name": "npm", "score": 7, "allow": []string{"is-odd"}},
+				{"name": "pypi"},
+			},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, time.Duration(0), conf.cacheTTL)
+		assert.Equal(t, unavailableWarn, conf.OnUnavailable)
+
+		npm, pypi := &conf.EcosystemConfig[0], &conf.EcosystemConfig[1]
+		assert.Equal(t, float64(7), conf.threshold(npm))
+		assert.Equal(t, float64(4), conf.threshold(pypi))
+		assert.True(t, conf.isAllowed(npm, "Left-Pad"))
+		assert.True(t, conf.isAllowed(npm, "is-odd"))
+		assert.False(t, conf.isAllowed(pypi, "is-odd"))
+	})
+
+	t.Run("missing threshold", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := parseConfig(map[string]any{
+			"action":           "summary",
+			"ecosystem_config": []map[string]any{{"name": "npm"}},
+		})
+		require.Error(t, err)
+	})
+
+	t.Run("invalid unavailable mode", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := parseConfig(map[string]any{
+			"action":           "summary",
+			"on_unavailable":   "retry",
+			"ecosystem_config": []map[string]any{{"name": "npm", "score": 5}},
+		})
+		require.Error(t, err)
+	})
+}
+
+func TestReplyCache(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2023, 11, 10, 10, 0, 0, 0, time.UTC)
+	cache := newReplyCache()
+	cache.now = func() time.Time { return now }
+
+	dep := &pb.Dependency{Ecosystem: pb.DepEcosystem_DEP_ECOSYSTEM_NPM, Name: "lodash"}
+	reply := &Reply{PackageName: "lodash"}
+
+	cache.put("https://trusty", dep, reply, time.Hour)
+
+	cached, ok := cache.get("https://trusty", dep, time.Hour)
+	require.True(t, ok)
+	assert.Equal(t, reply, cached)
+
+	_, ok = cache.get("https://other", dep, time.Hour)
+	assert.False(t, ok, "replies of another endpoint must not be reused")
+
+	_, ok = cache.get("https://trusty", dep, 0)
+	assert.False(t, ok, "a zero TTL disables the cache")
+
+	now = now.Add(2 * time.Hour)
+	_, ok = cache.get("https://trusty", dep, time.Hour)
+	assert.False(t, ok, "expired replies must not be reused")
+}
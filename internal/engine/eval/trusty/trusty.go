@@ -17,6 +17,7 @@ package trusty
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 
@@ -39,6 +40,7 @@ const (
 type Evaluator struct {
 	cli      provifv1.GitHub
 	endpoint string
+	cache    *replyCache
 }
 
 // NewTrustyEvaluator creates a new trusty evaluator
@@ -62,6 +64,7 @@ func NewTrustyEvaluator(
 	return &Evaluator{
 		cli:      ghcli,
 		endpoint: pie.GetEndpoint(),
+		cache:    sharedReplyCache,
 	}, nil
 }
 
@@ -71,8 +74,7 @@ func NewTrustyEvaluator(
 func (e *Evaluator) Eval(ctx context.Context, pol map[string]any, res *engif.Result) error {
 	var lowScoringPackages []string
 
-	//nolint:govet
-	prdeps, ok := res.Object.(pb.PrDependencies)
+	prdeps, ok := res.Object.(*pb.PrDependencies)
 	if !ok {
 		return fmt.Errorf("invalid object type for vulncheck evaluator")
 	}
@@ -116,8 +118,24 @@ func (e *Evaluator) Eval(ctx context.Context, pol map[string]any, res *engif.Res
 			continue
 		}
 
-		resp, err := piCli.SendRecvRequest(ctx, dep.Dep)
-		if err != nil {
+		if ruleConfig.isAllowed(ecoConfig, dep.Dep.Name) {
+			logger.Debug().
+				Str("dependency", dep.Dep.Name).
+				Msgf("the dependency is allowed, skipping")
+			continue
+		}
+
+		resp, err := e.getReply(ctx, piCli, ruleConfig, dep.Dep)
+		if errors.Is(err, errTrustyUnavailable) && ruleConfig.OnUnavailable != unavailableFail {
+			logger.Warn().
+				Err(err).
+				Str("dependency", dep.Dep.Name).
+				Msgf("trusty is unavailable, skipping")
+			if ruleConfig.OnUnavailable == unavailableWarn {
+				prSummaryHandler.trackUnscored(dep)
+			}
+			continue
+		} else if err != nil {
 			return fmt.Errorf("failed to send request: %w", err)
 		}
 
@@ -135,11 +153,12 @@ func (e *Evaluator) Eval(ctx context.Context, pol map[string]any, res *engif.Res
 			continue
 		}
 
-		if resp.Summary.Score >= ecoConfig.Score {
+		threshold := ruleConfig.threshold(ecoConfig)
+		if resp.Summary.Score >= threshold {
 			logger.Debug().
 				Str("dependency", dep.Dep.Name).
 				Float64("pkgScore", resp.Summary.Score).
-				Float64("threshold", ecoConfig.Score).
+				Float64("threshold", threshold).
 				Msgf("the dependency has higher score than threshold, skipping")
 			continue
 		}
@@ -147,7 +166,7 @@ func (e *Evaluator) Eval(ctx context.Context, pol map[string]any, res *engif.Res
 		logger.Debug().
 			Str("dependency", dep.Dep.Name).
 			Float64("pkgScore", resp.Summary.Score).
-			Float64("threshold", ecoConfig.Score).
+			Float64("threshold", threshold).
 			Msgf("the dependency has lower score than threshold, tracking")
 
 		lowScoringPackages = append(lowScoringPackages, dep.Dep.Name)
@@ -165,6 +184,26 @@ func (e *Evaluator) Eval(ctx context.Context, pol map[string]any, res *engif.Res
 	return nil
 }
 
+// getReply returns the cached Trusty reply of the dependency or asks the Trusty API
+func (e *Evaluator) getReply(
+	ctx context.Context,
+	piCli *trustyClient,
+	ruleConfig *config,
+	dep *pb.Dependency,
+) (*Reply, error) {
+	if resp, ok := e.cache.get(e.endpoint, dep, ruleConfig.cacheTTL); ok {
+		return resp, nil
+	}
+
+	resp, err := piCli.SendRecvRequest(ctx, dep)
+	if err != nil {
+		return nil, err
+	}
+
+	e.cache.put(e.endpoint, dep, resp, ruleConfig.cacheTTL)
+	return resp, nil
+}
+
 func isActionImplemented(action pr_actions.Action) bool {
 	return action == pr_actions.ActionSummary
 }
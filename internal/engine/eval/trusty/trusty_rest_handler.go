@@ -18,6 +18,7 @@ package trusty
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -26,6 +27,10 @@ import (
 	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
 )
 
+// errTrustyUnavailable is returned when the Trusty API can't be reached or
+// is not able to serve the request at the moment
+var errTrustyUnavailable = errors.New("trusty API is unavailable")
+
 func urlFromEndpointAndPaths(
 	baseUrl string,
 	endpoint string,
@@ -100,10 +105,13 @@ func (p *trustyClient) SendRecvRequest(ctx context.Context, dep *pb.Dependency)
 
 	resp, err := p.client.Do(req)
 	if err != nil {
-		return nil, fmt.Errorf("could not send request: %w", err)
+		return nil, fmt.Errorf("%w: could not send request: %w", errTrustyUnavailable, err)
 	}
 	defer resp.Body.Close()
 
+	if resp.StatusCode >= http.StatusInternalServerError || resp.StatusCode == http.StatusTooManyRequests {
+		return nil, fmt.Errorf("%w: received response: %d", errTrustyUnavailable, resp.StatusCode)
+	}
 	if resp.StatusCode != http.StatusOK {
 		return nil, fmt.Errorf("received non-200 response: %d", resp.StatusCode)
 	}
@@ -0,0 +1,118 @@
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package typosquat
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/mitchellh/mapstructure"
+
+	"github.com/stacklok/minder/internal/engine/eval/pr_actions"
+	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+const (
+	// defaultMaxDistance is the default maximum edit distance between a
+	// dependency name and a popular package name to be considered a typosquat
+	defaultMaxDistance = 2
+	// minNameLength is the length under which names are only compared with an
+	// edit distance of 1, short names are too close to each other otherwise
+	minNameLength = 5
+)
+
+type packageRepository struct {
+	Url string `json:"url" mapstructure:"url" validate:"required"`
+}
+
+type ecosystemConfig struct {
+	Name string `json:"name" mapstructure:"name" validate:"required"`
+	// PackageRepository is used to look up the publication date and number
+	// of versions of a package. The checks are skipped if not set.
+	PackageRepository *packageRepository `json:"package_repository" mapstructure:"package_repository"`
+	// PopularPackages extends the bundled list of popular packages
+	PopularPackages []string `json:"popular_packages" mapstructure:"popular_packages"`
+	// PopularPackagesUrl points to a newline separated list of popular packages
+	// that extends the bundled list, allowing it to be updated without a release.
+	PopularPackagesUrl string `json:"popular_packages_url" mapstructure:"popular_packages_url"`
+	// TrustedScopes are npm scopes whose packages are never flagged for
+	// scope confusion, e.g. the organization's own scope
+	TrustedScopes []string `json:"trusted_scopes" mapstructure:"trusted_scopes"`
+}
+
+// config is the configuration for the typosquat evaluator
+type config struct {
+	Action pr_actions.Action `json:"action" mapstructure:"action" validate:"required"`
+	// MaxDistance is the maximum edit distance to a popular package name
+	MaxDistance int `json:"max_distance" mapstructure:"max_distance" validate:"gte=0"`
+	// MinAgeDays flags packages whose first version was published fewer
+	// days ago. Disabled if zero.
+	MinAgeDays int `json:"min_age_days" mapstructure:"min_age_days" validate:"gte=0"`
+	// MinVersions flags packages with fewer published versions. Disabled if zero.
+	MinVersions int `json:"min_versions" mapstructure:"min_versions" validate:"gte=0"`
+	// Allow lists dependency names that are never flagged
+	Allow           []string          `json:"allow" mapstructure:"allow"`
+	EcosystemConfig []ecosystemConfig `json:"ecosystem_config" mapstructure:"ecosystem_config" validate:"required,dive"`
+}
+
+func parseConfig(ruleCfg map[string]any) (*config, error) {
+	if ruleCfg == nil {
+		return nil, errors.New("config was missing")
+	}
+
+	var conf config
+	validate := validator.New(validator.WithRequiredStructEnabled())
+
+	if err := mapstructure.Decode(ruleCfg, &conf); err != nil {
+		return nil, fmt.Errorf("could not parse config: %w", err)
+	}
+
+	if err := validate.Struct(&conf); err != nil {
+		return nil, fmt.Errorf("config failed validation: %w", err)
+	}
+
+	if conf.MaxDistance == 0 {
+		conf.MaxDistance = defaultMaxDistance
+	}
+
+	return &conf, nil
+}
+
+func (c *config) getEcosystemConfig(ecosystem pb.DepEcosystem) *ecosystemConfig {
+	sEco := ecosystem.AsString()
+	if sEco == "" {
+		return nil
+	}
+	sEco = strings.ToLower(sEco)
+
+	for _, eco := range c.EcosystemConfig {
+		if strings.ToLower(eco.Name) == sEco {
+			return &eco
+		}
+	}
+
+	return nil
+}
+
+func (c *config) isAllowed(name string) bool {
+	for _, a := range c.Allow {
+		if strings.EqualFold(a, name) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,140 @@
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package typosquat
+
+import (
+	"bufio"
+	"context"
+	"embed"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// maxPopularListSize is the largest remote list of popular packages we are
+// willing to download
+const maxPopularListSize = 8 << 20
+
+//go:embed popular/*.txt
+var bundledPopular embed.FS
+
+// popularIndex is the set of popular package names of an ecosystem
+type popularIndex struct {
+	ecosystem string
+	// names maps the normalized name to the name as listed
+	names map[string]string
+	// skeletons maps the skeleton of a name to the names that have it
+	skeletons map[string][]string
+}
+
+func newPopularIndex(ecosystem string) *popularIndex {
+	return &popularIndex{
+		ecosystem: strings.ToLower(ecosystem),
+		names:     make(map[string]string),
+		skeletons: make(map[string][]string),
+	}
+}
+
+// loadPopularIndex builds the index of popular packages of the ecosystem from the
+// bundled list, the packages listed in the configuration and the remote list
+// configured with popular_packages_url
+func loadPopularIndex(ctx context.Context, cli *http.Client, ecoConfig *ecosystemConfig) (*popularIndex, error) {
+	idx := newPopularIndex(ecoConfig.Name)
+
+	bundled, err := bundledPopular.Open("popular/" + idx.ecosystem + ".txt")
+	if err == nil {
+		defer bundled.Close()
+		if err := idx.addList(bundled); err != nil {
+			return nil, fmt.Errorf("could not read bundled list of popular packages: %w", err)
+		}
+	}
+
+	for _, name := range ecoConfig.PopularPackages {
+		idx.add(name)
+	}
+
+	if ecoConfig.PopularPackagesUrl != "" {
+		if err := idx.addRemoteList(ctx, cli, ecoConfig.PopularPackagesUrl); err != nil {
+			return nil, fmt.Errorf("could not fetch list of popular packages: %w", err)
+		}
+	}
+
+	return idx, nil
+}
+
+func (idx *popularIndex) addRemoteList(ctx context.Context, cli *http.Client, u string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return fmt.Errorf("could not create request: %w", err)
+	}
+
+	resp, err := cli.Do(req)
+	if err != nil {
+		return fmt.Errorf("could not send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("received non-200 response: %d", resp.StatusCode)
+	}
+
+	return idx.addList(io.LimitReader(resp.Body, maxPopularListSize))
+}
+
+// addList adds the names of a newline separated list, blank lines and lines
+// starting with # are ignored
+func (idx *popularIndex) addList(r io.Reader) error {
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		idx.add(line)
+	}
+	return scanner.Err()
+}
+
+func (idx *popularIndex) add(name string) {
+	norm := normalizeName(idx.ecosystem, name)
+	if norm == "" {
+		return
+	}
+	if _, ok := idx.names[norm]; ok {
+		return
+	}
+	idx.names[norm] = name
+
+	sk := skeleton(norm)
+	idx.skeletons[sk] = append(idx.skeletons[sk], name)
+}
+
+// contains returns true if the name is one of the popular packages
+func (idx *popularIndex) contains(name string) bool {
+	_, ok := idx.names[normalizeName(idx.ecosystem, name)]
+	return ok
+}
+
+// normalizeName returns the name the way the package registry of the ecosystem
+// compares it, so that e.g. PyYAML and pyyaml are the same package
+func normalizeName(ecosystem, name string) string {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if ecosystem == "pypi" {
+		// https://peps.python.org/pep-0503/#normalized-names
+		name = strings.NewReplacer("_", "-", ".", "-").Replace(name)
+	}
+	return name
+}
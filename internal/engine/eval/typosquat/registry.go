@@ -0,0 +1,251 @@
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package typosquat
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"golang.org/x/mod/module"
+	"golang.org/x/mod/semver"
+)
+
+// errPackageNotFound is returned when the registry does not know the package
+var errPackageNotFound = errors.New("package not found")
+
+// packageMetadata is the publication history of a package
+type packageMetadata struct {
+	// Created is when the first version of the package was published
+	Created time.Time
+	// Versions is the number of published versions
+	Versions int
+}
+
+// metadataFetcher fetches the publication history of a package from the
+// package registry of its ecosystem
+type metadataFetcher interface {
+	Fetch(ctx context.Context, name string) (*packageMetadata, error)
+}
+
+func newMetadataFetcher(cli *http.Client, ecoConfig *ecosystemConfig) (metadataFetcher, error) {
+	if ecoConfig.PackageRepository == nil {
+		return nil, nil
+	}
+
+	switch strings.ToLower(ecoConfig.Name) {
+	case "npm":
+		return &npmFetcher{client: cli, endpoint: ecoConfig.PackageRepository.Url}, nil
+	case "pypi":
+		return &pypiFetcher{client: cli, endpoint: ecoConfig.PackageRepository.Url}, nil
+	case "go":
+		return &goProxyFetcher{client: cli, endpoint: ecoConfig.PackageRepository.Url}, nil
+	default:
+		return nil, fmt.Errorf("unknown ecosystem: %s", ecoConfig.Name)
+	}
+}
+
+func doGet(ctx context.Context, cli *http.Client, u *url.URL, accept string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not create request: %w", err)
+	}
+	if accept != "" {
+		req.Header.Set("Accept", accept)
+	}
+
+	resp, err := cli.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("could not send request: %w", err)
+	}
+
+	if resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusGone {
+		resp.Body.Close()
+		return nil, errPackageNotFound
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, fmt.Errorf("received non-200 response: %d", resp.StatusCode)
+	}
+	return resp, nil
+}
+
+func getJSON(ctx context.Context, cli *http.Client, u *url.URL, into any) error {
+	resp, err := doGet(ctx, cli, u, "application/json")
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if err := json.NewDecoder(resp.Body).Decode(into); err != nil {
+		return fmt.Errorf("could not unmarshal response: %w", err)
+	}
+	return nil
+}
+
+// check that npm fetcher implements metadataFetcher
+var _ metadataFetcher = (*npmFetcher)(nil)
+
+type npmFetcher struct {
+	client   *http.Client
+	endpoint string
+}
+
+type npmPackageReply struct {
+	Time     map[string]string          `json:"time"`
+	Versions map[string]json.RawMessage `json:"versions"`
+}
+
+func (n *npmFetcher) Fetch(ctx context.Context, name string) (*packageMetadata, error) {
+	// the slash of scoped packages must be escaped, e.g. @babel%2Fcore
+	u, err := url.Parse(strings.TrimSuffix(n.endpoint, "/") + "/" + strings.Replace(name, "/", "%2F", 1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse endpoint: %w", err)
+	}
+
+	var reply npmPackageReply
+	if err := getJSON(ctx, n.client, u, &reply); err != nil {
+		return nil, err
+	}
+
+	meta := &packageMetadata{
+		Versions: len(reply.Versions),
+	}
+	if created, ok := reply.Time["created"]; ok {
+		meta.Created, err = time.Parse(time.RFC3339, created)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse creation time: %w", err)
+		}
+	}
+	return meta, nil
+}
+
+// check that pypi fetcher implements metadataFetcher
+var _ metadataFetcher = (*pypiFetcher)(nil)
+
+type pypiFetcher struct {
+	client   *http.Client
+	endpoint string
+}
+
+type pypiPackageReply struct {
+	Releases map[string][]struct {
+		UploadTime string `json:"upload_time_iso_8601"`
+	} `json:"releases"`
+}
+
+func (p *pypiFetcher) Fetch(ctx context.Context, name string) (*packageMetadata, error) {
+	u, err := url.Parse(p.endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse endpoint: %w", err)
+	}
+	u = u.JoinPath(name, "json")
+
+	var reply pypiPackageReply
+	if err := getJSON(ctx, p.client, u, &reply); err != nil {
+		return nil, err
+	}
+
+	meta := &packageMetadata{}
+	for _, files := range reply.Releases {
+		// releases without any uploaded file were yanked or never published
+		if len(files) == 0 {
+			continue
+		}
+		meta.Versions++
+
+		for _, f := range files {
+			uploaded, err := time.Parse(time.RFC3339, f.UploadTime)
+			if err != nil {
+				return nil, fmt.Errorf("could not parse upload time: %w", err)
+			}
+			if meta.Created.IsZero() || uploaded.Before(meta.Created) {
+				meta.Created = uploaded
+			}
+		}
+	}
+	return meta, nil
+}
+
+// check that the go proxy fetcher implements metadataFetcher
+var _ metadataFetcher = (*goProxyFetcher)(nil)
+
+type goProxyFetcher struct {
+	client   *http.Client
+	endpoint string
+}
+
+type goProxyInfoReply struct {
+	Time time.Time `json:"Time"`
+}
+
+// Fetch lists the tagged versions of the module and uses the publication time
+// of the lowest one as the creation time of the module
+func (g *goProxyFetcher) Fetch(ctx context.Context, name string) (*packageMetadata, error) {
+	escapedPath, err := module.EscapePath(name)
+	if err != nil {
+		return nil, fmt.Errorf("could not escape module path: %w", err)
+	}
+
+	base, err := url.Parse(g.endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse endpoint: %w", err)
+	}
+
+	resp, err := doGet(ctx, g.client, base.JoinPath(escapedPath, "@v", "list"), "")
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	versions := make([]string, 0)
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() {
+		v := strings.TrimSpace(scanner.Text())
+		if semver.IsValid(v) {
+			versions = append(versions, v)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("could not read version list: %w", err)
+	}
+
+	meta := &packageMetadata{
+		Versions: len(versions),
+	}
+	if len(versions) == 0 {
+		// modules without tags are only reachable through pseudo-versions
+		return meta, nil
+	}
+
+	semver.Sort(versions)
+	escapedVersion, err := module.EscapeVersion(versions[0])
+	if err != nil {
+		return nil, fmt.Errorf("could not escape module version: %w", err)
+	}
+
+	var info goProxyInfoReply
+	if err := getJSON(ctx, g.client, base.JoinPath(escapedPath, "@v", escapedVersion+".info"), &info); err != nil {
+		return nil, err
+	}
+	meta.Created = info.Time
+	return meta, nil
+}
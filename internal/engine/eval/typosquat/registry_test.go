@@ -0,0 +1,137 @@
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package typosquat
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMetadataFetchers(t *testing.T) {
+	t.Parallel()
+
+	created := time.Date(2023, 11, 2, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		ecosystem   string
+		dependency  string
+		replies     map[string]string
+		expected    *packageMetadata
+		expectError error
+	}{
+		{
+			name:       "npm",
+			ecosystem:  "npm",
+			dependency: "lodash",
+			replies: map[string]string{
+				"/lodash": `{"time": {"created": "2023-11-02T10:00:00.000Z", "modified": "2023-11-03T10:00:00.000Z"},
+					"versions": {"1.0.0": {}, "1.0.1": {}}}`,
+			},
+			expected: &packageMetadata{Created: created, Versions: 2},
+		},
+		{
+			name:       "npm scoped package",
+			ecosystem:  "npm",
+			dependency: "@babel/core",
+			replies: map[string]string{
+				"/@babel%2Fcore": `{"time": {"created": "2023-11-02T10:00:00Z"}, "versions": {"7.0.0": {}}}`,
+			},
+			expected: &packageMetadata{Created: created, Versions: 1},
+		},
+		{
+			name:       "pypi",
+			ecosystem:  "pypi",
+			dependency: "requests",
+			replies: map[string]string{
+				"/requests/json": `{"releases": {
+					"0.1.0": [{"upload_time_iso_8601": "2023-11-05T10:00:00.000000Z"}],
+					"0.0.1": [{"upload_time_iso_8601": "2023-11-02T10:00:00.000000Z"}],
+					"0.0.2": []
+				}}`,
+			},
+			expected: &packageMetadata{Created: created, Versions: 2},
+		},
+		{
+			name:       "go",
+			ecosystem:  "go",
+			dependency: "github.com/Example/mod",
+			replies: map[string]string{
+				"/github.com/!example/mod/@v/list":        "v1.1.0\nv1.0.0\nnot-a-version\n",
+				"/github.com/!example/mod/@v/v1.0.0.info": `{"Version": "v1.0.0", "Time": "2023-11-02T10:00:00Z"}`,
+			},
+			expected: &packageMetadata{Created: created, Versions: 2},
+		},
+		{
+			name:        "not found",
+			ecosystem:   "npm",
+			dependency:  "does-not-exist",
+			replies:     map[string]string{},
+			expectError: errPackageNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				reply, ok := tt.replies[r.URL.EscapedPath()]
+				if !ok {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				_, _ = w.Write([]byte(reply))
+			}))
+			defer ts.Close()
+
+			fetcher, err := newMetadataFetcher(ts.Client(), &ecosystemConfig{
+				Name:              tt.ecosystem,
+				PackageRepository: &packageRepository{Url: ts.URL},
+			})
+			require.NoError(t, err)
+
+			meta, err := fetcher.Fetch(context.Background(), tt.dependency)
+			if tt.expectError != nil {
+				require.ErrorIs(t, err, tt.expectError)
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, tt.expected.Created.Equal(meta.Created), "created %s", meta.Created)
+			assert.Equal(t, tt.expected.Versions, meta.Versions)
+		})
+	}
+}
+
+func TestHistoryReasons(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2023, 11, 10, 10, 0, 0, 0, time.UTC)
+	e := &Evaluator{now: func() time.Time { return now }}
+
+	cfg := &config{MinAgeDays: 30, MinVersions: 3}
+
+	assert.Equal(t, []string{"first published 8 days ago", "fewer than 3 published versions"},
+		e.historyReasons(cfg, &packageMetadata{Created: now.AddDate(0, 0, -8), Versions: 1}))
+	assert.Empty(t, e.historyReasons(cfg, &packageMetadata{Created: now.AddDate(-1, 0, 0), Versions: 12}))
+	assert.Empty(t, e.historyReasons(&config{}, &packageMetadata{Created: now, Versions: 1}))
+}
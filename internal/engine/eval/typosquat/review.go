@@ -0,0 +1,124 @@
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package typosquat
+
+import (
+	"fmt"
+	htmltemplate "html/template"
+	"strings"
+
+	"github.com/stacklok/minder/internal/engine/eval/pr_actions"
+	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+const (
+	reviewBodyMagicComment = "<!-- minder: pr-typosquat-review-body -->"
+	commitStatusContext    = "minder.stacklok.dev/pr-typosquat"
+	violationsFoundText    = `
+Minder found newly added dependencies that look suspicious. Their names are
+very similar to popular packages or they were published only recently. Please
+make sure that these are the packages you intended to add.
+`
+	violationsFoundTextShort = "Suspicious dependencies found."
+	noViolationsFoundText    = `
+Minder analyzed this PR and found no suspicious dependencies.
+`
+)
+
+const (
+	tableSuspiciousName = "suspiciousTable"
+	tableSuspicious     = `### Summary of suspicious dependencies
+Minder found the following newly added dependencies that look suspicious:
+<table>
+  <tr>
+    <th>Ecosystem</th>
+    <th>Name</th>
+    <th>Version</th>
+    <th>Reason</th>
+    <th>File</th>
+  </tr>
+  {{ range . }}
+  <tr>
+    <td>{{ .Ecosystem }}</td>
+    <td>{{ .Name }}</td>
+    <td>{{ .Version }}</td>
+    <td>{{ .Reason }}</td>
+    <td>{{ .File }}</td>
+  </tr>
+  {{ end }}
+</table>
+`
+)
+
+var reviewConfig = &pr_actions.ReviewConfig{
+	MagicComment:      reviewBodyMagicComment,
+	StatusContext:     commitStatusContext,
+	FoundText:         violationsFoundText,
+	NotFoundText:      noViolationsFoundText,
+	StatusFailureText: violationsFoundTextShort,
+	StatusSuccessText: strings.TrimSpace(noViolationsFoundText),
+	SummaryTemplate:   htmltemplate.Must(htmltemplate.New(tableSuspiciousName).Parse(tableSuspicious)),
+}
+
+// suspiciousDependency describes why a dependency is suspicious
+type suspiciousDependency struct {
+	Dependency *pb.Dependency
+	// Similar is the popular package the dependency can be confused with, nil
+	// if the name is not suspicious
+	Similar *similarMatch
+	// Reasons lists why the publication history of the package is suspicious
+	Reasons []string
+}
+
+func (v *suspiciousDependency) reasonString() string {
+	reasons := make([]string, 0, len(v.Reasons)+1)
+	if v.Similar != nil {
+		reasons = append(reasons, fmt.Sprintf("%s %s", v.Similar.Reason, v.Similar.Popular))
+	}
+	reasons = append(reasons, v.Reasons...)
+	return strings.Join(reasons, ", ")
+}
+
+func (v *suspiciousDependency) reviewComment() string {
+	return fmt.Sprintf(
+		"`%s` looks suspicious: %s. Please make sure this is the package you intended to add.",
+		v.Dependency.Name, v.reasonString())
+}
+
+type summaryRow struct {
+	Ecosystem string
+	Name      string
+	Version   string
+	Reason    string
+	File      string
+}
+
+// finding returns how the suspicious dependency is reported on the PR
+func (v *suspiciousDependency) finding(dep *pb.PrDependencies_ContextualDependency) *pr_actions.Finding {
+	return &pr_actions.Finding{
+		Dependency: dep,
+		ReviewComment: func(*pr_actions.Location) (string, int) {
+			return v.reviewComment(), 0
+		},
+		Description: fmt.Sprintf("`%s@%s`: %s", v.Dependency.Name, v.Dependency.Version, v.reasonString()),
+		SummaryRow: summaryRow{
+			Ecosystem: dep.Dep.Ecosystem.AsString(),
+			Name:      dep.Dep.Name,
+			Version:   dep.Dep.Version,
+			Reason:    v.reasonString(),
+			File:      dep.File.GetName(),
+		},
+	}
+}
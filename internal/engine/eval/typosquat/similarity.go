@@ -0,0 +1,236 @@
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package typosquat
+
+import (
+	"sort"
+	"strings"
+)
+
+const (
+	reasonEditDistance   = "name is similar to"
+	reasonHomoglyph      = "name looks like"
+	reasonScopeConfusion = "name can be confused with"
+)
+
+// minComparedLength is the length under which names are not compared by edit
+// distance at all, every two or three letter name is close to many others
+const minComparedLength = 4
+
+// defaultTrustedScopes are npm scopes that mirror the names of unscoped
+// packages by design and are never flagged for scope confusion
+var defaultTrustedScopes = []string{"@types"}
+
+// homoglyphs maps characters, and character sequences, that are commonly used to
+// impersonate another character to the character they impersonate
+var homoglyphs = []struct {
+	from string
+	to   string
+}{
+	// multi-character sequences first
+	{"rn", "m"},
+	{"vv", "w"},
+	{"cl", "d"},
+	// ASCII look-alikes
+	{"0", "o"},
+	{"1", "l"},
+	{"i", "l"},
+	{"|", "l"},
+	{"5", "s"},
+	{"3", "e"},
+	// Cyrillic look-alikes
+	{"а", "a"},
+	{"е", "e"},
+	{"о", "o"},
+	{"р", "p"},
+	{"с", "c"},
+	{"у", "y"},
+	{"х", "x"},
+	{"і", "l"},
+	{"ј", "j"},
+	{"ѕ", "s"},
+}
+
+// separatorReplacer removes the separators that are frequently swapped or
+// dropped by typosquats, e.g. python-dateutil vs. python_dateutil or dateutil
+var separatorReplacer = strings.NewReplacer("-", "", "_", "", ".", "")
+
+// skeleton returns a canonical form of the name in which look-alike
+// characters and separators are folded, two names with the same skeleton
+// look alike to a human.
+func skeleton(name string) string {
+	s := strings.ToLower(name)
+	s = separatorReplacer.Replace(s)
+	for _, h := range homoglyphs {
+		s = strings.ReplaceAll(s, h.from, h.to)
+	}
+	return s
+}
+
+// editDistance returns the optimal string alignment distance between a and b,
+// that is the Levenshtein distance that also counts the transposition of two
+// adjacent characters as a single edit.
+func editDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	la, lb := len(ra), len(rb)
+
+	// d[i][j] is the distance between the first i runes of a and the first j runes of b
+	d := make([][]int, la+1)
+	for i := range d {
+		d[i] = make([]int, lb+1)
+		d[i][0] = i
+	}
+	for j := 0; j <= lb; j++ {
+		d[0][j] = j
+	}
+
+	for i := 1; i <= la; i++ {
+		for j := 1; j <= lb; j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+
+			d[i][j] = min(
+				d[i-1][j]+1,      // deletion
+				d[i][j-1]+1,      // insertion
+				d[i-1][j-1]+cost, // substitution
+			)
+
+			if i > 1 && j > 1 && ra[i-1] == rb[j-2] && ra[i-2] == rb[j-1] {
+				d[i][j] = min(d[i][j], d[i-2][j-2]+1) // transposition
+			}
+		}
+	}
+
+	return d[la][lb]
+}
+
+// splitScope splits a scoped npm package name such as @types/node into the
+// scope and the bare name. The scope is empty for unscoped packages.
+func splitScope(name string) (string, string) {
+	if !strings.HasPrefix(name, "@") {
+		return "", name
+	}
+	scope, bare, found := strings.Cut(name, "/")
+	if !found {
+		return "", name
+	}
+	return scope, bare
+}
+
+// similarMatch describes the popular package a dependency name can be
+// confused with
+type similarMatch struct {
+	Popular string
+	Reason  string
+}
+
+// findSimilar returns the popular package the name can be mistaken for, or nil
+// if the name is not suspicious. Names of popular packages are never suspicious.
+func (idx *popularIndex) findSimilar(name string, maxDistance int, trustedScopes []string) *similarMatch {
+	norm := normalizeName(idx.ecosystem, name)
+	if idx.contains(norm) {
+		return nil
+	}
+
+	if idx.ecosystem == "npm" {
+		if m := idx.findScopeConfusion(norm, trustedScopes); m != nil {
+			return m
+		}
+	}
+
+	if lookalikes := idx.skeletons[skeleton(norm)]; len(lookalikes) > 0 {
+		return &similarMatch{Popular: lookalikes[0], Reason: reasonHomoglyph}
+	}
+
+	if len([]rune(norm)) < minComparedLength {
+		return nil
+	}
+
+	// iterate in a stable order so that ties are always resolved the same way
+	candidates := make([]string, 0, len(idx.names))
+	for popNorm := range idx.names {
+		candidates = append(candidates, popNorm)
+	}
+	sort.Strings(candidates)
+
+	bestDistance := -1
+	var best string
+	for _, popNorm := range candidates {
+		limit := maxDistance
+		if len([]rune(popNorm)) < minNameLength {
+			limit = 1
+		}
+
+		lenDiff := len([]rune(popNorm)) - len([]rune(norm))
+		if lenDiff > limit || -lenDiff > limit {
+			continue
+		}
+
+		d := editDistance(norm, popNorm)
+		if d == 0 || d > limit {
+			continue
+		}
+		if bestDistance == -1 || d < bestDistance {
+			bestDistance = d
+			best = idx.names[popNorm]
+		}
+	}
+
+	if bestDistance == -1 {
+		return nil
+	}
+	return &similarMatch{Popular: best, Reason: reasonEditDistance}
+}
+
+// findScopeConfusion detects npm packages that impersonate a popular package
+// by adding or removing a scope, e.g. @evil/lodash for lodash or babel-core
+// for @babel/core
+func (idx *popularIndex) findScopeConfusion(norm string, trustedScopes []string) *similarMatch {
+	scope, bare := splitScope(norm)
+	if scope != "" {
+		if isTrustedScope(scope, trustedScopes) {
+			return nil
+		}
+		if idx.contains(bare) {
+			return &similarMatch{Popular: idx.names[bare], Reason: reasonScopeConfusion}
+		}
+		return nil
+	}
+
+	for popNorm, popular := range idx.names {
+		popScope, popBare := splitScope(popNorm)
+		if popScope == "" {
+			continue
+		}
+		popScope = strings.TrimPrefix(popScope, "@")
+		if norm == popScope+"-"+popBare || norm == popScope+popBare {
+			return &similarMatch{Popular: popular, Reason: reasonScopeConfusion}
+		}
+	}
+	return nil
+}
+
+func isTrustedScope(scope string, trustedScopes []string) bool {
+	for _, list := range [][]string{defaultTrustedScopes, trustedScopes} {
+		for _, trusted := range list {
+			if strings.EqualFold(scope, trusted) || strings.EqualFold(scope, "@"+trusted) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,183 @@
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package typosquat
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEditDistance(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		a, b     string
+		expected int
+	}{
+		{"lodash", "lodash", 0},
+		{"lodash", "lodahs", 1},
+		{"lodash", "lodas", 1},
+		{"lodash", "llodash", 1},
+		{"lodash", "iodash", 1},
+		{"requests", "reqeusts", 1},
+		{"express", "expresss", 1},
+		{"react", "ract", 1},
+		{"django", "djnago", 1},
+		{"django", "flask", 5},
+		{"", "abc", 3},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.a+"/"+tt.b, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expected, editDistance(tt.a, tt.b))
+			assert.Equal(t, tt.expected, editDistance(tt.b, tt.a))
+		})
+	}
+}
+
+func TestFindSimilar(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		ecosystem     string
+		dependency    string
+		trustedScopes []string
+		expected      *similarMatch
+	}{
+		{
+			name:       "popular package",
+			ecosystem:  "npm",
+			dependency: "lodash",
+		},
+		{
+			name:       "unrelated package",
+			ecosystem:  "npm",
+			dependency: "left-pad-strings",
+		},
+		{
+			name:       "transposition",
+			ecosystem:  "pypi",
+			dependency: "reqeusts",
+			expected:   &similarMatch{Popular: "requests", Reason: reasonEditDistance},
+		},
+		{
+			name:       "short names only tolerate a single edit",
+			ecosystem:  "npm",
+			dependency: "rxact",
+			expected:   &similarMatch{Popular: "react", Reason: reasonEditDistance},
+		},
+		{
+			name:       "substitution",
+			ecosystem:  "pypi",
+			dependency: "dj4ngo",
+			expected:   &similarMatch{Popular: "django", Reason: reasonEditDistance},
+		},
+		{
+			name:       "digit look-alike",
+			ecosystem:  "npm",
+			dependency: "l0dash",
+			expected:   &similarMatch{Popular: "lodash", Reason: reasonHomoglyph},
+		},
+		{
+			name:       "cyrillic look-alike",
+			ecosystem:  "npm",
+			dependency: "еxprеss",
+			expected:   &similarMatch{Popular: "express", Reason: reasonHomoglyph},
+		},
+		{
+			name:       "separator swap",
+			ecosystem:  "pypi",
+			dependency: "pythondateutil",
+			expected:   &similarMatch{Popular: "python-dateutil", Reason: reasonHomoglyph},
+		},
+		{
+			name:       "pypi normalization",
+			ecosystem:  "pypi",
+			dependency: "Python_Dateutil",
+		},
+		{
+			name:       "scoped impersonation",
+			ecosystem:  "npm",
+			dependency: "@evil/lodash",
+			expected:   &similarMatch{Popular: "lodash", Reason: reasonScopeConfusion},
+		},
+		{
+			name:          "trusted scope",
+			ecosystem:     "npm",
+			dependency:    "@acme/lodash",
+			trustedScopes: []string{"acme"},
+		},
+		{
+			name:       "type definitions",
+			ecosystem:  "npm",
+			dependency: "@types/lodash",
+		},
+		{
+			name:       "dropped scope",
+			ecosystem:  "npm",
+			dependency: "angular-core",
+			expected:   &similarMatch{Popular: "@angular/core", Reason: reasonScopeConfusion},
+		},
+		{
+			name:       "go module",
+			ecosystem:  "go",
+			dependency: "github.com/sirupsen/logruss",
+			expected:   &similarMatch{Popular: "github.com/sirupsen/logrus", Reason: reasonEditDistance},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			idx, err := loadPopularIndex(context.Background(), http.DefaultClient, &ecosystemConfig{Name: tt.ecosystem})
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expected, idx.findSimilar(tt.dependency, defaultMaxDistance, tt.trustedScopes))
+		})
+	}
+}
+
+func TestLoadPopularIndex(t *testing.T) {
+	t.Parallel()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("# internal packages\n\nacme-widgets\n"))
+	}))
+	defer ts.Close()
+
+	idx, err := loadPopularIndex(context.Background(), ts.Client(), &ecosystemConfig{
+		Name:               "npm",
+		PopularPackages:    []string{"acme-gadgets"},
+		PopularPackagesUrl: ts.URL,
+	})
+	require.NoError(t, err)
+
+	assert.True(t, idx.contains("lodash"))
+	assert.True(t, idx.contains("acme-gadgets"))
+	assert.True(t, idx.contains("acme-widgets"))
+	assert.False(t, idx.contains("# internal packages"))
+	assert.Equal(t, &similarMatch{Popular: "acme-widgets", Reason: reasonEditDistance},
+		idx.findSimilar("acme-widgetz", defaultMaxDistance, nil))
+}
@@ -0,0 +1,241 @@
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package typosquat provides an evaluator that flags suspicious dependencies,
+// such as typosquats of popular packages or packages that were only just published
+package typosquat
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	evalerrors "github.com/stacklok/minder/internal/engine/errors"
+	"github.com/stacklok/minder/internal/engine/eval/pr_actions"
+	engif "github.com/stacklok/minder/internal/engine/interfaces"
+	"github.com/stacklok/minder/internal/providers"
+	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+	provifv1 "github.com/stacklok/minder/pkg/providers/v1"
+)
+
+const (
+	// TyposquatEvalType is the type of the typosquat evaluator
+	TyposquatEvalType = "typosquat"
+)
+
+// Evaluator is the typosquat evaluator
+type Evaluator struct {
+	cli     provifv1.GitHub
+	httpCli *http.Client
+	now     func() time.Time
+}
+
+// NewTyposquatEvaluator creates a new typosquat evaluator
+func NewTyposquatEvaluator(_ *pb.RuleType_Definition_Eval_Typosquat, pbuild *providers.ProviderBuilder) (*Evaluator, error) {
+	if pbuild == nil {
+		return nil, fmt.Errorf("provider builder is nil")
+	}
+
+	ghcli, err := pbuild.GetGitHub(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get github client: %w", err)
+	}
+
+	return &Evaluator{
+		cli:     ghcli,
+		httpCli: &http.Client{},
+		now:     time.Now,
+	}, nil
+}
+
+// Eval implements the Evaluator interface. Only the dependencies a pull request
+// adds are checked, existing dependencies were already vetted when they were added.
+func (e *Evaluator) Eval(ctx context.Context, pol map[string]any, res *engif.Result) error {
+	ruleConfig, err := parseConfig(pol)
+	if err != nil {
+		return fmt.Errorf("failed to parse config: %w", err)
+	}
+
+	prdeps, ok := res.Object.(*pb.PrDependencies)
+	if !ok {
+		return fmt.Errorf("invalid object type for typosquat evaluator")
+	}
+
+	if len(prdeps.Deps) == 0 {
+		return nil
+	}
+
+	prReplyHandler, err := pr_actions.NewStatusHandler(ctx, ruleConfig.Action, prdeps.Pr, e.cli, reviewConfig)
+	if err != nil {
+		return fmt.Errorf("failed to create pr action: %w", err)
+	}
+
+	suspicious, err := e.checkDependencies(ctx, ruleConfig, prdeps.Deps, prReplyHandler)
+	if err != nil {
+		return err
+	}
+
+	if err := prReplyHandler.Submit(ctx); err != nil {
+		return fmt.Errorf("failed to submit pr action: %w", err)
+	}
+
+	if len(suspicious) > 0 {
+		return evalerrors.NewErrEvaluationFailed(
+			fmt.Sprintf("suspicious dependencies: %s", strings.Join(suspicious, ",")))
+	}
+	return nil
+}
+
+// ecosystemCheckers holds the popular package index and registry client of
+// an ecosystem, they are only built for ecosystems the PR adds dependencies to
+type ecosystemCheckers struct {
+	popular *popularIndex
+	fetcher metadataFetcher
+}
+
+func (e *Evaluator) checkDependencies(
+	ctx context.Context,
+	ruleConfig *config,
+	deps []*pb.PrDependencies_ContextualDependency,
+	prReplyHandler pr_actions.StatusHandler,
+) ([]string, error) {
+	logger := zerolog.Ctx(ctx)
+	checkers := make(map[string]*ecosystemCheckers)
+
+	suspicious := make([]string, 0)
+	// the same dependency is often listed several times, e.g. twice in go.sum
+	seen := make(map[string]struct{})
+
+	for _, dep := range deps {
+		if dep.Dep == nil || dep.Dep.Name == "" {
+			continue
+		}
+
+		key := fmt.Sprintf("%s/%s", dep.Dep.Ecosystem.AsString(), dep.Dep.Name)
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+
+		if ruleConfig.isAllowed(dep.Dep.Name) {
+			continue
+		}
+
+		ecoConfig := ruleConfig.getEcosystemConfig(dep.Dep.Ecosystem)
+		if ecoConfig == nil {
+			logger.Info().
+				Str("dependency", dep.Dep.Name).
+				Str("ecosystem", dep.Dep.Ecosystem.AsString()).
+				Msg("no config for ecosystem, skipping")
+			continue
+		}
+
+		checker, ok := checkers[ecoConfig.Name]
+		if !ok {
+			var err error
+			checker, err = e.newEcosystemCheckers(ctx, ecoConfig)
+			if err != nil {
+				return nil, err
+			}
+			checkers[ecoConfig.Name] = checker
+		}
+
+		suspiciousDep, err := e.checkDependency(ctx, ruleConfig, ecoConfig, checker, dep.Dep)
+		if err != nil {
+			return nil, err
+		}
+		if suspiciousDep == nil {
+			continue
+		}
+
+		suspicious = append(suspicious, fmt.Sprintf("%s (%s)", dep.Dep.Name, suspiciousDep.reasonString()))
+		if err := prReplyHandler.Track(ctx, suspiciousDep.finding(dep)); err != nil {
+			return nil, fmt.Errorf("failed to track suspicious dependency: %w", err)
+		}
+	}
+
+	return suspicious, nil
+}
+
+func (e *Evaluator) newEcosystemCheckers(ctx context.Context, ecoConfig *ecosystemConfig) (*ecosystemCheckers, error) {
+	popular, err := loadPopularIndex(ctx, e.httpCli, ecoConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load popular packages: %w", err)
+	}
+
+	fetcher, err := newMetadataFetcher(e.httpCli, ecoConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create package registry client: %w", err)
+	}
+
+	return &ecosystemCheckers{
+		popular: popular,
+		fetcher: fetcher,
+	}, nil
+}
+
+// checkDependency returns why the dependency is suspicious or nil if it is not
+func (e *Evaluator) checkDependency(
+	ctx context.Context,
+	ruleConfig *config,
+	ecoConfig *ecosystemConfig,
+	checker *ecosystemCheckers,
+	dep *pb.Dependency,
+) (*suspiciousDependency, error) {
+	finding := &suspiciousDependency{
+		Dependency: dep,
+		Similar:    checker.popular.findSimilar(dep.Name, ruleConfig.MaxDistance, ecoConfig.TrustedScopes),
+	}
+
+	if checker.fetcher != nil && (ruleConfig.MinAgeDays > 0 || ruleConfig.MinVersions > 0) {
+		meta, err := checker.fetcher.Fetch(ctx, dep.Name)
+		if errors.Is(err, errPackageNotFound) {
+			// e.g. private packages, nothing to say about their history
+			zerolog.Ctx(ctx).Info().
+				Str("dependency", dep.Name).
+				Msg("package not found in registry")
+		} else if err != nil {
+			return nil, fmt.Errorf("failed to fetch metadata of %s: %w", dep.Name, err)
+		} else {
+			finding.Reasons = e.historyReasons(ruleConfig, meta)
+		}
+	}
+
+	if finding.Similar == nil && len(finding.Reasons) == 0 {
+		return nil, nil
+	}
+	return finding, nil
+}
+
+func (e *Evaluator) historyReasons(ruleConfig *config, meta *packageMetadata) []string {
+	reasons := make([]string, 0)
+
+	if ruleConfig.MinAgeDays > 0 && !meta.Created.IsZero() {
+		age := e.now().Sub(meta.Created)
+		if age < time.Duration(ruleConfig.MinAgeDays)*24*time.Hour {
+			reasons = append(reasons, fmt.Sprintf("first published %d days ago", int(age.Hours()/24)))
+		}
+	}
+
+	if ruleConfig.MinVersions > 0 && meta.Versions < ruleConfig.MinVersions {
+		reasons = append(reasons, fmt.Sprintf("fewer than %d published versions", ruleConfig.MinVersions))
+	}
+
+	return reasons
+}
@@ -62,9 +62,7 @@ func NewVulncheckEvaluator(_ *pb.RuleType_Definition_Eval_Vulncheck, pbuild *pro
 func (e *Evaluator) Eval(ctx context.Context, pol map[string]any, res *engif.Result) error {
 	var vulnerablePackages []string
 
-	// TODO(jhrozek): Fix this!
-	//nolint:govet
-	prdeps, ok := res.Object.(pb.PrDependencies)
+	prdeps, ok := res.Object.(*pb.PrDependencies)
 	if !ok {
 		return fmt.Errorf("invalid object type for vulncheck evaluator")
 	}
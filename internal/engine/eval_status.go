@@ -22,8 +22,10 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/rs/zerolog"
+	"github.com/sqlc-dev/pqtype"
 
 	"github.com/stacklok/minder/internal/db"
+	"github.com/stacklok/minder/internal/engine/actions/remediate"
 	evalerrors "github.com/stacklok/minder/internal/engine/errors"
 	engif "github.com/stacklok/minder/internal/engine/interfaces"
 	"github.com/stacklok/minder/internal/entities"
@@ -42,16 +44,17 @@ func (e *Executor) createEvalStatusParams(
 		return nil, fmt.Errorf("error parsing profile ID: %w", err)
 	}
 
-	repoID, artID, prID := inf.GetEntityDBIDs()
+	repoID, artID, prID, buildEnvID := inf.GetEntityDBIDs()
 
 	params := &engif.EvalStatusParams{
-		Rule:          rule,
-		Profile:       profile,
-		ProfileID:     profileID,
-		EntityType:    entities.EntityTypeToDB(inf.Type),
-		RepoID:        repoID,
-		ArtifactID:    artID,
-		PullRequestID: prID,
+		Rule:               rule,
+		Profile:            profile,
+		ProfileID:          profileID,
+		EntityType:         entities.EntityTypeToDB(inf.Type),
+		RepoID:             repoID,
+		ArtifactID:         artID,
+		PullRequestID:      prID,
+		BuildEnvironmentID: buildEnvID,
 	}
 
 	// Prepare params for fetching the current rule evaluation from the database
@@ -70,7 +73,7 @@ func (e *Executor) createEvalStatusParams(
 	case db.EntitiesPullRequest:
 		entityID = params.PullRequestID
 	case db.EntitiesBuildEnvironment:
-		return nil, fmt.Errorf("build environment entity type not supported")
+		entityID = params.BuildEnvironmentID
 	}
 
 	ruleName := sql.NullString{
@@ -123,10 +126,11 @@ func (e *Executor) createOrUpdateEvalStatus(
 			UUID:  params.RepoID,
 			Valid: true,
 		},
-		ArtifactID:    params.ArtifactID,
-		Entity:        params.EntityType,
-		RuleTypeID:    params.RuleTypeID,
-		PullRequestID: params.PullRequestID,
+		ArtifactID:         params.ArtifactID,
+		Entity:             params.EntityType,
+		RuleTypeID:         params.RuleTypeID,
+		PullRequestID:      params.PullRequestID,
+		BuildEnvironmentID: params.BuildEnvironmentID,
 	})
 
 	if err != nil {
@@ -152,11 +156,20 @@ func (e *Executor) createOrUpdateEvalStatus(
 			Msg("error upserting rule evaluation details")
 		return err
 	}
+	// Upsert the evaluation of the artifact versions the rule was evaluated against
+	if err := e.upsertArtifactVersionEvaluations(ctx, id, params); err != nil {
+		logger.Err(err).
+			Str("artifact_id", params.ArtifactID.UUID.String()).
+			Str("profile_id", params.ProfileID.String()).
+			Msg("error upserting artifact version evaluations")
+		return err
+	}
 	// Upsert remediation details
 	_, err = e.querier.UpsertRuleDetailsRemediate(ctx, db.UpsertRuleDetailsRemediateParams{
 		RuleEvalID: id,
 		Status:     evalerrors.ErrorAsRemediationStatus(params.GetActionsErr().RemediateErr),
 		Details:    errorAsActionDetails(params.GetActionsErr().RemediateErr),
+		Metadata:   params.GetActionsErr().RemediateMeta,
 	})
 	if err != nil {
 		logger.Err(err).
@@ -165,6 +178,22 @@ func (e *Executor) createOrUpdateEvalStatus(
 			Str("profile_id", params.ProfileID.String()).
 			Msg("error upserting rule remediation details")
 	}
+	// Record the request proposed by a remediation that requires approval
+	if err := e.recordRemediationProposal(ctx, id, params.GetActionsErr().RemediateErr); err != nil {
+		logger.Err(err).
+			Str("repo_id", params.RepoID.String()).
+			Str("entity_type", string(params.EntityType)).
+			Str("profile_id", params.ProfileID.String()).
+			Msg("error recording remediation proposal")
+	}
+	// Keep track of the pull request opened by the remediation, if any
+	if err := e.recordRemediationPullRequest(ctx, id, params); err != nil {
+		logger.Err(err).
+			Str("repo_id", params.RepoID.String()).
+			Str("entity_type", string(params.EntityType)).
+			Str("profile_id", params.ProfileID.String()).
+			Msg("error recording remediation pull request")
+	}
 	// Upsert alert details
 	_, err = e.querier.UpsertRuleDetailsAlert(ctx, db.UpsertRuleDetailsAlertParams{
 		RuleEvalID: id,
@@ -182,8 +211,110 @@ func (e *Executor) createOrUpdateEvalStatus(
 	return err
 }
 
+// upsertArtifactVersionEvaluations records the status of the evaluation for each of
+// the artifact versions that were ingested by the rule, and removes the statuses of the
+// versions the rule was not evaluated against this time
+func (e *Executor) upsertArtifactVersionEvaluations(
+	ctx context.Context,
+	ruleEvalID uuid.UUID,
+	params *engif.EvalStatusParams,
+) error {
+	if params.EntityType != db.EntitiesArtifact || !params.ArtifactID.Valid {
+		return nil
+	}
+
+	shas := []string{}
+	if res := params.GetIngestResult(); res != nil {
+		shas = append(shas, res.ArtifactVersions...)
+	}
+
+	if err := e.querier.DeleteStaleArtifactVersionEvaluations(ctx, db.DeleteStaleArtifactVersionEvaluationsParams{
+		RuleEvalID: ruleEvalID,
+		Shas:       shas,
+	}); err != nil {
+		return err
+	}
+
+	// versions with the same status are recorded together
+	type outcome struct {
+		status  db.EvalStatusTypes
+		details string
+	}
+	var outcomes []outcome
+	byOutcome := make(map[outcome][]string)
+	for _, sha := range shas {
+		evalErr := params.GetArtifactVersionErr(sha)
+		o := outcome{status: evalerrors.ErrorAsEvalStatus(evalErr), details: evalerrors.ErrorAsEvalDetails(evalErr)}
+		if _, ok := byOutcome[o]; !ok {
+			outcomes = append(outcomes, o)
+		}
+		byOutcome[o] = append(byOutcome[o], sha)
+	}
+
+	for _, o := range outcomes {
+		if err := e.querier.UpsertArtifactVersionEvaluations(ctx, db.UpsertArtifactVersionEvaluationsParams{
+			RuleEvalID: ruleEvalID,
+			Status:     o.status,
+			Details:    o.details,
+			ArtifactID: params.ArtifactID.UUID,
+			Shas:       byOutcome[o],
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// recordRemediationProposal stores the request proposed by a remediation that requires approval,
+// or removes the approval of the rule evaluation if the remediation didn't propose any, e.g.
+// because the rule passes now, so that a later failure is proposed and decided on again
+func (e *Executor) recordRemediationProposal(ctx context.Context, ruleEvalID uuid.UUID, remErr error) error {
+	var proposal *evalerrors.RemediationProposal
+	if !errors.As(remErr, &proposal) {
+		return e.querier.DeleteRemediationApproval(ctx, ruleEvalID)
+	}
+
+	return e.querier.UpsertRemediationApproval(ctx, db.UpsertRemediationApprovalParams{
+		RuleEvalID: ruleEvalID,
+		Method:     proposal.Method,
+		Endpoint:   proposal.Endpoint,
+		Body:       proposal.Body,
+	})
+}
+
+// recordRemediationPullRequest stores the pull request the remediation of a repository keeps track
+// of in its state, or removes the one stored before if there is none anymore, e.g. because it was
+// closed once the rule passed
+func (e *Executor) recordRemediationPullRequest(
+	ctx context.Context,
+	ruleEvalID uuid.UUID,
+	params *engif.EvalStatusParams,
+) error {
+	if params.EntityType != db.EntitiesRepository {
+		return nil
+	}
+
+	state := remediate.StateFromMetadata(pqtype.NullRawMessage{
+		RawMessage: params.GetActionsErr().RemediateMeta,
+		Valid:      true,
+	})
+	if state.PullRequest == nil {
+		return e.querier.DeleteRemediationPullRequest(ctx, ruleEvalID)
+	}
+
+	return e.querier.UpsertRemediationPullRequest(ctx, db.UpsertRemediationPullRequestParams{
+		RuleEvalID:   ruleEvalID,
+		RepositoryID: params.RepoID,
+		PrNumber:     int64(state.PullRequest.Number),
+		Branch:       state.PullRequest.Branch,
+		ContentSha:   state.PullRequest.ContentSha,
+	})
+}
+
 func errorAsActionDetails(err error) string {
-	if evalerrors.IsActionFatalError(err) {
+	// the reason for holding an action back is informative, but worth showing
+	if evalerrors.IsActionFatalError(err) || errors.Is(err, evalerrors.ErrActionPending) {
 		return err.Error()
 	}
 
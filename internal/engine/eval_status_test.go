@@ -0,0 +1,133 @@
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	mockdb "github.com/stacklok/minder/database/mock"
+	"github.com/stacklok/minder/internal/db"
+	evalerrors "github.com/stacklok/minder/internal/engine/errors"
+	engif "github.com/stacklok/minder/internal/engine/interfaces"
+)
+
+// verifiedEvaluator fails the evaluation unless all the versions are verified
+type verifiedEvaluator struct{}
+
+func (verifiedEvaluator) Eval(_ context.Context, _ map[string]any, res *engif.Result) error {
+	for _, version := range res.Object.([]map[string]any) {
+		if version["verified"] != true {
+			return evalerrors.NewErrEvaluationFailed("version not verified")
+		}
+	}
+	return nil
+}
+
+func TestUpsertArtifactVersionEvaluations(t *testing.T) {
+	t.Parallel()
+
+	ruleEvalID := uuid.New()
+	artifactID := uuid.New()
+
+	tests := []struct {
+		name     string
+		result   *engif.Result
+		expected map[db.EvalStatusTypes][]string
+	}{
+		{
+			name: "status of each version",
+			result: &engif.Result{
+				Object: []map[string]any{
+					{"verified": true}, {"verified": false}, {"verified": true},
+				},
+				ArtifactVersions: []string{"sha256:1", "sha256:2", "sha256:3"},
+			},
+			expected: map[db.EvalStatusTypes][]string{
+				db.EvalStatusTypesSuccess: {"sha256:1", "sha256:3"},
+				db.EvalStatusTypesFailure: {"sha256:2"},
+			},
+		},
+		{
+			name: "single version",
+			result: &engif.Result{
+				Object:           []map[string]any{{"verified": false}},
+				ArtifactVersions: []string{"sha256:1"},
+			},
+			expected: map[db.EvalStatusTypes][]string{
+				db.EvalStatusTypesFailure: {"sha256:1"},
+			},
+		},
+		{
+			name:     "nothing ingested",
+			expected: map[db.EvalStatusTypes][]string{},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			store := mockdb.NewMockStore(ctrl)
+
+			params := &engif.EvalStatusParams{
+				EntityType: db.EntitiesArtifact,
+				ArtifactID: uuid.NullUUID{UUID: artifactID, Valid: true},
+			}
+			rte := &RuleTypeEngine{reval: verifiedEvaluator{}}
+			if tt.result != nil {
+				params.SetIngestResult(tt.result)
+				params.SetArtifactVersionErrs(rte.evalArtifactVersions(context.Background(), nil, tt.result))
+				params.SetEvalErr(rte.reval.Eval(context.Background(), nil, tt.result))
+			}
+
+			shas := []string{}
+			if tt.result != nil {
+				shas = tt.result.ArtifactVersions
+			}
+			// the statuses of the versions the rule wasn't evaluated against are removed first
+			deleted := store.EXPECT().
+				DeleteStaleArtifactVersionEvaluations(gomock.Any(), db.DeleteStaleArtifactVersionEvaluationsParams{
+					RuleEvalID: ruleEvalID,
+					Shas:       shas,
+				}).
+				Return(nil)
+
+			got := make(map[db.EvalStatusTypes][]string)
+			store.EXPECT().
+				UpsertArtifactVersionEvaluations(gomock.Any(), gomock.Any()).
+				After(deleted).
+				DoAndReturn(func(_ context.Context, arg db.UpsertArtifactVersionEvaluationsParams) error {
+					assert.Equal(t, ruleEvalID, arg.RuleEvalID)
+					assert.Equal(t, artifactID, arg.ArtifactID)
+					got[arg.Status] = append(got[arg.Status], arg.Shas...)
+					return nil
+				}).
+				Times(len(tt.expected))
+
+			e := &Executor{querier: store}
+			require.NoError(t, e.upsertArtifactVersionEvaluations(context.Background(), ruleEvalID, params))
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
@@ -212,6 +212,7 @@ func (e *Executor) evalEntityEvent(
 	// for every rule. We use a sync.Map because it's safe for concurrent
 	// access.
 	ingestCache := ingestcache.NewCache()
+	inventory := newDependencyInventory(e.querier)
 
 	defer e.releaseLockAndFlush(ctx, inf)
 
@@ -242,6 +243,9 @@ func (e *Executor) evalEntityEvent(
 			// Evaluate the rule
 			evalParams.SetEvalErr(rte.Eval(ctx, inf, evalParams))
 
+			// Keep track of the dependencies we parsed while ingesting
+			inventory.record(ctx, inf, evalParams)
+
 			// Perform actions, if any
 			evalParams.SetActionsErr(ctx, rte.Actions(ctx, inf, evalParams))
 
@@ -273,6 +277,9 @@ func (e *Executor) getEvaluator(
 	rule *pb.Profile_Rule,
 	ingestCache ingestcache.Cache,
 ) (*engif.EvalStatusParams, *RuleTypeEngine, error) {
+	// The rule may override the remediate and alert settings of the profile
+	profile = profile.ForRule(rule)
+
 	// Create eval status params
 	params, err := e.createEvalStatusParams(ctx, inf, profile, rule)
 	if err != nil {
@@ -332,13 +339,17 @@ func (e *Executor) updateLockLease(
 	if params.PullRequestID.Valid {
 		logger = logger.Str("pull_request_id", params.PullRequestID.UUID.String())
 	}
+	if params.BuildEnvironmentID.Valid {
+		logger = logger.Str("build_environment_id", params.BuildEnvironmentID.UUID.String())
+	}
 
 	if err := e.querier.UpdateLease(ctx, db.UpdateLeaseParams{
-		Entity:        params.EntityType,
-		RepositoryID:  params.RepoID,
-		ArtifactID:    params.ArtifactID,
-		PullRequestID: params.PullRequestID,
-		LockedBy:      executionID,
+		Entity:             params.EntityType,
+		RepositoryID:       params.RepoID,
+		ArtifactID:         params.ArtifactID,
+		PullRequestID:      params.PullRequestID,
+		BuildEnvironmentID: params.BuildEnvironmentID,
+		LockedBy:           executionID,
 	}); err != nil {
 		logger.Err(err).Msg("error updating lock lease")
 		return
@@ -351,7 +362,7 @@ func (e *Executor) releaseLockAndFlush(
 	ctx context.Context,
 	inf *EntityInfoWrapper,
 ) {
-	repoID, artID, prID := inf.GetEntityDBIDs()
+	repoID, artID, prID, buildEnvID := inf.GetEntityDBIDs()
 
 	logger := zerolog.Ctx(ctx).Info().
 		Str("entity_type", inf.Type.ToString()).
@@ -364,13 +375,17 @@ func (e *Executor) releaseLockAndFlush(
 	if prID.Valid {
 		logger = logger.Str("pull_request_id", prID.UUID.String())
 	}
+	if buildEnvID.Valid {
+		logger = logger.Str("build_environment_id", buildEnvID.UUID.String())
+	}
 
 	if err := e.querier.ReleaseLock(ctx, db.ReleaseLockParams{
-		Entity:        entities.EntityTypeToDB(inf.Type),
-		RepositoryID:  repoID,
-		ArtifactID:    artID,
-		PullRequestID: prID,
-		LockedBy:      *inf.ExecutionID,
+		Entity:             entities.EntityTypeToDB(inf.Type),
+		RepositoryID:       repoID,
+		ArtifactID:         artID,
+		PullRequestID:      prID,
+		BuildEnvironmentID: buildEnvID,
+		LockedBy:           *inf.ExecutionID,
 	}); err != nil {
 		logger.Err(err).Msg("error updating lock lease")
 	}
@@ -210,6 +210,8 @@ default allow = true`,
 			Details:    "",
 		}).Return(ruleEvalDetailsId, nil)
 
+	// Empty metadata
+	meta, _ := json.Marshal(map[string]any{})
 	// Mock upserting remediate status
 	ruleEvalRemediationId := uuid.New()
 	mockStore.EXPECT().
@@ -217,9 +219,16 @@ default allow = true`,
 			RuleEvalID: ruleEvalId,
 			Status:     db.RemediationStatusTypesSkipped,
 			Details:    "",
+			Metadata:   meta,
 		}).Return(ruleEvalRemediationId, nil)
-	// Empty metadata
-	meta, _ := json.Marshal(map[string]any{})
+	// Mock removing the pending remediation proposal
+	mockStore.EXPECT().
+		DeleteRemediationApproval(gomock.Any(), ruleEvalId).
+		Return(nil)
+	// Mock removing the remediation pull request
+	mockStore.EXPECT().
+		DeleteRemediationPullRequest(gomock.Any(), ruleEvalId).
+		Return(nil)
 	// Mock upserting alert status
 	ruleEvalAlertId := uuid.New()
 	mockStore.EXPECT().
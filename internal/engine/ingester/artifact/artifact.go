@@ -20,11 +20,15 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"log"
 
+	"github.com/go-git/go-billy/v5"
 	"google.golang.org/protobuf/proto"
 
+	"github.com/stacklok/minder/internal/container"
 	evalerrors "github.com/stacklok/minder/internal/engine/errors"
 	engif "github.com/stacklok/minder/internal/engine/interfaces"
+	"github.com/stacklok/minder/internal/providers"
 	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
 )
 
@@ -36,13 +40,28 @@ const (
 // Ingest is the engine for a rule type that uses artifact data ingest
 // Implements enginer.ingester.Ingester
 type Ingest struct {
+	cfg     *pb.ArtifactType
+	prov    *providers.ProviderBuilder
+	inspect imageInspector
 }
 
+// imageInspector returns the config and manifest of a version of an artifact and,
+// if requested, its flattened filesystem
+type imageInspector func(
+	owner, artifactName, digest, token string,
+	withFilesystem bool,
+) (*container.ImageContent, billy.Filesystem, error)
+
 // NewArtifactDataIngest creates a new artifact rule data ingest engine
 func NewArtifactDataIngest(
-	_ *pb.ArtifactType,
+	cfg *pb.ArtifactType,
+	pbuild *providers.ProviderBuilder,
 ) (*Ingest, error) {
-	return &Ingest{}, nil
+	if cfg == nil {
+		cfg = &pb.ArtifactType{}
+	}
+
+	return &Ingest{cfg: cfg, prov: pbuild, inspect: container.GetArtifactImageContent}, nil
 }
 
 // GetType returns the type of the artifact rule data ingest engine
@@ -51,46 +70,99 @@ func (*Ingest) GetType() string {
 }
 
 // GetConfig returns the config for the artifact rule data ingest engine
-func (*Ingest) GetConfig() proto.Message {
-	return nil
+func (i *Ingest) GetConfig() proto.Message {
+	return i.cfg
 }
 
 // Ingest checks the passed in artifact, makes sure it is applicable to the current rule
 // and if it is, returns the appropriately marshalled data.
-func (_ *Ingest) Ingest(
-	_ context.Context,
+func (i *Ingest) Ingest(
+	ctx context.Context,
 	ent proto.Message,
 	params map[string]any,
+) (*engif.Result, error) {
+	return i.IngestWithDefinition(ctx, ent, params, nil)
+}
+
+// IngestWithDefinition is like Ingest, but also verifies the signatures of the
+// applicable versions against the public keys given in the rule definition.
+func (i *Ingest) IngestWithDefinition(
+	ctx context.Context,
+	ent proto.Message,
+	params map[string]any,
+	def map[string]any,
 ) (*engif.Result, error) {
 	cfg, err := configFromParams(params)
 	if err != nil {
 		return nil, err
 	}
 
+	keys, err := publicKeysFromDefinition(def)
+	if err != nil {
+		return nil, err
+	}
+
 	artifact, ok := ent.(*pb.Artifact)
 	if !ok {
 		return nil, fmt.Errorf("expected Artifact, got %T", ent)
 	}
 
+	// public keys sign container images, packages carry a provenance instead
+	var verify keyVerifier
+	if len(keys) > 0 && cfg.Type == artifactTypeContainer {
+		verify = func(version *pb.ArtifactVersion) (string, error) {
+			return container.VerifyArtifactWithKeys(ctx, artifact.GetOwner(), artifact.GetName(),
+				version.GetSha(), i.token(), keys)
+		}
+	}
+
+	// the content of images is only fetched for the rules that evaluate it
+	var inspect versionInspector
+	if cfg.Type == artifactTypeContainer && (i.cfg.GetInspectImage() || i.cfg.GetFilesystem()) {
+		inspect = func(version *pb.ArtifactVersion, withFilesystem bool) (*container.ImageContent, billy.Filesystem, error) {
+			return i.inspect(artifact.GetOwner(), artifact.GetName(), version.GetSha(), i.token(), withFilesystem)
+		}
+	}
+
 	// Filter the versions of the artifact that are applicable to this rule
-	applicable, err := getApplicableArtifactVersions(artifact, cfg)
-	if err != nil {
-		return nil, err
+	return getApplicableArtifactVersions(artifact, cfg, verify, inspect, i.cfg.GetFilesystem())
+}
+
+func (i *Ingest) token() string {
+	if i.prov == nil {
+		return ""
 	}
+	return i.prov.GetToken()
+}
 
-	return &engif.Result{
-		Object: applicable,
-	}, nil
+// keyVerifier returns the identifier of the public key that verifies a signature
+// of an artifact version, or an empty string if none does
+type keyVerifier func(version *pb.ArtifactVersion) (string, error)
+
+// versionInspector returns the content of the image of an artifact version
+type versionInspector func(
+	version *pb.ArtifactVersion,
+	withFilesystem bool,
+) (*container.ImageContent, billy.Filesystem, error)
+
+// applicableArtifactVersion is the data of an artifact version rules evaluate
+type applicableArtifactVersion struct {
+	Verification   any
+	GithubWorkflow any
+	Provenance     any
+	Image          *container.ImageContent `json:",omitempty"`
 }
 
+// getApplicableArtifactVersions returns the versions of the artifact that are applicable
+// to the rule and, if requested, the filesystem of the most recent one
 func getApplicableArtifactVersions(
 	artifact *pb.Artifact,
 	cfg *ingesterConfig,
-) ([]map[string]any, error) {
-	var applicableArtifactVersions []struct {
-		Verification   any
-		GithubWorkflow any
-	}
+	verify keyVerifier,
+	inspect versionInspector,
+	withFilesystem bool,
+) (*engif.Result, error) {
+	var applicableArtifactVersions []applicableArtifactVersion
 	// make sure the artifact type matches
 	if newArtifactIngestType(artifact.Type) != cfg.Type {
 		return nil, evalerrors.NewErrEvaluationSkipSilently("artifact type mismatch")
@@ -108,16 +180,19 @@ func getApplicableArtifactVersions(
 	}
 
 	// get all versions of the artifact that are applicable to this rule
+	var versions []*pb.ArtifactVersion
 	for _, artifactVersion := range artifact.Versions {
 		if !isProcessable(artifactVersion.Tags) {
 			continue
 		}
 
 		if tagMatcher.MatchTag(artifactVersion.Tags...) {
-			applicableArtifactVersions = append(applicableArtifactVersions, struct {
-				Verification   any
-				GithubWorkflow any
-			}{artifactVersion.SignatureVerification, artifactVersion.GithubWorkflow})
+			applicableArtifactVersions = append(applicableArtifactVersions, applicableArtifactVersion{
+				Verification:   applyIndexVerification(verifyWithKeys(artifactVersion, verify), cfg.IndexVerification, verify),
+				GithubWorkflow: artifactVersion.GithubWorkflow,
+				Provenance:     artifactVersion.Provenance,
+			})
+			versions = append(versions, artifactVersion)
 		}
 	}
 
@@ -126,6 +201,19 @@ func getApplicableArtifactVersions(
 		return nil, evalerrors.NewErrEvaluationFailed("no applicable artifact versions found")
 	}
 
+	var fs billy.Filesystem
+	if inspect != nil {
+		fs, err = inspectVersions(versions, applicableArtifactVersions, inspect, withFilesystem)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	shas := make([]string, 0, len(versions))
+	for _, version := range versions {
+		shas = append(shas, version.GetSha())
+	}
+
 	jsonBytes, err := json.Marshal(applicableArtifactVersions)
 	if err != nil {
 		return nil, err
@@ -137,7 +225,43 @@ func getApplicableArtifactVersions(
 		return nil, err
 	}
 	// return the list of applicable artifact versions
-	return result, nil
+	return &engif.Result{
+		Object:           result,
+		Fs:               fs,
+		ArtifactVersions: shas,
+	}, nil
+}
+
+// inspectVersions adds the content of their images to the applicable versions, and
+// returns the filesystem of the most recent one if requested
+func inspectVersions(
+	versions []*pb.ArtifactVersion,
+	applicable []applicableArtifactVersion,
+	inspect versionInspector,
+	withFilesystem bool,
+) (billy.Filesystem, error) {
+	latest := 0
+	for i, version := range versions {
+		if version.GetCreatedAt().AsTime().After(versions[latest].GetCreatedAt().AsTime()) {
+			latest = i
+		}
+	}
+
+	var fs billy.Filesystem
+	for i, version := range versions {
+		isLatest := withFilesystem && i == latest
+		content, versionFs, err := inspect(version, isLatest)
+		if err != nil {
+			// without the content of the image, the rule can't be evaluated
+			return nil, fmt.Errorf("error inspecting image of artifact version %s: %w", version.GetSha(), err)
+		}
+		applicable[i].Image = content
+		if isLatest {
+			fs = versionFs
+		}
+	}
+
+	return fs, nil
 }
 
 func isProcessable(tags []string) bool {
@@ -153,3 +277,98 @@ func isProcessable(tags []string) bool {
 
 	return true
 }
+
+// verifyWithKeys returns the signature verification of an artifact version, marked as
+// verified if the version was not verified yet and its signature matches one of the
+// public keys of the rule definition
+func verifyWithKeys(version *pb.ArtifactVersion, verify keyVerifier) *pb.SignatureVerification {
+	sigVerification := version.GetSignatureVerification()
+	if verify == nil || sigVerification.GetIsVerified() {
+		return sigVerification
+	}
+
+	keyID, err := verify(version)
+	if err != nil {
+		log.Printf("error verifying artifact version %s with public keys: %v", version.GetSha(), err)
+		return sigVerification
+	}
+	if keyID == "" {
+		return sigVerification
+	}
+
+	// don't modify the entity, it is shared by the rules of the profile
+	verified := &pb.SignatureVerification{}
+	if sigVerification != nil {
+		verified = proto.Clone(sigVerification).(*pb.SignatureVerification)
+	}
+	verified.IsSigned = true
+	verified.IsVerified = true
+	verified.KeyIdentifier = &keyID
+	return verified
+}
+
+// applyIndexVerification returns the signature verification of a multi-platform image
+// according to how the rule verifies them. The images of the platforms are verified with
+// the public keys of the rule too, and their verifications are kept so rules can evaluate
+// them.
+func applyIndexVerification(
+	sigVerification *pb.SignatureVerification,
+	policy indexVerification,
+	verify keyVerifier,
+) *pb.SignatureVerification {
+	platforms := sigVerification.GetPlatforms()
+	if len(platforms) == 0 || policy == indexVerificationIndex {
+		return sigVerification
+	}
+
+	platforms = verifyPlatformsWithKeys(platforms, verify)
+
+	allSigned, allVerified, allBundleVerified := true, true, true
+	for _, platform := range platforms {
+		allSigned = allSigned && platform.GetSignatureVerification().GetIsSigned()
+		allVerified = allVerified && platform.GetSignatureVerification().GetIsVerified()
+		allBundleVerified = allBundleVerified && platform.GetSignatureVerification().GetIsBundleVerified()
+	}
+
+	// don't modify the entity, it is shared by the rules of the profile
+	verification := proto.Clone(sigVerification).(*pb.SignatureVerification)
+	verification.Platforms = platforms
+	if policy == indexVerificationAny && (sigVerification.GetIsVerified() || !allVerified) {
+		return verification
+	}
+
+	verification.IsSigned = allSigned
+	verification.IsVerified = allVerified
+	verification.IsBundleVerified = allBundleVerified
+	return verification
+}
+
+// verifyPlatformsWithKeys returns the signature verifications of the images of the platforms
+// of a multi-platform image, marked as verified if their signature matches one of the public
+// keys of the rule definition
+func verifyPlatformsWithKeys(
+	platforms []*pb.PlatformSignatureVerification,
+	verify keyVerifier,
+) []*pb.PlatformSignatureVerification {
+	if verify == nil {
+		return platforms
+	}
+
+	verified := make([]*pb.PlatformSignatureVerification, 0, len(platforms))
+	for _, platform := range platforms {
+		sigVerification := verifyWithKeys(&pb.ArtifactVersion{
+			Sha:                   platform.GetDigest(),
+			SignatureVerification: platform.GetSignatureVerification(),
+		}, verify)
+		if sigVerification != platform.GetSignatureVerification() {
+			platform = &pb.PlatformSignatureVerification{
+				Platform:              platform.GetPlatform(),
+				Digest:                platform.GetDigest(),
+				SignatureVerification: sigVerification,
+			}
+		}
+		verified = append(verified, platform)
+	}
+
+	return verified
+}
@@ -16,10 +16,32 @@
 package artifact_test
 
 import (
+	"bytes"
 	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"net/http/httptest"
+	"strings"
 	"testing"
-
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/registry"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	cosignmutate "github.com/sigstore/cosign/v2/pkg/oci/mutate"
+	ociremote "github.com/sigstore/cosign/v2/pkg/oci/remote"
+	"github.com/sigstore/cosign/v2/pkg/oci/static"
+	"github.com/sigstore/sigstore/pkg/cryptoutils"
+	"github.com/sigstore/sigstore/pkg/signature"
+	"github.com/sigstore/sigstore/pkg/signature/payload"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	evalerrors "github.com/stacklok/minder/internal/engine/errors"
 	"github.com/stacklok/minder/internal/engine/ingester/artifact"
@@ -29,7 +51,7 @@ import (
 func TestArtifactIngestMatchingName(t *testing.T) {
 	t.Parallel()
 
-	ing, err := artifact.NewArtifactDataIngest(nil)
+	ing, err := artifact.NewArtifactDataIngest(nil, nil)
 	require.NoError(t, err, "expected no error")
 
 	got, err := ing.Ingest(context.Background(), &pb.Artifact{
@@ -51,7 +73,7 @@ func TestArtifactIngestMatchingName(t *testing.T) {
 func TestArtifactIngestMatchingTags(t *testing.T) {
 	t.Parallel()
 
-	ing, err := artifact.NewArtifactDataIngest(nil)
+	ing, err := artifact.NewArtifactDataIngest(nil, nil)
 	require.NoError(t, err, "expected no error")
 
 	got, err := ing.Ingest(context.Background(), &pb.Artifact{
@@ -76,7 +98,7 @@ func TestArtifactIngestMatchingTags(t *testing.T) {
 func TestArtifactIngestNoMatchingTags(t *testing.T) {
 	t.Parallel()
 
-	ing, err := artifact.NewArtifactDataIngest(nil)
+	ing, err := artifact.NewArtifactDataIngest(nil, nil)
 	require.NoError(t, err, "expected no error")
 
 	got, err := ing.Ingest(context.Background(), &pb.Artifact{
@@ -101,7 +123,7 @@ func TestArtifactIngestNoMatchingTags(t *testing.T) {
 func TestArtifactIngestNoMatchingMultipleTagsFromDifferentVersions(t *testing.T) {
 	t.Parallel()
 
-	ing, err := artifact.NewArtifactDataIngest(nil)
+	ing, err := artifact.NewArtifactDataIngest(nil, nil)
 	require.NoError(t, err, "expected no error")
 
 	got, err := ing.Ingest(context.Background(), &pb.Artifact{
@@ -126,7 +148,7 @@ func TestArtifactIngestNoMatchingMultipleTagsFromDifferentVersions(t *testing.T)
 func TestArtifactIngestNoMatchingMultipleTagsFromSameVersion(t *testing.T) {
 	t.Parallel()
 
-	ing, err := artifact.NewArtifactDataIngest(nil)
+	ing, err := artifact.NewArtifactDataIngest(nil, nil)
 	require.NoError(t, err, "expected no error")
 
 	got, err := ing.Ingest(context.Background(), &pb.Artifact{
@@ -151,7 +173,7 @@ func TestArtifactIngestNoMatchingMultipleTagsFromSameVersion(t *testing.T) {
 func TestArtifactIngestMatchingMultipleTagsFromSameVersion(t *testing.T) {
 	t.Parallel()
 
-	ing, err := artifact.NewArtifactDataIngest(nil)
+	ing, err := artifact.NewArtifactDataIngest(nil, nil)
 	require.NoError(t, err, "expected no error")
 
 	got, err := ing.Ingest(context.Background(), &pb.Artifact{
@@ -176,7 +198,7 @@ func TestArtifactIngestMatchingMultipleTagsFromSameVersion(t *testing.T) {
 func TestArtifactIngestNotMatchingName(t *testing.T) {
 	t.Parallel()
 
-	ing, err := artifact.NewArtifactDataIngest(nil)
+	ing, err := artifact.NewArtifactDataIngest(nil, nil)
 	require.NoError(t, err, "expected no error")
 
 	got, err := ing.Ingest(context.Background(), &pb.Artifact{
@@ -194,7 +216,7 @@ func TestArtifactIngestNotMatchingName(t *testing.T) {
 func TestArtifactIngestMatchAnyName(t *testing.T) {
 	t.Parallel()
 
-	ing, err := artifact.NewArtifactDataIngest(nil)
+	ing, err := artifact.NewArtifactDataIngest(nil, nil)
 	require.NoError(t, err, "expected no error")
 
 	got, err := ing.Ingest(context.Background(), &pb.Artifact{
@@ -215,7 +237,7 @@ func TestArtifactIngestMatchAnyName(t *testing.T) {
 func TestArtifactWithMatchingRegexp(t *testing.T) {
 	t.Parallel()
 
-	ing, err := artifact.NewArtifactDataIngest(nil)
+	ing, err := artifact.NewArtifactDataIngest(nil, nil)
 	require.NoError(t, err, "expected no error")
 
 	got, err := ing.Ingest(context.Background(), &pb.Artifact{
@@ -238,7 +260,7 @@ func TestArtifactWithMatchingRegexp(t *testing.T) {
 func TestArtifactWithMultipleTagsAndMatchingRegexp(t *testing.T) {
 	t.Parallel()
 
-	ing, err := artifact.NewArtifactDataIngest(nil)
+	ing, err := artifact.NewArtifactDataIngest(nil, nil)
 	require.NoError(t, err, "expected no error")
 
 	got, err := ing.Ingest(context.Background(), &pb.Artifact{
@@ -264,7 +286,7 @@ func TestArtifactWithMultipleTagsAndMatchingRegexp(t *testing.T) {
 func TestArtifactWithTagThatDoesntMatchRegexp(t *testing.T) {
 	t.Parallel()
 
-	ing, err := artifact.NewArtifactDataIngest(nil)
+	ing, err := artifact.NewArtifactDataIngest(nil, nil)
 	require.NoError(t, err, "expected no error")
 
 	got, err := ing.Ingest(context.Background(), &pb.Artifact{
@@ -289,7 +311,7 @@ func TestArtifactWithTagThatDoesntMatchRegexp(t *testing.T) {
 func TestArtifactWithMultipleTagsThatDontMatchRegexp(t *testing.T) {
 	t.Parallel()
 
-	ing, err := artifact.NewArtifactDataIngest(nil)
+	ing, err := artifact.NewArtifactDataIngest(nil, nil)
 	require.NoError(t, err, "expected no error")
 
 	got, err := ing.Ingest(context.Background(), &pb.Artifact{
@@ -316,7 +338,7 @@ func TestArtifactWithMultipleTagsThatDontMatchRegexp(t *testing.T) {
 func TestArtifactWithEmptyTagShouldError(t *testing.T) {
 	t.Parallel()
 
-	ing, err := artifact.NewArtifactDataIngest(nil)
+	ing, err := artifact.NewArtifactDataIngest(nil, nil)
 	require.NoError(t, err, "expected no error")
 
 	got, err := ing.Ingest(context.Background(), &pb.Artifact{
@@ -343,7 +365,7 @@ func TestArtifactWithEmptyTagShouldError(t *testing.T) {
 func TestArtifactVersionWithNoTagsShouldError(t *testing.T) {
 	t.Parallel()
 
-	ing, err := artifact.NewArtifactDataIngest(nil)
+	ing, err := artifact.NewArtifactDataIngest(nil, nil)
 	require.NoError(t, err, "expected no error")
 
 	got, err := ing.Ingest(context.Background(), &pb.Artifact{
@@ -366,7 +388,7 @@ func TestArtifactVersionWithNoTagsShouldError(t *testing.T) {
 func TestArtifactVersionWithEmptyStringTagShouldError(t *testing.T) {
 	t.Parallel()
 
-	ing, err := artifact.NewArtifactDataIngest(nil)
+	ing, err := artifact.NewArtifactDataIngest(nil, nil)
 	require.NoError(t, err, "expected no error")
 
 	got, err := ing.Ingest(context.Background(), &pb.Artifact{
@@ -385,3 +407,409 @@ func TestArtifactVersionWithEmptyStringTagShouldError(t *testing.T) {
 	require.Error(t, err, "expected error")
 	require.Nil(t, got, "expected nil result")
 }
+
+func TestArtifactIngestWithDefinitionInvalidPublicKey(t *testing.T) {
+	t.Parallel()
+
+	ing, err := artifact.NewArtifactDataIngest(nil, nil)
+	require.NoError(t, err, "expected no error")
+
+	got, err := ing.IngestWithDefinition(context.Background(), &pb.Artifact{
+		Type: "container",
+		Name: "matching-name",
+		Versions: []*pb.ArtifactVersion{
+			{
+				Tags: []string{"latest"},
+			},
+		},
+	}, map[string]interface{}{
+		"name": "matching-name",
+	}, map[string]interface{}{
+		"public_keys": []interface{}{"not a key"},
+	})
+
+	require.Error(t, err, "expected error")
+	require.Nil(t, got, "expected nil result")
+}
+
+func TestArtifactIngestWithDefinitionKeepsVerifiedVersions(t *testing.T) {
+	t.Parallel()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	pemKey, err := cryptoutils.MarshalPublicKeyToPEM(key.Public())
+	require.NoError(t, err)
+
+	ing, err := artifact.NewArtifactDataIngest(nil, nil)
+	require.NoError(t, err, "expected no error")
+
+	keyID := "release"
+	got, err := ing.IngestWithDefinition(context.Background(), &pb.Artifact{
+		Type: "container",
+		Name: "matching-name",
+		Versions: []*pb.ArtifactVersion{
+			{
+				Tags: []string{"latest"},
+				SignatureVerification: &pb.SignatureVerification{
+					IsSigned:      true,
+					IsVerified:    true,
+					KeyIdentifier: &keyID,
+				},
+			},
+		},
+	}, map[string]interface{}{
+		"name": "matching-name",
+	}, map[string]interface{}{
+		"is_verified": true,
+		"public_keys": []interface{}{string(pemKey)},
+	})
+
+	require.NoError(t, err, "expected no error")
+	versions, ok := got.Object.([]map[string]any)
+	require.True(t, ok, "expected a list of versions")
+	require.Len(t, versions, 1)
+	verification, ok := versions[0]["Verification"].(map[string]any)
+	require.True(t, ok, "expected a verification")
+	require.Equal(t, true, verification["is_verified"])
+	require.Equal(t, "release", verification["key_identifier"])
+}
+
+func TestArtifactIngestExposesProvenance(t *testing.T) {
+	t.Parallel()
+
+	ing, err := artifact.NewArtifactDataIngest(nil, nil)
+	require.NoError(t, err, "expected no error")
+
+	got, err := ing.Ingest(context.Background(), &pb.Artifact{
+		Type: "container",
+		Name: "matching-name",
+		Versions: []*pb.ArtifactVersion{
+			{
+				Tags: []string{"latest"},
+				Provenance: &pb.Provenance{
+					IsVerified:       true,
+					BuilderId:        "https://github.com/stacklok/minder/.github/workflows/build.yml@refs/heads/main",
+					SourceRepository: "https://github.com/stacklok/minder",
+					SourceRef:        "refs/heads/main",
+				},
+			},
+		},
+	}, map[string]interface{}{
+		"name": "matching-name",
+	})
+
+	require.NoError(t, err, "expected no error")
+	versions, ok := got.Object.([]map[string]any)
+	require.True(t, ok, "expected a list of versions")
+	require.Len(t, versions, 1)
+	provenance, ok := versions[0]["Provenance"].(map[string]any)
+	require.True(t, ok, "expected a provenance")
+	require.Equal(t, true, provenance["is_verified"])
+	require.Equal(t, "refs/heads/main", provenance["source_ref"])
+}
+
+func TestArtifactIngestPackageTypes(t *testing.T) {
+	t.Parallel()
+
+	ing, err := artifact.NewArtifactDataIngest(nil, nil)
+	require.NoError(t, err, "expected no error")
+
+	npmPackage := &pb.Artifact{
+		Type: "npm",
+		Name: "minder-js",
+		Versions: []*pb.ArtifactVersion{
+			{
+				Tags: []string{"1.0.0"},
+				Sha:  "1.0.0",
+				Provenance: &pb.Provenance{
+					IsVerified: true,
+					SourceRef:  "refs/tags/v1.0.0",
+				},
+			},
+			{
+				Tags: []string{"0.9.0"},
+				Sha:  "0.9.0",
+			},
+		},
+	}
+
+	got, err := ing.Ingest(context.Background(), npmPackage, map[string]interface{}{
+		"type":      "npm",
+		"tag_regex": "^1\\.",
+	})
+	require.NoError(t, err, "expected no error")
+	versions, ok := got.Object.([]map[string]any)
+	require.True(t, ok, "expected a list of versions")
+	require.Len(t, versions, 1, "versions are matched by their version")
+	provenance, ok := versions[0]["Provenance"].(map[string]any)
+	require.True(t, ok, "expected a provenance")
+	require.Equal(t, "refs/tags/v1.0.0", provenance["source_ref"])
+
+	// rules of other artifact types don't apply to the package
+	for _, typ := range []string{"container", "maven", "python"} {
+		got, err = ing.Ingest(context.Background(), npmPackage, map[string]interface{}{
+			"type": typ,
+		})
+		require.ErrorIs(t, err, evalerrors.ErrEvaluationSkipSilently, "expected ErrEvaluationSkipSilently")
+		require.Nil(t, got, "expected nil result")
+	}
+
+	// unknown types never match
+	got, err = ing.Ingest(context.Background(), &pb.Artifact{
+		Type:     "rubygems",
+		Name:     "minder",
+		Versions: []*pb.ArtifactVersion{{Tags: []string{"1.0.0"}}},
+	}, map[string]interface{}{
+		"type": "rubygems",
+	})
+	require.ErrorIs(t, err, evalerrors.ErrEvaluationSkipSilently, "expected ErrEvaluationSkipSilently")
+	require.Nil(t, got, "expected nil result")
+}
+
+// pushImage pushes a random image running as the user to the registry and returns its digest
+func pushImage(t *testing.T, repo, tag, user string) string {
+	t.Helper()
+
+	img, err := random.Image(1024, 1)
+	require.NoError(t, err)
+	cfg, err := img.ConfigFile()
+	require.NoError(t, err)
+	cfg = cfg.DeepCopy()
+	cfg.Config.User = user
+	img, err = mutate.ConfigFile(img, cfg)
+	require.NoError(t, err)
+
+	ref, err := name.ParseReference(fmt.Sprintf("%s:%s", repo, tag))
+	require.NoError(t, err)
+	require.NoError(t, remote.Write(ref, img))
+
+	digest, err := img.Digest()
+	require.NoError(t, err)
+	return digest.String()
+}
+
+func TestArtifactIngestImageContent(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(registry.New())
+	defer srv.Close()
+	repo := fmt.Sprintf("%s/stacklok/minder", strings.TrimPrefix(srv.URL, "http://"))
+
+	image := &pb.Artifact{
+		Type: "container",
+		Name: repo,
+		Versions: []*pb.ArtifactVersion{
+			{
+				Tags:      []string{"v1"},
+				Sha:       pushImage(t, repo, "v1", ""),
+				CreatedAt: timestamppb.New(time.Now().Add(-time.Hour)),
+			},
+			{
+				Tags:      []string{"v2"},
+				Sha:       pushImage(t, repo, "v2", "nonroot"),
+				CreatedAt: timestamppb.New(time.Now()),
+			},
+		},
+	}
+
+	ing, err := artifact.NewArtifactDataIngest(&pb.ArtifactType{InspectImage: true, Filesystem: true}, nil)
+	require.NoError(t, err, "expected no error")
+
+	got, err := ing.Ingest(context.Background(), image, map[string]interface{}{})
+	require.NoError(t, err, "expected no error")
+	versions, ok := got.Object.([]map[string]any)
+	require.True(t, ok, "expected a list of versions")
+	require.Len(t, versions, 2)
+	users := []any{}
+	for _, version := range versions {
+		content, ok := version["Image"].(map[string]any)
+		require.True(t, ok, "expected the content of the image")
+		users = append(users, content["user"])
+	}
+	require.Equal(t, []any{"", "nonroot"}, users)
+	require.NotNil(t, got.Fs, "expected the filesystem of the latest version")
+
+	// rule types that don't evaluate the content of images don't fetch it
+	ing, err = artifact.NewArtifactDataIngest(nil, nil)
+	require.NoError(t, err, "expected no error")
+	got, err = ing.Ingest(context.Background(), image, map[string]interface{}{})
+	require.NoError(t, err, "expected no error")
+	versions, ok = got.Object.([]map[string]any)
+	require.True(t, ok, "expected a list of versions")
+	require.NotContains(t, versions[0], "Image")
+	require.Nil(t, got.Fs)
+}
+
+func TestArtifactIngestIndexVerification(t *testing.T) {
+	t.Parallel()
+
+	ing, err := artifact.NewArtifactDataIngest(nil, nil)
+	require.NoError(t, err, "expected no error")
+
+	platform := func(name string, verified bool) *pb.PlatformSignatureVerification {
+		return &pb.PlatformSignatureVerification{
+			Platform:              name,
+			SignatureVerification: &pb.SignatureVerification{IsSigned: verified, IsVerified: verified},
+		}
+	}
+	image := func(platforms ...*pb.PlatformSignatureVerification) *pb.Artifact {
+		return &pb.Artifact{
+			Type: "container",
+			Name: "minder",
+			Versions: []*pb.ArtifactVersion{{
+				Tags:                  []string{"latest"},
+				SignatureVerification: &pb.SignatureVerification{Platforms: platforms},
+			}},
+		}
+	}
+
+	testCases := []struct {
+		name     string
+		policy   string
+		image    *pb.Artifact
+		verified bool
+	}{
+		{
+			name:     "any with all platforms verified",
+			image:    image(platform("linux/amd64", true), platform("linux/arm64", true)),
+			verified: true,
+		},
+		{
+			name:     "any with a platform not verified",
+			image:    image(platform("linux/amd64", true), platform("linux/arm64", false)),
+			verified: false,
+		},
+		{
+			name:     "index ignores the platforms",
+			policy:   "index",
+			image:    image(platform("linux/amd64", true), platform("linux/arm64", true)),
+			verified: false,
+		},
+		{
+			name:     "platforms with all platforms verified",
+			policy:   "platforms",
+			image:    image(platform("linux/amd64", true), platform("linux/arm64", true)),
+			verified: true,
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			params := map[string]interface{}{}
+			if tc.policy != "" {
+				params["index_verification"] = tc.policy
+			}
+			got, err := ing.Ingest(context.Background(), tc.image, params)
+			require.NoError(t, err, "expected no error")
+			versions, ok := got.Object.([]map[string]any)
+			require.True(t, ok, "expected a list of versions")
+			verification, ok := versions[0]["Verification"].(map[string]any)
+			require.True(t, ok, "expected a verification")
+			require.Equal(t, tc.verified, verification["is_verified"] == true)
+			require.Len(t, verification["platforms"], 2, "the platforms are reported")
+		})
+	}
+
+	// the platforms are required to be verified even if the index is
+	indexSigned := image(platform("linux/amd64", true), platform("linux/arm64", false))
+	indexSigned.Versions[0].SignatureVerification.IsSigned = true
+	indexSigned.Versions[0].SignatureVerification.IsVerified = true
+	got, err := ing.Ingest(context.Background(), indexSigned, map[string]interface{}{"index_verification": "platforms"})
+	require.NoError(t, err, "expected no error")
+	verification := got.Object.([]map[string]any)[0]["Verification"].(map[string]any)
+	require.NotEqual(t, true, verification["is_verified"])
+	require.True(t, indexSigned.Versions[0].SignatureVerification.IsVerified, "the entity is not modified")
+
+	_, err = ing.Ingest(context.Background(), indexSigned, map[string]interface{}{"index_verification": "some"})
+	require.Error(t, err, "expected an invalid policy")
+}
+
+// signImage signs an image in the registry with the key the same way `cosign sign --key` does
+func signImage(t *testing.T, repo, digest string, key *ecdsa.PrivateKey) {
+	t.Helper()
+
+	digestRef, err := name.NewDigest(fmt.Sprintf("%s@%s", repo, digest))
+	require.NoError(t, err)
+
+	rawPayload, err := (&payload.Cosign{Image: digestRef}).MarshalJSON()
+	require.NoError(t, err)
+
+	signer, err := signature.LoadECDSASignerVerifier(key, crypto.SHA256)
+	require.NoError(t, err)
+	sig, err := signer.SignMessage(bytes.NewReader(rawPayload))
+	require.NoError(t, err)
+
+	ociSig, err := static.NewSignature(rawPayload, base64.StdEncoding.EncodeToString(sig))
+	require.NoError(t, err)
+
+	entity, err := ociremote.SignedEntity(digestRef)
+	require.NoError(t, err)
+	signed, err := cosignmutate.AttachSignatureToEntity(entity, ociSig)
+	require.NoError(t, err)
+	require.NoError(t, ociremote.WriteSignatures(digestRef.Repository, signed))
+}
+
+func TestArtifactIngestIndexVerificationWithKeys(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(registry.New())
+	defer srv.Close()
+	repo := fmt.Sprintf("%s/stacklok/minder", strings.TrimPrefix(srv.URL, "http://"))
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	pemKey, err := cryptoutils.MarshalPublicKeyToPEM(key.Public())
+	require.NoError(t, err)
+
+	// only the images of the platforms are signed with the key, not the index
+	index := pushImage(t, repo, "latest", "")
+	amd64 := pushImage(t, repo, "amd64", "")
+	arm64 := pushImage(t, repo, "arm64", "")
+	signImage(t, repo, amd64, key)
+
+	platform := func(name, digest string) *pb.PlatformSignatureVerification {
+		return &pb.PlatformSignatureVerification{
+			Platform:              name,
+			Digest:                digest,
+			SignatureVerification: &pb.SignatureVerification{},
+		}
+	}
+	image := func(platforms ...*pb.PlatformSignatureVerification) *pb.Artifact {
+		return &pb.Artifact{
+			Type: "container",
+			Name: repo,
+			Versions: []*pb.ArtifactVersion{{
+				Tags:                  []string{"latest"},
+				Sha:                   index,
+				SignatureVerification: &pb.SignatureVerification{Platforms: platforms},
+			}},
+		}
+	}
+
+	ing, err := artifact.NewArtifactDataIngest(nil, nil)
+	require.NoError(t, err, "expected no error")
+	def := map[string]interface{}{"public_keys": []interface{}{string(pemKey)}}
+	params := map[string]interface{}{"index_verification": "platforms"}
+
+	got, err := ing.IngestWithDefinition(context.Background(), image(platform("linux/amd64", amd64)), params, def)
+	require.NoError(t, err, "expected no error")
+	verification := got.Object.([]map[string]any)[0]["Verification"].(map[string]any)
+	require.Equal(t, true, verification["is_verified"], "the platforms are verified with the keys")
+	platforms, ok := verification["platforms"].([]any)
+	require.True(t, ok, "expected the platforms")
+	require.Len(t, platforms, 1)
+	platformVerification := platforms[0].(map[string]any)["signature_verification"].(map[string]any)
+	require.Equal(t, true, platformVerification["is_verified"])
+	require.NotEmpty(t, platformVerification["key_identifier"])
+
+	unsignedPlatform := image(platform("linux/amd64", amd64), platform("linux/arm64", arm64))
+	got, err = ing.IngestWithDefinition(context.Background(), unsignedPlatform, params, def)
+	require.NoError(t, err, "expected no error")
+	verification = got.Object.([]map[string]any)[0]["Verification"].(map[string]any)
+	require.NotEqual(t, true, verification["is_verified"], "a platform is not signed with the keys")
+	require.False(t, unsignedPlatform.Versions[0].SignatureVerification.Platforms[0].SignatureVerification.IsVerified,
+		"the entity is not modified")
+}
@@ -21,12 +21,17 @@ import (
 	"strings"
 
 	"github.com/mitchellh/mapstructure"
+
+	"github.com/stacklok/minder/internal/container"
 )
 
 type artifactType string
 
 const (
 	artifactTypeContainer artifactType = "container"
+	artifactTypeNpm       artifactType = "npm"
+	artifactTypeMaven     artifactType = "maven"
+	artifactTypePython    artifactType = "python"
 	artifactTypeUnknown   artifactType = "unknown"
 )
 
@@ -34,16 +39,36 @@ func newArtifactIngestType(s string) artifactType {
 	switch strings.ToLower(s) {
 	case "container":
 		return artifactTypeContainer
+	case "npm":
+		return artifactTypeNpm
+	case "maven":
+		return artifactTypeMaven
+	case "python":
+		return artifactTypePython
 	default:
 		return artifactTypeUnknown
 	}
 }
 
+// indexVerification is how the signatures of multi-platform images are verified
+type indexVerification string
+
+const (
+	// indexVerificationAny considers an image verified if its index or the images of
+	// all of its platforms are verified
+	indexVerificationAny indexVerification = "any"
+	// indexVerificationIndex only considers the signature of the index
+	indexVerificationIndex indexVerification = "index"
+	// indexVerificationPlatforms requires the images of all platforms to be verified
+	indexVerificationPlatforms indexVerification = "platforms"
+)
+
 type ingesterConfig struct {
-	Name     string       `yaml:"name" json:"name" mapstructure:"name"`
-	Tags     []string     `yaml:"tags" json:"tags" mapstructure:"tags"`
-	TagRegex string       `yaml:"tag_regex" json:"tag_regex" mapstructure:"tag_regex"`
-	Type     artifactType `yaml:"type" json:"type" mapstructure:"type"`
+	Name              string            `yaml:"name" json:"name" mapstructure:"name"`
+	Tags              []string          `yaml:"tags" json:"tags" mapstructure:"tags"`
+	TagRegex          string            `yaml:"tag_regex" json:"tag_regex" mapstructure:"tag_regex"`
+	Type              artifactType      `yaml:"type" json:"type" mapstructure:"type"`
+	IndexVerification indexVerification `yaml:"index_verification" json:"index_verification" mapstructure:"index_verification"`
 }
 
 func configFromParams(params map[string]any) (*ingesterConfig, error) {
@@ -56,5 +81,35 @@ func configFromParams(params map[string]any) (*ingesterConfig, error) {
 		cfg.Type = artifactTypeContainer
 	}
 
+	switch cfg.IndexVerification {
+	case "":
+		cfg.IndexVerification = indexVerificationAny
+	case indexVerificationAny, indexVerificationIndex, indexVerificationPlatforms:
+	default:
+		return nil, fmt.Errorf("invalid index_verification %s", cfg.IndexVerification)
+	}
+
 	return cfg, nil
 }
+
+// publicKeysFromDefinition parses the PEM encoded public keys given in the
+// public_keys field of a rule definition
+func publicKeysFromDefinition(def map[string]any) ([]*container.VerificationKey, error) {
+	var cfg struct {
+		PublicKeys []string `mapstructure:"public_keys"`
+	}
+	if err := mapstructure.Decode(def, &cfg); err != nil {
+		return nil, fmt.Errorf("error decoding public keys of rule definition: %w", err)
+	}
+
+	keys := make([]*container.VerificationKey, 0, len(cfg.PublicKeys))
+	for _, pemKey := range cfg.PublicKeys {
+		key, err := container.NewVerificationKey("", []byte(pemKey))
+		if err != nil {
+			return nil, fmt.Errorf("invalid public key in rule definition: %w", err)
+		}
+		keys = append(keys, key)
+	}
+
+	return keys, nil
+}
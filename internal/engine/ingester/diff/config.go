@@ -15,6 +15,10 @@
 // Package diff provides the diff rule data ingest engine
 package diff
 
+import (
+	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+)
+
 // DependencyEcosystem is the type of dependency ecosystem
 type DependencyEcosystem string
 
@@ -34,3 +38,32 @@ type EcosystemMapping struct {
 	Ecosystem DependencyEcosystem `json:"ecosystem" yaml:"ecosystem" mapstructure:"ecosystem"`
 	Files     []string            `json:"files" yaml:"files" mapstructure:"files"`
 }
+
+// DependencyEcosystemFromProto returns the dependency ecosystem of the protobuf
+// enum value or DepEcosystemNone if it is not known.
+func DependencyEcosystemFromProto(eco pb.DepEcosystem) DependencyEcosystem {
+	switch eco {
+	case pb.DepEcosystem_DEP_ECOSYSTEM_NPM:
+		return DepEcosystemNPM
+	case pb.DepEcosystem_DEP_ECOSYSTEM_GO:
+		return DepEcosystemGo
+	case pb.DepEcosystem_DEP_ECOSYSTEM_PYPI:
+		return DepEcosystemPyPI
+	default:
+		return DepEcosystemNone
+	}
+}
+
+// AsProto returns the protobuf enum value of the dependency ecosystem
+func (e DependencyEcosystem) AsProto() pb.DepEcosystem {
+	switch e {
+	case DepEcosystemNPM:
+		return pb.DepEcosystem_DEP_ECOSYSTEM_NPM
+	case DepEcosystemGo:
+		return pb.DepEcosystem_DEP_ECOSYSTEM_GO
+	case DepEcosystemPyPI:
+		return pb.DepEcosystem_DEP_ECOSYSTEM_PYPI
+	default:
+		return pb.DepEcosystem_DEP_ECOSYSTEM_UNSPECIFIED
+	}
+}
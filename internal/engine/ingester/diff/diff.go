@@ -18,7 +18,6 @@ package diff
 import (
 	"context"
 	"fmt"
-	"path/filepath"
 
 	"github.com/rs/zerolog"
 	"google.golang.org/protobuf/reflect/protoreflect"
@@ -118,7 +117,7 @@ func (di *Diff) Ingest(
 	}
 
 	return &engif.Result{
-		Object: pb.PrDependencies{
+		Object: &pb.PrDependencies{
 			Pr:   pr,
 			Deps: allDiffs,
 		},
@@ -155,14 +154,7 @@ func (di *Diff) ingestFile(
 }
 
 func (di *Diff) getEcosystemForFile(filename string) DependencyEcosystem {
-	lastComponent := filepath.Base(filename)
-
-	for _, ecoMapping := range di.cfg.Ecosystems {
-		if match, _ := filepath.Match(ecoMapping.Depfile, lastComponent); match {
-			return DependencyEcosystem(ecoMapping.Name)
-		}
-	}
-	return DepEcosystemNone
+	return EcosystemForFile(filename, di.cfg.Ecosystems)
 }
 
 func (di *Diff) getParserForFile(filename string, logger zerolog.Logger) ecosystemParser {
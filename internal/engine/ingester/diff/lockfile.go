@@ -0,0 +1,128 @@
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package diff provides the diff rule data ingest engine
+package diff
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	billy "github.com/go-git/go-billy/v5"
+	billyutil "github.com/go-git/go-billy/v5/util"
+
+	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+// EcosystemForFile returns the dependency ecosystem whose depfile pattern matches
+// the base name of filename or DepEcosystemNone if no ecosystem matches.
+func EcosystemForFile(filename string, ecosystems []*pb.DiffType_Ecosystem) DependencyEcosystem {
+	lastComponent := filepath.Base(filename)
+
+	for _, ecoMapping := range ecosystems {
+		if match, _ := filepath.Match(ecoMapping.Depfile, lastComponent); match {
+			return DependencyEcosystem(ecoMapping.Name)
+		}
+	}
+	return DepEcosystemNone
+}
+
+// ParseDependencyFile parses the full contents of a dependency file, such as a
+// lockfile checked out from a repository. The ecosystem parsers only consider
+// lines added by a patch, so every line of the file is treated as added.
+func ParseDependencyFile(eco DependencyEcosystem, contents string) ([]*pb.Dependency, error) {
+	parser := newEcosystemParser(eco)
+	if parser == nil {
+		return nil, fmt.Errorf("no parser available for ecosystem %q", eco)
+	}
+
+	lines := strings.Split(contents, "\n")
+	for i := range lines {
+		lines[i] = "+" + lines[i]
+	}
+
+	return parser(strings.Join(lines, "\n"))
+}
+
+// ParseFilesystem walks the filesystem and parses every dependency file that
+// matches one of the configured ecosystems. The returned dependencies carry the
+// path of the file they were found in, but no patch URL.
+func ParseFilesystem(
+	fs billy.Filesystem,
+	ecosystems []*pb.DiffType_Ecosystem,
+) ([]*pb.PrDependencies_ContextualDependency, error) {
+	allDeps := make([]*pb.PrDependencies_ContextualDependency, 0)
+
+	err := billyutil.Walk(fs, "/", func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if info.IsDir() {
+			if info.Name() == ".git" {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		eco := EcosystemForFile(path, ecosystems)
+		if eco == DepEcosystemNone {
+			return nil
+		}
+
+		contents, err := readFile(fs, path)
+		if err != nil {
+			return fmt.Errorf("error reading file %s: %w", path, err)
+		}
+
+		deps, err := ParseDependencyFile(eco, contents)
+		if err != nil {
+			return fmt.Errorf("error parsing file %s: %w", path, err)
+		}
+
+		for _, dep := range deps {
+			allDeps = append(allDeps, &pb.PrDependencies_ContextualDependency{
+				Dep: dep,
+				File: &pb.PrDependencies_ContextualDependency_FilePatch{
+					Name: strings.TrimPrefix(path, "/"),
+				},
+			})
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return allDeps, nil
+}
+
+func readFile(fs billy.Filesystem, path string) (string, error) {
+	f, err := fs.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	contents, err := io.ReadAll(f)
+	if err != nil {
+		return "", err
+	}
+
+	return string(contents), nil
+}
@@ -0,0 +1,71 @@
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package diff
+
+import (
+	"testing"
+
+	"github.com/go-git/go-billy/v5/memfs"
+	billyutil "github.com/go-git/go-billy/v5/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+const goSumContents = `github.com/google/uuid v1.4.0 h1:MtMxsa51/r9yyhkyLsVeVt0B+BGQZzpQiTQ4eHZ8bc4=
+github.com/google/uuid v1.4.0/go.mod h1:TIyPZe4MgqvfeYDBFedMoGGpEw/LqOeaOT+nhxU+yHo=
+`
+
+const requirementsContents = `# production dependencies
+Flask==3.0.0
+requests>=2.31.0
+`
+
+func TestParseFilesystem(t *testing.T) {
+	t.Parallel()
+
+	fs := memfs.New()
+	require.NoError(t, billyutil.WriteFile(fs, "go.sum", []byte(goSumContents), 0644))
+	require.NoError(t, billyutil.WriteFile(fs, "service/requirements.txt", []byte(requirementsContents), 0644))
+	require.NoError(t, billyutil.WriteFile(fs, "README.md", []byte("# readme"), 0644))
+	require.NoError(t, billyutil.WriteFile(fs, ".git/go.sum", []byte(goSumContents), 0644))
+
+	deps, err := ParseFilesystem(fs, []*pb.DiffType_Ecosystem{
+		{Name: "go", Depfile: "go.sum"},
+		{Name: "pypi", Depfile: "requirements.txt"},
+	})
+	require.NoError(t, err)
+	require.Len(t, deps, 3)
+
+	byName := make(map[string]*pb.PrDependencies_ContextualDependency, len(deps))
+	for _, d := range deps {
+		byName[d.Dep.Name] = d
+	}
+
+	assert.Equal(t, "v1.4.0", byName["github.com/google/uuid"].Dep.Version)
+	assert.Equal(t, "go.sum", byName["github.com/google/uuid"].File.Name)
+	assert.Equal(t, "3.0.0", byName["flask"].Dep.Version)
+	assert.Equal(t, "service/requirements.txt", byName["flask"].File.Name)
+	assert.Equal(t, "2.31.0", byName["requests"].Dep.Version)
+	assert.Empty(t, byName["requests"].File.PatchUrl)
+}
+
+func TestParseDependencyFileUnknownEcosystem(t *testing.T) {
+	t.Parallel()
+
+	_, err := ParseDependencyFile(DependencyEcosystem("cargo"), "")
+	require.Error(t, err)
+}
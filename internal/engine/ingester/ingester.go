@@ -25,6 +25,8 @@ import (
 	"github.com/stacklok/minder/internal/engine/ingester/diff"
 	"github.com/stacklok/minder/internal/engine/ingester/git"
 	"github.com/stacklok/minder/internal/engine/ingester/rest"
+	"github.com/stacklok/minder/internal/engine/ingester/rulesets"
+	"github.com/stacklok/minder/internal/engine/ingester/sbom"
 	engif "github.com/stacklok/minder/internal/engine/interfaces"
 	"github.com/stacklok/minder/internal/providers"
 	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
@@ -33,8 +35,11 @@ import (
 // test that the ingester implementations implements the interface
 // this would be probably nicer in the implementation file, but that would cause an import loop
 var _ engif.Ingester = (*artifact.Ingest)(nil)
+var _ engif.DefinitionIngester = (*artifact.Ingest)(nil)
 var _ engif.Ingester = (*builtin.BuiltinRuleDataIngest)(nil)
 var _ engif.Ingester = (*rest.Ingestor)(nil)
+var _ engif.Ingester = (*sbom.Ingestor)(nil)
+var _ engif.Ingester = (*rulesets.Ingestor)(nil)
 
 // NewRuleDataIngest creates a new rule data ingest based no the given rule
 // type definition.
@@ -58,12 +63,16 @@ func NewRuleDataIngest(rt *pb.RuleType, pbuild *providers.ProviderBuilder) (engi
 		if rt.Def.Ingest.GetArtifact() == nil {
 			return nil, fmt.Errorf("rule type engine missing artifact configuration")
 		}
-		return artifact.NewArtifactDataIngest(ing.GetArtifact())
+		return artifact.NewArtifactDataIngest(ing.GetArtifact(), pbuild)
 
 	case git.GitRuleDataIngestType:
 		return git.NewGitIngester(ing.GetGit(), pbuild)
 	case diff.DiffRuleDataIngestType:
 		return diff.NewDiffIngester(ing.GetDiff(), pbuild)
+	case sbom.SBOMRuleDataIngestType:
+		return sbom.NewSBOMIngester(ing.GetSbom(), pbuild)
+	case rulesets.RulesetsRuleDataIngestType:
+		return rulesets.NewRulesetsIngester(ing.GetRulesets(), pbuild)
 	default:
 		return nil, fmt.Errorf("unsupported rule type engine: %s", rt.Def.Ingest.Type)
 	}
@@ -0,0 +1,114 @@
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package rulesets provides the GitHub rulesets rule data ingest engine
+package rulesets
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	engif "github.com/stacklok/minder/internal/engine/interfaces"
+	"github.com/stacklok/minder/internal/providers"
+	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+	provifv1 "github.com/stacklok/minder/pkg/providers/v1"
+)
+
+const (
+	// RulesetsRuleDataIngestType is the type of the rulesets rule data ingest engine
+	RulesetsRuleDataIngestType = "rulesets"
+)
+
+// Ingestor is the engine for a rule type that uses rulesets data ingest.
+// The ingested object is the list of rulesets that apply to the repository,
+// each with its full list of rules.
+type Ingestor struct {
+	cfg *pb.GhRulesetsType
+	cli provifv1.GitHub
+}
+
+// NewRulesetsIngester creates a new rulesets rule data ingest engine
+func NewRulesetsIngester(cfg *pb.GhRulesetsType, pbuild *providers.ProviderBuilder) (*Ingestor, error) {
+	if pbuild == nil {
+		return nil, fmt.Errorf("provider builder is nil")
+	}
+
+	if cfg == nil {
+		cfg = &pb.GhRulesetsType{}
+	}
+
+	cli, err := pbuild.GetGitHub(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get github client: %w", err)
+	}
+
+	return &Ingestor{
+		cfg: cfg,
+		cli: cli,
+	}, nil
+}
+
+// GetType returns the type of the rulesets rule data ingest engine
+func (*Ingestor) GetType() string {
+	return RulesetsRuleDataIngestType
+}
+
+// GetConfig returns the config for the rulesets rule data ingest engine
+func (i *Ingestor) GetConfig() protoreflect.ProtoMessage {
+	return i.cfg
+}
+
+// Ingest fetches the rulesets that apply to a repository
+func (i *Ingestor) Ingest(ctx context.Context, ent protoreflect.ProtoMessage, _ map[string]any) (*engif.Result, error) {
+	repo, ok := ent.(*pb.Repository)
+	if !ok {
+		return nil, fmt.Errorf("rulesets ingester does not support entity %T", ent)
+	}
+
+	includeParents := !i.cfg.GetRepositoryOnly()
+	summaries, err := i.cli.ListRulesets(ctx, repo.GetOwner(), repo.GetName(), includeParents)
+	if err != nil {
+		return nil, fmt.Errorf("error listing rulesets: %w", err)
+	}
+
+	// the list only contains a summary of each ruleset, the rules
+	// themselves have to be fetched one ruleset at a time
+	rulesets := make([]any, 0, len(summaries))
+	for _, summary := range summaries {
+		var s struct {
+			ID int64 `json:"id"`
+		}
+		if err := json.Unmarshal(summary, &s); err != nil {
+			return nil, fmt.Errorf("error parsing ruleset: %w", err)
+		}
+
+		raw, err := i.cli.GetRuleset(ctx, repo.GetOwner(), repo.GetName(), s.ID, includeParents)
+		if err != nil {
+			return nil, fmt.Errorf("error getting ruleset %d: %w", s.ID, err)
+		}
+
+		var ruleset map[string]any
+		if err := json.Unmarshal(raw, &ruleset); err != nil {
+			return nil, fmt.Errorf("error parsing ruleset %d: %w", s.ID, err)
+		}
+		rulesets = append(rulesets, ruleset)
+	}
+
+	return &engif.Result{
+		Object: rulesets,
+	}, nil
+}
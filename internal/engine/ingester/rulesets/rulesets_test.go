@@ -0,0 +1,143 @@
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rulesets
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	mock_ghclient "github.com/stacklok/minder/internal/providers/github/mock"
+	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+const (
+	repoRuleset = `{"id": 1, "name": "main", "source_type": "Repository", "enforcement": "active",
+"rules": [{"type": "deletion"}, {"type": "merge_queue", "parameters": {"merge_method": "SQUASH"}}]}`
+	orgRuleset = `{"id": 2, "name": "org", "source_type": "Organization", "enforcement": "evaluate",
+"rules": [{"type": "required_signatures"}]}`
+)
+
+func TestIngest(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		cfg         *pb.GhRulesetsType
+		ent         *pb.Repository
+		mockSetup   func(*mock_ghclient.MockGitHub)
+		expected    []string
+		expectedErr string
+	}{
+		{
+			name: "includes the organization rulesets",
+			cfg:  &pb.GhRulesetsType{},
+			ent:  &pb.Repository{Owner: "stacklok", Name: "minder"},
+			mockSetup: func(mockGitHub *mock_ghclient.MockGitHub) {
+				mockGitHub.EXPECT().
+					ListRulesets(gomock.Any(), "stacklok", "minder", true).
+					Return([]json.RawMessage{json.RawMessage(`{"id": 1}`), json.RawMessage(`{"id": 2}`)}, nil)
+				mockGitHub.EXPECT().
+					GetRuleset(gomock.Any(), "stacklok", "minder", int64(1), true).
+					Return(json.RawMessage(repoRuleset), nil)
+				mockGitHub.EXPECT().
+					GetRuleset(gomock.Any(), "stacklok", "minder", int64(2), true).
+					Return(json.RawMessage(orgRuleset), nil)
+			},
+			expected: []string{"main", "org"},
+		},
+		{
+			name: "repository only",
+			cfg:  &pb.GhRulesetsType{RepositoryOnly: true},
+			ent:  &pb.Repository{Owner: "stacklok", Name: "minder"},
+			mockSetup: func(mockGitHub *mock_ghclient.MockGitHub) {
+				mockGitHub.EXPECT().
+					ListRulesets(gomock.Any(), "stacklok", "minder", false).
+					Return([]json.RawMessage{json.RawMessage(`{"id": 1}`)}, nil)
+				mockGitHub.EXPECT().
+					GetRuleset(gomock.Any(), "stacklok", "minder", int64(1), false).
+					Return(json.RawMessage(repoRuleset), nil)
+			},
+			expected: []string{"main"},
+		},
+		{
+			name: "no rulesets",
+			cfg:  &pb.GhRulesetsType{},
+			ent:  &pb.Repository{Owner: "stacklok", Name: "minder"},
+			mockSetup: func(mockGitHub *mock_ghclient.MockGitHub) {
+				mockGitHub.EXPECT().
+					ListRulesets(gomock.Any(), "stacklok", "minder", true).
+					Return([]json.RawMessage{}, nil)
+			},
+			expected: []string{},
+		},
+		{
+			name: "listing fails",
+			cfg:  &pb.GhRulesetsType{},
+			ent:  &pb.Repository{Owner: "stacklok", Name: "minder"},
+			mockSetup: func(mockGitHub *mock_ghclient.MockGitHub) {
+				mockGitHub.EXPECT().
+					ListRulesets(gomock.Any(), "stacklok", "minder", true).
+					Return(nil, errors.New("boom"))
+			},
+			expectedErr: "error listing rulesets",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockGitHub := mock_ghclient.NewMockGitHub(ctrl)
+			tt.mockSetup(mockGitHub)
+
+			ing := &Ingestor{cfg: tt.cfg, cli: mockGitHub}
+			res, err := ing.Ingest(context.Background(), tt.ent, nil)
+			if tt.expectedErr != "" {
+				require.ErrorContains(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+
+			rulesets, ok := res.Object.([]any)
+			require.True(t, ok, "expected the rulesets as a list")
+
+			names := make([]string, 0, len(rulesets))
+			for _, r := range rulesets {
+				ruleset, ok := r.(map[string]any)
+				require.True(t, ok, "expected a ruleset as a map")
+				names = append(names, ruleset["name"].(string))
+			}
+			assert.Equal(t, tt.expected, names)
+		})
+	}
+}
+
+func TestIngestRequiresRepository(t *testing.T) {
+	t.Parallel()
+
+	ing := &Ingestor{cfg: &pb.GhRulesetsType{}}
+	_, err := ing.Ingest(context.Background(), &pb.Artifact{}, nil)
+	require.ErrorContains(t, err, "does not support entity")
+}
@@ -0,0 +1,29 @@
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package sbom provides the sbom rule data ingest engine
+package sbom
+
+// IngesterConfig is the profile-provided configuration for the sbom ingester
+// This allows for users to pass in configuration to the ingester
+// in different calls as opposed to having to set it in the rule type.
+type IngesterConfig struct {
+	// Format overrides the SBOM format set in the rule type
+	Format string `json:"format" yaml:"format" mapstructure:"format"`
+	// Branch is the branch of the repository to generate the SBOM for
+	Branch string `json:"branch" yaml:"branch" mapstructure:"branch"`
+	// Tag selects the artifact version to fetch the SBOM attestation of.
+	// If not set, the most recent version is used.
+	Tag string `json:"tag" yaml:"tag" mapstructure:"tag"`
+}
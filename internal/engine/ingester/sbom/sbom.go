@@ -0,0 +1,271 @@
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package sbom provides the sbom rule data ingest engine
+package sbom
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/mitchellh/mapstructure"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/stacklok/minder/internal/container"
+	"github.com/stacklok/minder/internal/db"
+	evalerrors "github.com/stacklok/minder/internal/engine/errors"
+	engif "github.com/stacklok/minder/internal/engine/interfaces"
+	"github.com/stacklok/minder/internal/providers"
+	sbomgen "github.com/stacklok/minder/internal/sbom"
+	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+	provifv1 "github.com/stacklok/minder/pkg/providers/v1"
+)
+
+const (
+	// SBOMRuleDataIngestType is the type of the sbom rule data ingest engine
+	SBOMRuleDataIngestType = "sbom"
+)
+
+// attestationFetcher fetches the predicate of an attestation attached to an artifact version
+type attestationFetcher func(
+	ctx context.Context,
+	cli provifv1.Provider,
+	trust *container.TrustRoot,
+	ownerLogin, artifactName, versionName string,
+	predicateTypes []string,
+) (string, json.RawMessage, error)
+
+// Ingestor is the engine for a rule type that uses sbom data ingest.
+// For repositories the SBOM is generated from the lockfiles of a clone of the
+// repository, for artifacts it is read from the SBOM attestation attached to
+// the artifact and verified against the trust root of the provider.
+type Ingestor struct {
+	cfg       *pb.SBOMType
+	prov      provifv1.Provider
+	gitprov   provifv1.Git
+	trustRoot func(ctx context.Context) (*container.TrustRoot, error)
+	fetch     attestationFetcher
+}
+
+// NewSBOMIngester creates a new sbom rule data ingest engine
+func NewSBOMIngester(cfg *pb.SBOMType, pbuild *providers.ProviderBuilder) (*Ingestor, error) {
+	if pbuild == nil {
+		return nil, fmt.Errorf("provider builder is nil")
+	}
+
+	if cfg == nil {
+		cfg = &pb.SBOMType{}
+	}
+
+	if _, err := sbomgen.ParseFormat(cfg.Format); err != nil {
+		return nil, err
+	}
+
+	ing := &Ingestor{
+		cfg:       cfg,
+		prov:      pbuild,
+		trustRoot: pbuild.GetTrustRoot,
+		fetch:     container.GetArtifactAttestationPredicate,
+	}
+
+	// cloning is only needed for repositories, so a provider that
+	// can't clone is still usable for artifacts
+	if pbuild.Implements(db.ProviderTypeGit) {
+		gitprov, err := pbuild.GetGit()
+		if err != nil {
+			return nil, fmt.Errorf("could not get git provider: %w", err)
+		}
+		ing.gitprov = gitprov
+	}
+
+	return ing, nil
+}
+
+// GetType returns the type of the sbom rule data ingest engine
+func (*Ingestor) GetType() string {
+	return SBOMRuleDataIngestType
+}
+
+// GetConfig returns the config for the sbom rule data ingest engine
+func (i *Ingestor) GetConfig() protoreflect.ProtoMessage {
+	return i.cfg
+}
+
+// Ingest produces or fetches the SBOM of the entity
+func (i *Ingestor) Ingest(ctx context.Context, ent protoreflect.ProtoMessage, params map[string]any) (*engif.Result, error) {
+	userCfg := &IngesterConfig{}
+	if err := mapstructure.Decode(params, userCfg); err != nil {
+		return nil, fmt.Errorf("failed to read sbom ingester configuration from params: %w", err)
+	}
+
+	switch entity := ent.(type) {
+	case *pb.Repository:
+		return i.ingestRepository(ctx, entity, userCfg)
+	case *pb.Artifact:
+		return i.ingestArtifact(ctx, entity, userCfg)
+	default:
+		return nil, fmt.Errorf("sbom ingester does not support entity %T", ent)
+	}
+}
+
+func (i *Ingestor) ingestRepository(
+	ctx context.Context,
+	repo *pb.Repository,
+	userCfg *IngesterConfig,
+) (*engif.Result, error) {
+	if i.gitprov == nil {
+		return nil, fmt.Errorf("provider builder does not implement git")
+	}
+
+	format, err := i.getFormat(userCfg)
+	if err != nil {
+		return nil, err
+	}
+
+	if repo.GetCloneUrl() == "" {
+		return nil, fmt.Errorf("could not get clone url")
+	}
+
+	r, err := i.gitprov.Clone(ctx, repo.GetCloneUrl(), i.getBranch(repo, userCfg))
+	if err != nil {
+		return nil, fmt.Errorf("could not clone repo: %w", err)
+	}
+
+	wt, err := r.Worktree()
+	if err != nil {
+		return nil, fmt.Errorf("could not get worktree: %w", err)
+	}
+
+	components, err := sbomgen.ComponentsFromFilesystem(wt.Filesystem, i.cfg.Ecosystems)
+	if err != nil {
+		return nil, err
+	}
+
+	doc, err := sbomgen.GenerateObject(format, fmt.Sprintf("%s/%s", repo.GetOwner(), repo.GetName()), components)
+	if err != nil {
+		return nil, fmt.Errorf("could not generate sbom: %w", err)
+	}
+
+	return &engif.Result{
+		Object: doc,
+		Fs:     wt.Filesystem,
+		Storer: r.Storer,
+	}, nil
+}
+
+func (i *Ingestor) ingestArtifact(
+	ctx context.Context,
+	artifact *pb.Artifact,
+	userCfg *IngesterConfig,
+) (*engif.Result, error) {
+	// unless a format was requested explicitly, take whichever SBOM is attached
+	predicateTypes := []string{sbomgen.PredicateTypeCycloneDX, sbomgen.PredicateTypeSPDX}
+	if userCfg.Format != "" || i.cfg.Format != "" {
+		format, err := i.getFormat(userCfg)
+		if err != nil {
+			return nil, err
+		}
+		predicateTypes = format.PredicateTypes()
+	}
+
+	version := selectArtifactVersion(artifact.GetVersions(), userCfg.Tag)
+	if version == nil {
+		return nil, evalerrors.NewErrEvaluationSkipSilently("no applicable artifact version found")
+	}
+
+	trust, err := i.trustRoot(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not get sigstore trust root: %w", err)
+	}
+
+	_, predicate, err := i.fetch(
+		ctx, i.prov, trust, artifact.GetOwner(), artifact.GetName(), version.GetSha(), predicateTypes)
+	if errors.Is(err, container.ErrAttestationNotFound) {
+		return nil, evalerrors.NewErrEvaluationFailed("no SBOM attestation found for artifact version %s", version.GetSha())
+	} else if errors.Is(err, container.ErrAttestationNotVerified) {
+		return nil, evalerrors.NewErrEvaluationFailed("SBOM attestation of artifact version %s is not verified", version.GetSha())
+	} else if err != nil {
+		return nil, fmt.Errorf("could not fetch sbom attestation: %w", err)
+	}
+
+	var doc map[string]any
+	if err := json.Unmarshal(predicate, &doc); err != nil {
+		return nil, fmt.Errorf("could not decode sbom attestation: %w", err)
+	}
+
+	return &engif.Result{
+		Object: doc,
+	}, nil
+}
+
+func (i *Ingestor) getFormat(userCfg *IngesterConfig) (sbomgen.Format, error) {
+	// If the user has specified a format, use that
+	if userCfg.Format != "" {
+		return sbomgen.ParseFormat(userCfg.Format)
+	}
+
+	return sbomgen.ParseFormat(i.cfg.Format)
+}
+
+func (i *Ingestor) getBranch(repo *pb.Repository, userCfg *IngesterConfig) string {
+	// If the user has specified a branch, use that
+	if userCfg.Branch != "" {
+		return userCfg.Branch
+	}
+
+	// If the branch is provided in the rule-type
+	// configuration, use that
+	if i.cfg.Branch != "" {
+		return i.cfg.Branch
+	}
+
+	// an empty branch clones the default branch of the repository
+	return repo.GetDefaultBranch()
+}
+
+// selectArtifactVersion returns the most recent version carrying the tag or the
+// most recent tagged version if no tag is given.
+func selectArtifactVersion(versions []*pb.ArtifactVersion, tag string) *pb.ArtifactVersion {
+	candidates := make([]*pb.ArtifactVersion, 0, len(versions))
+	for _, v := range versions {
+		if len(v.GetTags()) == 0 || v.GetSha() == "" {
+			continue
+		}
+		if tag != "" && !hasTag(v, tag) {
+			continue
+		}
+		candidates = append(candidates, v)
+	}
+
+	if len(candidates) == 0 {
+		return nil
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].GetCreatedAt().AsTime().After(candidates[j].GetCreatedAt().AsTime())
+	})
+	return candidates[0]
+}
+
+func hasTag(v *pb.ArtifactVersion, tag string) bool {
+	for _, t := range v.GetTags() {
+		if t == tag {
+			return true
+		}
+	}
+	return false
+}
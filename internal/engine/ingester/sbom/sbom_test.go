@@ -0,0 +1,215 @@
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sbom
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/go-git/go-billy/v5/memfs"
+	billyutil "github.com/go-git/go-billy/v5/util"
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/storage/memory"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/stacklok/minder/internal/container"
+	evalerrors "github.com/stacklok/minder/internal/engine/errors"
+	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+	provifv1 "github.com/stacklok/minder/pkg/providers/v1"
+)
+
+type fakeGit struct {
+	files  map[string]string
+	branch string
+}
+
+func (*fakeGit) GetToken() string {
+	return ""
+}
+
+func (f *fakeGit) Clone(_ context.Context, _ string, branch string) (*git.Repository, error) {
+	f.branch = branch
+	fs := memfs.New()
+	for name, contents := range f.files {
+		if err := billyutil.WriteFile(fs, name, []byte(contents), 0644); err != nil {
+			return nil, err
+		}
+	}
+	return git.Init(memory.NewStorage(), fs)
+}
+
+func TestIngestRepository(t *testing.T) {
+	t.Parallel()
+
+	gitprov := &fakeGit{files: map[string]string{
+		"go.sum": "github.com/google/uuid v1.4.0 h1:MtMxsa51/r9yyhkyLsVeVt0B+BGQZzpQiTQ4eHZ8bc4=\n",
+	}}
+	ing := &Ingestor{
+		cfg:     &pb.SBOMType{Format: "cyclonedx", Branch: "develop"},
+		gitprov: gitprov,
+	}
+
+	res, err := ing.Ingest(context.Background(), &pb.Repository{
+		Owner:    "stacklok",
+		Name:     "minder",
+		CloneUrl: "https://github.com/stacklok/minder.git",
+	}, map[string]any{"format": "spdx"})
+	require.NoError(t, err)
+	require.NotNil(t, res.Fs)
+	assert.Equal(t, "develop", gitprov.branch)
+
+	doc, ok := res.Object.(map[string]any)
+	require.True(t, ok, "expected the SBOM as a map")
+	assert.Equal(t, "SPDX-2.3", doc["spdxVersion"], "params should override the rule type format")
+	assert.Equal(t, "stacklok/minder", doc["name"])
+	assert.Len(t, doc["packages"], 2)
+}
+
+func TestIngestRepositoryDefaultBranch(t *testing.T) {
+	t.Parallel()
+
+	gitprov := &fakeGit{files: map[string]string{
+		"go.sum": "github.com/google/uuid v1.4.0 h1:MtMxsa51/r9yyhkyLsVeVt0B+BGQZzpQiTQ4eHZ8bc4=\n",
+	}}
+	ing := &Ingestor{
+		cfg:     &pb.SBOMType{},
+		gitprov: gitprov,
+	}
+
+	_, err := ing.Ingest(context.Background(), &pb.Repository{
+		Owner:         "stacklok",
+		Name:          "minder",
+		CloneUrl:      "https://github.com/stacklok/minder.git",
+		DefaultBranch: "trunk",
+	}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "trunk", gitprov.branch)
+}
+
+func TestIngestRepositoryWithoutGit(t *testing.T) {
+	t.Parallel()
+
+	ing := &Ingestor{cfg: &pb.SBOMType{}}
+	_, err := ing.Ingest(context.Background(), &pb.Repository{CloneUrl: "https://example.com/repo.git"}, nil)
+	require.Error(t, err)
+}
+
+func TestIngestArtifact(t *testing.T) {
+	t.Parallel()
+
+	older := timestamppb.New(time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC))
+	newer := timestamppb.New(time.Date(2023, 11, 1, 0, 0, 0, 0, time.UTC))
+	artifact := &pb.Artifact{
+		Owner: "stacklok",
+		Name:  "minder",
+		Versions: []*pb.ArtifactVersion{
+			{Sha: "sha256:old", Tags: []string{"v1"}, CreatedAt: older},
+			{Sha: "sha256:new", Tags: []string{"v2", "latest"}, CreatedAt: newer},
+			{Sha: "sha256:untagged", CreatedAt: newer},
+		},
+	}
+
+	tests := []struct {
+		name          string
+		cfg           *pb.SBOMType
+		params        map[string]any
+		expectVersion string
+		expectTypes   []string
+		fetchErr      error
+		expectFailed  bool
+	}{
+		{
+			name:          "latest version and any format",
+			cfg:           &pb.SBOMType{},
+			expectVersion: "sha256:new",
+			expectTypes:   []string{"https://cyclonedx.org/bom", "https://spdx.dev/Document"},
+		},
+		{
+			name:          "tag and format from params",
+			cfg:           &pb.SBOMType{},
+			params:        map[string]any{"tag": "v1", "format": "spdx"},
+			expectVersion: "sha256:old",
+			expectTypes:   []string{"https://spdx.dev/Document"},
+		},
+		{
+			name:          "no attestation",
+			cfg:           &pb.SBOMType{Format: "cyclonedx"},
+			expectVersion: "sha256:new",
+			expectTypes:   []string{"https://cyclonedx.org/bom"},
+			fetchErr:      container.ErrAttestationNotFound,
+			expectFailed:  true,
+		},
+		{
+			name:          "unverified attestation",
+			cfg:           &pb.SBOMType{Format: "cyclonedx"},
+			expectVersion: "sha256:new",
+			expectTypes:   []string{"https://cyclonedx.org/bom"},
+			fetchErr:      container.ErrAttestationNotVerified,
+			expectFailed:  true,
+		},
+	}
+
+	trust := &container.TrustRoot{}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ing := &Ingestor{
+				cfg: tt.cfg,
+				trustRoot: func(context.Context) (*container.TrustRoot, error) {
+					return trust, nil
+				},
+				fetch: func(
+					_ context.Context, _ provifv1.Provider, tr *container.TrustRoot,
+					owner, name, version string, types []string,
+				) (string, json.RawMessage, error) {
+					assert.Same(t, trust, tr, "attestations must be verified against the provider trust root")
+					assert.Equal(t, "stacklok", owner)
+					assert.Equal(t, "minder", name)
+					assert.Equal(t, tt.expectVersion, version)
+					assert.Equal(t, tt.expectTypes, types)
+					if tt.fetchErr != nil {
+						return "", nil, tt.fetchErr
+					}
+					return types[0], json.RawMessage(`{"bomFormat": "CycloneDX"}`), nil
+				},
+			}
+
+			res, err := ing.Ingest(context.Background(), artifact, tt.params)
+			if tt.expectFailed {
+				require.ErrorIs(t, err, evalerrors.ErrEvaluationFailed)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, map[string]any{"bomFormat": "CycloneDX"}, res.Object)
+		})
+	}
+}
+
+func TestIngestArtifactNoTaggedVersion(t *testing.T) {
+	t.Parallel()
+
+	ing := &Ingestor{cfg: &pb.SBOMType{}}
+	_, err := ing.Ingest(context.Background(), &pb.Artifact{
+		Versions: []*pb.ArtifactVersion{{Sha: "sha256:abc", Tags: []string{"v1"}}},
+	}, map[string]any{"tag": "v2"})
+	require.ErrorIs(t, err, evalerrors.ErrEvaluationSkipSilently)
+}
@@ -42,6 +42,14 @@ type Ingester interface {
 	GetConfig() protoreflect.ProtoMessage
 }
 
+// DefinitionIngester is implemented by ingesters whose result also depends on the
+// rule definition of the profile and not only on the rule parameters
+type DefinitionIngester interface {
+	// IngestWithDefinition does the data ingestion for a rule type given the rule definition
+	IngestWithDefinition(
+		ctx context.Context, ent protoreflect.ProtoMessage, params map[string]any, def map[string]any) (*Result, error)
+}
+
 // Evaluator is the interface for a rule type evaluator
 type Evaluator interface {
 	Eval(ctx context.Context, profile map[string]any, res *Result) error
@@ -60,6 +68,9 @@ type Result struct {
 	// FIXME: It might be cleaner to either wrap both Fs and Storer in a struct
 	// or pass out the git.Repository structure instead of the storer.
 	Storer storage.Storer
+	// ArtifactVersions are the digests of the artifact versions the ingested data
+	// is about. The evaluation of an artifact is recorded for each of them.
+	ArtifactVersions []string
 }
 
 // ActionOpt is the type that defines what action to take when remediating
@@ -72,6 +83,8 @@ const (
 	ActionOptOff
 	// ActionOptDryRun means perform a dry run of the remediation
 	ActionOptDryRun
+	// ActionOptApprovalRequired means propose the remediation and perform it once approved
+	ActionOptApprovalRequired
 	// ActionOptUnknown means the action is unknown. This is a sentinel value.
 	ActionOptUnknown
 )
@@ -79,9 +92,10 @@ const (
 // ActionOptFromString returns the ActionOpt from a string representation
 func ActionOptFromString(s *string, defAction ActionOpt) ActionOpt {
 	var actionOptMap = map[string]ActionOpt{
-		"on":      ActionOptOn,
-		"off":     ActionOptOff,
-		"dry_run": ActionOptDryRun,
+		"on":                ActionOptOn,
+		"off":               ActionOptOff,
+		"dry_run":           ActionOptDryRun,
+		"approval_required": ActionOptApprovalRequired,
 	}
 
 	if s == nil {
@@ -107,6 +121,13 @@ type Action interface {
 		params ActionsParams, metadata *json.RawMessage) (json.RawMessage, error)
 }
 
+// RefreshingAlert is implemented by the alert actions that are turned on again on every
+// failing evaluation, so that they report the latest violation, instead of only when the
+// evaluation status changes
+type RefreshingAlert interface {
+	RefreshOnFailure() bool
+}
+
 // ActionCmd is the type that defines what effect an action should have
 type ActionCmd string
 
@@ -124,19 +145,22 @@ const (
 // a repo and most profiles are expecting a repo, the RepoID parameter is mandatory. For entities
 // other than artifacts, the ArtifactID should be 0 that is translated to NULL in the database.
 type EvalStatusParams struct {
-	Result           *Result
-	Profile          *pb.Profile
-	Rule             *pb.Profile_Rule
-	RuleType         *pb.RuleType
-	ProfileID        uuid.UUID
-	RepoID           uuid.UUID
-	ArtifactID       uuid.NullUUID
-	PullRequestID    uuid.NullUUID
-	EntityType       db.Entities
-	RuleTypeID       uuid.UUID
-	EvalStatusFromDb *db.ListRuleEvaluationsByProfileIdRow
-	evalErr          error
-	actionsErr       evalerrors.ActionsError
+	Result             *Result
+	Profile            *pb.Profile
+	Rule               *pb.Profile_Rule
+	RuleType           *pb.RuleType
+	ProfileID          uuid.UUID
+	RepoID             uuid.UUID
+	ArtifactID         uuid.NullUUID
+	PullRequestID      uuid.NullUUID
+	BuildEnvironmentID uuid.NullUUID
+	EntityType         db.Entities
+	RuleTypeID         uuid.UUID
+	EvalStatusFromDb   *db.ListRuleEvaluationsByProfileIdRow
+	evalErr            error
+	actionsErr         evalerrors.ActionsError
+	// artifactVersionErrs are the results of the evaluation of each artifact version
+	artifactVersionErrs map[string]error
 }
 
 // Ensure EvalStatusParams implements the necessary interfaces
@@ -216,11 +240,28 @@ func (e *EvalStatusParams) GetIngestResult() *Result {
 	return e.Result
 }
 
+// SetArtifactVersionErrs sets the results of the evaluation of each of the artifact
+// versions the ingested data is about, by digest
+func (e *EvalStatusParams) SetArtifactVersionErrs(errs map[string]error) {
+	e.artifactVersionErrs = errs
+}
+
+// GetArtifactVersionErr returns the result of the evaluation of an artifact version,
+// which is the result of the evaluation of the rule unless the version was evaluated
+// on its own
+func (e *EvalStatusParams) GetArtifactVersionErr(sha string) error {
+	if err, ok := e.artifactVersionErrs[sha]; ok {
+		return err
+	}
+	return e.evalErr
+}
+
 // EvalParams is the interface used for a rule type evaluator
 type EvalParams interface {
 	GetRule() *pb.Profile_Rule
 	SetIngestResult(*Result)
 	GetIngestResult() *Result
+	SetArtifactVersionErrs(map[string]error)
 }
 
 // ActionsParams is the interface used for processing a rule type action
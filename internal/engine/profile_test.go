@@ -642,3 +642,40 @@ func TestFilterRulesForType(t *testing.T) {
 		})
 	}
 }
+
+func TestRuleActionOverrides(t *testing.T) {
+	t.Parallel()
+
+	r := strings.NewReader(`
+---
+version: v1
+type: profile
+name: acme-github-profile
+context:
+  provider: github
+alert: "on"
+remediate: "off"
+repository:
+  - type: secret_scanning
+    remediate: "on"
+    def:
+      enabled: true
+  - type: branch_protection
+    def:
+      enabled: true
+`)
+
+	p, err := engine.ParseYAML(r)
+	require.NoError(t, err, "ParseYAML should not have errored")
+	require.Len(t, p.Repository, 2)
+
+	overridden := p.ForRule(p.Repository[0])
+	require.Equal(t, "on", overridden.GetRemediate(), "the rule should override the remediate setting")
+	require.Equal(t, "on", overridden.GetAlert(), "the alert setting should be inherited from the profile")
+	require.Equal(t, "off", p.GetRemediate(), "the profile itself should not be modified")
+	require.Equal(t, "on", p.GetRuleRemediate(p.Repository[0]))
+
+	inherited := p.ForRule(p.Repository[1])
+	require.Same(t, p, inherited, "a rule that doesn't override anything should use the profile as is")
+	require.Equal(t, "off", p.GetRuleRemediate(p.Repository[1]))
+}
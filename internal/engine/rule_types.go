@@ -250,9 +250,21 @@ func (r *RuleTypeEngine) GetRuleInstanceValidator() *RuleValidator {
 
 // Eval runs the rule type engine against the given entity
 func (r *RuleTypeEngine) Eval(ctx context.Context, inf *EntityInfoWrapper, params engif.EvalParams) error {
-	// Try looking at the ingesting cache first
-	result, ok := r.ingestCache.Get(r.rdi, inf.Entity, params.GetRule().Params)
-	if !ok {
+	var result *engif.Result
+	if di, isDefIngester := r.rdi.(engif.DefinitionIngester); isDefIngester {
+		// the cache is keyed by the rule parameters only, so the results of
+		// ingesters that depend on the rule definition are not cached
+		var err error
+		result, err = di.IngestWithDefinition(ctx, inf.Entity,
+			params.GetRule().Params.AsMap(), params.GetRule().Def.AsMap())
+		if err != nil {
+			return fmt.Errorf("error ingesting data: %w", err)
+		}
+	} else if cached, ok := r.ingestCache.Get(r.rdi, inf.Entity, params.GetRule().Params); ok {
+		// Try looking at the ingesting cache first
+		log.Printf("Using cached result for %s", r.GetID())
+		result = cached
+	} else {
 		var err error
 		// Ingest the data needed for the rule evaluation
 		result, err = r.rdi.Ingest(ctx, inf.Entity, params.GetRule().Params.AsMap())
@@ -263,15 +275,40 @@ func (r *RuleTypeEngine) Eval(ctx context.Context, inf *EntityInfoWrapper, param
 		}
 
 		r.ingestCache.Set(r.rdi, inf.Entity, params.GetRule().Params, result)
-	} else {
-		log.Printf("Using cached result for %s", r.GetID())
 	}
 
 	params.SetIngestResult(result)
+	params.SetArtifactVersionErrs(r.evalArtifactVersions(ctx, params.GetRule().Def.AsMap(), result))
 	// Process evaluation
 	return r.reval.Eval(ctx, params.GetRule().Def.AsMap(), result)
 }
 
+// evalArtifactVersions evaluates the rule against each of the artifact versions the ingested
+// data is about, so the status of every version is recorded rather than the status of the rule
+// for all of them. It returns nil if the data is about a single version, or isn't a list of
+// versions. Rules evaluating the filesystem see the one of the most recent version.
+func (r *RuleTypeEngine) evalArtifactVersions(
+	ctx context.Context,
+	def map[string]any,
+	result *engif.Result,
+) map[string]error {
+	versions, ok := result.Object.([]map[string]any)
+	if !ok || len(result.ArtifactVersions) < 2 || len(versions) != len(result.ArtifactVersions) {
+		return nil
+	}
+
+	errs := make(map[string]error, len(versions))
+	for i, sha := range result.ArtifactVersions {
+		errs[sha] = r.reval.Eval(ctx, def, &engif.Result{
+			Object:           []map[string]any{versions[i]},
+			Fs:               result.Fs,
+			Storer:           result.Storer,
+			ArtifactVersions: []string{sha},
+		})
+	}
+	return errs
+}
+
 // Actions runs all actions for the rule type engine against the given entity
 func (r *RuleTypeEngine) Actions(
 	ctx context.Context,
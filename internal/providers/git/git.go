@@ -51,11 +51,15 @@ func (g *Git) GetToken() string {
 // Clone clones a git repository
 func (g *Git) Clone(ctx context.Context, url, branch string) (*git.Repository, error) {
 	opts := &git.CloneOptions{
-		URL:           url,
-		SingleBranch:  true,
-		Depth:         1,
-		Tags:          git.NoTags,
-		ReferenceName: plumbing.NewBranchReferenceName(branch),
+		URL:          url,
+		SingleBranch: true,
+		Depth:        1,
+		Tags:         git.NoTags,
+	}
+
+	// without a branch the remote HEAD, i.e. the default branch, is cloned
+	if branch != "" {
+		opts.ReferenceName = plumbing.NewBranchReferenceName(branch)
 	}
 
 	if g.token != "" {
@@ -18,11 +18,13 @@ package github
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
+	"strings"
 
 	"github.com/google/go-github/v53/github"
 
@@ -65,14 +67,15 @@ func convertRepositories(repos []*github.Repository) []*minderv1.Repository {
 
 func convertRepository(repo *github.Repository) *minderv1.Repository {
 	return &minderv1.Repository{
-		Name:      repo.GetName(),
-		Owner:     repo.GetOwner().GetLogin(),
-		RepoId:    int32(repo.GetID()), // FIXME this is a 64 bit int
-		HookUrl:   repo.GetHooksURL(),
-		DeployUrl: repo.GetDeploymentsURL(),
-		CloneUrl:  repo.GetCloneURL(),
-		IsPrivate: *repo.Private,
-		IsFork:    *repo.Fork,
+		Name:          repo.GetName(),
+		Owner:         repo.GetOwner().GetLogin(),
+		RepoId:        int32(repo.GetID()), // FIXME this is a 64 bit int
+		HookUrl:       repo.GetHooksURL(),
+		DeployUrl:     repo.GetDeploymentsURL(),
+		CloneUrl:      repo.GetCloneURL(),
+		DefaultBranch: repo.GetDefaultBranch(),
+		IsPrivate:     *repo.Private,
+		IsFork:        *repo.Fork,
 	}
 }
 
@@ -427,6 +430,76 @@ func (c *RestClient) UpdateBranchProtection(
 	return err
 }
 
+// ListRulesets returns the rulesets of a repository, including the rulesets of its organization
+// that apply to it if includeParents is set. Rulesets are returned as JSON documents, since the
+// GitHub client can't unmarshal the rule types it doesn't know about yet.
+func (c *RestClient) ListRulesets(
+	ctx context.Context, owner, repo string, includeParents bool,
+) ([]json.RawMessage, error) {
+	return c.listJSON(ctx, fmt.Sprintf("repos/%v/%v/rulesets?includes_parents=%t", owner, repo, includeParents))
+}
+
+// GetRuleset returns a ruleset that applies to a repository, as a JSON document
+func (c *RestClient) GetRuleset(
+	ctx context.Context, owner, repo string, id int64, includeParents bool,
+) (json.RawMessage, error) {
+	var ruleset json.RawMessage
+	u := fmt.Sprintf("repos/%v/%v/rulesets/%v?includes_parents=%t", owner, repo, id, includeParents)
+	if err := c.getJSON(ctx, u, &ruleset); err != nil {
+		return nil, err
+	}
+	return ruleset, nil
+}
+
+// ListOrganizationRulesets returns the rulesets of an organization, as JSON documents
+func (c *RestClient) ListOrganizationRulesets(ctx context.Context, org string) ([]json.RawMessage, error) {
+	return c.listJSON(ctx, fmt.Sprintf("orgs/%v/rulesets", org))
+}
+
+// GetOrganizationRuleset returns a ruleset of an organization, as a JSON document
+func (c *RestClient) GetOrganizationRuleset(ctx context.Context, org string, id int64) (json.RawMessage, error) {
+	var ruleset json.RawMessage
+	if err := c.getJSON(ctx, fmt.Sprintf("orgs/%v/rulesets/%v", org, id), &ruleset); err != nil {
+		return nil, err
+	}
+	return ruleset, nil
+}
+
+// listJSON returns the JSON documents listed by a GET request to the GitHub API, going
+// through all the pages of the list
+func (c *RestClient) listJSON(ctx context.Context, u string) ([]json.RawMessage, error) {
+	sep := "?"
+	if strings.Contains(u, "?") {
+		sep = "&"
+	}
+
+	var all []json.RawMessage
+	for page := 1; page != 0; {
+		req, err := c.client.NewRequest(http.MethodGet, fmt.Sprintf("%s%sper_page=100&page=%d", u, sep, page), nil)
+		if err != nil {
+			return nil, err
+		}
+		var items []json.RawMessage
+		resp, err := c.client.Do(ctx, req, &items)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, items...)
+		page = resp.NextPage
+	}
+	return all, nil
+}
+
+// getJSON decodes the JSON document returned by a GET request to the GitHub API
+func (c *RestClient) getJSON(ctx context.Context, u string, v any) error {
+	req, err := c.client.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return err
+	}
+	_, err = c.client.Do(ctx, req, v)
+	return err
+}
+
 // GetAuthenticatedUser returns the authenticated user
 func (c *RestClient) GetAuthenticatedUser(ctx context.Context) (*github.User, error) {
 	user, _, err := c.client.Users.Get(ctx, "")
@@ -582,6 +655,24 @@ func (c *RestClient) GetCommit(ctx context.Context, owner, repo, commitSHA strin
 	return commit, nil
 }
 
+// GetFileContents returns the contents of a file of a repository at the given ref. It
+// returns ErrNotFound if the file doesn't exist at that ref.
+func (c *RestClient) GetFileContents(ctx context.Context, owner, repo, path, ref string) (string, error) {
+	file, _, resp, err := c.client.Repositories.GetContents(ctx, owner, repo, path,
+		&github.RepositoryContentGetOptions{Ref: ref})
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return "", fmt.Errorf("file %s not found in %s/%s at %s: %w", path, owner, repo, ref, ErrNotFound)
+		}
+		return "", fmt.Errorf("error getting file contents: %w", err)
+	}
+	if file == nil {
+		return "", fmt.Errorf("%s is not a file", path)
+	}
+
+	return file.GetContent()
+}
+
 // CreateBlob creates a blob in a repository.
 func (c *RestClient) CreateBlob(ctx context.Context, owner, repo string, blob *github.Blob) (*github.Blob, error) {
 	b, _, err := c.client.Git.CreateBlob(ctx, owner, repo, blob)
@@ -606,12 +697,14 @@ func (c *RestClient) CreateTree(
 	return t, nil
 }
 
-// CreateCommit creates a commit in a repository.
+// CreateCommit creates a commit in a repository. The author defaults to the
+// authenticated user if nil.
 func (c *RestClient) CreateCommit(
 	ctx context.Context,
 	owner, repo, message string,
 	tree *github.Tree,
 	parentSha string,
+	author *github.CommitAuthor,
 ) (*github.Commit, error) {
 	commit, _, err := c.client.Git.CreateCommit(ctx, owner, repo, &github.Commit{
 		Message: github.String(message),
@@ -621,6 +714,7 @@ func (c *RestClient) CreateCommit(
 				SHA: github.String(parentSha),
 			},
 		},
+		Author: author,
 	})
 	if err != nil {
 		return nil, err
@@ -692,6 +786,38 @@ func (c *RestClient) ListPullRequests(
 	return prs, nil
 }
 
+// UpdatePullRequest updates the title and body of a pull request
+func (c *RestClient) UpdatePullRequest(
+	ctx context.Context,
+	owner, repo string,
+	number int,
+	title, body string,
+) (*github.PullRequest, error) {
+	pr, _, err := c.client.PullRequests.Edit(ctx, owner, repo, number, &github.PullRequest{
+		Title: github.String(title),
+		Body:  github.String(body),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return pr, nil
+}
+
+// ClosePullRequest closes a pull request without merging it
+func (c *RestClient) ClosePullRequest(ctx context.Context, owner, repo string, number int) error {
+	_, _, err := c.client.PullRequests.Edit(ctx, owner, repo, number, &github.PullRequest{
+		State: github.String("closed"),
+	})
+	return err
+}
+
+// DeleteRef deletes a reference, e.g. a branch, in a repository.
+func (c *RestClient) DeleteRef(ctx context.Context, owner, repo, ref string) error {
+	_, err := c.client.Git.DeleteRef(ctx, owner, repo, ref)
+	return err
+}
+
 // CreateComment creates a comment on a pull request or an issue
 func (c *RestClient) CreateComment(ctx context.Context, owner, repo string, number int, comment string) error {
 	_, _, err := c.client.Issues.CreateComment(ctx, owner, repo, number, &github.IssueComment{
@@ -699,3 +825,89 @@ func (c *RestClient) CreateComment(ctx context.Context, owner, repo string, numb
 	})
 	return err
 }
+
+// CreateIssue opens an issue in a repository
+func (c *RestClient) CreateIssue(
+	ctx context.Context, owner, repo string, issue *github.IssueRequest,
+) (*github.Issue, error) {
+	i, _, err := c.client.Issues.Create(ctx, owner, repo, issue)
+	if err != nil {
+		return nil, fmt.Errorf("error creating issue: %w", err)
+	}
+	return i, nil
+}
+
+// EditIssue edits an issue of a repository, including its state. It returns
+// ErrNotFound if the issue doesn't exist anymore.
+func (c *RestClient) EditIssue(
+	ctx context.Context, owner, repo string, number int, issue *github.IssueRequest,
+) (*github.Issue, error) {
+	i, resp, err := c.client.Issues.Edit(ctx, owner, repo, number, issue)
+	if err != nil {
+		if resp != nil && (resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusGone) {
+			return nil, fmt.Errorf("issue %d not found in %s/%s: %w", number, owner, repo, ErrNotFound)
+		}
+		return nil, fmt.Errorf("error editing issue: %w", err)
+	}
+	return i, nil
+}
+
+// ListEnvironments lists all the deployment environments of a repository
+func (c *RestClient) ListEnvironments(ctx context.Context, owner, repo string) ([]*github.Environment, error) {
+	opt := &github.EnvironmentListOptions{
+		ListOptions: github.ListOptions{
+			PerPage: 100,
+		},
+	}
+
+	var allEnvs []*github.Environment
+	for {
+		envs, resp, err := c.client.Repositories.ListEnvironments(ctx, owner, repo, opt)
+		if err != nil {
+			return nil, fmt.Errorf("error listing environments: %w", err)
+		}
+		allEnvs = append(allEnvs, envs.Environments...)
+		if resp.NextPage == 0 {
+			break
+		}
+		opt.Page = resp.NextPage
+	}
+
+	return allEnvs, nil
+}
+
+// GetEnvironment returns a single deployment environment of a repository. It
+// returns ErrNotFound if the environment doesn't exist (anymore).
+func (c *RestClient) GetEnvironment(ctx context.Context, owner, repo, name string) (*github.Environment, error) {
+	env, resp, err := c.client.Repositories.GetEnvironment(ctx, owner, repo, url.PathEscape(name))
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return nil, fmt.Errorf("environment %s not found in %s/%s: %w", name, owner, repo, ErrNotFound)
+		}
+		return nil, fmt.Errorf("error getting environment: %w", err)
+	}
+
+	return env, nil
+}
+
+// ListEnvironmentSecrets lists the secrets of a deployment environment, without their values
+func (c *RestClient) ListEnvironmentSecrets(ctx context.Context, repoID int64, env string) ([]*github.Secret, error) {
+	opt := &github.ListOptions{
+		PerPage: 100,
+	}
+
+	var allSecrets []*github.Secret
+	for {
+		secrets, resp, err := c.client.Actions.ListEnvSecrets(ctx, int(repoID), url.PathEscape(env), opt)
+		if err != nil {
+			return nil, fmt.Errorf("error listing environment secrets: %w", err)
+		}
+		allSecrets = append(allSecrets, secrets.Secrets...)
+		if resp.NextPage == 0 {
+			break
+		}
+		opt.Page = resp.NextPage
+	}
+
+	return allSecrets, nil
+}
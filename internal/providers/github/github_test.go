@@ -16,11 +16,13 @@ package github
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	provtelemetry "github.com/stacklok/minder/internal/providers/telemetry"
 	minderv1 "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
@@ -116,3 +118,38 @@ func TestArtifactAPIEscapes(t *testing.T) {
 	}
 
 }
+
+func TestListRulesetsPaginates(t *testing.T) {
+	t.Parallel()
+
+	var testServer *httptest.Server
+	testServer = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/repos/stacklok/minder/rulesets", r.URL.Path)
+		assert.Equal(t, "false", r.URL.Query().Get("includes_parents"))
+		assert.Equal(t, "100", r.URL.Query().Get("per_page"))
+		switch r.URL.Query().Get("page") {
+		case "1":
+			w.Header().Set("Link", fmt.Sprintf(
+				`<%s/repos/stacklok/minder/rulesets?includes_parents=false&per_page=100&page=2>; rel="next"`,
+				testServer.URL))
+			_, _ = w.Write([]byte(`[{"id": 1, "name": "main"}]`))
+		case "2":
+			_, _ = w.Write([]byte(`[{"id": 2, "name": "release"}]`))
+		default:
+			t.Errorf("unexpected page %s", r.URL.Query().Get("page"))
+		}
+	}))
+	defer testServer.Close()
+
+	client, err := NewRestClient(context.Background(), &minderv1.GitHubProviderConfig{
+		Endpoint: testServer.URL + "/",
+	},
+		provtelemetry.NewNoopMetrics(),
+		"token", "")
+	require.NoError(t, err)
+
+	rulesets, err := client.ListRulesets(context.Background(), "stacklok", "minder", false)
+	require.NoError(t, err)
+	require.Len(t, rulesets, 2)
+	assert.JSONEq(t, `{"id": 2, "name": "release"}`, string(rulesets[1]))
+}
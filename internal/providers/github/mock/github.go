@@ -6,11 +6,14 @@ package mockgh
 
 import (
 	context "context"
+	json "encoding/json"
 	http "net/http"
 	reflect "reflect"
 
 	git "github.com/go-git/go-git/v5"
 	gomock "github.com/golang/mock/gomock"
+	name "github.com/google/go-containerregistry/pkg/name"
+	remote "github.com/google/go-containerregistry/pkg/v1/remote"
 	github "github.com/google/go-github/v53/github"
 	v1 "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
 )
@@ -252,6 +255,116 @@ func (mr *MockRepoListerMockRecorder) ListUserRepositories(arg0, arg1 interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUserRepositories", reflect.TypeOf((*MockRepoLister)(nil).ListUserRepositories), arg0, arg1)
 }
 
+// MockOCI is a mock of OCI interface.
+type MockOCI struct {
+	ctrl     *gomock.Controller
+	recorder *MockOCIMockRecorder
+}
+
+// MockOCIMockRecorder is the mock recorder for MockOCI.
+type MockOCIMockRecorder struct {
+	mock *MockOCI
+}
+
+// NewMockOCI creates a new mock instance.
+func NewMockOCI(ctrl *gomock.Controller) *MockOCI {
+	mock := &MockOCI{ctrl: ctrl}
+	mock.recorder = &MockOCIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOCI) EXPECT() *MockOCIMockRecorder {
+	return m.recorder
+}
+
+// GetReference mocks base method.
+func (m *MockOCI) GetReference(repository, reference string) (name.Reference, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReference", repository, reference)
+	ret0, _ := ret[0].(name.Reference)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReference indicates an expected call of GetReference.
+func (mr *MockOCIMockRecorder) GetReference(repository, reference interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReference", reflect.TypeOf((*MockOCI)(nil).GetReference), repository, reference)
+}
+
+// GetRegistry mocks base method.
+func (m *MockOCI) GetRegistry() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRegistry")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// GetRegistry indicates an expected call of GetRegistry.
+func (mr *MockOCIMockRecorder) GetRegistry() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRegistry", reflect.TypeOf((*MockOCI)(nil).GetRegistry))
+}
+
+// GetRemoteOptions mocks base method.
+func (m *MockOCI) GetRemoteOptions(ctx context.Context) []remote.Option {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRemoteOptions", ctx)
+	ret0, _ := ret[0].([]remote.Option)
+	return ret0
+}
+
+// GetRemoteOptions indicates an expected call of GetRemoteOptions.
+func (mr *MockOCIMockRecorder) GetRemoteOptions(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRemoteOptions", reflect.TypeOf((*MockOCI)(nil).GetRemoteOptions), ctx)
+}
+
+// GetToken mocks base method.
+func (m *MockOCI) GetToken() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetToken")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// GetToken indicates an expected call of GetToken.
+func (mr *MockOCIMockRecorder) GetToken() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetToken", reflect.TypeOf((*MockOCI)(nil).GetToken))
+}
+
+// ListRepositories mocks base method.
+func (m *MockOCI) ListRepositories(ctx context.Context) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRepositories", ctx)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRepositories indicates an expected call of ListRepositories.
+func (mr *MockOCIMockRecorder) ListRepositories(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRepositories", reflect.TypeOf((*MockOCI)(nil).ListRepositories), ctx)
+}
+
+// ListTags mocks base method.
+func (m *MockOCI) ListTags(ctx context.Context, repository string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTags", ctx, repository)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTags indicates an expected call of ListTags.
+func (mr *MockOCIMockRecorder) ListTags(ctx, repository interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTags", reflect.TypeOf((*MockOCI)(nil).ListTags), ctx, repository)
+}
+
 // MockGitHub is a mock of GitHub interface.
 type MockGitHub struct {
 	ctrl     *gomock.Controller
@@ -275,6 +388,20 @@ func (m *MockGitHub) EXPECT() *MockGitHubMockRecorder {
 	return m.recorder
 }
 
+// ClosePullRequest mocks base method.
+func (m *MockGitHub) ClosePullRequest(ctx context.Context, owner, repo string, number int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClosePullRequest", ctx, owner, repo, number)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ClosePullRequest indicates an expected call of ClosePullRequest.
+func (mr *MockGitHubMockRecorder) ClosePullRequest(ctx, owner, repo, number interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClosePullRequest", reflect.TypeOf((*MockGitHub)(nil).ClosePullRequest), ctx, owner, repo, number)
+}
+
 // CloseSecurityAdvisory mocks base method.
 func (m *MockGitHub) CloseSecurityAdvisory(ctx context.Context, owner, repo, id string) error {
 	m.ctrl.T.Helper()
@@ -319,18 +446,18 @@ func (mr *MockGitHubMockRecorder) CreateComment(ctx, owner, repo, number, commen
 }
 
 // CreateCommit mocks base method.
-func (m *MockGitHub) CreateCommit(ctx context.Context, owner, repo, message string, tree *github.Tree, parentSha string) (*github.Commit, error) {
+func (m *MockGitHub) CreateCommit(ctx context.Context, owner, repo, message string, tree *github.Tree, parentSha string, author *github.CommitAuthor) (*github.Commit, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateCommit", ctx, owner, repo, message, tree, parentSha)
+	ret := m.ctrl.Call(m, "CreateCommit", ctx, owner, repo, message, tree, parentSha, author)
 	ret0, _ := ret[0].(*github.Commit)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateCommit indicates an expected call of CreateCommit.
-func (mr *MockGitHubMockRecorder) CreateCommit(ctx, owner, repo, message, tree, parentSha interface{}) *gomock.Call {
+func (mr *MockGitHubMockRecorder) CreateCommit(ctx, owner, repo, message, tree, parentSha, author interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCommit", reflect.TypeOf((*MockGitHub)(nil).CreateCommit), ctx, owner, repo, message, tree, parentSha)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCommit", reflect.TypeOf((*MockGitHub)(nil).CreateCommit), ctx, owner, repo, message, tree, parentSha, author)
 }
 
 // CreateHook mocks base method.
@@ -348,6 +475,21 @@ func (mr *MockGitHubMockRecorder) CreateHook(ctx, owner, repo, hook interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateHook", reflect.TypeOf((*MockGitHub)(nil).CreateHook), ctx, owner, repo, hook)
 }
 
+// CreateIssue mocks base method.
+func (m *MockGitHub) CreateIssue(ctx context.Context, owner, repo string, issue *github.IssueRequest) (*github.Issue, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateIssue", ctx, owner, repo, issue)
+	ret0, _ := ret[0].(*github.Issue)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateIssue indicates an expected call of CreateIssue.
+func (mr *MockGitHubMockRecorder) CreateIssue(ctx, owner, repo, issue interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateIssue", reflect.TypeOf((*MockGitHub)(nil).CreateIssue), ctx, owner, repo, issue)
+}
+
 // CreatePullRequest mocks base method.
 func (m *MockGitHub) CreatePullRequest(ctx context.Context, owner, repo, title, body, head, base string) (*github.PullRequest, error) {
 	m.ctrl.T.Helper()
@@ -437,6 +579,20 @@ func (mr *MockGitHubMockRecorder) DeleteHook(ctx, owner, repo, id interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteHook", reflect.TypeOf((*MockGitHub)(nil).DeleteHook), ctx, owner, repo, id)
 }
 
+// DeleteRef mocks base method.
+func (m *MockGitHub) DeleteRef(ctx context.Context, owner, repo, ref string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRef", ctx, owner, repo, ref)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRef indicates an expected call of DeleteRef.
+func (mr *MockGitHubMockRecorder) DeleteRef(ctx, owner, repo, ref interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRef", reflect.TypeOf((*MockGitHub)(nil).DeleteRef), ctx, owner, repo, ref)
+}
+
 // DismissReview mocks base method.
 func (m *MockGitHub) DismissReview(arg0 context.Context, arg1, arg2 string, arg3 int, arg4 int64, arg5 *github.PullRequestReviewDismissalRequest) (*github.PullRequestReview, error) {
 	m.ctrl.T.Helper()
@@ -467,6 +623,21 @@ func (mr *MockGitHubMockRecorder) Do(ctx, req interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGitHub)(nil).Do), ctx, req)
 }
 
+// EditIssue mocks base method.
+func (m *MockGitHub) EditIssue(ctx context.Context, owner, repo string, number int, issue *github.IssueRequest) (*github.Issue, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EditIssue", ctx, owner, repo, number, issue)
+	ret0, _ := ret[0].(*github.Issue)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EditIssue indicates an expected call of EditIssue.
+func (mr *MockGitHubMockRecorder) EditIssue(ctx, owner, repo, number, issue interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EditIssue", reflect.TypeOf((*MockGitHub)(nil).EditIssue), ctx, owner, repo, number, issue)
+}
+
 // GetAuthenticatedUser mocks base method.
 func (m *MockGitHub) GetAuthenticatedUser(arg0 context.Context) (*github.User, error) {
 	m.ctrl.T.Helper()
@@ -526,6 +697,51 @@ func (mr *MockGitHubMockRecorder) GetCommit(ctx, owner, repo, commitSHA interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCommit", reflect.TypeOf((*MockGitHub)(nil).GetCommit), ctx, owner, repo, commitSHA)
 }
 
+// GetEnvironment mocks base method.
+func (m *MockGitHub) GetEnvironment(ctx context.Context, owner, repo, name string) (*github.Environment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEnvironment", ctx, owner, repo, name)
+	ret0, _ := ret[0].(*github.Environment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEnvironment indicates an expected call of GetEnvironment.
+func (mr *MockGitHubMockRecorder) GetEnvironment(ctx, owner, repo, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEnvironment", reflect.TypeOf((*MockGitHub)(nil).GetEnvironment), ctx, owner, repo, name)
+}
+
+// GetFileContents mocks base method.
+func (m *MockGitHub) GetFileContents(ctx context.Context, owner, repo, path, ref string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFileContents", ctx, owner, repo, path, ref)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFileContents indicates an expected call of GetFileContents.
+func (mr *MockGitHubMockRecorder) GetFileContents(ctx, owner, repo, path, ref interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFileContents", reflect.TypeOf((*MockGitHub)(nil).GetFileContents), ctx, owner, repo, path, ref)
+}
+
+// GetOrganizationRuleset mocks base method.
+func (m *MockGitHub) GetOrganizationRuleset(ctx context.Context, org string, id int64) (json.RawMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganizationRuleset", ctx, org, id)
+	ret0, _ := ret[0].(json.RawMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrganizationRuleset indicates an expected call of GetOrganizationRuleset.
+func (mr *MockGitHubMockRecorder) GetOrganizationRuleset(ctx, org, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationRuleset", reflect.TypeOf((*MockGitHub)(nil).GetOrganizationRuleset), ctx, org, id)
+}
+
 // GetOwner mocks base method.
 func (m *MockGitHub) GetOwner() string {
 	m.ctrl.T.Helper()
@@ -645,6 +861,21 @@ func (mr *MockGitHubMockRecorder) GetRepository(arg0, arg1, arg2 interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRepository", reflect.TypeOf((*MockGitHub)(nil).GetRepository), arg0, arg1, arg2)
 }
 
+// GetRuleset mocks base method.
+func (m *MockGitHub) GetRuleset(ctx context.Context, owner, repo string, id int64, includeParents bool) (json.RawMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRuleset", ctx, owner, repo, id, includeParents)
+	ret0, _ := ret[0].(json.RawMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRuleset indicates an expected call of GetRuleset.
+func (mr *MockGitHubMockRecorder) GetRuleset(ctx, owner, repo, id, includeParents interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRuleset", reflect.TypeOf((*MockGitHub)(nil).GetRuleset), ctx, owner, repo, id, includeParents)
+}
+
 // GetToken mocks base method.
 func (m *MockGitHub) GetToken() string {
 	m.ctrl.T.Helper()
@@ -689,6 +920,36 @@ func (mr *MockGitHubMockRecorder) ListAllRepositories(arg0, arg1, arg2 interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAllRepositories", reflect.TypeOf((*MockGitHub)(nil).ListAllRepositories), arg0, arg1, arg2)
 }
 
+// ListEnvironmentSecrets mocks base method.
+func (m *MockGitHub) ListEnvironmentSecrets(ctx context.Context, repoID int64, env string) ([]*github.Secret, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEnvironmentSecrets", ctx, repoID, env)
+	ret0, _ := ret[0].([]*github.Secret)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEnvironmentSecrets indicates an expected call of ListEnvironmentSecrets.
+func (mr *MockGitHubMockRecorder) ListEnvironmentSecrets(ctx, repoID, env interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEnvironmentSecrets", reflect.TypeOf((*MockGitHub)(nil).ListEnvironmentSecrets), ctx, repoID, env)
+}
+
+// ListEnvironments mocks base method.
+func (m *MockGitHub) ListEnvironments(ctx context.Context, owner, repo string) ([]*github.Environment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEnvironments", ctx, owner, repo)
+	ret0, _ := ret[0].([]*github.Environment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEnvironments indicates an expected call of ListEnvironments.
+func (mr *MockGitHubMockRecorder) ListEnvironments(ctx, owner, repo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEnvironments", reflect.TypeOf((*MockGitHub)(nil).ListEnvironments), ctx, owner, repo)
+}
+
 // ListFiles mocks base method.
 func (m *MockGitHub) ListFiles(ctx context.Context, owner, repo string, prNumber, perPage, pageNumber int) ([]*github.CommitFile, *github.Response, error) {
 	m.ctrl.T.Helper()
@@ -735,6 +996,21 @@ func (mr *MockGitHubMockRecorder) ListOrganizationRepsitories(arg0, arg1 interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOrganizationRepsitories", reflect.TypeOf((*MockGitHub)(nil).ListOrganizationRepsitories), arg0, arg1)
 }
 
+// ListOrganizationRulesets mocks base method.
+func (m *MockGitHub) ListOrganizationRulesets(ctx context.Context, org string) ([]json.RawMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOrganizationRulesets", ctx, org)
+	ret0, _ := ret[0].([]json.RawMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOrganizationRulesets indicates an expected call of ListOrganizationRulesets.
+func (mr *MockGitHubMockRecorder) ListOrganizationRulesets(ctx, org interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOrganizationRulesets", reflect.TypeOf((*MockGitHub)(nil).ListOrganizationRulesets), ctx, org)
+}
+
 // ListPackagesByRepository mocks base method.
 func (m *MockGitHub) ListPackagesByRepository(arg0 context.Context, arg1 bool, arg2, arg3 string, arg4 int64, arg5, arg6 int) ([]*github.Package, error) {
 	m.ctrl.T.Helper()
@@ -780,6 +1056,21 @@ func (mr *MockGitHubMockRecorder) ListReviews(arg0, arg1, arg2, arg3, arg4 inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListReviews", reflect.TypeOf((*MockGitHub)(nil).ListReviews), arg0, arg1, arg2, arg3, arg4)
 }
 
+// ListRulesets mocks base method.
+func (m *MockGitHub) ListRulesets(ctx context.Context, owner, repo string, includeParents bool) ([]json.RawMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRulesets", ctx, owner, repo, includeParents)
+	ret0, _ := ret[0].([]json.RawMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRulesets indicates an expected call of ListRulesets.
+func (mr *MockGitHubMockRecorder) ListRulesets(ctx, owner, repo, includeParents interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRulesets", reflect.TypeOf((*MockGitHub)(nil).ListRulesets), ctx, owner, repo, includeParents)
+}
+
 // ListUserRepositories mocks base method.
 func (m *MockGitHub) ListUserRepositories(arg0 context.Context, arg1 string) ([]*v1.Repository, error) {
 	m.ctrl.T.Helper()
@@ -839,6 +1130,21 @@ func (mr *MockGitHubMockRecorder) UpdateBranchProtection(arg0, arg1, arg2, arg3,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBranchProtection", reflect.TypeOf((*MockGitHub)(nil).UpdateBranchProtection), arg0, arg1, arg2, arg3, arg4)
 }
 
+// UpdatePullRequest mocks base method.
+func (m *MockGitHub) UpdatePullRequest(ctx context.Context, owner, repo string, number int, title, body string) (*github.PullRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePullRequest", ctx, owner, repo, number, title, body)
+	ret0, _ := ret[0].(*github.PullRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePullRequest indicates an expected call of UpdatePullRequest.
+func (mr *MockGitHubMockRecorder) UpdatePullRequest(ctx, owner, repo, number, title, body interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePullRequest", reflect.TypeOf((*MockGitHub)(nil).UpdatePullRequest), ctx, owner, repo, number, title, body)
+}
+
 // UpdateRef mocks base method.
 func (m *MockGitHub) UpdateRef(ctx context.Context, owner, repo, ref, sha string, force bool) (*github.Reference, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,222 @@
+// Copyright 2023 Stacklok, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package oci implements a client for interacting with generic OCI registries,
+// e.g. Docker Hub, Quay, ECR or a self-hosted distribution registry.
+package oci
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+
+	"github.com/stacklok/minder/internal/db"
+	"github.com/stacklok/minder/internal/providers/telemetry"
+	minderv1 "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+	provifv1 "github.com/stacklok/minder/pkg/providers/v1"
+)
+
+// Implements is the list of provider types that the OCI provider implements
+var Implements = []db.ProviderType{
+	db.ProviderTypeOci,
+}
+
+// OCI is the client for interacting with an OCI registry
+type OCI struct {
+	registry     name.Registry
+	nameOpts     []name.Option
+	namespace    string
+	repositories []string
+	auth         authn.Authenticator
+	transport    http.RoundTripper
+	tok          string
+}
+
+// Ensure that OCI implements the OCI interface
+var _ provifv1.OCI = (*OCI)(nil)
+
+// NewOCI creates a new OCI registry client. The token is used as the password
+// of the configured user, or as a registry token if there is no user. Without
+// a token the registry is accessed anonymously.
+func NewOCI(
+	config *minderv1.OCIProviderConfig,
+	metrics telemetry.HttpClientMetrics,
+	tok string,
+) (*OCI, error) {
+	host, insecure, err := parseRegistryURL(config.GetRegistryUrl())
+	if err != nil {
+		return nil, err
+	}
+
+	var nameOpts []name.Option
+	if insecure {
+		nameOpts = append(nameOpts, name.Insecure)
+	}
+
+	registry, err := name.NewRegistry(host, nameOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing registry %s: %w", host, err)
+	}
+
+	transport, err := metrics.NewDurationRoundTripper(remote.DefaultTransport, db.ProviderTypeOci)
+	if err != nil {
+		return nil, fmt.Errorf("error creating duration round tripper: %w", err)
+	}
+
+	return &OCI{
+		registry:     registry,
+		nameOpts:     nameOpts,
+		namespace:    strings.Trim(config.GetNamespace(), "/"),
+		repositories: config.GetRepositories(),
+		auth:         newAuthenticator(config.GetUsername(), tok),
+		transport:    transport,
+		tok:          tok,
+	}, nil
+}
+
+// parseRegistryURL returns the host of the registry and whether it is served over plain HTTP.
+// The URL may omit the scheme, in which case HTTPS is assumed.
+func parseRegistryURL(registryURL string) (string, bool, error) {
+	if !strings.Contains(registryURL, "://") {
+		registryURL = "https://" + registryURL
+	}
+
+	u, err := url.Parse(registryURL)
+	if err != nil {
+		return "", false, fmt.Errorf("error parsing registry URL: %w", err)
+	}
+
+	if u.Host == "" {
+		return "", false, fmt.Errorf("registry URL %s has no host", registryURL)
+	}
+
+	switch u.Scheme {
+	case "https":
+		return u.Host, false, nil
+	case "http":
+		return u.Host, true, nil
+	default:
+		return "", false, fmt.Errorf("unsupported registry URL scheme %s", u.Scheme)
+	}
+}
+
+func newAuthenticator(username, tok string) authn.Authenticator {
+	if tok == "" {
+		return authn.Anonymous
+	}
+
+	if username == "" {
+		return authn.FromConfig(authn.AuthConfig{RegistryToken: tok})
+	}
+
+	return &authn.Basic{Username: username, Password: tok}
+}
+
+// GetToken returns the token for the provider
+func (o *OCI) GetToken() string {
+	return o.tok
+}
+
+// GetRegistry returns the host of the registry
+func (o *OCI) GetRegistry() string {
+	return o.registry.Name()
+}
+
+// ListRepositories lists the image repositories of the registry. If the provider
+// was configured with a list of repositories, that list is returned as is. Otherwise
+// the catalog of the registry is listed, restricted to the configured namespace.
+func (o *OCI) ListRepositories(ctx context.Context) ([]string, error) {
+	if len(o.repositories) > 0 {
+		return o.repositories, nil
+	}
+
+	repos, err := remote.Catalog(ctx, o.registry, o.GetRemoteOptions(ctx)...)
+	if err != nil {
+		return nil, fmt.Errorf("error listing catalog of %s: %w", o.registry.Name(), err)
+	}
+
+	if o.namespace == "" {
+		return repos, nil
+	}
+
+	filtered := make([]string, 0, len(repos))
+	for _, repo := range repos {
+		if strings.HasPrefix(repo, o.namespace+"/") {
+			filtered = append(filtered, repo)
+		}
+	}
+
+	return filtered, nil
+}
+
+// ListTags lists the tags of an image repository
+func (o *OCI) ListTags(ctx context.Context, repository string) ([]string, error) {
+	repo, err := name.NewRepository(fmt.Sprintf("%s/%s", o.registry.Name(), repository), o.nameOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing repository %s: %w", repository, err)
+	}
+
+	tags, err := remote.List(repo, o.GetRemoteOptions(ctx)...)
+	if err != nil {
+		return nil, fmt.Errorf("error listing tags of %s: %w", repository, err)
+	}
+
+	return tags, nil
+}
+
+// GetReference returns the reference to a tag or digest of an image repository
+func (o *OCI) GetReference(repository string, reference string) (name.Reference, error) {
+	repoName := fmt.Sprintf("%s/%s", o.registry.Name(), repository)
+
+	if strings.Contains(reference, ":") {
+		return name.NewDigest(fmt.Sprintf("%s@%s", repoName, reference), o.nameOpts...)
+	}
+
+	return name.NewTag(fmt.Sprintf("%s:%s", repoName, reference), o.nameOpts...)
+}
+
+// GetRemoteOptions returns the options to access the registry with go-containerregistry
+func (o *OCI) GetRemoteOptions(ctx context.Context) []remote.Option {
+	return []remote.Option{
+		remote.WithContext(ctx),
+		remote.WithAuth(o.auth),
+		remote.WithTransport(o.transport),
+	}
+}
+
+// ParseV1Config parses the raw config into a OCIProviderConfig struct
+func ParseV1Config(rawCfg json.RawMessage) (*minderv1.OCIProviderConfig, error) {
+	type wrapper struct {
+		OCI *minderv1.OCIProviderConfig `json:"oci" validate:"required"`
+	}
+
+	var w wrapper
+	if err := provifv1.ParseAndValidate(rawCfg, &w); err != nil {
+		return nil, err
+	}
+
+	// Validate the config according to the protobuf validation rules.
+	if err := w.OCI.Validate(); err != nil {
+		return nil, fmt.Errorf("error validating OCI v1 provider config: %w", err)
+	}
+
+	return w.OCI, nil
+}
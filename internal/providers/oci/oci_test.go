@@ -0,0 +1,122 @@
+// Copyright 2023 Stacklok, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oci
+
+import (
+	"context"
+	"encoding/json"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/registry"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	provtelemetry "github.com/stacklok/minder/internal/providers/telemetry"
+	minderv1 "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+func TestParseV1Config(t *testing.T) {
+	t.Parallel()
+
+	cfg, err := ParseV1Config(json.RawMessage(`{"oci": {"registry_url": "https://index.docker.io", "namespace": "stacklok"}}`))
+	require.NoError(t, err)
+	assert.Equal(t, "https://index.docker.io", cfg.GetRegistryUrl())
+	assert.Equal(t, "stacklok", cfg.GetNamespace())
+
+	_, err = ParseV1Config(json.RawMessage(`{"oci": {"namespace": "stacklok"}}`))
+	assert.Error(t, err)
+
+	_, err = ParseV1Config(json.RawMessage(`{"github": {}}`))
+	assert.Error(t, err)
+}
+
+func TestParseRegistryURL(t *testing.T) {
+	t.Parallel()
+
+	host, insecure, err := parseRegistryURL("https://quay.io")
+	require.NoError(t, err)
+	assert.Equal(t, "quay.io", host)
+	assert.False(t, insecure)
+
+	host, insecure, err = parseRegistryURL("registry.example.com:5000")
+	require.NoError(t, err)
+	assert.Equal(t, "registry.example.com:5000", host)
+	assert.False(t, insecure)
+
+	host, insecure, err = parseRegistryURL("http://localhost:5000")
+	require.NoError(t, err)
+	assert.Equal(t, "localhost:5000", host)
+	assert.True(t, insecure)
+
+	_, _, err = parseRegistryURL("ftp://registry.example.com")
+	assert.Error(t, err)
+}
+
+func TestOCIListing(t *testing.T) {
+	t.Parallel()
+
+	ts := httptest.NewServer(registry.New())
+	defer ts.Close()
+
+	ctx := context.Background()
+
+	prov, err := NewOCI(&minderv1.OCIProviderConfig{
+		RegistryUrl: ts.URL,
+		Namespace:   "stacklok",
+	}, provtelemetry.NewNoopMetrics(), "")
+	require.NoError(t, err)
+
+	for _, image := range []string{"stacklok/minder:v1", "stacklok/minder:v2", "stacklok/mediator:latest", "other/image:latest"} {
+		img, err := random.Image(64, 1)
+		require.NoError(t, err)
+
+		repo, tag, _ := strings.Cut(image, ":")
+		ref, err := prov.GetReference(repo, tag)
+		require.NoError(t, err)
+		require.NoError(t, remote.Write(ref, img, prov.GetRemoteOptions(ctx)...))
+	}
+
+	repos, err := prov.ListRepositories(ctx)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"stacklok/minder", "stacklok/mediator"}, repos)
+
+	tags, err := prov.ListTags(ctx, "stacklok/minder")
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"v1", "v2"}, tags)
+
+	ref, err := prov.GetReference("stacklok/minder", "sha256:0000000000000000000000000000000000000000000000000000000000000000")
+	require.NoError(t, err)
+	assert.Contains(t, ref.String(), "/stacklok/minder@sha256:")
+}
+
+func TestOCIConfiguredRepositories(t *testing.T) {
+	t.Parallel()
+
+	prov, err := NewOCI(&minderv1.OCIProviderConfig{
+		RegistryUrl:  "https://index.docker.io",
+		Repositories: []string{"stacklok/minder"},
+	}, provtelemetry.NewNoopMetrics(), "token")
+	require.NoError(t, err)
+
+	repos, err := prov.ListRepositories(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"stacklok/minder"}, repos)
+	assert.Equal(t, "index.docker.io", prov.GetRegistry())
+	assert.Equal(t, "token", prov.GetToken())
+}
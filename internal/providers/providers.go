@@ -18,17 +18,22 @@ package providers
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 
 	"github.com/google/uuid"
 	"golang.org/x/exp/slices"
+	"google.golang.org/protobuf/proto"
 
+	"github.com/stacklok/minder/internal/container"
 	"github.com/stacklok/minder/internal/crypto"
 	"github.com/stacklok/minder/internal/db"
 	gitclient "github.com/stacklok/minder/internal/providers/git"
 	ghclient "github.com/stacklok/minder/internal/providers/github"
 	httpclient "github.com/stacklok/minder/internal/providers/http"
+	ociclient "github.com/stacklok/minder/internal/providers/oci"
 	"github.com/stacklok/minder/internal/providers/telemetry"
+	minderv1 "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
 	provinfv1 "github.com/stacklok/minder/pkg/providers/v1"
 )
 
@@ -53,6 +58,7 @@ func GetProviderBuilder(
 		return nil, fmt.Errorf("error decrypting access token: %w", err)
 	}
 
+	opts = append(opts, WithCryptoEngine(crypteng))
 	return NewProviderBuilder(&prov, encToken, decryptedToken.AccessToken, opts...), nil
 }
 
@@ -63,6 +69,7 @@ type ProviderBuilder struct {
 	tokenInf db.ProviderAccessToken
 	tok      string
 	metrics  telemetry.ProviderMetrics
+	crypteng *crypto.Engine
 }
 
 // ProviderBuilderOption is a function which can be used to set options on the ProviderBuilder.
@@ -75,6 +82,14 @@ func WithProviderMetrics(metrics telemetry.ProviderMetrics) ProviderBuilderOptio
 	}
 }
 
+// WithCryptoEngine sets the crypto engine decrypting the secrets used along the provider,
+// such as the secrets signing webhook alerts
+func WithCryptoEngine(crypteng *crypto.Engine) ProviderBuilderOption {
+	return func(pb *ProviderBuilder) {
+		pb.crypteng = crypteng
+	}
+}
+
 // NewProviderBuilder creates a new provider builder.
 func NewProviderBuilder(
 	p *db.Provider,
@@ -112,6 +127,15 @@ func (pb *ProviderBuilder) GetToken() string {
 	return pb.tok
 }
 
+// DecryptSecret decrypts a secret encrypted by the crypto engine of the server
+func (pb *ProviderBuilder) DecryptSecret(encSecret string) ([]byte, error) {
+	if pb.crypteng == nil {
+		return nil, fmt.Errorf("no crypto engine to decrypt secrets with")
+	}
+
+	return pb.crypteng.DecryptSecret(encSecret)
+}
+
 // GetGit returns a git client for the provider.
 func (pb *ProviderBuilder) GetGit() (*gitclient.Git, error) {
 	if !pb.Implements(db.ProviderTypeGit) {
@@ -171,6 +195,59 @@ func (pb *ProviderBuilder) GetGitHub(ctx context.Context) (*ghclient.RestClient,
 	return cli, nil
 }
 
+// GetOCI returns an OCI registry client for the provider.
+func (pb *ProviderBuilder) GetOCI() (provinfv1.OCI, error) {
+	if !pb.Implements(db.ProviderTypeOci) {
+		return nil, fmt.Errorf("provider does not implement oci")
+	}
+
+	if pb.p.Version != provinfv1.V1 {
+		return nil, fmt.Errorf("provider version not supported")
+	}
+
+	cfg, err := ociclient.ParseV1Config(pb.p.Definition)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing oci config: %w", err)
+	}
+
+	return ociclient.NewOCI(cfg, pb.metrics, pb.tok)
+}
+
+// GetTrustRoot returns the sigstore trust root the signatures of the artifacts
+// of the provider are verified against.
+func (pb *ProviderBuilder) GetTrustRoot(ctx context.Context) (*container.TrustRoot, error) {
+	cfg, err := ParseSigstoreTrustConfig(pb.p.Definition)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing sigstore config: %w", err)
+	}
+
+	return container.NewTrustRoot(ctx, cfg)
+}
+
+// ParseSigstoreTrustConfig parses the sigstore trust configuration of a provider
+// definition. It returns nil if the provider uses the public good instance.
+func ParseSigstoreTrustConfig(rawCfg json.RawMessage) (*minderv1.SigstoreTrustConfig, error) {
+	type wrapper struct {
+		Sigstore *minderv1.SigstoreTrustConfig `json:"sigstore"`
+	}
+
+	var w wrapper
+	if err := provinfv1.ParseAndValidate(rawCfg, &w); err != nil {
+		return nil, err
+	}
+
+	// an empty configuration stands for the public good instance
+	if w.Sigstore == nil || proto.Equal(w.Sigstore, &minderv1.SigstoreTrustConfig{}) {
+		return nil, nil
+	}
+
+	if err := w.Sigstore.Validate(); err != nil {
+		return nil, fmt.Errorf("error validating sigstore config: %w", err)
+	}
+
+	return w.Sigstore, nil
+}
+
 // GetRepoLister returns a repo lister for the provider.
 func (pb *ProviderBuilder) GetRepoLister(ctx context.Context) (provinfv1.RepoLister, error) {
 	if !pb.Implements(db.ProviderTypeRepoLister) {
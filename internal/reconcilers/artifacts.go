@@ -21,12 +21,14 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"slices"
 	"sort"
 	"strings"
 	"time"
 
 	"github.com/ThreeDotsLabs/watermill/message"
 	"github.com/go-playground/validator/v10"
+	gogithub "github.com/google/go-github/v53/github"
 	"github.com/google/uuid"
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -37,11 +39,22 @@ import (
 	"github.com/stacklok/minder/internal/providers"
 	"github.com/stacklok/minder/internal/providers/github"
 	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+	provifv1 "github.com/stacklok/minder/pkg/providers/v1"
 )
 
 // CONTAINER_TYPE is the type for container artifacts
 var CONTAINER_TYPE = "container"
 
+// NPM_TYPE is the type for npm package artifacts
+var NPM_TYPE = "npm"
+
+// MAVEN_TYPE is the type for Maven package artifacts
+var MAVEN_TYPE = "maven"
+
+// packageTypes are the types of GitHub Packages reconciled as artifacts. GitHub Packages
+// has no registry for Python packages, so python artifacts are never listed from it.
+var packageTypes = []string{CONTAINER_TYPE, NPM_TYPE, MAVEN_TYPE}
+
 // RepoReconcilerEvent is an event that is sent to the reconciler topic
 type RepoReconcilerEvent struct {
 	// Project is the group that the event is relevant to
@@ -67,11 +80,12 @@ func NewRepoReconcilerMessage(provider string, repoID int32, projectID uuid.UUID
 	return msg, nil
 }
 
-// handleRepoReconcilerEvent handles events coming from the reconciler topic
-func (e *Reconciler) handleRepoReconcilerEvent(msg *message.Message) error {
+// parseRepoReconcilerEvent parses and validates the event of a message, invalid
+// events are nil
+func parseRepoReconcilerEvent(msg *message.Message) (*RepoReconcilerEvent, error) {
 	var evt RepoReconcilerEvent
 	if err := json.Unmarshal(msg.Payload, &evt); err != nil {
-		return fmt.Errorf("error unmarshalling payload: %w", err)
+		return nil, fmt.Errorf("error unmarshalling payload: %w", err)
 	}
 
 	// validate event
@@ -80,22 +94,97 @@ func (e *Reconciler) handleRepoReconcilerEvent(msg *message.Message) error {
 		// We don't return the event since there's no use
 		// retrying it if it's invalid.
 		log.Printf("error validating event: %v", err)
-		return nil
+		return nil, nil
+	}
+
+	return &evt, nil
+}
+
+// handleRepoReconcilerEvent handles events coming from the reconciler topic
+func (e *Reconciler) handleRepoReconcilerEvent(msg *message.Message) error {
+	evt, err := parseRepoReconcilerEvent(msg)
+	if err != nil || evt == nil {
+		return err
 	}
 
 	ctx := msg.Context()
 	log.Printf("handling reconciler event for project %s and repository %d", evt.Project.String(), evt.Repository)
-	return e.handleArtifactsReconcilerEvent(ctx, &evt)
+	return e.handleArtifactsReconcilerEvent(ctx, evt)
 }
 
-// HandleArtifactsReconcilerEvent recreates the artifacts belonging to
-// an specific repository
-// nolint: gocyclo
+// handleArtifactsOnlyReconcilerEvent handles events coming from the artifacts reconciler
+// topic. Only the artifacts of the repository are reconciled, the repository itself is
+// not evaluated.
+func (e *Reconciler) handleArtifactsOnlyReconcilerEvent(msg *message.Message) error {
+	evt, err := parseRepoReconcilerEvent(msg)
+	if err != nil || evt == nil {
+		return err
+	}
+
+	ctx := msg.Context()
+	repository, prov, p, err := e.getRepositoryAndProvider(ctx, evt)
+	if err != nil {
+		return err
+	}
+
+	return e.reconcileArtifacts(ctx, evt.Project, repository, prov, p)
+}
+
+// HandleArtifactsReconcilerEvent evaluates the profiles of an specific repository
+// and recreates the build environments and artifacts belonging to it
 func (e *Reconciler) handleArtifactsReconcilerEvent(ctx context.Context, evt *RepoReconcilerEvent) error {
+	repository, prov, p, err := e.getRepositoryAndProvider(ctx, evt)
+	if err != nil {
+		return err
+	}
+
+	// evaluate profile for repo
+	repo := &pb.Repository{
+		Owner:         repository.RepoOwner,
+		Name:          repository.RepoName,
+		RepoId:        repository.RepoID,
+		HookUrl:       repository.WebhookUrl,
+		DeployUrl:     repository.DeployUrl,
+		CloneUrl:      repository.CloneUrl,
+		DefaultBranch: repository.DefaultBranch,
+		CreatedAt:     timestamppb.New(repository.CreatedAt),
+		UpdatedAt:     timestamppb.New(repository.UpdatedAt),
+	}
+
+	err = engine.NewEntityInfoWrapper().
+		WithProvider(prov.Name).
+		WithRepository(repo).
+		WithProjectID(evt.Project).
+		WithRepositoryID(repository.ID).
+		Publish(e.evt)
+	if err != nil {
+		return fmt.Errorf("error publishing message: %w", err)
+	}
+
+	// images in OCI registries are linked to the repositories they were built from when the
+	// registries are reconciled, which now includes this repository
+	if err := e.publishOCIArtifactsReconcilerEvents(ctx, evt.Project); err != nil {
+		log.Printf("error publishing OCI artifacts reconciler events: %v", err)
+	}
+
+	if err := e.reconcileBuildEnvironments(ctx, evt.Project, repository, prov, p); err != nil {
+		// the artifacts of the repository are reconciled regardless
+		log.Printf("error reconciling build environments: %v", err)
+	}
+
+	return e.reconcileArtifacts(ctx, evt.Project, repository, prov, p)
+}
+
+// getRepositoryAndProvider returns the repository of an event and the provider it
+// was registered with
+func (e *Reconciler) getRepositoryAndProvider(
+	ctx context.Context,
+	evt *RepoReconcilerEvent,
+) (db.Repository, db.Provider, *providers.ProviderBuilder, error) {
 	// first retrieve data for the repository
 	repository, err := e.store.GetRepositoryByRepoID(ctx, evt.Repository)
 	if err != nil {
-		return fmt.Errorf("error retrieving repository: %w", err)
+		return db.Repository{}, db.Provider{}, nil, fmt.Errorf("error retrieving repository: %w", err)
 	}
 
 	prov, err := e.store.GetProviderByName(ctx, db.GetProviderByNameParams{
@@ -103,7 +192,7 @@ func (e *Reconciler) handleArtifactsReconcilerEvent(ctx context.Context, evt *Re
 		ProjectID: evt.Project,
 	})
 	if err != nil {
-		return fmt.Errorf("error retrieving provider: %w", err)
+		return db.Repository{}, db.Provider{}, nil, fmt.Errorf("error retrieving provider: %w", err)
 	}
 
 	pbOpts := []providers.ProviderBuilderOption{
@@ -111,29 +200,25 @@ func (e *Reconciler) handleArtifactsReconcilerEvent(ctx context.Context, evt *Re
 	}
 	p, err := providers.GetProviderBuilder(ctx, prov, evt.Project, e.store, e.crypteng, pbOpts...)
 	if err != nil {
-		return fmt.Errorf("error building client: %w", err)
+		return db.Repository{}, db.Provider{}, nil, fmt.Errorf("error building client: %w", err)
 	}
 
-	// evaluate profile for repo
-	repo := &pb.Repository{
-		Owner:     repository.RepoOwner,
-		Name:      repository.RepoName,
-		RepoId:    repository.RepoID,
-		HookUrl:   repository.WebhookUrl,
-		DeployUrl: repository.DeployUrl,
-		CloneUrl:  repository.CloneUrl,
-		CreatedAt: timestamppb.New(repository.CreatedAt),
-		UpdatedAt: timestamppb.New(repository.UpdatedAt),
-	}
+	return repository, prov, p, nil
+}
 
-	err = engine.NewEntityInfoWrapper().
-		WithProvider(prov.Name).
-		WithRepository(repo).
-		WithProjectID(evt.Project).
-		WithRepositoryID(repository.ID).
-		Publish(e.evt)
+// reconcileArtifacts stores the artifacts of the repository, removes the ones that were
+// deleted and the versions the retention policy of the project doesn't keep, and publishes
+// the artifacts for evaluation
+func (e *Reconciler) reconcileArtifacts(
+	ctx context.Context,
+	projectID uuid.UUID,
+	repository db.Repository,
+	prov db.Provider,
+	p *providers.ProviderBuilder,
+) error {
+	retention, err := GetArtifactRetention(ctx, e.store, projectID)
 	if err != nil {
-		return fmt.Errorf("error publishing message: %w", err)
+		return err
 	}
 
 	if !p.Implements(db.ProviderTypeGithub) {
@@ -146,23 +231,74 @@ func (e *Reconciler) handleArtifactsReconcilerEvent(ctx context.Context, evt *Re
 		return fmt.Errorf("error getting github client: %w", err)
 	}
 
-	isOrg := (cli.GetOwner() != "")
-	// todo: add another type of artifacts
-	artifacts, err := cli.ListPackagesByRepository(ctx, isOrg, repository.RepoOwner,
-		CONTAINER_TYPE, int64(repository.RepoID), 1, 100)
+	trust, err := p.GetTrustRoot(ctx)
+	if err != nil {
+		return fmt.Errorf("error getting sigstore trust root: %w", err)
+	}
+
+	keys, err := e.store.ListSigningKeysByProjectID(ctx, projectID)
 	if err != nil {
-		if errors.Is(err, github.ErrNotFound) {
-			// we do not return error since it's a valid use case for a repository to not have artifacts
-			log.Printf("error retrieving artifacts for RepoID %d: %v", repository.RepoID, err)
-			return nil
+		return fmt.Errorf("error listing signing keys: %w", err)
+	}
+	trust = trust.WithSigningKeys(keys)
+
+	for _, packageType := range packageTypes {
+		pkgs := &packageReconciliation{
+			projectID:    projectID,
+			providerName: prov.Name,
+			repository:   repository,
+			cli:          cli,
+			trust:        trust,
+			retention:    retention,
+			packageType:  packageType,
 		}
+		if err := e.reconcilePackages(ctx, pkgs); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// packageReconciliation is the reconciliation of the packages of a type of a repository
+type packageReconciliation struct {
+	projectID    uuid.UUID
+	providerName string
+	repository   db.Repository
+	cli          provifv1.GitHub
+	trust        *container.TrustRoot
+	retention    ArtifactRetention
+	packageType  string
+}
+
+// reconcilePackages stores the packages of a type of the repository and the versions the
+// retention policy keeps, and publishes them for evaluation. Stored packages and versions
+// that were deleted from GitHub since are removed.
+func (e *Reconciler) reconcilePackages(ctx context.Context, pkgs *packageReconciliation) error {
+	cli, repository := pkgs.cli, pkgs.repository
+	isOrg := (cli.GetOwner() != "")
+	artifacts, err := cli.ListPackagesByRepository(ctx, isOrg, repository.RepoOwner,
+		pkgs.packageType, int64(repository.RepoID), 1, 100)
+	if errors.Is(err, github.ErrNotFound) {
+		// it's a valid use case for a repository to not have artifacts, e.g. once its
+		// last package is deleted, so the stored ones are still pruned
+		log.Printf("no %s artifacts found for RepoID %d: %v", pkgs.packageType, repository.RepoID, err)
+		artifacts = nil
+	} else if err != nil {
 		return err
 	}
+
+	listed := make(map[string]bool, len(artifacts))
+	for _, artifact := range artifacts {
+		listed[strings.ToLower(artifact.GetName())] = true
+	}
+	e.removeDeletedPackages(ctx, repository.ID, pkgs.providerName, pkgs.packageType, listed)
+
 	for _, artifact := range artifacts {
 		// store information if we do not have it
 		newArtifact, err := e.store.UpsertArtifact(ctx,
 			db.UpsertArtifactParams{RepositoryID: repository.ID, ArtifactName: artifact.GetName(),
-				ArtifactType: artifact.GetPackageType(), ArtifactVisibility: artifact.GetVisibility()})
+				ArtifactType: artifact.GetPackageType(), ArtifactVisibility: artifact.GetVisibility(),
+				Provider: pkgs.providerName})
 
 		if err != nil {
 			// just log error and continue
@@ -170,38 +306,31 @@ func (e *Reconciler) handleArtifactsReconcilerEvent(ctx context.Context, evt *Re
 			continue
 		}
 
-		// remove older versions
-		thirtyDaysAgo := time.Now().AddDate(0, 0, -30)
-		err = e.store.DeleteOldArtifactVersions(ctx,
-			db.DeleteOldArtifactVersionsParams{ArtifactID: newArtifact.ID, CreatedAt: thirtyDaysAgo})
-		if err != nil {
-			// just log error, we will not remove older for now
-			log.Printf("error removing older artifact versions: %v", err)
-		}
-
-		// now query for versions, retrieve the ones from last month
+		// now query for versions, retrieve the ones the retention policy keeps
 		versions, err := cli.GetPackageVersions(ctx, isOrg, repository.RepoOwner, artifact.GetPackageType(), artifact.GetName())
 		if err != nil {
 			// just log error and continue
 			log.Printf("error retrieving artifact versions: %v", err)
 			continue
 		}
-		var listVersionedArtifacts []*pb.ArtifactVersion
-		for _, version := range versions {
-			if version.CreatedAt.Before(thirtyDaysAgo) {
-				continue
-			}
+		versions = slices.DeleteFunc(versions, func(version *gogithub.PackageVersion) bool {
+			return !isArtifactVersion(artifact.GetPackageType(), version)
+		})
+		createdAt := make([]time.Time, len(versions))
+		for i, version := range versions {
+			createdAt[i] = version.GetCreatedAt().Time
+		}
+		kept := pkgs.retention.retained(createdAt)
 
-			tags := version.Metadata.Container.Tags
-			if container.TagsContainSignature(tags) {
+		current := make(map[int64]bool, len(versions))
+		var listVersionedArtifacts []*pb.ArtifactVersion
+		for i, version := range versions {
+			if !kept[i] {
 				continue
 			}
-			sort.Strings(tags)
-			tagNames := strings.Join(tags, ",")
+			current[version.GetID()] = true
 
-			// now get information for signature and workflow
-			sigInfo, workflowInfo, err := container.GetArtifactSignatureAndWorkflowInfo(
-				ctx, cli, *artifact.GetOwner().Login, artifact.GetName(), version.GetName())
+			info, err := getPackageVersionInfo(ctx, cli, pkgs.trust, artifact, version)
 			if errors.Is(err, container.ErrSigValidation) {
 				// just log error and continue
 				log.Printf("error validating signature: %v", err)
@@ -217,9 +346,10 @@ func (e *Reconciler) handleArtifactsReconcilerEvent(ctx context.Context, evt *Re
 				db.UpsertArtifactVersionParams{
 					ArtifactID: newArtifact.ID,
 					Version:    *version.ID,
-					Tags:       sql.NullString{Valid: true, String: tagNames},
-					Sha:        *version.Name, SignatureVerification: sigInfo,
-					GithubWorkflow: workflowInfo,
+					Tags:       sql.NullString{Valid: true, String: strings.Join(info.tags, ",")},
+					Sha:        *version.Name, SignatureVerification: info.sigInfo,
+					GithubWorkflow: info.workflowInfo,
+					Provenance:     info.provenanceInfo,
 					CreatedAt:      version.CreatedAt.Time,
 				})
 			if err != nil {
@@ -229,26 +359,41 @@ func (e *Reconciler) handleArtifactsReconcilerEvent(ctx context.Context, evt *Re
 			}
 
 			ghWorkflow := &pb.GithubWorkflow{}
-			if err := protojson.Unmarshal(workflowInfo, ghWorkflow); err != nil {
+			if err := protojson.Unmarshal(info.workflowInfo, ghWorkflow); err != nil {
 				// just log error and continue
 				log.Printf("error unmarshalling github workflow: %v", err)
 				continue
 			}
 
 			sigVerification := &pb.SignatureVerification{}
-			if err := protojson.Unmarshal(sigInfo, sigVerification); err != nil {
+			if err := protojson.Unmarshal(info.sigInfo, sigVerification); err != nil {
 				log.Printf("error unmarshalling signature verification: %v", err)
 				continue
 			}
+
+			provenance := &pb.Provenance{}
+			if len(info.provenanceInfo) > 0 {
+				if err := protojson.Unmarshal(info.provenanceInfo, provenance); err != nil {
+					log.Printf("error unmarshalling provenance: %v", err)
+					continue
+				}
+			}
 			listVersionedArtifacts = append(listVersionedArtifacts, &pb.ArtifactVersion{
 				VersionId:             newVersion.Version,
-				Tags:                  tags,
+				Tags:                  info.tags,
 				Sha:                   *version.Name,
 				SignatureVerification: sigVerification,
 				GithubWorkflow:        ghWorkflow,
+				Provenance:            provenance,
 				CreatedAt:             timestamppb.New(version.CreatedAt.Time),
 			})
 		}
+
+		// versions that were deleted or untagged, or that the policy no longer keeps
+		e.removeStaleArtifactVersions(ctx, newArtifact.ID, func(version db.ArtifactVersion) bool {
+			return current[version.Version]
+		})
+
 		pbArtifact := &pb.Artifact{
 			ArtifactPk: newArtifact.ID.String(),
 			Owner:      *artifact.GetOwner().Login,
@@ -260,9 +405,9 @@ func (e *Reconciler) handleArtifactsReconcilerEvent(ctx context.Context, evt *Re
 			CreatedAt:  timestamppb.New(artifact.GetCreatedAt().Time),
 		}
 		err = engine.NewEntityInfoWrapper().
-			WithProvider(prov.Name).
+			WithProvider(pkgs.providerName).
 			WithArtifact(pbArtifact).
-			WithProjectID(evt.Project).
+			WithProjectID(pkgs.projectID).
 			WithArtifactID(newArtifact.ID).
 			WithRepositoryID(repository.ID).
 			Publish(e.evt)
@@ -273,3 +418,146 @@ func (e *Reconciler) handleArtifactsReconcilerEvent(ctx context.Context, evt *Re
 	}
 	return nil
 }
+
+// removeDeletedPackages removes the stored packages of a type of the repository that are
+// no longer listed on GitHub. Artifacts of other providers, such as the images of OCI
+// registries, are reconciled separately.
+func (e *Reconciler) removeDeletedPackages(
+	ctx context.Context,
+	repositoryID uuid.UUID,
+	providerName string,
+	packageType string,
+	listed map[string]bool,
+) {
+	stored, err := e.store.ListArtifactsByRepoID(ctx, repositoryID)
+	if err != nil {
+		log.Printf("error listing stored artifacts: %v", err)
+		return
+	}
+
+	for _, artifact := range stored {
+		if artifact.ArtifactType != packageType || !isProviderArtifact(artifact, providerName) ||
+			listed[strings.ToLower(artifact.ArtifactName)] {
+			continue
+		}
+
+		log.Printf("removing artifact %s, it was deleted", artifact.ArtifactName)
+		if err := e.store.DeleteArtifact(ctx, artifact.ID); err != nil {
+			log.Printf("error removing deleted artifact: %v", err)
+		}
+	}
+}
+
+// removeStaleArtifactVersions removes the stored versions of an artifact that are not current
+func (e *Reconciler) removeStaleArtifactVersions(
+	ctx context.Context,
+	artifactID uuid.UUID,
+	isCurrent func(db.ArtifactVersion) bool,
+) {
+	stored, err := e.store.ListArtifactVersionsByArtifactID(ctx, db.ListArtifactVersionsByArtifactIDParams{
+		ArtifactID: artifactID,
+	})
+	if err != nil {
+		log.Printf("error listing stored artifact versions: %v", err)
+		return
+	}
+
+	for _, version := range stored {
+		if isCurrent(version) {
+			continue
+		}
+		if err := e.store.DeleteArtifactVersion(ctx, version.ID); err != nil {
+			log.Printf("error removing stale artifact version: %v", err)
+		}
+	}
+}
+
+// isProviderArtifact returns whether the artifact was recorded under the provider. Artifacts
+// recorded before the provider was stored belong to the provider of their repository, unless
+// they are images of an OCI registry provider, which are named after their repository in the
+// registry.
+func isProviderArtifact(artifact db.Artifact, providerName string) bool {
+	if artifact.Provider != "" {
+		return artifact.Provider == providerName
+	}
+	host, _, ok := strings.Cut(artifact.ArtifactName, "/")
+	return !ok || !strings.ContainsAny(host, ".:")
+}
+
+// isArtifactVersion returns whether a version of a package is an artifact. Signatures and
+// attestations are stored as versions of container images, and untagged images are only
+// referenced by digest.
+func isArtifactVersion(packageType string, version *gogithub.PackageVersion) bool {
+	if packageType != CONTAINER_TYPE {
+		return true
+	}
+
+	tags := version.GetMetadata().GetContainer().Tags
+	return len(tags) > 0 && !container.TagsContainSignature(tags) && !container.TagsContainAttestation(tags)
+}
+
+// packageVersionInfo is what is stored about a version of a package
+type packageVersionInfo struct {
+	tags           []string
+	sigInfo        json.RawMessage
+	workflowInfo   json.RawMessage
+	provenanceInfo json.RawMessage
+}
+
+// getPackageVersionInfo returns the tags, the signature verification, the workflow and the
+// build provenance of a version of a package. Versions of packages other than container images
+// are tagged with their version, and only npm packages carry a signed provenance.
+func getPackageVersionInfo(
+	ctx context.Context,
+	cli provifv1.GitHub,
+	trust *container.TrustRoot,
+	artifact *gogithub.Package,
+	version *gogithub.PackageVersion,
+) (*packageVersionInfo, error) {
+	switch artifact.GetPackageType() {
+	case CONTAINER_TYPE:
+		tags := version.Metadata.Container.Tags
+		sort.Strings(tags)
+
+		// now get information for signature and workflow
+		sigInfo, workflowInfo, err := container.GetArtifactSignatureAndWorkflowInfo(
+			ctx, cli, trust, *artifact.GetOwner().Login, artifact.GetName(), version.GetName())
+		if err != nil && !errors.Is(err, container.ErrProtoParse) {
+			return nil, err
+		}
+
+		provenanceInfo, provErr := container.GetArtifactProvenance(
+			ctx, cli, trust, *artifact.GetOwner().Login, artifact.GetName(), version.GetName())
+		if provErr != nil {
+			// just log error, the version is stored without provenance
+			log.Printf("error getting provenance: %v", provErr)
+		}
+
+		return &packageVersionInfo{
+			tags:           tags,
+			sigInfo:        sigInfo,
+			workflowInfo:   workflowInfo,
+			provenanceInfo: provenanceInfo,
+		}, err
+	case NPM_TYPE:
+		sigInfo, workflowInfo, provenanceInfo, err := container.GetNpmPackageSignatureAndProvenance(
+			ctx, trust, container.NpmRegistryURL,
+			container.NpmPackageName(*artifact.GetOwner().Login, artifact.GetName()), version.GetName())
+		if err != nil {
+			return nil, err
+		}
+
+		return &packageVersionInfo{
+			tags:           []string{version.GetName()},
+			sigInfo:        sigInfo,
+			workflowInfo:   workflowInfo,
+			provenanceInfo: provenanceInfo,
+		}, nil
+	default:
+		return &packageVersionInfo{
+			tags:         []string{version.GetName()},
+			sigInfo:      json.RawMessage("{}"),
+			workflowInfo: json.RawMessage("{}"),
+		}, nil
+	}
+}
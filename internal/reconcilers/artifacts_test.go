@@ -0,0 +1,61 @@
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reconcilers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	mockdb "github.com/stacklok/minder/database/mock"
+	"github.com/stacklok/minder/internal/db"
+	"github.com/stacklok/minder/internal/providers/github"
+	mock_ghclient "github.com/stacklok/minder/internal/providers/github/mock"
+)
+
+func TestReconcilePackagesPrunesWhenNoneAreListed(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := db.Repository{ID: uuid.New(), RepoOwner: "stacklok", RepoName: "minder", RepoID: 42}
+	deleted := db.Artifact{ID: uuid.New(), ArtifactName: "minder", ArtifactType: CONTAINER_TYPE, Provider: "github"}
+	otherType := db.Artifact{ID: uuid.New(), ArtifactName: "minder", ArtifactType: "npm", Provider: "github"}
+
+	cli := mock_ghclient.NewMockGitHub(ctrl)
+	cli.EXPECT().GetOwner().Return("stacklok")
+	cli.EXPECT().
+		ListPackagesByRepository(gomock.Any(), true, "stacklok", CONTAINER_TYPE, int64(42), 1, 100).
+		Return(nil, github.ErrNotFound)
+
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().
+		ListArtifactsByRepoID(gomock.Any(), repository.ID).
+		Return([]db.Artifact{deleted, otherType}, nil)
+	store.EXPECT().DeleteArtifact(gomock.Any(), deleted.ID).Return(nil)
+
+	r := &Reconciler{store: store}
+	err := r.reconcilePackages(context.Background(), &packageReconciliation{
+		providerName: "github",
+		repository:   repository,
+		cli:          cli,
+		packageType:  CONTAINER_TYPE,
+	})
+	require.NoError(t, err)
+}
@@ -0,0 +1,186 @@
+// Copyright 2023 Stacklok, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reconcilers
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	gogithub "github.com/google/go-github/v53/github"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/stacklok/minder/internal/db"
+	"github.com/stacklok/minder/internal/engine"
+	"github.com/stacklok/minder/internal/providers"
+	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+	provifv1 "github.com/stacklok/minder/pkg/providers/v1"
+)
+
+// reconcileBuildEnvironments stores the deployment environments of the repository,
+// removes the ones that were deleted from GitHub and publishes them for evaluation
+func (e *Reconciler) reconcileBuildEnvironments(
+	ctx context.Context,
+	projectID uuid.UUID,
+	repository db.Repository,
+	prov db.Provider,
+	p *providers.ProviderBuilder,
+) error {
+	if !p.Implements(db.ProviderTypeGithub) {
+		log.Printf("provider %s is not supported for build environments reconciler", prov.Name)
+		return nil
+	}
+
+	cli, err := p.GetGitHub(ctx)
+	if err != nil {
+		return fmt.Errorf("error getting github client: %w", err)
+	}
+
+	envs, err := cli.ListEnvironments(ctx, repository.RepoOwner, repository.RepoName)
+	if err != nil {
+		return fmt.Errorf("error listing build environments: %w", err)
+	}
+
+	listed := make(map[string]bool, len(envs))
+	for _, env := range envs {
+		dbEnv, pbEnv, err := UpsertBuildEnvironment(ctx, e.store, cli, repository, env)
+		if err != nil {
+			// just log error and continue
+			log.Printf("error storing build environment %s: %v", env.GetName(), err)
+			continue
+		}
+		listed[dbEnv.Name] = true
+
+		err = engine.NewEntityInfoWrapper().
+			WithProvider(prov.Name).
+			WithBuildEnvironment(pbEnv).
+			WithProjectID(projectID).
+			WithRepositoryID(repository.ID).
+			WithBuildEnvironmentID(dbEnv.ID).
+			Publish(e.evt)
+		if err != nil {
+			return fmt.Errorf("error publishing message: %w", err)
+		}
+	}
+
+	e.removeDeletedBuildEnvironments(ctx, repository.ID, listed)
+	return nil
+}
+
+// removeDeletedBuildEnvironments removes the stored build environments of a repository
+// that are no longer listed by GitHub
+func (e *Reconciler) removeDeletedBuildEnvironments(ctx context.Context, repoID uuid.UUID, listed map[string]bool) {
+	stored, err := e.store.ListBuildEnvironmentsByRepositoryID(ctx, repoID)
+	if err != nil {
+		log.Printf("error listing stored build environments: %v", err)
+		return
+	}
+
+	for _, env := range stored {
+		if listed[env.Name] {
+			continue
+		}
+		if err := e.store.DeleteBuildEnvironment(ctx, env.ID); err != nil {
+			log.Printf("error deleting build environment %s: %v", env.Name, err)
+		}
+	}
+}
+
+// UpsertBuildEnvironment stores a deployment environment of a repository, along with the
+// names of its secrets, and returns it with its ID
+func UpsertBuildEnvironment(
+	ctx context.Context,
+	store db.Store,
+	cli provifv1.GitHub,
+	repository db.Repository,
+	env *gogithub.Environment,
+) (db.BuildEnvironment, *pb.BuildEnvironment, error) {
+	secrets, err := cli.ListEnvironmentSecrets(ctx, int64(repository.RepoID), env.GetName())
+	if err != nil {
+		return db.BuildEnvironment{}, nil, fmt.Errorf("error listing secrets: %w", err)
+	}
+
+	pbEnv := buildEnvironmentFromGitHub(repository, env, secrets)
+	properties, err := protojson.Marshal(pbEnv)
+	if err != nil {
+		return db.BuildEnvironment{}, nil, fmt.Errorf("error marshalling build environment: %w", err)
+	}
+
+	dbEnv, err := store.UpsertBuildEnvironment(ctx, db.UpsertBuildEnvironmentParams{
+		RepositoryID: repository.ID,
+		Name:         env.GetName(),
+		Properties:   properties,
+	})
+	if err != nil {
+		return db.BuildEnvironment{}, nil, err
+	}
+
+	id := dbEnv.ID.String()
+	pbEnv.Id = &id
+	return dbEnv, pbEnv, nil
+}
+
+// buildEnvironmentFromGitHub returns the build environment of a GitHub deployment environment
+func buildEnvironmentFromGitHub(
+	repository db.Repository,
+	env *gogithub.Environment,
+	secrets []*gogithub.Secret,
+) *pb.BuildEnvironment {
+	pbEnv := &pb.BuildEnvironment{
+		Name:            env.GetName(),
+		EnvironmentId:   env.GetID(),
+		RepoOwner:       repository.RepoOwner,
+		RepoName:        repository.RepoName,
+		RepoId:          repository.RepoID,
+		Url:             env.GetHTMLURL(),
+		CanAdminsBypass: env.GetCanAdminsBypass(),
+		Secrets:         []string{},
+		CreatedAt:       timestamppb.New(env.GetCreatedAt().Time),
+		UpdatedAt:       timestamppb.New(env.GetUpdatedAt().Time),
+	}
+
+	if policy := env.DeploymentBranchPolicy; policy != nil {
+		pbEnv.DeploymentBranchPolicy = &pb.BuildEnvironmentBranchPolicy{
+			ProtectedBranches:    policy.GetProtectedBranches(),
+			CustomBranchPolicies: policy.GetCustomBranchPolicies(),
+		}
+	}
+
+	for _, rule := range env.ProtectionRules {
+		pbRule := &pb.BuildEnvironmentProtectionRule{
+			Type:      rule.GetType(),
+			WaitTimer: int32(rule.GetWaitTimer()),
+		}
+		for _, reviewer := range rule.Reviewers {
+			pbReviewer := &pb.BuildEnvironmentReviewer{Type: reviewer.GetType()}
+			switch r := reviewer.Reviewer.(type) {
+			case *gogithub.User:
+				pbReviewer.Name = r.GetLogin()
+			case *gogithub.Team:
+				pbReviewer.Name = r.GetSlug()
+			}
+			pbRule.Reviewers = append(pbRule.Reviewers, pbReviewer)
+		}
+		pbEnv.ProtectionRules = append(pbEnv.ProtectionRules, pbRule)
+	}
+
+	for _, secret := range secrets {
+		pbEnv.Secrets = append(pbEnv.Secrets, secret.Name)
+	}
+
+	return pbEnv
+}
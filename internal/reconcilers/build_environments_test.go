@@ -0,0 +1,82 @@
+// Copyright 2023 Stacklok, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reconcilers
+
+import (
+	"testing"
+
+	gogithub "github.com/google/go-github/v53/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/stacklok/minder/internal/db"
+)
+
+func TestBuildEnvironmentFromGitHub(t *testing.T) {
+	t.Parallel()
+
+	repository := db.Repository{RepoOwner: "stacklok", RepoName: "minder", RepoID: 42}
+	env := &gogithub.Environment{
+		ID:              gogithub.Int64(7),
+		Name:            gogithub.String("production"),
+		HTMLURL:         gogithub.String("https://github.com/stacklok/minder/deployments/activity_log?environments_filter=production"),
+		CanAdminsBypass: gogithub.Bool(false),
+		DeploymentBranchPolicy: &gogithub.BranchPolicy{
+			ProtectedBranches:    gogithub.Bool(true),
+			CustomBranchPolicies: gogithub.Bool(false),
+		},
+		ProtectionRules: []*gogithub.ProtectionRule{
+			{Type: gogithub.String("wait_timer"), WaitTimer: gogithub.Int(30)},
+			{
+				Type: gogithub.String("required_reviewers"),
+				Reviewers: []*gogithub.RequiredReviewer{
+					{Type: gogithub.String("User"), Reviewer: &gogithub.User{Login: gogithub.String("octocat")}},
+					{Type: gogithub.String("Team"), Reviewer: &gogithub.Team{Slug: gogithub.String("maintainers")}},
+				},
+			},
+		},
+	}
+	secrets := []*gogithub.Secret{{Name: "DEPLOY_TOKEN"}}
+
+	got := buildEnvironmentFromGitHub(repository, env, secrets)
+
+	assert.Equal(t, "production", got.GetName())
+	assert.Equal(t, int64(7), got.GetEnvironmentId())
+	assert.Equal(t, "stacklok", got.GetRepoOwner())
+	assert.Equal(t, "minder", got.GetRepoName())
+	assert.Equal(t, int32(42), got.GetRepoId())
+	assert.False(t, got.GetCanAdminsBypass())
+	assert.True(t, got.GetDeploymentBranchPolicy().GetProtectedBranches())
+	assert.Equal(t, []string{"DEPLOY_TOKEN"}, got.GetSecrets())
+
+	require.Len(t, got.GetProtectionRules(), 2)
+	assert.Equal(t, int32(30), got.GetProtectionRules()[0].GetWaitTimer())
+	reviewers := got.GetProtectionRules()[1].GetReviewers()
+	require.Len(t, reviewers, 2)
+	assert.Equal(t, "octocat", reviewers[0].GetName())
+	assert.Equal(t, "User", reviewers[0].GetType())
+	assert.Equal(t, "maintainers", reviewers[1].GetName())
+	assert.Equal(t, "Team", reviewers[1].GetType())
+}
+
+func TestBuildEnvironmentFromGitHubWithoutPolicy(t *testing.T) {
+	t.Parallel()
+
+	got := buildEnvironmentFromGitHub(db.Repository{}, &gogithub.Environment{Name: gogithub.String("staging")}, nil)
+
+	assert.Nil(t, got.DeploymentBranchPolicy, "any branch can deploy")
+	assert.Empty(t, got.GetProtectionRules())
+	assert.NotNil(t, got.GetSecrets())
+}
@@ -0,0 +1,574 @@
+// Copyright 2023 Stacklok, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reconcilers
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"golang.org/x/exp/slices"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/stacklok/minder/internal/container"
+	"github.com/stacklok/minder/internal/db"
+	"github.com/stacklok/minder/internal/engine"
+	"github.com/stacklok/minder/internal/events"
+	"github.com/stacklok/minder/internal/providers"
+	"github.com/stacklok/minder/internal/providers/oci"
+	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+	provifv1 "github.com/stacklok/minder/pkg/providers/v1"
+)
+
+// ociImageVersion is an image of an OCI repository together with all the tags pointing to it
+type ociImageVersion struct {
+	info *container.ImageInfo
+	tags []string
+}
+
+// OCIArtifactsReconcilerEvent is an event that is sent to the OCI artifacts reconciler topic
+// to reconcile the images of an OCI registry provider
+type OCIArtifactsReconcilerEvent struct {
+	// Project is the project of the provider
+	Project uuid.UUID `json:"project" validate:"gte=0"`
+}
+
+// NewOCIArtifactsReconcilerMessage creates a new OCI artifacts reconciler event for the
+// provider of the project
+func NewOCIArtifactsReconcilerMessage(provider string, projectID uuid.UUID) (*message.Message, error) {
+	evt := &OCIArtifactsReconcilerEvent{
+		Project: projectID,
+	}
+
+	evtStr, err := json.Marshal(evt)
+	if err != nil {
+		return nil, fmt.Errorf("error marshalling OCI artifacts reconciler event: %w", err)
+	}
+
+	msg := message.NewMessage(uuid.New().String(), evtStr)
+	msg.Metadata.Set(events.ProviderTypeKey, provider)
+	return msg, nil
+}
+
+// publishOCIArtifactsReconcilerEvents publishes an OCI artifacts reconciler event for every
+// OCI registry provider of the project
+func (e *Reconciler) publishOCIArtifactsReconcilerEvents(ctx context.Context, projectID uuid.UUID) error {
+	provs, err := e.store.ListProvidersByProjectID(ctx, projectID)
+	if err != nil {
+		return fmt.Errorf("error listing providers: %w", err)
+	}
+
+	for _, prov := range provs {
+		if !slices.Contains(prov.Implements, db.ProviderTypeOci) {
+			continue
+		}
+
+		msg, err := NewOCIArtifactsReconcilerMessage(prov.Name, projectID)
+		if err != nil {
+			return err
+		}
+
+		if err := e.evt.Publish(InternalOCIArtifactsReconcilerEventTopic, msg); err != nil {
+			log.Printf("error publishing OCI artifacts reconciler event for provider %s: %v", prov.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// handleOCIArtifactsReconcilerEvent handles events coming from the OCI artifacts reconciler
+// topic. The catalog of the registry is listed once, and its images are linked to the
+// registered repositories of the project they were built from.
+func (e *Reconciler) handleOCIArtifactsReconcilerEvent(msg *message.Message) error {
+	ctx := msg.Context()
+	providerName := msg.Metadata.Get(events.ProviderTypeKey)
+
+	var evt OCIArtifactsReconcilerEvent
+	if err := json.Unmarshal(msg.Payload, &evt); err != nil {
+		return fmt.Errorf("error unmarshalling payload: %w", err)
+	}
+
+	// validate event
+	validate := validator.New()
+	if err := validate.Struct(&evt); err != nil {
+		// We don't return the event since there's no use
+		// retrying it if it's invalid.
+		log.Printf("error validating event: %v", err)
+		return nil
+	}
+
+	prov, err := e.store.GetProviderByName(ctx, db.GetProviderByNameParams{
+		Name:      providerName,
+		ProjectID: evt.Project,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		// the provider was deleted since
+		log.Printf("provider %s not found", providerName)
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("error retrieving provider: %w", err)
+	}
+
+	if !slices.Contains(prov.Implements, db.ProviderTypeOci) {
+		log.Printf("provider %s is not an OCI registry", prov.Name)
+		return nil
+	}
+
+	log.Printf("handling OCI artifacts reconciler event for project %s and provider %s", evt.Project, prov.Name)
+	registry, err := e.newOCIReconciliation(ctx, evt.Project, prov)
+	if err != nil {
+		return err
+	}
+
+	return e.reconcileOCIRegistry(ctx, registry)
+}
+
+// ociReconciliation is the reconciliation of the images of a registry
+type ociReconciliation struct {
+	cli          provifv1.OCI
+	trust        *container.TrustRoot
+	projectID    uuid.UUID
+	providerName string
+	retention    ArtifactRetention
+	// bySource are the registered repositories of the project by their normalized clone URL
+	bySource map[string]db.Repository
+	// byName are the registered repositories of the project by their lowercase owner/name
+	byName map[string]db.Repository
+	// mapped are the owner/name of the repositories images are explicitly built from
+	mapped map[string]string
+}
+
+// newOCIReconciliation returns the reconciliation of the images of an OCI registry provider
+func (e *Reconciler) newOCIReconciliation(
+	ctx context.Context,
+	projectID uuid.UUID,
+	prov db.Provider,
+) (*ociReconciliation, error) {
+	cfg, err := oci.ParseV1Config(prov.Definition)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing provider config: %w", err)
+	}
+
+	p, err := providers.GetProviderBuilder(ctx, prov, projectID, e.store, e.crypteng,
+		providers.WithProviderMetrics(e.provMt))
+	if err != nil {
+		return nil, fmt.Errorf("error building client: %w", err)
+	}
+
+	cli, err := p.GetOCI()
+	if err != nil {
+		return nil, fmt.Errorf("error getting oci client: %w", err)
+	}
+
+	trust, err := p.GetTrustRoot(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error getting sigstore trust root: %w", err)
+	}
+
+	keys, err := e.store.ListSigningKeysByProjectID(ctx, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("error listing signing keys: %w", err)
+	}
+
+	retention, err := GetArtifactRetention(ctx, e.store, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	repos, err := e.store.ListRegisteredRepositoriesByProjectID(ctx, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("error listing repositories: %w", err)
+	}
+
+	registry := &ociReconciliation{
+		cli:          cli,
+		trust:        trust.WithSigningKeys(keys),
+		projectID:    projectID,
+		providerName: prov.Name,
+		retention:    retention,
+		bySource:     make(map[string]db.Repository, len(repos)),
+		byName:       make(map[string]db.Repository, len(repos)),
+		mapped:       make(map[string]string, len(cfg.GetImageRepositories())),
+	}
+	for _, repo := range repos {
+		if source := normalizeImageSource(repo.CloneUrl); source != "" {
+			registry.bySource[source] = repo
+		}
+		registry.byName[strings.ToLower(repo.RepoOwner+"/"+repo.RepoName)] = repo
+	}
+	for _, mapping := range cfg.GetImageRepositories() {
+		registry.mapped[strings.ToLower(mapping.GetImage())] = mapping.GetRepository()
+	}
+
+	return registry, nil
+}
+
+// repositoryOf returns the registered repository an image is built from, either the one it
+// is mapped to in the provider config or the one its source label points to
+func (registry *ociReconciliation) repositoryOf(
+	imageRepo string,
+	versions []*ociImageVersion,
+) (db.Repository, bool) {
+	if name, ok := registry.mapped[strings.ToLower(imageRepo)]; ok {
+		repository, ok := registry.byName[strings.ToLower(name)]
+		if !ok {
+			log.Printf("skipping image %s, the repository %s it is mapped to is not registered", imageRepo, name)
+		}
+		return repository, ok
+	}
+
+	source := imageSource(versions)
+	if source == "" {
+		log.Printf("skipping image %s, it has no %s label and is not mapped to a repository",
+			imageRepo, container.ImageSourceAnnotation)
+		return db.Repository{}, false
+	}
+
+	repository, ok := registry.bySource[normalizeImageSource(source)]
+	return repository, ok
+}
+
+// reconcileOCIRegistry stores the images of a registry built from the registered repositories
+// and the versions the retention policy keeps, and publishes them for evaluation. Images that
+// were deleted from the registry or are no longer built from the repository they were stored
+// for are removed.
+func (e *Reconciler) reconcileOCIRegistry(ctx context.Context, registry *ociReconciliation) error {
+	cli := registry.cli
+	imageRepos, err := cli.ListRepositories(ctx)
+	if err != nil {
+		// just log error, the registry may be temporarily unavailable
+		log.Printf("error listing image repositories: %v", err)
+		return nil
+	}
+
+	// without a minimum number of versions to keep, older images don't need to be inspected
+	var since time.Time
+	if registry.retention.KeepLast <= 0 {
+		since = registry.retention.cutoff()
+	}
+
+	// the artifacts the images of the catalog are stored as, uuid.Nil for the images that
+	// aren't built from a registered repository
+	listed := make(map[string]uuid.UUID, len(imageRepos))
+	linked := make(map[string]bool, len(imageRepos))
+	for _, imageRepo := range imageRepos {
+		artifactName := fmt.Sprintf("%s/%s", cli.GetRegistry(), imageRepo)
+		key := strings.ToLower(artifactName)
+		listed[key] = uuid.Nil
+
+		versions, err := listOCIImageVersions(ctx, cli, imageRepo, since)
+		if err != nil {
+			log.Printf("error listing versions of image %s: %v", imageRepo, err)
+			continue
+		}
+
+		if len(versions) == 0 {
+			continue
+		}
+
+		repository, ok := registry.repositoryOf(imageRepo, versions)
+		linked[key] = ok
+		if !ok {
+			continue
+		}
+
+		artifactID, err := e.reconcileOCIImage(ctx, registry, repository, imageRepo, artifactName, versions)
+		if err != nil {
+			return err
+		}
+		listed[key] = artifactID
+	}
+
+	e.removeDeletedImages(ctx, registry, listed, linked)
+	return nil
+}
+
+// reconcileOCIImage stores an image built from a repository and the versions the retention
+// policy keeps, and publishes it for evaluation
+func (e *Reconciler) reconcileOCIImage(
+	ctx context.Context,
+	registry *ociReconciliation,
+	repository db.Repository,
+	imageRepo string,
+	artifactName string,
+	versions []*ociImageVersion,
+) (uuid.UUID, error) {
+	cli := registry.cli
+	visibility := "private"
+	if cli.GetToken() == "" {
+		visibility = "public"
+	}
+
+	newArtifact, err := e.store.UpsertArtifact(ctx,
+		db.UpsertArtifactParams{RepositoryID: repository.ID, ArtifactName: artifactName,
+			ArtifactType: CONTAINER_TYPE, ArtifactVisibility: visibility,
+			Provider: registry.providerName})
+	if err != nil {
+		// just log error and continue
+		log.Printf("error storing artifact: %v", err)
+		return uuid.Nil, nil
+	}
+
+	createdAt := make([]time.Time, len(versions))
+	for i, version := range versions {
+		createdAt[i] = version.info.Created
+	}
+	kept := registry.retention.retained(createdAt)
+
+	current := make(map[string]bool, len(versions))
+	var listVersionedArtifacts []*pb.ArtifactVersion
+	for i, version := range versions {
+		if !kept[i] {
+			continue
+		}
+		current[version.info.Digest] = true
+
+		pbVersion, err := e.storeOCIImageVersion(ctx, cli, registry.trust, newArtifact.ID, imageRepo, version)
+		if err != nil {
+			// just log error and continue
+			log.Printf("error storing version %s of image %s: %v", version.info.Digest, imageRepo, err)
+			continue
+		}
+		listVersionedArtifacts = append(listVersionedArtifacts, pbVersion)
+	}
+
+	// images that were deleted or untagged, or that the policy no longer keeps
+	e.removeStaleArtifactVersions(ctx, newArtifact.ID, func(version db.ArtifactVersion) bool {
+		return current[version.Sha]
+	})
+
+	owner, _, _ := strings.Cut(imageRepo, "/")
+	pbArtifact := &pb.Artifact{
+		ArtifactPk: newArtifact.ID.String(),
+		Owner:      owner,
+		Name:       artifactName,
+		Type:       CONTAINER_TYPE,
+		Visibility: visibility,
+		Repository: repository.RepoName,
+		Versions:   listVersionedArtifacts,
+		CreatedAt:  timestamppb.New(newArtifact.CreatedAt),
+	}
+	err = engine.NewEntityInfoWrapper().
+		WithProvider(registry.providerName).
+		WithArtifact(pbArtifact).
+		WithProjectID(registry.projectID).
+		WithArtifactID(newArtifact.ID).
+		WithRepositoryID(repository.ID).
+		Publish(e.evt)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("error publishing message: %w", err)
+	}
+
+	return newArtifact.ID, nil
+}
+
+// removeDeletedImages removes the stored images of the registry that are no longer in its
+// catalog, and the ones that are no longer built from the repository they were stored for.
+// Images whose versions couldn't be listed are kept.
+func (e *Reconciler) removeDeletedImages(
+	ctx context.Context,
+	registry *ociReconciliation,
+	listed map[string]uuid.UUID,
+	linked map[string]bool,
+) {
+	stored, err := e.store.ListArtifactsByProvider(ctx, db.ListArtifactsByProviderParams{
+		ProjectID: registry.projectID,
+		Provider:  registry.providerName,
+	})
+	if err != nil {
+		log.Printf("error listing stored artifacts: %v", err)
+		return
+	}
+
+	for _, artifact := range stored {
+		key := strings.ToLower(artifact.ArtifactName)
+		artifactID, inCatalog := listed[key]
+		isLinked, reconciled := linked[key]
+		switch {
+		case !inCatalog:
+			// the image was deleted from the registry
+		case !reconciled:
+			// the versions of the image couldn't be listed
+			continue
+		case !isLinked:
+			// the image is no longer built from a registered repository
+		case artifactID == uuid.Nil || artifactID == artifact.ID:
+			continue
+		}
+
+		log.Printf("removing artifact %s, it was deleted or is no longer built from its repository", artifact.ArtifactName)
+		if err := e.store.DeleteArtifact(ctx, artifact.ID); err != nil {
+			log.Printf("error removing deleted artifact: %v", err)
+		}
+	}
+}
+
+// listOCIImageVersions lists the images of a repository created after the given time,
+// or all of them if it's zero, grouping the tags by the digest they point to
+func listOCIImageVersions(
+	ctx context.Context,
+	cli provifv1.OCI,
+	imageRepo string,
+	since time.Time,
+) ([]*ociImageVersion, error) {
+	tags, err := cli.ListTags(ctx, imageRepo)
+	if err != nil {
+		return nil, err
+	}
+
+	versions := make(map[string]*ociImageVersion)
+	for _, tag := range tags {
+		// cosign stores signatures and attestations as tags of the same repository
+		if container.TagsContainSignature([]string{tag}) || container.TagsContainAttestation([]string{tag}) {
+			continue
+		}
+
+		ref, err := cli.GetReference(imageRepo, tag)
+		if err != nil {
+			log.Printf("error parsing reference to %s:%s: %v", imageRepo, tag, err)
+			continue
+		}
+
+		info, err := container.InspectImage(ref, cli.GetRemoteOptions(ctx)...)
+		if err != nil {
+			log.Printf("error inspecting image %s: %v", ref.String(), err)
+			continue
+		}
+
+		if !since.IsZero() && info.Created.Before(since) {
+			continue
+		}
+
+		version, ok := versions[info.Digest]
+		if !ok {
+			version = &ociImageVersion{info: info}
+			versions[info.Digest] = version
+		}
+		version.tags = append(version.tags, tag)
+	}
+
+	result := make([]*ociImageVersion, 0, len(versions))
+	for _, version := range versions {
+		sort.Strings(version.tags)
+		result = append(result, version)
+	}
+
+	return result, nil
+}
+
+// imageSource returns the source label of the most recent version of the image that has one
+func imageSource(versions []*ociImageVersion) string {
+	var source string
+	var created time.Time
+	for _, version := range versions {
+		if version.info.Source != "" && (source == "" || version.info.Created.After(created)) {
+			source, created = version.info.Source, version.info.Created
+		}
+	}
+	return source
+}
+
+// normalizeImageSource strips the scheme and .git suffix of a repository URL, so that
+// e.g. https://github.com/stacklok/minder.git and github.com/stacklok/minder match
+func normalizeImageSource(source string) string {
+	source = strings.ToLower(strings.TrimSpace(source))
+	if _, after, ok := strings.Cut(source, "://"); ok {
+		source = after
+	}
+	source = strings.TrimSuffix(source, "/")
+	return strings.TrimSuffix(source, ".git")
+}
+
+func (e *Reconciler) storeOCIImageVersion(
+	ctx context.Context,
+	cli provifv1.OCI,
+	trust *container.TrustRoot,
+	artifactID uuid.UUID,
+	imageRepo string,
+	version *ociImageVersion,
+) (*pb.ArtifactVersion, error) {
+	ref, err := cli.GetReference(imageRepo, version.info.Digest)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing reference: %w", err)
+	}
+
+	sigInfo, workflowInfo, err := container.GetImageSignatureAndWorkflowInfo(ctx, ref, trust, cli.GetRemoteOptions(ctx)...)
+	if errors.Is(err, container.ErrProtoParse) {
+		// log error and just pass an empty json
+		log.Printf("error getting bytes from proto: %v", err)
+	} else if err != nil {
+		return nil, err
+	}
+
+	provenanceInfo, err := container.GetImageProvenance(ctx, ref, trust, cli.GetRemoteOptions(ctx)...)
+	if err != nil {
+		// just log error, the version is stored without provenance
+		log.Printf("error getting provenance: %v", err)
+	}
+
+	newVersion, err := e.store.UpsertArtifactVersion(ctx,
+		db.UpsertArtifactVersionParams{
+			ArtifactID: artifactID,
+			// images of OCI registries have no numeric version IDs, their versions are
+			// identified by digest
+			Version:               0,
+			Tags:                  sql.NullString{Valid: true, String: strings.Join(version.tags, ",")},
+			Sha:                   version.info.Digest,
+			SignatureVerification: sigInfo,
+			GithubWorkflow:        workflowInfo,
+			Provenance:            provenanceInfo,
+			CreatedAt:             version.info.Created,
+		})
+	if err != nil {
+		return nil, fmt.Errorf("error storing artifact version: %w", err)
+	}
+
+	ghWorkflow := &pb.GithubWorkflow{}
+	if err := protojson.Unmarshal(workflowInfo, ghWorkflow); err != nil {
+		return nil, fmt.Errorf("error unmarshalling github workflow: %w", err)
+	}
+
+	sigVerification := &pb.SignatureVerification{}
+	if err := protojson.Unmarshal(sigInfo, sigVerification); err != nil {
+		return nil, fmt.Errorf("error unmarshalling signature verification: %w", err)
+	}
+
+	provenance := &pb.Provenance{}
+	if len(provenanceInfo) > 0 {
+		if err := protojson.Unmarshal(provenanceInfo, provenance); err != nil {
+			return nil, fmt.Errorf("error unmarshalling provenance: %w", err)
+		}
+	}
+
+	return &pb.ArtifactVersion{
+		VersionId:             newVersion.Version,
+		Tags:                  version.tags,
+		Sha:                   version.info.Digest,
+		SignatureVerification: sigVerification,
+		GithubWorkflow:        ghWorkflow,
+		Provenance:            provenance,
+		CreatedAt:             timestamppb.New(version.info.Created),
+	}, nil
+}
@@ -0,0 +1,120 @@
+// Copyright 2023 Stacklok, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reconcilers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/stacklok/minder/internal/container"
+	"github.com/stacklok/minder/internal/db"
+)
+
+func TestOCIReconciliationRepositoryOf(t *testing.T) {
+	t.Parallel()
+
+	minder := db.Repository{RepoOwner: "stacklok", RepoName: "minder", CloneUrl: "https://github.com/stacklok/minder.git"}
+	mediator := db.Repository{RepoOwner: "stacklok", RepoName: "mediator", CloneUrl: "https://github.com/stacklok/mediator.git"}
+	registry := &ociReconciliation{
+		bySource: map[string]db.Repository{
+			normalizeImageSource(minder.CloneUrl):   minder,
+			normalizeImageSource(mediator.CloneUrl): mediator,
+		},
+		byName: map[string]db.Repository{
+			"stacklok/minder":   minder,
+			"stacklok/mediator": mediator,
+		},
+		mapped: map[string]string{
+			"stacklok/mediator-server": "Stacklok/Mediator",
+			"stacklok/archived":        "stacklok/archived",
+		},
+	}
+
+	now := time.Now()
+	versionsFrom := func(sources ...string) []*ociImageVersion {
+		versions := make([]*ociImageVersion, 0, len(sources))
+		for i, source := range sources {
+			versions = append(versions, &ociImageVersion{
+				info: &container.ImageInfo{Source: source, Created: now.Add(time.Duration(i) * time.Hour)},
+			})
+		}
+		return versions
+	}
+
+	testCases := []struct {
+		name      string
+		imageRepo string
+		versions  []*ociImageVersion
+		expected  *db.Repository
+	}{
+		{
+			name:      "source label",
+			imageRepo: "stacklok/minder-server",
+			versions:  versionsFrom("github.com/Stacklok/Minder/"),
+			expected:  &minder,
+		},
+		{
+			name:      "most recent source label",
+			imageRepo: "stacklok/minder-server",
+			versions:  versionsFrom("https://github.com/stacklok/mediator", "", "https://github.com/stacklok/minder"),
+			expected:  &minder,
+		},
+		{
+			name:      "mapping takes precedence over the source label",
+			imageRepo: "Stacklok/Mediator-Server",
+			versions:  versionsFrom("https://github.com/stacklok/minder"),
+			expected:  &mediator,
+		},
+		{
+			name:      "mapping without source label",
+			imageRepo: "stacklok/mediator-server",
+			versions:  versionsFrom(""),
+			expected:  &mediator,
+		},
+		{
+			name:      "mapped to a repository that is not registered",
+			imageRepo: "stacklok/archived",
+			versions:  versionsFrom("https://github.com/stacklok/minder"),
+		},
+		{
+			name:      "no source label",
+			imageRepo: "stacklok/minder-server",
+			versions:  versionsFrom("", ""),
+		},
+		{
+			name:      "source of a repository that is not registered",
+			imageRepo: "stacklok/frizbee",
+			versions:  versionsFrom("https://github.com/stacklok/frizbee"),
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			repository, ok := registry.repositoryOf(tc.imageRepo, tc.versions)
+			if tc.expected == nil {
+				assert.False(t, ok)
+				return
+			}
+			require.True(t, ok)
+			assert.Equal(t, *tc.expected, repository)
+		})
+	}
+}
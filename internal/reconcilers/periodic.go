@@ -0,0 +1,81 @@
+// Copyright 2023 Stacklok, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reconcilers
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RunArtifactReconciliation periodically publishes an artifacts reconciler event for every
+// registered repository, so that deleted and untagged versions are removed and the retention
+// policies are applied even if no webhook is received. It returns when the context is done,
+// and right away if the interval is not positive.
+func (e *Reconciler) RunArtifactReconciliation(ctx context.Context, interval time.Duration) error {
+	if interval <= 0 {
+		log.Printf("periodic artifact reconciliation is disabled")
+		return nil
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if err := e.publishArtifactReconcilerEvents(ctx); err != nil {
+				// just log error, the next tick will try again
+				log.Printf("error reconciling artifacts: %v", err)
+			}
+		}
+	}
+}
+
+// publishArtifactReconcilerEvents publishes an artifacts reconciler event for every
+// registered repository, and an OCI artifacts reconciler event for every OCI registry
+// provider of their projects
+func (e *Reconciler) publishArtifactReconcilerEvents(ctx context.Context) error {
+	repos, err := e.store.ListAllRegisteredRepositories(ctx)
+	if err != nil {
+		return fmt.Errorf("error listing repositories: %w", err)
+	}
+
+	projects := make(map[uuid.UUID]bool)
+	for _, repo := range repos {
+		if !projects[repo.ProjectID] {
+			projects[repo.ProjectID] = true
+			if err := e.publishOCIArtifactsReconcilerEvents(ctx, repo.ProjectID); err != nil {
+				log.Printf("error publishing OCI artifacts reconciler events for project %s: %v", repo.ProjectID, err)
+			}
+		}
+
+		msg, err := NewRepoReconcilerMessage(repo.Provider, repo.RepoID, repo.ProjectID)
+		if err != nil {
+			return err
+		}
+
+		if err := e.evt.Publish(InternalArtifactsReconcilerEventTopic, msg); err != nil {
+			log.Printf("error publishing artifacts reconciler event for repository %d: %v", repo.RepoID, err)
+		}
+	}
+
+	return nil
+}
@@ -29,6 +29,12 @@ const (
 	InternalReconcilerEventTopic = "internal.repo.reconciler.event"
 	// InternalProfileInitEventTopic is the topic for internal init events
 	InternalProfileInitEventTopic = "internal.profile.init.event"
+	// InternalArtifactsReconcilerEventTopic is the topic for the periodic reconciliation
+	// of the artifacts of a repository
+	InternalArtifactsReconcilerEventTopic = "internal.artifacts.reconciler.event"
+	// InternalOCIArtifactsReconcilerEventTopic is the topic for the reconciliation of the
+	// images of an OCI registry provider
+	InternalOCIArtifactsReconcilerEventTopic = "internal.oci.artifacts.reconciler.event"
 )
 
 // Reconciler is a helper that reconciles entities
@@ -79,4 +85,6 @@ func NewReconciler(
 func (e *Reconciler) Register(r events.Registrar) {
 	r.Register(InternalReconcilerEventTopic, e.handleRepoReconcilerEvent)
 	r.Register(InternalProfileInitEventTopic, e.handleProfileInitEvent)
+	r.Register(InternalArtifactsReconcilerEventTopic, e.handleArtifactsOnlyReconcilerEvent)
+	r.Register(InternalOCIArtifactsReconcilerEventTopic, e.handleOCIArtifactsReconcilerEvent)
 }
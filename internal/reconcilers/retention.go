@@ -0,0 +1,135 @@
+// Copyright 2023 Stacklok, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reconcilers
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/stacklok/minder/internal/db"
+	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+// defaultArtifactMaxAgeDays is how long the artifact versions of projects without
+// a retention policy are kept
+const defaultArtifactMaxAgeDays = 30
+
+// ArtifactRetention is the retention policy of the artifact versions of a project.
+// A version is removed if it is older than MaxAgeDays and not one of the KeepLast
+// most recent versions of its artifact. Zero means no limit.
+type ArtifactRetention struct {
+	KeepLast   int32
+	MaxAgeDays int32
+}
+
+// DefaultArtifactRetention is the retention policy of projects without one
+var DefaultArtifactRetention = ArtifactRetention{MaxAgeDays: defaultArtifactMaxAgeDays}
+
+// GetArtifactRetention returns the retention policy of the artifact versions of a project
+func GetArtifactRetention(ctx context.Context, store db.Querier, projectID uuid.UUID) (ArtifactRetention, error) {
+	policy, err := store.GetArtifactRetentionPolicy(ctx, projectID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return DefaultArtifactRetention, nil
+	} else if err != nil {
+		return ArtifactRetention{}, fmt.Errorf("error getting artifact retention policy: %w", err)
+	}
+
+	return ArtifactRetention{KeepLast: policy.KeepLast.Int32, MaxAgeDays: policy.MaxAgeDays.Int32}, nil
+}
+
+// ArtifactRetentionFromPb returns the retention policy of the protobuf message
+func ArtifactRetentionFromPb(policy *pb.ArtifactRetentionPolicy) (ArtifactRetention, error) {
+	if policy.GetKeepLast() < 0 || policy.GetMaxAgeDays() < 0 {
+		return ArtifactRetention{}, errors.New("retention limits can't be negative")
+	}
+	return ArtifactRetention{KeepLast: policy.GetKeepLast(), MaxAgeDays: policy.GetMaxAgeDays()}, nil
+}
+
+// ToPb returns the protobuf message of the retention policy
+func (r ArtifactRetention) ToPb() *pb.ArtifactRetentionPolicy {
+	return &pb.ArtifactRetentionPolicy{KeepLast: r.KeepLast, MaxAgeDays: r.MaxAgeDays}
+}
+
+// cutoff returns the time before which versions are too old, or the zero time if
+// the age of versions is not limited
+func (r ArtifactRetention) cutoff() time.Time {
+	if r.MaxAgeDays <= 0 {
+		return time.Time{}
+	}
+	return time.Now().AddDate(0, 0, -int(r.MaxAgeDays))
+}
+
+// retained filters the creation times of the versions of an artifact down to the ones the
+// policy keeps, returning the indexes of the retained versions
+func (r ArtifactRetention) retained(createdAt []time.Time) map[int]bool {
+	order := make([]int, len(createdAt))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		return createdAt[order[a]].After(createdAt[order[b]])
+	})
+
+	cutoff := r.cutoff()
+	kept := make(map[int]bool, len(createdAt))
+	for rank, i := range order {
+		switch {
+		case r.KeepLast > 0 && rank < int(r.KeepLast):
+			kept[i] = true
+		case !cutoff.IsZero():
+			kept[i] = createdAt[i].After(cutoff)
+		default:
+			// without an age limit, the versions beyond the most recent ones are removed
+			kept[i] = r.KeepLast <= 0
+		}
+	}
+
+	return kept
+}
+
+// pruneParams returns the arguments of the queries of the versions the policy doesn't keep
+func (r ArtifactRetention) pruneParams(artifactID uuid.UUID) db.ListPrunableArtifactVersionsParams {
+	params := db.ListPrunableArtifactVersionsParams{ArtifactID: artifactID}
+	if cutoff := r.cutoff(); !cutoff.IsZero() {
+		params.CreatedBefore = sql.NullTime{Valid: true, Time: cutoff}
+	}
+	if r.KeepLast > 0 {
+		params.KeepLast = sql.NullInt32{Valid: true, Int32: r.KeepLast}
+	}
+	return params
+}
+
+// PruneArtifactVersions removes the versions of an artifact the retention policy doesn't
+// keep and returns them. On a dry run the versions are only listed.
+func PruneArtifactVersions(
+	ctx context.Context,
+	store db.Querier,
+	artifactID uuid.UUID,
+	retention ArtifactRetention,
+	dryRun bool,
+) ([]db.ArtifactVersion, error) {
+	params := retention.pruneParams(artifactID)
+	if dryRun {
+		return store.ListPrunableArtifactVersions(ctx, params)
+	}
+
+	return store.DeletePrunableArtifactVersions(ctx, db.DeletePrunableArtifactVersionsParams(params))
+}
@@ -0,0 +1,90 @@
+// Copyright 2023 Stacklok, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reconcilers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestArtifactRetentionRetained(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	daysAgo := func(days int) time.Time { return now.AddDate(0, 0, -days) }
+	// not sorted, as they are listed by the providers
+	createdAt := []time.Time{daysAgo(40), daysAgo(1), daysAgo(60), daysAgo(10)}
+
+	testCases := []struct {
+		name      string
+		retention ArtifactRetention
+		expected  map[int]bool
+	}{
+		{
+			name:      "max age",
+			retention: ArtifactRetention{MaxAgeDays: 30},
+			expected:  map[int]bool{0: false, 1: true, 2: false, 3: true},
+		},
+		{
+			name:      "keep last",
+			retention: ArtifactRetention{KeepLast: 3},
+			expected:  map[int]bool{0: true, 1: true, 2: false, 3: true},
+		},
+		{
+			name:      "keep last beyond max age",
+			retention: ArtifactRetention{KeepLast: 1, MaxAgeDays: 30},
+			expected:  map[int]bool{0: false, 1: true, 2: false, 3: true},
+		},
+		{
+			name:      "recent versions beyond keep last",
+			retention: ArtifactRetention{KeepLast: 3, MaxAgeDays: 5},
+			expected:  map[int]bool{0: true, 1: true, 2: false, 3: true},
+		},
+		{
+			name:      "no limits",
+			retention: ArtifactRetention{},
+			expected:  map[int]bool{0: true, 1: true, 2: true, 3: true},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.expected, tc.retention.retained(createdAt))
+		})
+	}
+}
+
+func TestArtifactRetentionPruneParams(t *testing.T) {
+	t.Parallel()
+
+	artifactID := uuid.New()
+
+	params := ArtifactRetention{}.pruneParams(artifactID)
+	assert.Equal(t, artifactID, params.ArtifactID)
+	assert.False(t, params.CreatedBefore.Valid)
+	assert.False(t, params.KeepLast.Valid, "nothing is pruned without limits")
+
+	params = ArtifactRetention{KeepLast: 5, MaxAgeDays: 7}.pruneParams(artifactID)
+	assert.True(t, params.KeepLast.Valid)
+	assert.Equal(t, int32(5), params.KeepLast.Int32)
+	assert.True(t, params.CreatedBefore.Valid)
+	assert.WithinDuration(t, time.Now().AddDate(0, 0, -7), params.CreatedBefore.Time, time.Minute)
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/go-playground/validator/v10"
 	"github.com/google/uuid"
 	"github.com/rs/zerolog"
+	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/stacklok/minder/internal/db"
@@ -134,14 +135,15 @@ func (s *Reconciler) publishProfileInitEvents(
 	for _, dbrepo := range dbrepos {
 		// protobufs are our API, so we always execute on these instead of the DB directly.
 		repo := &pb.Repository{
-			Owner:     dbrepo.RepoOwner,
-			Name:      dbrepo.RepoName,
-			RepoId:    dbrepo.RepoID,
-			HookUrl:   dbrepo.WebhookUrl,
-			DeployUrl: dbrepo.DeployUrl,
-			CloneUrl:  dbrepo.CloneUrl,
-			CreatedAt: timestamppb.New(dbrepo.CreatedAt),
-			UpdatedAt: timestamppb.New(dbrepo.UpdatedAt),
+			Owner:         dbrepo.RepoOwner,
+			Name:          dbrepo.RepoName,
+			RepoId:        dbrepo.RepoID,
+			HookUrl:       dbrepo.WebhookUrl,
+			DeployUrl:     dbrepo.DeployUrl,
+			CloneUrl:      dbrepo.CloneUrl,
+			DefaultBranch: dbrepo.DefaultBranch,
+			CreatedAt:     timestamppb.New(dbrepo.CreatedAt),
+			UpdatedAt:     timestamppb.New(dbrepo.UpdatedAt),
 		}
 
 		err := engine.NewEntityInfoWrapper().
@@ -159,6 +161,7 @@ func (s *Reconciler) publishProfileInitEvents(
 	}
 
 	// after we've initialized repository profiles, let's initialize artifacts
+	// and build environments
 	// TODO(jakub): this should be done in an iterator of sorts
 	for i := range dbrepos {
 		pdb := &dbrepos[i]
@@ -166,6 +169,11 @@ func (s *Reconciler) publishProfileInitEvents(
 		if err != nil {
 			return fmt.Errorf("publishProfileInitEvents: error publishing artifact events: %v", err)
 		}
+
+		err = s.publishBuildEnvironmentProfileInitEvents(ctx, ectx, pdb)
+		if err != nil {
+			return fmt.Errorf("publishProfileInitEvents: error publishing build environment events: %v", err)
+		}
 	}
 
 	return nil
@@ -191,8 +199,14 @@ func (s *Reconciler) publishArtifactProfileInitEvents(
 			return fmt.Errorf("error getting artifact versions: %w", err)
 		}
 
+		// images of OCI registries are evaluated under the registry provider
+		providerName := ectx.Provider.Name
+		if dbA.Provider != "" {
+			providerName = dbA.Provider
+		}
+
 		err = engine.NewEntityInfoWrapper().
-			WithProvider(ectx.Provider.Name).
+			WithProvider(providerName).
 			WithProjectID(ectx.Project.ID).
 			WithArtifact(pbArtifact).
 			WithRepositoryID(dbrepo.ID).
@@ -208,3 +222,40 @@ func (s *Reconciler) publishArtifactProfileInitEvents(
 	}
 	return nil
 }
+
+func (s *Reconciler) publishBuildEnvironmentProfileInitEvents(
+	ctx context.Context,
+	ectx *engine.EntityContext,
+	dbrepo *db.Repository,
+) error {
+	dbEnvs, err := s.store.ListBuildEnvironmentsByRepositoryID(ctx, dbrepo.ID)
+	if err != nil {
+		return fmt.Errorf("error getting build environments: %w", err)
+	}
+	for _, dbEnv := range dbEnvs {
+		// the properties hold the build environment as last read from the provider
+		pbEnv := &pb.BuildEnvironment{}
+		if err := protojson.Unmarshal(dbEnv.Properties, pbEnv); err != nil {
+			log.Printf("error unmarshalling build environment %s: %v", dbEnv.ID, err)
+			continue
+		}
+		id := dbEnv.ID.String()
+		pbEnv.Id = &id
+
+		err = engine.NewEntityInfoWrapper().
+			WithProvider(ectx.Provider.Name).
+			WithProjectID(ectx.Project.ID).
+			WithBuildEnvironment(pbEnv).
+			WithRepositoryID(dbrepo.ID).
+			WithBuildEnvironmentID(dbEnv.ID).
+			Publish(s.evt)
+
+		// This is a non-fatal error, so we'll just log it
+		// and continue
+		if err != nil {
+			log.Printf("error publishing init event for build environment %s: %v", dbEnv.ID, err)
+			continue
+		}
+	}
+	return nil
+}
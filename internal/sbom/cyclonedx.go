@@ -0,0 +1,99 @@
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package sbom provides the generation of software bills of materials from the
+// dependencies minder extracts out of a repository.
+package sbom
+
+import (
+	"time"
+)
+
+const (
+	cycloneDXSpecVersion = "1.5"
+	// cycloneDXFileProperty records which dependency file a component was found in
+	cycloneDXFileProperty = "minder:dependency_file"
+	toolName              = "minder"
+)
+
+// the subset of the CycloneDX 1.5 JSON schema minder emits
+type cycloneDXDocument struct {
+	BOMFormat    string               `json:"bomFormat"`
+	SpecVersion  string               `json:"specVersion"`
+	SerialNumber string               `json:"serialNumber"`
+	Version      int                  `json:"version"`
+	Metadata     cycloneDXMetadata    `json:"metadata"`
+	Components   []cycloneDXComponent `json:"components"`
+}
+
+type cycloneDXMetadata struct {
+	Timestamp string             `json:"timestamp"`
+	Tools     cycloneDXTools     `json:"tools"`
+	Component cycloneDXComponent `json:"component"`
+}
+
+type cycloneDXTools struct {
+	Components []cycloneDXComponent `json:"components"`
+}
+
+type cycloneDXComponent struct {
+	BOMRef     string              `json:"bom-ref,omitempty"`
+	Type       string              `json:"type"`
+	Name       string              `json:"name"`
+	Version    string              `json:"version,omitempty"`
+	PURL       string              `json:"purl,omitempty"`
+	Properties []cycloneDXProperty `json:"properties,omitempty"`
+}
+
+type cycloneDXProperty struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+func newCycloneDXDocument(subject string, components []*Component, now time.Time, serial string) *cycloneDXDocument {
+	doc := &cycloneDXDocument{
+		BOMFormat:    "CycloneDX",
+		SpecVersion:  cycloneDXSpecVersion,
+		SerialNumber: "urn:uuid:" + serial,
+		Version:      1,
+		Metadata: cycloneDXMetadata{
+			Timestamp: now.Format(time.RFC3339),
+			Tools: cycloneDXTools{
+				Components: []cycloneDXComponent{{Type: "application", Name: toolName}},
+			},
+			Component: cycloneDXComponent{
+				BOMRef: subject,
+				Type:   "application",
+				Name:   subject,
+			},
+		},
+		Components: make([]cycloneDXComponent, 0, len(components)),
+	}
+
+	for _, c := range components {
+		cdxc := cycloneDXComponent{
+			BOMRef:  c.PURL,
+			Type:    "library",
+			Name:    c.Name,
+			Version: c.Version,
+			PURL:    c.PURL,
+		}
+		for _, f := range c.Files {
+			cdxc.Properties = append(cdxc.Properties, cycloneDXProperty{Name: cycloneDXFileProperty, Value: f})
+		}
+		doc.Components = append(doc.Components, cdxc)
+	}
+
+	return doc
+}
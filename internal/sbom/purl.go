@@ -0,0 +1,77 @@
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package sbom provides the generation of software bills of materials from the
+// dependencies minder extracts out of a repository.
+package sbom
+
+import (
+	"net/url"
+	"strings"
+
+	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+// PackageURL returns the package URL (https://github.com/package-url/purl-spec)
+// identifying the dependency.
+func PackageURL(dep *pb.Dependency) string {
+	var purlType, namespace, name string
+
+	switch dep.Ecosystem {
+	case pb.DepEcosystem_DEP_ECOSYSTEM_NPM:
+		purlType = "npm"
+		// scoped packages use the scope as the namespace
+		if strings.HasPrefix(dep.Name, "@") {
+			namespace, name, _ = strings.Cut(dep.Name, "/")
+		} else {
+			name = dep.Name
+		}
+	case pb.DepEcosystem_DEP_ECOSYSTEM_GO:
+		purlType = "golang"
+		if i := strings.LastIndex(dep.Name, "/"); i >= 0 {
+			namespace, name = dep.Name[:i], dep.Name[i+1:]
+		} else {
+			name = dep.Name
+		}
+	case pb.DepEcosystem_DEP_ECOSYSTEM_PYPI:
+		purlType = "pypi"
+		// the purl spec mandates normalized pypi names
+		name = strings.ReplaceAll(strings.ToLower(dep.Name), "_", "-")
+	default:
+		purlType = "generic"
+		name = dep.Name
+	}
+
+	var sb strings.Builder
+	sb.WriteString("pkg:")
+	sb.WriteString(purlType)
+	sb.WriteString("/")
+	if namespace != "" {
+		for _, segment := range strings.Split(namespace, "/") {
+			sb.WriteString(escapePurlSegment(segment))
+			sb.WriteString("/")
+		}
+	}
+	sb.WriteString(escapePurlSegment(name))
+	if dep.Version != "" {
+		sb.WriteString("@")
+		sb.WriteString(escapePurlSegment(dep.Version))
+	}
+	return sb.String()
+}
+
+func escapePurlSegment(s string) string {
+	// PathEscape leaves '@' alone, but it is a separator in package URLs
+	return strings.ReplaceAll(url.PathEscape(s), "@", "%40")
+}
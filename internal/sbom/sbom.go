@@ -0,0 +1,194 @@
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package sbom provides the generation of software bills of materials from the
+// dependencies minder extracts out of a repository.
+package sbom
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	billy "github.com/go-git/go-billy/v5"
+	"github.com/google/uuid"
+
+	"github.com/stacklok/minder/internal/engine/ingester/diff"
+	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+// Format is the format of an SBOM document
+type Format string
+
+const (
+	// FormatCycloneDX is the CycloneDX 1.5 JSON format
+	FormatCycloneDX Format = "cyclonedx"
+	// FormatSPDX is the SPDX 2.3 JSON format
+	FormatSPDX Format = "spdx"
+)
+
+const (
+	// PredicateTypeCycloneDX is the in-toto predicate type of CycloneDX attestations
+	PredicateTypeCycloneDX = "https://cyclonedx.org/bom"
+	// PredicateTypeSPDX is the in-toto predicate type of SPDX attestations
+	PredicateTypeSPDX = "https://spdx.dev/Document"
+)
+
+// DefaultEcosystems are the dependency files parsed when no ecosystems are
+// configured explicitly.
+var DefaultEcosystems = []*pb.DiffType_Ecosystem{
+	{Name: string(diff.DepEcosystemNPM), Depfile: "package-lock.json"},
+	{Name: string(diff.DepEcosystemGo), Depfile: "go.sum"},
+	{Name: string(diff.DepEcosystemPyPI), Depfile: "requirements.txt"},
+}
+
+// ParseFormat validates the format string, an empty string returns the
+// default CycloneDX format.
+func ParseFormat(format string) (Format, error) {
+	switch f := Format(strings.ToLower(format)); f {
+	case "":
+		return FormatCycloneDX, nil
+	case FormatCycloneDX, FormatSPDX:
+		return f, nil
+	default:
+		return "", fmt.Errorf("unsupported SBOM format %q", format)
+	}
+}
+
+// PredicateTypes returns the attestation predicate types of the format
+func (f Format) PredicateTypes() []string {
+	switch f {
+	case FormatCycloneDX:
+		return []string{PredicateTypeCycloneDX}
+	case FormatSPDX:
+		return []string{PredicateTypeSPDX}
+	default:
+		return nil
+	}
+}
+
+// Component is a single package listed in the SBOM
+type Component struct {
+	Ecosystem pb.DepEcosystem
+	Name      string
+	Version   string
+	PURL      string
+	// Files are the dependency files the component was found in
+	Files []string
+}
+
+// ComponentsFromFilesystem parses the dependency files of the given
+// ecosystems found in fs and returns the components, deduplicated and sorted
+// by package URL.
+func ComponentsFromFilesystem(fs billy.Filesystem, ecosystems []*pb.DiffType_Ecosystem) ([]*Component, error) {
+	if len(ecosystems) == 0 {
+		ecosystems = DefaultEcosystems
+	}
+
+	deps, err := diff.ParseFilesystem(fs, ecosystems)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing dependency files: %w", err)
+	}
+
+	return ComponentsFromDependencies(deps), nil
+}
+
+// ComponentsFromDependencies converts the dependencies into components,
+// deduplicated and sorted by package URL.
+func ComponentsFromDependencies(deps []*pb.PrDependencies_ContextualDependency) []*Component {
+	byPurl := make(map[string]*Component, len(deps))
+	for _, d := range deps {
+		dep := d.GetDep()
+		if dep == nil || dep.Name == "" {
+			continue
+		}
+
+		purl := PackageURL(dep)
+		c, ok := byPurl[purl]
+		if !ok {
+			c = &Component{
+				Ecosystem: dep.Ecosystem,
+				Name:      dep.Name,
+				Version:   dep.Version,
+				PURL:      purl,
+			}
+			byPurl[purl] = c
+		}
+		if f := d.GetFile().GetName(); f != "" && !contains(c.Files, f) {
+			c.Files = append(c.Files, f)
+		}
+	}
+
+	components := make([]*Component, 0, len(byPurl))
+	for _, c := range byPurl {
+		sort.Strings(c.Files)
+		components = append(components, c)
+	}
+	sort.Slice(components, func(i, j int) bool {
+		return components[i].PURL < components[j].PURL
+	})
+	return components
+}
+
+// Generate renders the components into a JSON encoded SBOM of the given format.
+// subject is the name of the software the SBOM describes, typically the
+// repository's owner/name.
+func Generate(format Format, subject string, components []*Component) ([]byte, error) {
+	return generate(format, subject, components, time.Now().UTC(), newSerial())
+}
+
+// GenerateObject is like Generate but returns the document as a generic map,
+// which is what the rule evaluators consume.
+func GenerateObject(format Format, subject string, components []*Component) (map[string]any, error) {
+	doc, err := Generate(format, subject, components)
+	if err != nil {
+		return nil, err
+	}
+
+	var obj map[string]any
+	if err := json.Unmarshal(doc, &obj); err != nil {
+		return nil, fmt.Errorf("error decoding SBOM: %w", err)
+	}
+	return obj, nil
+}
+
+func generate(format Format, subject string, components []*Component, now time.Time, serial string) ([]byte, error) {
+	var doc any
+	switch format {
+	case FormatCycloneDX:
+		doc = newCycloneDXDocument(subject, components, now, serial)
+	case FormatSPDX:
+		doc = newSPDXDocument(subject, components, now, serial)
+	default:
+		return nil, fmt.Errorf("unsupported SBOM format %q", format)
+	}
+
+	return json.MarshalIndent(doc, "", "  ")
+}
+
+// newSerial returns a unique identifier for a generated document
+func newSerial() string {
+	return uuid.New().String()
+}
+
+func contains(list []string, s string) bool {
+	for _, e := range list {
+		if e == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,169 @@
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sbom
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+func TestPackageURL(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		dep      *pb.Dependency
+		expected string
+	}{
+		{
+			name:     "npm",
+			dep:      &pb.Dependency{Ecosystem: pb.DepEcosystem_DEP_ECOSYSTEM_NPM, Name: "lodash", Version: "4.17.21"},
+			expected: "pkg:npm/lodash@4.17.21",
+		},
+		{
+			name:     "scoped npm",
+			dep:      &pb.Dependency{Ecosystem: pb.DepEcosystem_DEP_ECOSYSTEM_NPM, Name: "@types/node", Version: "20.8.0"},
+			expected: "pkg:npm/%40types/node@20.8.0",
+		},
+		{
+			name:     "go",
+			dep:      &pb.Dependency{Ecosystem: pb.DepEcosystem_DEP_ECOSYSTEM_GO, Name: "github.com/google/uuid", Version: "v1.4.0"},
+			expected: "pkg:golang/github.com/google/uuid@v1.4.0",
+		},
+		{
+			name:     "pypi is normalized",
+			dep:      &pb.Dependency{Ecosystem: pb.DepEcosystem_DEP_ECOSYSTEM_PYPI, Name: "Typing_Extensions", Version: "4.8.0"},
+			expected: "pkg:pypi/typing-extensions@4.8.0",
+		},
+		{
+			name:     "no version",
+			dep:      &pb.Dependency{Ecosystem: pb.DepEcosystem_DEP_ECOSYSTEM_PYPI, Name: "requests"},
+			expected: "pkg:pypi/requests",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expected, PackageURL(tt.dep))
+		})
+	}
+}
+
+func TestComponentsFromDependencies(t *testing.T) {
+	t.Parallel()
+
+	uuidDep := &pb.Dependency{Ecosystem: pb.DepEcosystem_DEP_ECOSYSTEM_GO, Name: "github.com/google/uuid", Version: "v1.4.0"}
+	components := ComponentsFromDependencies([]*pb.PrDependencies_ContextualDependency{
+		{Dep: uuidDep, File: &pb.PrDependencies_ContextualDependency_FilePatch{Name: "tools/go.sum"}},
+		{Dep: uuidDep, File: &pb.PrDependencies_ContextualDependency_FilePatch{Name: "go.sum"}},
+		{Dep: &pb.Dependency{Ecosystem: pb.DepEcosystem_DEP_ECOSYSTEM_NPM, Name: "lodash", Version: "4.17.21"}},
+	})
+
+	require.Len(t, components, 2)
+	assert.Equal(t, "pkg:golang/github.com/google/uuid@v1.4.0", components[0].PURL)
+	assert.Equal(t, []string{"go.sum", "tools/go.sum"}, components[0].Files)
+	assert.Equal(t, "pkg:npm/lodash@4.17.21", components[1].PURL)
+	assert.Empty(t, components[1].Files)
+}
+
+func TestGenerate(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2023, 11, 20, 10, 0, 0, 0, time.UTC)
+	components := []*Component{
+		{
+			Ecosystem: pb.DepEcosystem_DEP_ECOSYSTEM_NPM,
+			Name:      "lodash",
+			Version:   "4.17.21",
+			PURL:      "pkg:npm/lodash@4.17.21",
+			Files:     []string{"package-lock.json"},
+		},
+	}
+
+	t.Run("cyclonedx", func(t *testing.T) {
+		t.Parallel()
+
+		out, err := generate(FormatCycloneDX, "stacklok/minder", components, now, "serial")
+		require.NoError(t, err)
+
+		var doc map[string]any
+		require.NoError(t, json.Unmarshal(out, &doc))
+		assert.Equal(t, "CycloneDX", doc["bomFormat"])
+		assert.Equal(t, "1.5", doc["specVersion"])
+		assert.Equal(t, "urn:uuid:serial", doc["serialNumber"])
+		assert.Equal(t, "2023-11-20T10:00:00Z", doc["metadata"].(map[string]any)["timestamp"])
+
+		comps := doc["components"].([]any)
+		require.Len(t, comps, 1)
+		comp := comps[0].(map[string]any)
+		assert.Equal(t, "library", comp["type"])
+		assert.Equal(t, "lodash", comp["name"])
+		assert.Equal(t, "pkg:npm/lodash@4.17.21", comp["purl"])
+	})
+
+	t.Run("spdx", func(t *testing.T) {
+		t.Parallel()
+
+		out, err := generate(FormatSPDX, "stacklok/minder", components, now, "serial")
+		require.NoError(t, err)
+
+		var doc map[string]any
+		require.NoError(t, json.Unmarshal(out, &doc))
+		assert.Equal(t, "SPDX-2.3", doc["spdxVersion"])
+		assert.Equal(t, "https://stacklok.com/minder/spdx/serial", doc["documentNamespace"])
+
+		pkgs := doc["packages"].([]any)
+		require.Len(t, pkgs, 2, "expected the root package and one dependency")
+		dep := pkgs[1].(map[string]any)
+		assert.Equal(t, "lodash", dep["name"])
+		assert.Equal(t, "4.17.21", dep["versionInfo"])
+		ref := dep["externalRefs"].([]any)[0].(map[string]any)
+		assert.Equal(t, "pkg:npm/lodash@4.17.21", ref["referenceLocator"])
+
+		rels := doc["relationships"].([]any)
+		require.Len(t, rels, 2)
+		assert.Equal(t, "DEPENDS_ON", rels[1].(map[string]any)["relationshipType"])
+	})
+
+	t.Run("unknown format", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := generate(Format("swid"), "stacklok/minder", components, now, "serial")
+		require.Error(t, err)
+	})
+}
+
+func TestParseFormat(t *testing.T) {
+	t.Parallel()
+
+	f, err := ParseFormat("")
+	require.NoError(t, err)
+	assert.Equal(t, FormatCycloneDX, f)
+
+	f, err = ParseFormat("SPDX")
+	require.NoError(t, err)
+	assert.Equal(t, FormatSPDX, f)
+
+	_, err = ParseFormat("swid")
+	require.Error(t, err)
+}
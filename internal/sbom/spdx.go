@@ -0,0 +1,120 @@
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package sbom provides the generation of software bills of materials from the
+// dependencies minder extracts out of a repository.
+package sbom
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	spdxVersion          = "SPDX-2.3"
+	spdxDocumentID       = "SPDXRef-DOCUMENT"
+	spdxRootPackageID    = "SPDXRef-Package-root"
+	spdxNoAssertion      = "NOASSERTION"
+	spdxNamespacePrefix  = "https://stacklok.com/minder/spdx/"
+	spdxRelDescribes     = "DESCRIBES"
+	spdxRelDependsOn     = "DEPENDS_ON"
+	spdxRefCategory      = "PACKAGE-MANAGER"
+	spdxRefTypePURL      = "purl"
+	spdxDataLicense      = "CC0-1.0"
+	spdxCreatorToolValue = "Tool: " + toolName
+)
+
+// the subset of the SPDX 2.3 JSON schema minder emits
+type spdxDocument struct {
+	SPDXVersion       string             `json:"spdxVersion"`
+	DataLicense       string             `json:"dataLicense"`
+	SPDXID            string             `json:"SPDXID"`
+	Name              string             `json:"name"`
+	DocumentNamespace string             `json:"documentNamespace"`
+	CreationInfo      spdxCreationInfo   `json:"creationInfo"`
+	Packages          []spdxPackage      `json:"packages"`
+	Relationships     []spdxRelationship `json:"relationships"`
+}
+
+type spdxCreationInfo struct {
+	Created  string   `json:"created"`
+	Creators []string `json:"creators"`
+}
+
+type spdxPackage struct {
+	SPDXID           string            `json:"SPDXID"`
+	Name             string            `json:"name"`
+	VersionInfo      string            `json:"versionInfo,omitempty"`
+	DownloadLocation string            `json:"downloadLocation"`
+	FilesAnalyzed    bool              `json:"filesAnalyzed"`
+	ExternalRefs     []spdxExternalRef `json:"externalRefs,omitempty"`
+}
+
+type spdxExternalRef struct {
+	ReferenceCategory string `json:"referenceCategory"`
+	ReferenceType     string `json:"referenceType"`
+	ReferenceLocator  string `json:"referenceLocator"`
+}
+
+type spdxRelationship struct {
+	SPDXElementID      string `json:"spdxElementId"`
+	RelationshipType   string `json:"relationshipType"`
+	RelatedSPDXElement string `json:"relatedSpdxElement"`
+}
+
+func newSPDXDocument(subject string, components []*Component, now time.Time, serial string) *spdxDocument {
+	doc := &spdxDocument{
+		SPDXVersion:       spdxVersion,
+		DataLicense:       spdxDataLicense,
+		SPDXID:            spdxDocumentID,
+		Name:              subject,
+		DocumentNamespace: spdxNamespacePrefix + serial,
+		CreationInfo: spdxCreationInfo{
+			Created:  now.Format(time.RFC3339),
+			Creators: []string{spdxCreatorToolValue},
+		},
+		Packages: []spdxPackage{{
+			SPDXID:           spdxRootPackageID,
+			Name:             subject,
+			DownloadLocation: spdxNoAssertion,
+		}},
+		Relationships: []spdxRelationship{{
+			SPDXElementID:      spdxDocumentID,
+			RelationshipType:   spdxRelDescribes,
+			RelatedSPDXElement: spdxRootPackageID,
+		}},
+	}
+
+	for i, c := range components {
+		id := fmt.Sprintf("SPDXRef-Package-%d", i+1)
+		doc.Packages = append(doc.Packages, spdxPackage{
+			SPDXID:           id,
+			Name:             c.Name,
+			VersionInfo:      c.Version,
+			DownloadLocation: spdxNoAssertion,
+			ExternalRefs: []spdxExternalRef{{
+				ReferenceCategory: spdxRefCategory,
+				ReferenceType:     spdxRefTypePURL,
+				ReferenceLocator:  c.PURL,
+			}},
+		})
+		doc.Relationships = append(doc.Relationships, spdxRelationship{
+			SPDXElementID:      spdxRootPackageID,
+			RelationshipType:   spdxRelDependsOn,
+			RelatedSPDXElement: id,
+		})
+	}
+
+	return doc
+}
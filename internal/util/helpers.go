@@ -535,15 +535,16 @@ func GetRepository(ctx context.Context, store db.ExtendQuerier, repoID uuid.UUID
 
 	strRepoID := repoID.String()
 	return &minderv1.Repository{
-		Id:        &strRepoID,
-		Owner:     dbrepo.RepoOwner,
-		Name:      dbrepo.RepoName,
-		RepoId:    dbrepo.RepoID,
-		HookUrl:   dbrepo.WebhookUrl,
-		DeployUrl: dbrepo.DeployUrl,
-		CloneUrl:  dbrepo.CloneUrl,
-		CreatedAt: timestamppb.New(dbrepo.CreatedAt),
-		UpdatedAt: timestamppb.New(dbrepo.UpdatedAt),
+		Id:            &strRepoID,
+		Owner:         dbrepo.RepoOwner,
+		Name:          dbrepo.RepoName,
+		RepoId:        dbrepo.RepoID,
+		HookUrl:       dbrepo.WebhookUrl,
+		DeployUrl:     dbrepo.DeployUrl,
+		CloneUrl:      dbrepo.CloneUrl,
+		DefaultBranch: dbrepo.DefaultBranch,
+		CreatedAt:     timestamppb.New(dbrepo.CreatedAt),
+		UpdatedAt:     timestamppb.New(dbrepo.UpdatedAt),
 	}, nil
 }
 
@@ -596,12 +597,20 @@ func GetArtifactWithVersions(
 				continue
 			}
 		}
+		provenance := &minderv1.Provenance{}
+		if dbVersion.Provenance.Valid {
+			if err := protojson.Unmarshal(dbVersion.Provenance.RawMessage, provenance); err != nil {
+				log.Printf("error unmarshalling provenance: %v", err)
+				continue
+			}
+		}
 		listArtifactVersions = append(listArtifactVersions, &minderv1.ArtifactVersion{
 			VersionId:             dbVersion.Version,
 			Tags:                  tags,
 			Sha:                   dbVersion.Sha,
 			SignatureVerification: sigVer,
 			GithubWorkflow:        ghWorkflow,
+			Provenance:            provenance,
 			CreatedAt:             timestamppb.New(dbVersion.CreatedAt),
 		})
 	}
@@ -648,3 +657,27 @@ func GetPullRequest(
 		RepoName:  dbrepo.RepoName,
 	}, nil
 }
+
+// GetBuildEnvironment retrieves a build environment from the database
+// and converts it to a protobuf
+func GetBuildEnvironment(
+	ctx context.Context,
+	store db.ExtendQuerier,
+	buildEnvironmentID uuid.UUID,
+) (*minderv1.BuildEnvironment, error) {
+	dbenv, err := store.GetBuildEnvironmentByID(ctx, buildEnvironmentID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("build environment not found")
+	} else if err != nil {
+		return nil, fmt.Errorf("cannot read build environment: %v", err)
+	}
+
+	env := &minderv1.BuildEnvironment{}
+	if err := protojson.Unmarshal(dbenv.Properties, env); err != nil {
+		return nil, fmt.Errorf("cannot parse build environment: %v", err)
+	}
+
+	strID := dbenv.ID.String()
+	env.Id = &strID
+	return env, nil
+}
@@ -0,0 +1,418 @@
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package versionconstraint matches package versions against version constraints
+// written in the syntax of the ecosystem of the package.
+//
+// npm constraints follow node-semver: comparators (">=1.2.0 <1.4.0"), caret and
+// tilde ranges ("^1.2.0", "~1.2"), x-ranges ("1.x", "1.2.*"), hyphen ranges
+// ("1.2.0 - 1.4") and alternatives separated by "||". PyPI constraints follow
+// PEP 440 version specifiers: comma separated clauses using ~=, ==, !=, <=, >=,
+// < and >, with trailing ".*" wildcards for == and !=. Arbitrary equality (===)
+// and epochs are not supported. Other ecosystems, like Go, use comma separated
+// comparators such as ">= 1.2.0, < 1.4.0".
+package versionconstraint
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-version"
+
+	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+// ErrUnsupported is returned for constraint syntax that is valid in the ecosystem
+// but can't be evaluated
+var ErrUnsupported = errors.New("unsupported version constraint")
+
+// check is a single comparison of a version
+type check func(v *version.Version) bool
+
+// Constraint is a parsed version constraint. A version matches if it satisfies
+// all the checks of any of the alternatives.
+type Constraint struct {
+	anyOf [][]check
+}
+
+// Parse parses a version constraint in the syntax of the ecosystem
+func Parse(eco pb.DepEcosystem, constraint string) (*Constraint, error) {
+	switch eco {
+	case pb.DepEcosystem_DEP_ECOSYSTEM_NPM:
+		return parseNpm(constraint)
+	case pb.DepEcosystem_DEP_ECOSYSTEM_PYPI:
+		return parsePep440(constraint)
+	default:
+		constraints, err := version.NewConstraint(constraint)
+		if err != nil {
+			return nil, err
+		}
+		return &Constraint{anyOf: [][]check{{constraints.Check}}}, nil
+	}
+}
+
+// Check returns true if the version satisfies the constraint. Versions that
+// can't be parsed never match.
+func (c *Constraint) Check(v string) bool {
+	parsed, err := version.NewVersion(v)
+	if err != nil {
+		return false
+	}
+
+	for _, checks := range c.anyOf {
+		if all(checks, parsed) {
+			return true
+		}
+	}
+	return false
+}
+
+func all(checks []check, v *version.Version) bool {
+	for _, c := range checks {
+		if !c(v) {
+			return false
+		}
+	}
+	return true
+}
+
+func parseNpm(constraint string) (*Constraint, error) {
+	c := &Constraint{}
+	for _, rng := range strings.Split(constraint, "||") {
+		checks, err := parseNpmRange(rng)
+		if err != nil {
+			return nil, err
+		}
+		c.anyOf = append(c.anyOf, checks)
+	}
+	return c, nil
+}
+
+// parseNpmRange parses a set of comparators that all need to be satisfied
+func parseNpmRange(rng string) ([]check, error) {
+	// commas aren't part of the npm syntax, but are accepted for consistency
+	// with the other ecosystems
+	fields := strings.Fields(strings.ReplaceAll(rng, ",", " "))
+
+	if len(fields) == 3 && fields[1] == "-" {
+		return npmHyphenRange(fields[0], fields[2])
+	}
+
+	// operators may be separated from their version by whitespace
+	var comparators []string
+	for i := 0; i < len(fields); i++ {
+		f := fields[i]
+		if strings.Trim(f, "<>=~^") == "" && i+1 < len(fields) {
+			f += fields[i+1]
+			i++
+		}
+		comparators = append(comparators, f)
+	}
+
+	checks := []check{}
+	for _, comp := range comparators {
+		cc, err := npmComparator(comp)
+		if err != nil {
+			return nil, err
+		}
+		checks = append(checks, cc...)
+	}
+	return checks, nil
+}
+
+func npmComparator(comp string) ([]check, error) {
+	op := ""
+	for _, candidate := range []string{">=", "<=", "~>", ">", "<", "=", "^", "~"} {
+		if strings.HasPrefix(comp, candidate) {
+			op = candidate
+			break
+		}
+	}
+
+	p, err := parsePartial(strings.TrimPrefix(comp, op))
+	if err != nil {
+		return nil, err
+	}
+
+	// an empty partial version, like "*", matches everything
+	if p.empty() {
+		if op == ">" || op == "<" {
+			return []check{func(*version.Version) bool { return false }}, nil
+		}
+		return nil, nil
+	}
+
+	switch op {
+	case "^":
+		return []check{ge(p.lower()), lt(p.caretUpper())}, nil
+	case "~", "~>":
+		return []check{ge(p.lower()), lt(p.tildeUpper())}, nil
+	case ">":
+		if p.full != nil {
+			return []check{gt(p.full)}, nil
+		}
+		return []check{ge(p.upper())}, nil
+	case ">=":
+		return []check{ge(p.lower())}, nil
+	case "<":
+		return []check{lt(p.lower())}, nil
+	case "<=":
+		if p.full != nil {
+			return []check{le(p.full)}, nil
+		}
+		return []check{lt(p.upper())}, nil
+	default:
+		if p.full != nil {
+			return []check{eq(p.full)}, nil
+		}
+		return []check{ge(p.lower()), lt(p.upper())}, nil
+	}
+}
+
+func npmHyphenRange(from, to string) ([]check, error) {
+	lo, err := parsePartial(from)
+	if err != nil {
+		return nil, err
+	}
+	hi, err := parsePartial(to)
+	if err != nil {
+		return nil, err
+	}
+
+	checks := []check{}
+	if !lo.empty() {
+		checks = append(checks, ge(lo.lower()))
+	}
+	if hi.full != nil {
+		checks = append(checks, le(hi.full))
+	} else if !hi.empty() {
+		checks = append(checks, lt(hi.upper()))
+	}
+	return checks, nil
+}
+
+// partial is a possibly incomplete npm version like "1", "1.2" or "1.2.x"
+type partial struct {
+	segments []int
+	// full is set if all three segments are given, it may carry a prerelease
+	full *version.Version
+}
+
+func parsePartial(s string) (*partial, error) {
+	s = strings.TrimPrefix(strings.TrimSpace(s), "v")
+	if s == "" {
+		return nil, fmt.Errorf("missing version in constraint")
+	}
+
+	p := &partial{}
+	parts := strings.SplitN(s, ".", 3)
+	for _, part := range parts {
+		if part == "x" || part == "X" || part == "*" {
+			return p, nil
+		}
+		if len(p.segments) == 2 {
+			// the last segment may carry a prerelease or build metadata
+			full, err := version.NewVersion(s)
+			if err != nil {
+				return nil, err
+			}
+			p.segments = append(p.segments, full.Segments()[2])
+			p.full = full
+			return p, nil
+		}
+		n, err := strconv.Atoi(part)
+		if err != nil {
+			return nil, fmt.Errorf("invalid version %q", s)
+		}
+		p.segments = append(p.segments, n)
+	}
+	return p, nil
+}
+
+func (p *partial) empty() bool {
+	return len(p.segments) == 0
+}
+
+// lower is the smallest version matching the partial version
+func (p *partial) lower() *version.Version {
+	if p.full != nil {
+		return p.full
+	}
+	return versionOf(p.segments...)
+}
+
+// upper is the smallest version above the partial version, e.g. 1.3.0 for 1.2
+func (p *partial) upper() *version.Version {
+	return bump(p.segments, len(p.segments)-1)
+}
+
+// caretUpper is the exclusive bound of ^ ranges, which allow changes that don't
+// modify the left-most non-zero segment
+func (p *partial) caretUpper() *version.Version {
+	for i, s := range p.segments {
+		if s != 0 || i == len(p.segments)-1 {
+			return bump(p.segments, i)
+		}
+	}
+	return bump(p.segments, 0)
+}
+
+// tildeUpper is the exclusive bound of ~ ranges, which allow patch level changes
+// if a minor version is given and minor level changes otherwise
+func (p *partial) tildeUpper() *version.Version {
+	if len(p.segments) == 1 {
+		return bump(p.segments, 0)
+	}
+	return bump(p.segments, 1)
+}
+
+func parsePep440(constraint string) (*Constraint, error) {
+	checks := []check{}
+	for _, clause := range strings.Split(constraint, ",") {
+		cc, err := pep440Clause(strings.TrimSpace(clause))
+		if err != nil {
+			return nil, err
+		}
+		checks = append(checks, cc...)
+	}
+	return &Constraint{anyOf: [][]check{checks}}, nil
+}
+
+func pep440Clause(clause string) ([]check, error) {
+	if strings.HasPrefix(clause, "===") {
+		return nil, fmt.Errorf("%w: arbitrary equality %q", ErrUnsupported, clause)
+	}
+
+	op := ""
+	for _, candidate := range []string{"~=", "==", "!=", "<=", ">=", "<", ">"} {
+		if strings.HasPrefix(clause, candidate) {
+			op = candidate
+			break
+		}
+	}
+	if op == "" {
+		return nil, fmt.Errorf("missing operator in version specifier %q", clause)
+	}
+
+	v := strings.TrimSpace(strings.TrimPrefix(clause, op))
+	if strings.Contains(v, "!") {
+		return nil, fmt.Errorf("%w: version epoch in %q", ErrUnsupported, clause)
+	}
+
+	if prefix, ok := strings.CutSuffix(v, ".*"); ok {
+		if op != "==" && op != "!=" {
+			return nil, fmt.Errorf("wildcard not allowed with %s in %q", op, clause)
+		}
+		segments, err := releaseSegments(prefix)
+		if err != nil {
+			return nil, err
+		}
+		inPrefix := and(ge(versionOf(segments...)), lt(bump(segments, len(segments)-1)))
+		if op == "!=" {
+			return []check{not(inPrefix)}, nil
+		}
+		return []check{inPrefix}, nil
+	}
+
+	if op == "~=" {
+		segments, err := releaseSegments(v)
+		if err != nil {
+			return nil, err
+		}
+		if len(segments) < 2 {
+			return nil, fmt.Errorf("compatible release %q requires at least two release segments", clause)
+		}
+		return []check{ge(versionOf(segments...)), lt(bump(segments, len(segments)-2))}, nil
+	}
+
+	parsed, err := version.NewVersion(v)
+	if err != nil {
+		return nil, fmt.Errorf("invalid version in %q: %w", clause, err)
+	}
+
+	switch op {
+	case "==":
+		return []check{eq(parsed)}, nil
+	case "!=":
+		return []check{not(eq(parsed))}, nil
+	case "<=":
+		return []check{le(parsed)}, nil
+	case ">=":
+		return []check{ge(parsed)}, nil
+	case "<":
+		return []check{lt(parsed)}, nil
+	default:
+		return []check{gt(parsed)}, nil
+	}
+}
+
+// releaseSegments parses a PEP 440 release made of numeric segments only
+func releaseSegments(release string) ([]int, error) {
+	var segments []int
+	for _, part := range strings.Split(release, ".") {
+		n, err := strconv.Atoi(part)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %q is not a plain release version", ErrUnsupported, release)
+		}
+		segments = append(segments, n)
+	}
+	return segments, nil
+}
+
+// bump returns the version made of the segments up to idx, with the one at idx
+// incremented and the remaining ones set to zero
+func bump(segments []int, idx int) *version.Version {
+	bumped := make([]int, len(segments))
+	copy(bumped, segments[:idx])
+	bumped[idx] = segments[idx] + 1
+	return versionOf(bumped...)
+}
+
+func versionOf(segments ...int) *version.Version {
+	strs := make([]string, 0, len(segments))
+	for _, s := range segments {
+		strs = append(strs, strconv.Itoa(s))
+	}
+	return version.Must(version.NewVersion(strings.Join(strs, ".")))
+}
+
+func eq(c *version.Version) check {
+	return func(v *version.Version) bool { return v.Equal(c) }
+}
+
+func gt(c *version.Version) check {
+	return func(v *version.Version) bool { return v.GreaterThan(c) }
+}
+
+func ge(c *version.Version) check {
+	return func(v *version.Version) bool { return v.GreaterThanOrEqual(c) }
+}
+
+func lt(c *version.Version) check {
+	return func(v *version.Version) bool { return v.LessThan(c) }
+}
+
+func le(c *version.Version) check {
+	return func(v *version.Version) bool { return v.LessThanOrEqual(c) }
+}
+
+func not(c check) check {
+	return func(v *version.Version) bool { return !c(v) }
+}
+
+func and(a, b check) check {
+	return func(v *version.Version) bool { return a(v) && b(v) }
+}
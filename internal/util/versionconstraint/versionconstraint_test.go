@@ -0,0 +1,154 @@
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package versionconstraint
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+func TestCheck(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		eco        pb.DepEcosystem
+		constraint string
+		match      []string
+		noMatch    []string
+	}{
+		{
+			name:       "npm caret",
+			eco:        pb.DepEcosystem_DEP_ECOSYSTEM_NPM,
+			constraint: "^1.2.0",
+			match:      []string{"1.2.0", "1.9.3"},
+			noMatch:    []string{"1.1.9", "2.0.0"},
+		},
+		{
+			name:       "npm caret below 1.0",
+			eco:        pb.DepEcosystem_DEP_ECOSYSTEM_NPM,
+			constraint: "^0.2.3",
+			match:      []string{"0.2.3", "0.2.9"},
+			noMatch:    []string{"0.3.0", "1.0.0"},
+		},
+		{
+			name:       "npm tilde",
+			eco:        pb.DepEcosystem_DEP_ECOSYSTEM_NPM,
+			constraint: "~1.2",
+			match:      []string{"1.2.0", "1.2.7"},
+			noMatch:    []string{"1.3.0", "1.1.0"},
+		},
+		{
+			name:       "npm x-range",
+			eco:        pb.DepEcosystem_DEP_ECOSYSTEM_NPM,
+			constraint: "1.x",
+			match:      []string{"1.0.0", "1.99.1"},
+			noMatch:    []string{"0.9.0", "2.0.0"},
+		},
+		{
+			name:       "npm comparators and alternatives",
+			eco:        pb.DepEcosystem_DEP_ECOSYSTEM_NPM,
+			constraint: ">= 1.2.0 <1.4 || 2.1.0",
+			match:      []string{"1.2.0", "1.3.9", "2.1.0"},
+			noMatch:    []string{"1.4.0", "2.1.1"},
+		},
+		{
+			name:       "npm hyphen range",
+			eco:        pb.DepEcosystem_DEP_ECOSYSTEM_NPM,
+			constraint: "1.2.3 - 2.3",
+			match:      []string{"1.2.3", "2.3.9"},
+			noMatch:    []string{"1.2.2", "2.4.0"},
+		},
+		{
+			name:       "pep 440 compatible release",
+			eco:        pb.DepEcosystem_DEP_ECOSYSTEM_PYPI,
+			constraint: "~=2.2",
+			match:      []string{"2.2", "2.9.1"},
+			noMatch:    []string{"2.1", "3.0"},
+		},
+		{
+			name:       "pep 440 prefix matching",
+			eco:        pb.DepEcosystem_DEP_ECOSYSTEM_PYPI,
+			constraint: ">=1.0, !=1.3.*, <2",
+			match:      []string{"1.0", "1.2.9", "1.4"},
+			noMatch:    []string{"1.3", "1.3.4", "2.0"},
+		},
+		{
+			name:       "go comparators",
+			eco:        pb.DepEcosystem_DEP_ECOSYSTEM_GO,
+			constraint: ">= 1.2.0, < 1.4.0",
+			match:      []string{"v1.2.0", "1.3.1"},
+			noMatch:    []string{"v1.4.0", "not a version"},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			c, err := Parse(tt.eco, tt.constraint)
+			require.NoError(t, err)
+
+			for _, v := range tt.match {
+				assert.True(t, c.Check(v), "expected %s to match %s", v, tt.constraint)
+			}
+			for _, v := range tt.noMatch {
+				assert.False(t, c.Check(v), "expected %s not to match %s", v, tt.constraint)
+			}
+		})
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		eco         pb.DepEcosystem
+		constraint  string
+		unsupported bool
+	}{
+		{name: "npm garbage", eco: pb.DepEcosystem_DEP_ECOSYSTEM_NPM, constraint: "not a range"},
+		{name: "pep 440 without operator", eco: pb.DepEcosystem_DEP_ECOSYSTEM_PYPI, constraint: "1.2"},
+		{name: "pep 440 wildcard with ordering", eco: pb.DepEcosystem_DEP_ECOSYSTEM_PYPI, constraint: ">=1.*"},
+		{name: "pep 440 single segment compatible release", eco: pb.DepEcosystem_DEP_ECOSYSTEM_PYPI, constraint: "~=1"},
+		{
+			name: "pep 440 arbitrary equality", eco: pb.DepEcosystem_DEP_ECOSYSTEM_PYPI,
+			constraint: "===1.0", unsupported: true,
+		},
+		{
+			name: "pep 440 epoch", eco: pb.DepEcosystem_DEP_ECOSYSTEM_PYPI,
+			constraint: "==1!2.0", unsupported: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := Parse(tt.eco, tt.constraint)
+			require.Error(t, err)
+			if tt.unsupported {
+				require.ErrorIs(t, err, ErrUnsupported)
+			}
+		})
+	}
+}
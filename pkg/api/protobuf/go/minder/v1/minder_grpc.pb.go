@@ -125,8 +125,14 @@ var HealthService_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	ArtifactService_ListArtifacts_FullMethodName   = "/minder.v1.ArtifactService/ListArtifacts"
-	ArtifactService_GetArtifactById_FullMethodName = "/minder.v1.ArtifactService/GetArtifactById"
+	ArtifactService_ListArtifacts_FullMethodName              = "/minder.v1.ArtifactService/ListArtifacts"
+	ArtifactService_GetArtifactById_FullMethodName            = "/minder.v1.ArtifactService/GetArtifactById"
+	ArtifactService_CreateSigningKey_FullMethodName           = "/minder.v1.ArtifactService/CreateSigningKey"
+	ArtifactService_ListSigningKeys_FullMethodName            = "/minder.v1.ArtifactService/ListSigningKeys"
+	ArtifactService_DeleteSigningKey_FullMethodName           = "/minder.v1.ArtifactService/DeleteSigningKey"
+	ArtifactService_GetArtifactRetentionPolicy_FullMethodName = "/minder.v1.ArtifactService/GetArtifactRetentionPolicy"
+	ArtifactService_SetArtifactRetentionPolicy_FullMethodName = "/minder.v1.ArtifactService/SetArtifactRetentionPolicy"
+	ArtifactService_PruneArtifactVersions_FullMethodName      = "/minder.v1.ArtifactService/PruneArtifactVersions"
 )
 
 // ArtifactServiceClient is the client API for ArtifactService service.
@@ -135,6 +141,20 @@ const (
 type ArtifactServiceClient interface {
 	ListArtifacts(ctx context.Context, in *ListArtifactsRequest, opts ...grpc.CallOption) (*ListArtifactsResponse, error)
 	GetArtifactById(ctx context.Context, in *GetArtifactByIdRequest, opts ...grpc.CallOption) (*GetArtifactByIdResponse, error)
+	// CreateSigningKey registers a public key on the project. Signatures of
+	// the artifacts of the project made with the matching private key are
+	// considered verified.
+	CreateSigningKey(ctx context.Context, in *CreateSigningKeyRequest, opts ...grpc.CallOption) (*CreateSigningKeyResponse, error)
+	ListSigningKeys(ctx context.Context, in *ListSigningKeysRequest, opts ...grpc.CallOption) (*ListSigningKeysResponse, error)
+	DeleteSigningKey(ctx context.Context, in *DeleteSigningKeyRequest, opts ...grpc.CallOption) (*DeleteSigningKeyResponse, error)
+	GetArtifactRetentionPolicy(ctx context.Context, in *GetArtifactRetentionPolicyRequest, opts ...grpc.CallOption) (*GetArtifactRetentionPolicyResponse, error)
+	// SetArtifactRetentionPolicy sets how long the artifact versions of the
+	// project are kept. Versions the policy doesn't keep are removed when the
+	// artifacts are reconciled.
+	SetArtifactRetentionPolicy(ctx context.Context, in *SetArtifactRetentionPolicyRequest, opts ...grpc.CallOption) (*SetArtifactRetentionPolicyResponse, error)
+	// PruneArtifactVersions removes the stored artifact versions the retention
+	// policy of the project doesn't keep, or lists them on a dry run.
+	PruneArtifactVersions(ctx context.Context, in *PruneArtifactVersionsRequest, opts ...grpc.CallOption) (*PruneArtifactVersionsResponse, error)
 }
 
 type artifactServiceClient struct {
@@ -163,12 +183,80 @@ func (c *artifactServiceClient) GetArtifactById(ctx context.Context, in *GetArti
 	return out, nil
 }
 
+func (c *artifactServiceClient) CreateSigningKey(ctx context.Context, in *CreateSigningKeyRequest, opts ...grpc.CallOption) (*CreateSigningKeyResponse, error) {
+	out := new(CreateSigningKeyResponse)
+	err := c.cc.Invoke(ctx, ArtifactService_CreateSigningKey_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *artifactServiceClient) ListSigningKeys(ctx context.Context, in *ListSigningKeysRequest, opts ...grpc.CallOption) (*ListSigningKeysResponse, error) {
+	out := new(ListSigningKeysResponse)
+	err := c.cc.Invoke(ctx, ArtifactService_ListSigningKeys_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *artifactServiceClient) DeleteSigningKey(ctx context.Context, in *DeleteSigningKeyRequest, opts ...grpc.CallOption) (*DeleteSigningKeyResponse, error) {
+	out := new(DeleteSigningKeyResponse)
+	err := c.cc.Invoke(ctx, ArtifactService_DeleteSigningKey_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *artifactServiceClient) GetArtifactRetentionPolicy(ctx context.Context, in *GetArtifactRetentionPolicyRequest, opts ...grpc.CallOption) (*GetArtifactRetentionPolicyResponse, error) {
+	out := new(GetArtifactRetentionPolicyResponse)
+	err := c.cc.Invoke(ctx, ArtifactService_GetArtifactRetentionPolicy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *artifactServiceClient) SetArtifactRetentionPolicy(ctx context.Context, in *SetArtifactRetentionPolicyRequest, opts ...grpc.CallOption) (*SetArtifactRetentionPolicyResponse, error) {
+	out := new(SetArtifactRetentionPolicyResponse)
+	err := c.cc.Invoke(ctx, ArtifactService_SetArtifactRetentionPolicy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *artifactServiceClient) PruneArtifactVersions(ctx context.Context, in *PruneArtifactVersionsRequest, opts ...grpc.CallOption) (*PruneArtifactVersionsResponse, error) {
+	out := new(PruneArtifactVersionsResponse)
+	err := c.cc.Invoke(ctx, ArtifactService_PruneArtifactVersions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ArtifactServiceServer is the server API for ArtifactService service.
 // All implementations must embed UnimplementedArtifactServiceServer
 // for forward compatibility
 type ArtifactServiceServer interface {
 	ListArtifacts(context.Context, *ListArtifactsRequest) (*ListArtifactsResponse, error)
 	GetArtifactById(context.Context, *GetArtifactByIdRequest) (*GetArtifactByIdResponse, error)
+	// CreateSigningKey registers a public key on the project. Signatures of
+	// the artifacts of the project made with the matching private key are
+	// considered verified.
+	CreateSigningKey(context.Context, *CreateSigningKeyRequest) (*CreateSigningKeyResponse, error)
+	ListSigningKeys(context.Context, *ListSigningKeysRequest) (*ListSigningKeysResponse, error)
+	DeleteSigningKey(context.Context, *DeleteSigningKeyRequest) (*DeleteSigningKeyResponse, error)
+	GetArtifactRetentionPolicy(context.Context, *GetArtifactRetentionPolicyRequest) (*GetArtifactRetentionPolicyResponse, error)
+	// SetArtifactRetentionPolicy sets how long the artifact versions of the
+	// project are kept. Versions the policy doesn't keep are removed when the
+	// artifacts are reconciled.
+	SetArtifactRetentionPolicy(context.Context, *SetArtifactRetentionPolicyRequest) (*SetArtifactRetentionPolicyResponse, error)
+	// PruneArtifactVersions removes the stored artifact versions the retention
+	// policy of the project doesn't keep, or lists them on a dry run.
+	PruneArtifactVersions(context.Context, *PruneArtifactVersionsRequest) (*PruneArtifactVersionsResponse, error)
 	mustEmbedUnimplementedArtifactServiceServer()
 }
 
@@ -182,6 +270,24 @@ func (UnimplementedArtifactServiceServer) ListArtifacts(context.Context, *ListAr
 func (UnimplementedArtifactServiceServer) GetArtifactById(context.Context, *GetArtifactByIdRequest) (*GetArtifactByIdResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetArtifactById not implemented")
 }
+func (UnimplementedArtifactServiceServer) CreateSigningKey(context.Context, *CreateSigningKeyRequest) (*CreateSigningKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSigningKey not implemented")
+}
+func (UnimplementedArtifactServiceServer) ListSigningKeys(context.Context, *ListSigningKeysRequest) (*ListSigningKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSigningKeys not implemented")
+}
+func (UnimplementedArtifactServiceServer) DeleteSigningKey(context.Context, *DeleteSigningKeyRequest) (*DeleteSigningKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSigningKey not implemented")
+}
+func (UnimplementedArtifactServiceServer) GetArtifactRetentionPolicy(context.Context, *GetArtifactRetentionPolicyRequest) (*GetArtifactRetentionPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetArtifactRetentionPolicy not implemented")
+}
+func (UnimplementedArtifactServiceServer) SetArtifactRetentionPolicy(context.Context, *SetArtifactRetentionPolicyRequest) (*SetArtifactRetentionPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetArtifactRetentionPolicy not implemented")
+}
+func (UnimplementedArtifactServiceServer) PruneArtifactVersions(context.Context, *PruneArtifactVersionsRequest) (*PruneArtifactVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PruneArtifactVersions not implemented")
+}
 func (UnimplementedArtifactServiceServer) mustEmbedUnimplementedArtifactServiceServer() {}
 
 // UnsafeArtifactServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -231,6 +337,114 @@ func _ArtifactService_GetArtifactById_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ArtifactService_CreateSigningKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSigningKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArtifactServiceServer).CreateSigningKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArtifactService_CreateSigningKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArtifactServiceServer).CreateSigningKey(ctx, req.(*CreateSigningKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArtifactService_ListSigningKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSigningKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArtifactServiceServer).ListSigningKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArtifactService_ListSigningKeys_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArtifactServiceServer).ListSigningKeys(ctx, req.(*ListSigningKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArtifactService_DeleteSigningKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSigningKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArtifactServiceServer).DeleteSigningKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArtifactService_DeleteSigningKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArtifactServiceServer).DeleteSigningKey(ctx, req.(*DeleteSigningKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArtifactService_GetArtifactRetentionPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetArtifactRetentionPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArtifactServiceServer).GetArtifactRetentionPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArtifactService_GetArtifactRetentionPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArtifactServiceServer).GetArtifactRetentionPolicy(ctx, req.(*GetArtifactRetentionPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArtifactService_SetArtifactRetentionPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetArtifactRetentionPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArtifactServiceServer).SetArtifactRetentionPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArtifactService_SetArtifactRetentionPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArtifactServiceServer).SetArtifactRetentionPolicy(ctx, req.(*SetArtifactRetentionPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArtifactService_PruneArtifactVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PruneArtifactVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArtifactServiceServer).PruneArtifactVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArtifactService_PruneArtifactVersions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArtifactServiceServer).PruneArtifactVersions(ctx, req.(*PruneArtifactVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ArtifactService_ServiceDesc is the grpc.ServiceDesc for ArtifactService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -246,6 +460,30 @@ var ArtifactService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetArtifactById",
 			Handler:    _ArtifactService_GetArtifactById_Handler,
 		},
+		{
+			MethodName: "CreateSigningKey",
+			Handler:    _ArtifactService_CreateSigningKey_Handler,
+		},
+		{
+			MethodName: "ListSigningKeys",
+			Handler:    _ArtifactService_ListSigningKeys_Handler,
+		},
+		{
+			MethodName: "DeleteSigningKey",
+			Handler:    _ArtifactService_DeleteSigningKey_Handler,
+		},
+		{
+			MethodName: "GetArtifactRetentionPolicy",
+			Handler:    _ArtifactService_GetArtifactRetentionPolicy_Handler,
+		},
+		{
+			MethodName: "SetArtifactRetentionPolicy",
+			Handler:    _ArtifactService_SetArtifactRetentionPolicy_Handler,
+		},
+		{
+			MethodName: "PruneArtifactVersions",
+			Handler:    _ArtifactService_PruneArtifactVersions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "minder/v1/minder.proto",
@@ -493,6 +731,143 @@ var OAuthService_ServiceDesc = grpc.ServiceDesc{
 	Metadata: "minder/v1/minder.proto",
 }
 
+const (
+	ProviderService_CreateProvider_FullMethodName         = "/minder.v1.ProviderService/CreateProvider"
+	ProviderService_SetSigstoreTrustConfig_FullMethodName = "/minder.v1.ProviderService/SetSigstoreTrustConfig"
+)
+
+// ProviderServiceClient is the client API for ProviderService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ProviderServiceClient interface {
+	// CreateProvider enrolls a provider that is configured declaratively,
+	// e.g. an OCI registry, in a project
+	CreateProvider(ctx context.Context, in *CreateProviderRequest, opts ...grpc.CallOption) (*CreateProviderResponse, error)
+	// SetSigstoreTrustConfig sets the sigstore instance the signatures of the
+	// artifacts of a provider are verified against. An empty configuration
+	// restores the public good instance.
+	SetSigstoreTrustConfig(ctx context.Context, in *SetSigstoreTrustConfigRequest, opts ...grpc.CallOption) (*SetSigstoreTrustConfigResponse, error)
+}
+
+type providerServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewProviderServiceClient(cc grpc.ClientConnInterface) ProviderServiceClient {
+	return &providerServiceClient{cc}
+}
+
+func (c *providerServiceClient) CreateProvider(ctx context.Context, in *CreateProviderRequest, opts ...grpc.CallOption) (*CreateProviderResponse, error) {
+	out := new(CreateProviderResponse)
+	err := c.cc.Invoke(ctx, ProviderService_CreateProvider_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerServiceClient) SetSigstoreTrustConfig(ctx context.Context, in *SetSigstoreTrustConfigRequest, opts ...grpc.CallOption) (*SetSigstoreTrustConfigResponse, error) {
+	out := new(SetSigstoreTrustConfigResponse)
+	err := c.cc.Invoke(ctx, ProviderService_SetSigstoreTrustConfig_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ProviderServiceServer is the server API for ProviderService service.
+// All implementations must embed UnimplementedProviderServiceServer
+// for forward compatibility
+type ProviderServiceServer interface {
+	// CreateProvider enrolls a provider that is configured declaratively,
+	// e.g. an OCI registry, in a project
+	CreateProvider(context.Context, *CreateProviderRequest) (*CreateProviderResponse, error)
+	// SetSigstoreTrustConfig sets the sigstore instance the signatures of the
+	// artifacts of a provider are verified against. An empty configuration
+	// restores the public good instance.
+	SetSigstoreTrustConfig(context.Context, *SetSigstoreTrustConfigRequest) (*SetSigstoreTrustConfigResponse, error)
+	mustEmbedUnimplementedProviderServiceServer()
+}
+
+// UnimplementedProviderServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedProviderServiceServer struct {
+}
+
+func (UnimplementedProviderServiceServer) CreateProvider(context.Context, *CreateProviderRequest) (*CreateProviderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateProvider not implemented")
+}
+func (UnimplementedProviderServiceServer) SetSigstoreTrustConfig(context.Context, *SetSigstoreTrustConfigRequest) (*SetSigstoreTrustConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSigstoreTrustConfig not implemented")
+}
+func (UnimplementedProviderServiceServer) mustEmbedUnimplementedProviderServiceServer() {}
+
+// UnsafeProviderServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ProviderServiceServer will
+// result in compilation errors.
+type UnsafeProviderServiceServer interface {
+	mustEmbedUnimplementedProviderServiceServer()
+}
+
+func RegisterProviderServiceServer(s grpc.ServiceRegistrar, srv ProviderServiceServer) {
+	s.RegisterService(&ProviderService_ServiceDesc, srv)
+}
+
+func _ProviderService_CreateProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateProviderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderServiceServer).CreateProvider(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProviderService_CreateProvider_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderServiceServer).CreateProvider(ctx, req.(*CreateProviderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProviderService_SetSigstoreTrustConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSigstoreTrustConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderServiceServer).SetSigstoreTrustConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProviderService_SetSigstoreTrustConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderServiceServer).SetSigstoreTrustConfig(ctx, req.(*SetSigstoreTrustConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ProviderService_ServiceDesc is the grpc.ServiceDesc for ProviderService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ProviderService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "minder.v1.ProviderService",
+	HandlerType: (*ProviderServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateProvider",
+			Handler:    _ProviderService_CreateProvider_Handler,
+		},
+		{
+			MethodName: "SetSigstoreTrustConfig",
+			Handler:    _ProviderService_SetSigstoreTrustConfig_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "minder/v1/minder.proto",
+}
+
 const (
 	RepositoryService_RegisterRepository_FullMethodName                 = "/minder.v1.RepositoryService/RegisterRepository"
 	RepositoryService_ListRemoteRepositoriesFromProvider_FullMethodName = "/minder.v1.RepositoryService/ListRemoteRepositoriesFromProvider"
@@ -501,6 +876,10 @@ const (
 	RepositoryService_GetRepositoryByName_FullMethodName                = "/minder.v1.RepositoryService/GetRepositoryByName"
 	RepositoryService_DeleteRepositoryById_FullMethodName               = "/minder.v1.RepositoryService/DeleteRepositoryById"
 	RepositoryService_DeleteRepositoryByName_FullMethodName             = "/minder.v1.RepositoryService/DeleteRepositoryByName"
+	RepositoryService_GetRepositorySBOM_FullMethodName                  = "/minder.v1.RepositoryService/GetRepositorySBOM"
+	RepositoryService_ListRepositoryDependencies_FullMethodName         = "/minder.v1.RepositoryService/ListRepositoryDependencies"
+	RepositoryService_ListDependencyUsage_FullMethodName                = "/minder.v1.RepositoryService/ListDependencyUsage"
+	RepositoryService_ListRemediationPullRequests_FullMethodName        = "/minder.v1.RepositoryService/ListRemediationPullRequests"
 )
 
 // RepositoryServiceClient is the client API for RepositoryService service.
@@ -514,6 +893,10 @@ type RepositoryServiceClient interface {
 	GetRepositoryByName(ctx context.Context, in *GetRepositoryByNameRequest, opts ...grpc.CallOption) (*GetRepositoryByNameResponse, error)
 	DeleteRepositoryById(ctx context.Context, in *DeleteRepositoryByIdRequest, opts ...grpc.CallOption) (*DeleteRepositoryByIdResponse, error)
 	DeleteRepositoryByName(ctx context.Context, in *DeleteRepositoryByNameRequest, opts ...grpc.CallOption) (*DeleteRepositoryByNameResponse, error)
+	GetRepositorySBOM(ctx context.Context, in *GetRepositorySBOMRequest, opts ...grpc.CallOption) (*GetRepositorySBOMResponse, error)
+	ListRepositoryDependencies(ctx context.Context, in *ListRepositoryDependenciesRequest, opts ...grpc.CallOption) (*ListRepositoryDependenciesResponse, error)
+	ListDependencyUsage(ctx context.Context, in *ListDependencyUsageRequest, opts ...grpc.CallOption) (*ListDependencyUsageResponse, error)
+	ListRemediationPullRequests(ctx context.Context, in *ListRemediationPullRequestsRequest, opts ...grpc.CallOption) (*ListRemediationPullRequestsResponse, error)
 }
 
 type repositoryServiceClient struct {
@@ -587,6 +970,42 @@ func (c *repositoryServiceClient) DeleteRepositoryByName(ctx context.Context, in
 	return out, nil
 }
 
+func (c *repositoryServiceClient) GetRepositorySBOM(ctx context.Context, in *GetRepositorySBOMRequest, opts ...grpc.CallOption) (*GetRepositorySBOMResponse, error) {
+	out := new(GetRepositorySBOMResponse)
+	err := c.cc.Invoke(ctx, RepositoryService_GetRepositorySBOM_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryServiceClient) ListRepositoryDependencies(ctx context.Context, in *ListRepositoryDependenciesRequest, opts ...grpc.CallOption) (*ListRepositoryDependenciesResponse, error) {
+	out := new(ListRepositoryDependenciesResponse)
+	err := c.cc.Invoke(ctx, RepositoryService_ListRepositoryDependencies_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryServiceClient) ListDependencyUsage(ctx context.Context, in *ListDependencyUsageRequest, opts ...grpc.CallOption) (*ListDependencyUsageResponse, error) {
+	out := new(ListDependencyUsageResponse)
+	err := c.cc.Invoke(ctx, RepositoryService_ListDependencyUsage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryServiceClient) ListRemediationPullRequests(ctx context.Context, in *ListRemediationPullRequestsRequest, opts ...grpc.CallOption) (*ListRemediationPullRequestsResponse, error) {
+	out := new(ListRemediationPullRequestsResponse)
+	err := c.cc.Invoke(ctx, RepositoryService_ListRemediationPullRequests_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RepositoryServiceServer is the server API for RepositoryService service.
 // All implementations must embed UnimplementedRepositoryServiceServer
 // for forward compatibility
@@ -598,6 +1017,10 @@ type RepositoryServiceServer interface {
 	GetRepositoryByName(context.Context, *GetRepositoryByNameRequest) (*GetRepositoryByNameResponse, error)
 	DeleteRepositoryById(context.Context, *DeleteRepositoryByIdRequest) (*DeleteRepositoryByIdResponse, error)
 	DeleteRepositoryByName(context.Context, *DeleteRepositoryByNameRequest) (*DeleteRepositoryByNameResponse, error)
+	GetRepositorySBOM(context.Context, *GetRepositorySBOMRequest) (*GetRepositorySBOMResponse, error)
+	ListRepositoryDependencies(context.Context, *ListRepositoryDependenciesRequest) (*ListRepositoryDependenciesResponse, error)
+	ListDependencyUsage(context.Context, *ListDependencyUsageRequest) (*ListDependencyUsageResponse, error)
+	ListRemediationPullRequests(context.Context, *ListRemediationPullRequestsRequest) (*ListRemediationPullRequestsResponse, error)
 	mustEmbedUnimplementedRepositoryServiceServer()
 }
 
@@ -626,6 +1049,18 @@ func (UnimplementedRepositoryServiceServer) DeleteRepositoryById(context.Context
 func (UnimplementedRepositoryServiceServer) DeleteRepositoryByName(context.Context, *DeleteRepositoryByNameRequest) (*DeleteRepositoryByNameResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteRepositoryByName not implemented")
 }
+func (UnimplementedRepositoryServiceServer) GetRepositorySBOM(context.Context, *GetRepositorySBOMRequest) (*GetRepositorySBOMResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRepositorySBOM not implemented")
+}
+func (UnimplementedRepositoryServiceServer) ListRepositoryDependencies(context.Context, *ListRepositoryDependenciesRequest) (*ListRepositoryDependenciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRepositoryDependencies not implemented")
+}
+func (UnimplementedRepositoryServiceServer) ListDependencyUsage(context.Context, *ListDependencyUsageRequest) (*ListDependencyUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDependencyUsage not implemented")
+}
+func (UnimplementedRepositoryServiceServer) ListRemediationPullRequests(context.Context, *ListRemediationPullRequestsRequest) (*ListRemediationPullRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRemediationPullRequests not implemented")
+}
 func (UnimplementedRepositoryServiceServer) mustEmbedUnimplementedRepositoryServiceServer() {}
 
 // UnsafeRepositoryServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -765,6 +1200,78 @@ func _RepositoryService_DeleteRepositoryByName_Handler(srv interface{}, ctx cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RepositoryService_GetRepositorySBOM_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRepositorySBOMRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepositoryServiceServer).GetRepositorySBOM(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RepositoryService_GetRepositorySBOM_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServiceServer).GetRepositorySBOM(ctx, req.(*GetRepositorySBOMRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RepositoryService_ListRepositoryDependencies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRepositoryDependenciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepositoryServiceServer).ListRepositoryDependencies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RepositoryService_ListRepositoryDependencies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServiceServer).ListRepositoryDependencies(ctx, req.(*ListRepositoryDependenciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RepositoryService_ListDependencyUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDependencyUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepositoryServiceServer).ListDependencyUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RepositoryService_ListDependencyUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServiceServer).ListDependencyUsage(ctx, req.(*ListDependencyUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RepositoryService_ListRemediationPullRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRemediationPullRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepositoryServiceServer).ListRemediationPullRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RepositoryService_ListRemediationPullRequests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServiceServer).ListRemediationPullRequests(ctx, req.(*ListRemediationPullRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // RepositoryService_ServiceDesc is the grpc.ServiceDesc for RepositoryService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -800,6 +1307,22 @@ var RepositoryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteRepositoryByName",
 			Handler:    _RepositoryService_DeleteRepositoryByName_Handler,
 		},
+		{
+			MethodName: "GetRepositorySBOM",
+			Handler:    _RepositoryService_GetRepositorySBOM_Handler,
+		},
+		{
+			MethodName: "ListRepositoryDependencies",
+			Handler:    _RepositoryService_ListRepositoryDependencies_Handler,
+		},
+		{
+			MethodName: "ListDependencyUsage",
+			Handler:    _RepositoryService_ListDependencyUsage_Handler,
+		},
+		{
+			MethodName: "ListRemediationPullRequests",
+			Handler:    _RepositoryService_ListRemediationPullRequests_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "minder/v1/minder.proto",
@@ -977,6 +1500,9 @@ const (
 	ProfileService_GetProfileById_FullMethodName            = "/minder.v1.ProfileService/GetProfileById"
 	ProfileService_GetProfileStatusByName_FullMethodName    = "/minder.v1.ProfileService/GetProfileStatusByName"
 	ProfileService_GetProfileStatusByProject_FullMethodName = "/minder.v1.ProfileService/GetProfileStatusByProject"
+	ProfileService_ListRemediationApprovals_FullMethodName  = "/minder.v1.ProfileService/ListRemediationApprovals"
+	ProfileService_ApproveRemediation_FullMethodName        = "/minder.v1.ProfileService/ApproveRemediation"
+	ProfileService_RejectRemediation_FullMethodName         = "/minder.v1.ProfileService/RejectRemediation"
 	ProfileService_ListRuleTypes_FullMethodName             = "/minder.v1.ProfileService/ListRuleTypes"
 	ProfileService_GetRuleTypeByName_FullMethodName         = "/minder.v1.ProfileService/GetRuleTypeByName"
 	ProfileService_GetRuleTypeById_FullMethodName           = "/minder.v1.ProfileService/GetRuleTypeById"
@@ -996,6 +1522,14 @@ type ProfileServiceClient interface {
 	GetProfileById(ctx context.Context, in *GetProfileByIdRequest, opts ...grpc.CallOption) (*GetProfileByIdResponse, error)
 	GetProfileStatusByName(ctx context.Context, in *GetProfileStatusByNameRequest, opts ...grpc.CallOption) (*GetProfileStatusByNameResponse, error)
 	GetProfileStatusByProject(ctx context.Context, in *GetProfileStatusByProjectRequest, opts ...grpc.CallOption) (*GetProfileStatusByProjectResponse, error)
+	// ListRemediationApprovals lists the requests proposed by the remediations
+	// of the profiles that require approval.
+	ListRemediationApprovals(ctx context.Context, in *ListRemediationApprovalsRequest, opts ...grpc.CallOption) (*ListRemediationApprovalsResponse, error)
+	// ApproveRemediation performs the request proposed by a remediation.
+	ApproveRemediation(ctx context.Context, in *ApproveRemediationRequest, opts ...grpc.CallOption) (*ApproveRemediationResponse, error)
+	// RejectRemediation rejects the request proposed by a remediation. It's
+	// proposed again if the remediation proposes a different request.
+	RejectRemediation(ctx context.Context, in *RejectRemediationRequest, opts ...grpc.CallOption) (*RejectRemediationResponse, error)
 	ListRuleTypes(ctx context.Context, in *ListRuleTypesRequest, opts ...grpc.CallOption) (*ListRuleTypesResponse, error)
 	GetRuleTypeByName(ctx context.Context, in *GetRuleTypeByNameRequest, opts ...grpc.CallOption) (*GetRuleTypeByNameResponse, error)
 	GetRuleTypeById(ctx context.Context, in *GetRuleTypeByIdRequest, opts ...grpc.CallOption) (*GetRuleTypeByIdResponse, error)
@@ -1075,6 +1609,33 @@ func (c *profileServiceClient) GetProfileStatusByProject(ctx context.Context, in
 	return out, nil
 }
 
+func (c *profileServiceClient) ListRemediationApprovals(ctx context.Context, in *ListRemediationApprovalsRequest, opts ...grpc.CallOption) (*ListRemediationApprovalsResponse, error) {
+	out := new(ListRemediationApprovalsResponse)
+	err := c.cc.Invoke(ctx, ProfileService_ListRemediationApprovals_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileServiceClient) ApproveRemediation(ctx context.Context, in *ApproveRemediationRequest, opts ...grpc.CallOption) (*ApproveRemediationResponse, error) {
+	out := new(ApproveRemediationResponse)
+	err := c.cc.Invoke(ctx, ProfileService_ApproveRemediation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileServiceClient) RejectRemediation(ctx context.Context, in *RejectRemediationRequest, opts ...grpc.CallOption) (*RejectRemediationResponse, error) {
+	out := new(RejectRemediationResponse)
+	err := c.cc.Invoke(ctx, ProfileService_RejectRemediation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *profileServiceClient) ListRuleTypes(ctx context.Context, in *ListRuleTypesRequest, opts ...grpc.CallOption) (*ListRuleTypesResponse, error) {
 	out := new(ListRuleTypesResponse)
 	err := c.cc.Invoke(ctx, ProfileService_ListRuleTypes_FullMethodName, in, out, opts...)
@@ -1140,6 +1701,14 @@ type ProfileServiceServer interface {
 	GetProfileById(context.Context, *GetProfileByIdRequest) (*GetProfileByIdResponse, error)
 	GetProfileStatusByName(context.Context, *GetProfileStatusByNameRequest) (*GetProfileStatusByNameResponse, error)
 	GetProfileStatusByProject(context.Context, *GetProfileStatusByProjectRequest) (*GetProfileStatusByProjectResponse, error)
+	// ListRemediationApprovals lists the requests proposed by the remediations
+	// of the profiles that require approval.
+	ListRemediationApprovals(context.Context, *ListRemediationApprovalsRequest) (*ListRemediationApprovalsResponse, error)
+	// ApproveRemediation performs the request proposed by a remediation.
+	ApproveRemediation(context.Context, *ApproveRemediationRequest) (*ApproveRemediationResponse, error)
+	// RejectRemediation rejects the request proposed by a remediation. It's
+	// proposed again if the remediation proposes a different request.
+	RejectRemediation(context.Context, *RejectRemediationRequest) (*RejectRemediationResponse, error)
 	ListRuleTypes(context.Context, *ListRuleTypesRequest) (*ListRuleTypesResponse, error)
 	GetRuleTypeByName(context.Context, *GetRuleTypeByNameRequest) (*GetRuleTypeByNameResponse, error)
 	GetRuleTypeById(context.Context, *GetRuleTypeByIdRequest) (*GetRuleTypeByIdResponse, error)
@@ -1174,6 +1743,15 @@ func (UnimplementedProfileServiceServer) GetProfileStatusByName(context.Context,
 func (UnimplementedProfileServiceServer) GetProfileStatusByProject(context.Context, *GetProfileStatusByProjectRequest) (*GetProfileStatusByProjectResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetProfileStatusByProject not implemented")
 }
+func (UnimplementedProfileServiceServer) ListRemediationApprovals(context.Context, *ListRemediationApprovalsRequest) (*ListRemediationApprovalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRemediationApprovals not implemented")
+}
+func (UnimplementedProfileServiceServer) ApproveRemediation(context.Context, *ApproveRemediationRequest) (*ApproveRemediationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveRemediation not implemented")
+}
+func (UnimplementedProfileServiceServer) RejectRemediation(context.Context, *RejectRemediationRequest) (*RejectRemediationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectRemediation not implemented")
+}
 func (UnimplementedProfileServiceServer) ListRuleTypes(context.Context, *ListRuleTypesRequest) (*ListRuleTypesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListRuleTypes not implemented")
 }
@@ -1331,6 +1909,60 @@ func _ProfileService_GetProfileStatusByProject_Handler(srv interface{}, ctx cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProfileService_ListRemediationApprovals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRemediationApprovalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServiceServer).ListRemediationApprovals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProfileService_ListRemediationApprovals_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServiceServer).ListRemediationApprovals(ctx, req.(*ListRemediationApprovalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfileService_ApproveRemediation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveRemediationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServiceServer).ApproveRemediation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProfileService_ApproveRemediation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServiceServer).ApproveRemediation(ctx, req.(*ApproveRemediationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfileService_RejectRemediation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectRemediationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServiceServer).RejectRemediation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProfileService_RejectRemediation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServiceServer).RejectRemediation(ctx, req.(*RejectRemediationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ProfileService_ListRuleTypes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListRuleTypesRequest)
 	if err := dec(in); err != nil {
@@ -1474,6 +2106,18 @@ var ProfileService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetProfileStatusByProject",
 			Handler:    _ProfileService_GetProfileStatusByProject_Handler,
 		},
+		{
+			MethodName: "ListRemediationApprovals",
+			Handler:    _ProfileService_ListRemediationApprovals_Handler,
+		},
+		{
+			MethodName: "ApproveRemediation",
+			Handler:    _ProfileService_ApproveRemediation_Handler,
+		},
+		{
+			MethodName: "RejectRemediation",
+			Handler:    _ProfileService_RejectRemediation_Handler,
+		},
 		{
 			MethodName: "ListRuleTypes",
 			Handler:    _ProfileService_ListRuleTypes_Handler,
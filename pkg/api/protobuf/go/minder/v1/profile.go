@@ -13,3 +13,41 @@
 // limitations under the License.
 
 package v1
+
+import "google.golang.org/protobuf/proto"
+
+// GetRuleRemediate returns the remediate setting in effect for the given rule of the
+// profile, which is the rule's own setting if it overrides the one of the profile
+func (p *Profile) GetRuleRemediate(r *Profile_Rule) string {
+	if rem := r.GetRemediate(); rem != "" {
+		return rem
+	}
+	return p.GetRemediate()
+}
+
+// GetRuleAlert returns the alert setting in effect for the given rule of the profile,
+// which is the rule's own setting if it overrides the one of the profile
+func (p *Profile) GetRuleAlert(r *Profile_Rule) string {
+	if alert := r.GetAlert(); alert != "" {
+		return alert
+	}
+	return p.GetAlert()
+}
+
+// ForRule returns the profile as seen by the given rule, that is, with the remediate
+// and alert settings overridden by the rule if it sets them. The profile is copied
+// only if the rule overrides any of them.
+func (p *Profile) ForRule(r *Profile_Rule) *Profile {
+	if r.GetRemediate() == "" && r.GetAlert() == "" {
+		return p
+	}
+
+	rp := proto.Clone(p).(*Profile)
+	if rem := r.GetRemediate(); rem != "" {
+		rp.Remediate = proto.String(rem)
+	}
+	if alert := r.GetAlert(); alert != "" {
+		rp.Alert = proto.String(alert)
+	}
+	return rp
+}
@@ -17,6 +17,8 @@ package v1
 import (
 	"errors"
 	"fmt"
+	"net/url"
+	"strings"
 )
 
 var (
@@ -53,6 +55,49 @@ func (rpcfg *RESTProviderConfig) Validate() error {
 	return nil
 }
 
+// ensure OCIProviderConfig implements the Validator interface
+var _ Validator = (*OCIProviderConfig)(nil)
+
+// Validate is a utility function which allows for the validation of a struct.
+func (ocicfg *OCIProviderConfig) Validate() error {
+	if ocicfg.GetRegistryUrl() == "" {
+		return fmt.Errorf("registry_url is required")
+	}
+
+	for _, mapping := range ocicfg.GetImageRepositories() {
+		if mapping.GetImage() == "" {
+			return fmt.Errorf("image is required in image_repositories")
+		}
+		owner, name, ok := strings.Cut(mapping.GetRepository(), "/")
+		if !ok || owner == "" || name == "" || strings.Contains(name, "/") {
+			return fmt.Errorf("repository of image %s must be in the owner/name format", mapping.GetImage())
+		}
+	}
+
+	return nil
+}
+
+// ensure SigstoreTrustConfig implements the Validator interface
+var _ Validator = (*SigstoreTrustConfig)(nil)
+
+// Validate is a utility function which allows for the validation of a struct.
+func (stc *SigstoreTrustConfig) Validate() error {
+	if stc.GetTufMirror() != "" && stc.GetTufRoot() == "" {
+		return fmt.Errorf("tuf_root is required when tuf_mirror is set")
+	}
+
+	if stc.GetOffline() && (stc.GetTufMirror() != "" || stc.GetRekorUrl() != "") {
+		return fmt.Errorf("tuf_mirror and rekor_url cannot be set in offline mode")
+	}
+
+	// a private instance never falls back to the trust roots of the public good one
+	if stc.GetTufMirror() == "" && (stc.GetFulcioRoots() == "" || len(stc.GetRekorPublicKeys()) == 0) {
+		return fmt.Errorf("fulcio_roots and rekor_public_keys are required unless tuf_mirror is set")
+	}
+
+	return nil
+}
+
 // Ensure Entity implements the Validator interface
 var _ Validator = (*Entity)(nil)
 
@@ -204,6 +249,20 @@ func (prRem *RuleType_Definition_Remediate_PullRequestRemediation) Validate() er
 		}
 	}
 
+	switch prRem.GetCommitMethod() {
+	case "", "git", "api":
+	default:
+		return fmt.Errorf("invalid commit method: %s", prRem.GetCommitMethod())
+	}
+
+	if prRem.GetBatch() && prRem.GetCommitMethod() == "git" {
+		return errors.New("batched pull requests are committed through the api commit method")
+	}
+
+	if prRem.CommitAuthor != nil && (prRem.CommitAuthor.Name == "" || prRem.CommitAuthor.Email == "") {
+		return errors.New("commit author requires a name and an email")
+	}
+
 	return nil
 }
 
@@ -215,8 +274,43 @@ func (prContent *RuleType_Definition_Remediate_PullRequestRemediation_Content) V
 		return fmt.Errorf("content path is required")
 	}
 
-	if prContent.Content == "" {
-		return fmt.Errorf("content is required")
+	switch prContent.Action {
+	case "", "replace", "patch", "jsonpatch", "jq":
+		if prContent.Content == "" {
+			return fmt.Errorf("content is required")
+		}
+	case "delete":
+	default:
+		return fmt.Errorf("invalid content action: %s", prContent.Action)
+	}
+
+	return nil
+}
+
+var _ Validator = (*RuleType_Definition_Alert_AlertTypeWebhook)(nil)
+
+// MaxWebhookRetries is the maximum number of times the delivery of a webhook alert is retried
+const MaxWebhookRetries = 5
+
+// Validate validates a webhook alert
+func (wh *RuleType_Definition_Alert_AlertTypeWebhook) Validate() error {
+	if wh == nil {
+		return errors.New("webhook alert is nil")
+	}
+
+	u, err := url.Parse(wh.Url)
+	if err != nil || u.Scheme != "https" || u.Host == "" {
+		return fmt.Errorf("invalid webhook url, an https url is required: %s", wh.Url)
+	}
+
+	switch wh.Format {
+	case "", "json", "cloudevents":
+	default:
+		return fmt.Errorf("invalid webhook format: %s", wh.Format)
+	}
+
+	if wh.GetMaxRetries() < 0 || wh.GetMaxRetries() > MaxWebhookRetries {
+		return fmt.Errorf("max retries must be between 0 and %d", MaxWebhookRetries)
 	}
 
 	return nil
@@ -25,6 +25,8 @@ import (
 
 	"github.com/go-git/go-git/v5"
 	"github.com/go-playground/validator/v10"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
 	"github.com/google/go-github/v53/github"
 
 	minderv1 "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
@@ -71,6 +73,26 @@ type RepoLister interface {
 	ListOrganizationRepsitories(context.Context, string) ([]*minderv1.Repository, error)
 }
 
+// OCI is the interface for interacting with OCI registries
+type OCI interface {
+	Provider
+
+	// GetRegistry returns the host of the registry
+	GetRegistry() string
+
+	// ListRepositories lists the image repositories of the registry the provider has access to
+	ListRepositories(ctx context.Context) ([]string, error)
+
+	// ListTags lists the tags of an image repository
+	ListTags(ctx context.Context, repository string) ([]string, error)
+
+	// GetReference returns the reference to a tag or digest of an image repository
+	GetReference(repository string, reference string) (name.Reference, error)
+
+	// GetRemoteOptions returns the options to access the registry with go-containerregistry
+	GetRemoteOptions(ctx context.Context) []remote.Option
+}
+
 // GitHub is the interface for interacting with the GitHub REST API
 // Add methods here for interacting with the GitHub Rest API
 type GitHub interface {
@@ -83,6 +105,10 @@ type GitHub interface {
 	ListAllRepositories(context.Context, bool, string) ([]*github.Repository, error)
 	GetBranchProtection(context.Context, string, string, string) (*github.Protection, error)
 	UpdateBranchProtection(context.Context, string, string, string, *github.ProtectionRequest) error
+	ListRulesets(ctx context.Context, owner, repo string, includeParents bool) ([]json.RawMessage, error)
+	GetRuleset(ctx context.Context, owner, repo string, id int64, includeParents bool) (json.RawMessage, error)
+	ListOrganizationRulesets(ctx context.Context, org string) ([]json.RawMessage, error)
+	GetOrganizationRuleset(ctx context.Context, org string, id int64) (json.RawMessage, error)
 	ListAllPackages(context.Context, bool, string, string, int, int) ([]*github.Package, error)
 	ListPackagesByRepository(context.Context, bool, string, string, int64, int, int) ([]*github.Package, error)
 	GetPackageByName(context.Context, bool, string, string, string) (*github.Package, error)
@@ -106,14 +132,24 @@ type GitHub interface {
 	CloseSecurityAdvisory(ctx context.Context, owner, repo, id string) error
 	GetRef(ctx context.Context, owner, repo, refString string) (*github.Reference, error)
 	GetCommit(ctx context.Context, owner, repo, commitSHA string) (*github.Commit, error)
+	GetFileContents(ctx context.Context, owner, repo, path, ref string) (string, error)
 	CreateBlob(ctx context.Context, owner, repo string, blob *github.Blob) (*github.Blob, error)
 	CreateTree(ctx context.Context, owner, repo, base string, entries []*github.TreeEntry) (*github.Tree, error)
-	CreateCommit(ctx context.Context, owner, repo, message string, tree *github.Tree, parentSha string) (*github.Commit, error)
+	CreateCommit(ctx context.Context, owner, repo, message string, tree *github.Tree, parentSha string,
+		author *github.CommitAuthor) (*github.Commit, error)
 	CreateRef(ctx context.Context, owner, repo, ref, sha string) (*github.Reference, error)
 	UpdateRef(ctx context.Context, owner, repo, ref, sha string, force bool) (*github.Reference, error)
 	CreatePullRequest(ctx context.Context, owner, repo, title, body, head, base string) (*github.PullRequest, error)
 	ListPullRequests(ctx context.Context, owner, repo string, opt *github.PullRequestListOptions) ([]*github.PullRequest, error)
+	UpdatePullRequest(ctx context.Context, owner, repo string, number int, title, body string) (*github.PullRequest, error)
+	ClosePullRequest(ctx context.Context, owner, repo string, number int) error
+	DeleteRef(ctx context.Context, owner, repo, ref string) error
 	CreateComment(ctx context.Context, owner, repo string, number int, comment string) error
+	CreateIssue(ctx context.Context, owner, repo string, issue *github.IssueRequest) (*github.Issue, error)
+	EditIssue(ctx context.Context, owner, repo string, number int, issue *github.IssueRequest) (*github.Issue, error)
+	ListEnvironments(ctx context.Context, owner, repo string) ([]*github.Environment, error)
+	GetEnvironment(ctx context.Context, owner, repo, name string) (*github.Environment, error)
+	ListEnvironmentSecrets(ctx context.Context, repoID int64, env string) ([]*github.Secret, error)
 }
 
 // ParseAndValidate parses the given provider configuration and validates it.